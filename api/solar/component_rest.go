@@ -6,18 +6,23 @@ package solar
 import (
 	"context"
 
+	"github.com/Masterminds/semver/v3"
 	"go.opendefense.cloud/kit/apiserver/resource"
 	"go.opendefense.cloud/kit/apiserver/rest"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ resource.Object = &Component{}
+var _ resource.ObjectWithStatusSubResource = &Component{}
 var _ rest.PrepareForUpdater = &Component{}
 var _ rest.PrepareForCreater = &Component{}
 var _ rest.TableConverter = &Component{}
+var _ rest.Validater = &Component{}
+var _ rest.ValidateUpdater = &Component{}
 
 func (o *Component) GetObjectMeta() *metav1.ObjectMeta {
 	return &o.ObjectMeta
@@ -39,6 +44,12 @@ func (o *Component) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("components").GroupResource()
 }
 
+func (o *Component) CopyStatusTo(obj runtime.Object) {
+	if obj, ok := obj.(*Component); ok {
+		obj.Status = o.Status
+	}
+}
+
 func (o *Component) PrepareForUpdate(ctx context.Context, old runtime.Object) {
 	or := old.(*Component)
 	incrementGenerationIfNotEqual(o, o.Spec, or.Spec)
@@ -54,8 +65,37 @@ func (o *Component) ConvertToTable(ctx context.Context, tableOptions runtime.Obj
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Registry", Type: "string"},
 			{Name: "Repository", Type: "string"},
+			{Name: "Latest Version", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
-		[]any{o.Name, o.Spec.Registry, o.Spec.Repository, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
+		[]any{o.Name, o.Spec.Registry, o.Spec.Repository, o.Status.LatestVersion, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
 	), nil
 }
+
+func (o *Component) Validate(_ context.Context) field.ErrorList {
+	return validateComponent(o)
+}
+
+func (o *Component) ValidateUpdate(_ context.Context, _ runtime.Object) field.ErrorList {
+	return validateComponent(o)
+}
+
+func validateComponent(o *Component) field.ErrorList {
+	var errs field.ErrorList
+
+	if td := o.Spec.TagDiscovery; td != nil {
+		tdPath := field.NewPath("spec").Child("tagDiscovery")
+
+		if td.Interval != nil && td.Interval.Duration <= 0 {
+			errs = append(errs, field.Invalid(tdPath.Child("interval"), td.Interval.Duration, "interval must be greater than 0"))
+		}
+
+		if td.SemverConstraint != "" {
+			if _, err := semver.NewConstraint(td.SemverConstraint); err != nil {
+				errs = append(errs, field.Invalid(tdPath.Child("semverConstraint"), td.SemverConstraint, err.Error()))
+			}
+		}
+	}
+
+	return errs
+}
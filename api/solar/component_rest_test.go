@@ -0,0 +1,71 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package solar_test
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"go.opendefense.cloud/solar/api/solar"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Component REST", func() {
+	newComponent := func(td *solar.TagDiscovery) *solar.Component {
+		return &solar.Component{
+			Spec: solar.ComponentSpec{
+				Scheme:       "https",
+				Registry:     "registry.example.com",
+				Repository:   "example.com/my-component",
+				TagDiscovery: td,
+			},
+		}
+	}
+
+	Describe("Validate (create path)", func() {
+		It("accepts a component without tag discovery", func() {
+			Expect(newComponent(nil).Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("accepts tag discovery with an interval and a semver constraint", func() {
+			c := newComponent(&solar.TagDiscovery{
+				Interval:         &metav1.Duration{Duration: 10 * time.Minute},
+				SemverConstraint: ">= 1.2, < 2",
+			})
+			Expect(c.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects a non-positive interval", func() {
+			errs := newComponent(&solar.TagDiscovery{
+				Interval: &metav1.Duration{Duration: 0},
+			}).Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.tagDiscovery.interval"))
+		})
+
+		It("rejects an unparsable semver constraint", func() {
+			errs := newComponent(&solar.TagDiscovery{
+				SemverConstraint: "not a constraint",
+			}).Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.tagDiscovery.semverConstraint"))
+		})
+	})
+
+	Describe("ValidateUpdate (update path)", func() {
+		It("rejects the same invalid state as Validate", func() {
+			old := newComponent(nil)
+			updated := old.DeepCopy()
+			updated.Spec.TagDiscovery = &solar.TagDiscovery{SemverConstraint: "~>"}
+
+			errs := updated.ValidateUpdate(context.Background(), old)
+			Expect(errs).NotTo(BeEmpty())
+			Expect(errs[0].Field).To(Equal("spec.tagDiscovery.semverConstraint"))
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 )
 
 var _ resource.Object = &ComponentVersion{}
+var _ resource.ObjectWithStatusSubResource = &ComponentVersion{}
 var _ rest.PrepareForUpdater = &ComponentVersion{}
 var _ rest.PrepareForCreater = &ComponentVersion{}
 var _ rest.TableConverter = &ComponentVersion{}
@@ -39,6 +40,12 @@ func (o *ComponentVersion) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("componentversions").GroupResource()
 }
 
+func (o *ComponentVersion) CopyStatusTo(obj runtime.Object) {
+	if obj, ok := obj.(*ComponentVersion); ok {
+		obj.Status = o.Status
+	}
+}
+
 func (o *ComponentVersion) PrepareForUpdate(ctx context.Context, old runtime.Object) {
 	or := old.(*ComponentVersion)
 	incrementGenerationIfNotEqual(o, o.Spec, or.Spec)
@@ -54,8 +61,18 @@ func (o *ComponentVersion) ConvertToTable(ctx context.Context, tableOptions runt
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Component Ref", Type: "string"},
 			{Name: "Tag", Type: "string"},
+			{Name: "Phase", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
-		[]any{o.Name, o.Spec.ComponentRef.Name, o.Spec.Tag, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
+		[]any{o.Name, o.Spec.ComponentRef.Name, o.Spec.Tag, string(o.phase()), duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
 	), nil
 }
+
+// phase returns the phase of the ComponentVersion, defaulting to Available.
+func (o *ComponentVersion) phase() ComponentVersionPhase {
+	if o.Status.Phase == "" {
+		return ComponentVersionPhaseAvailable
+	}
+
+	return o.Status.Phase
+}
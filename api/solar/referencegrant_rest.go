@@ -5,17 +5,20 @@ package solar
 
 import (
 	"context"
+	"strings"
 
 	"go.opendefense.cloud/kit/apiserver/resource"
 	"go.opendefense.cloud/kit/apiserver/rest"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 var _ resource.Object = &ReferenceGrant{}
 var _ rest.PrepareForUpdater = &ReferenceGrant{}
 var _ rest.PrepareForCreater = &ReferenceGrant{}
+var _ rest.TableConverter = &ReferenceGrant{}
 
 func (o *ReferenceGrant) GetObjectMeta() *metav1.ObjectMeta {
 	return &o.ObjectMeta
@@ -37,6 +40,27 @@ func (o *ReferenceGrant) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("referencegrants").GroupResource()
 }
 
+func (o *ReferenceGrant) ConvertToTable(ctx context.Context, tableOptions runtime.Object) (*metav1.Table, error) {
+	from := make([]string, 0, len(o.Spec.From))
+	for _, f := range o.Spec.From {
+		from = append(from, f.Kind+"/"+f.Namespace)
+	}
+	to := make([]string, 0, len(o.Spec.To))
+	for _, t := range o.Spec.To {
+		to = append(to, t.Kind)
+	}
+
+	return newTable(o,
+		[]metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "From", Type: "string"},
+			{Name: "To", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+		[]any{o.Name, strings.Join(from, ","), strings.Join(to, ","), duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
+	), nil
+}
+
 func (o *ReferenceGrant) PrepareForUpdate(ctx context.Context, old runtime.Object) {
 	or := old.(*ReferenceGrant)
 	incrementGenerationIfNotEqual(o, o.Spec, or.Spec)
@@ -5,23 +5,37 @@ package solar
 
 import (
 	"context"
+	"slices"
+	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	"go.opendefense.cloud/kit/apiserver/resource"
 	"go.opendefense.cloud/kit/apiserver/rest"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"go.opendefense.cloud/solar/pkg/cron"
 )
 
 var _ resource.Object = &Registry{}
+var _ resource.ObjectWithStatusSubResource = &Registry{}
 var _ rest.PrepareForUpdater = &Registry{}
 var _ rest.PrepareForCreater = &Registry{}
 var _ rest.TableConverter = &Registry{}
 var _ rest.Validater = &Registry{}
 var _ rest.ValidateUpdater = &Registry{}
 
+// webhookAuthAlgorithms are the HMAC hash algorithms supported for webhook authentication.
+var webhookAuthAlgorithms = []string{"sha1", "sha256", "sha512"}
+
+// reservedLabelPrefix is the prefix of the ComponentVersion labels set by the
+// discovery worker itself, which label mappings must not overwrite.
+const reservedLabelPrefix = "solar.opendefense.cloud/"
+
 func (o *Registry) GetObjectMeta() *metav1.ObjectMeta {
 	return &o.ObjectMeta
 }
@@ -42,6 +56,12 @@ func (o *Registry) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("registries").GroupResource()
 }
 
+func (o *Registry) CopyStatusTo(obj runtime.Object) {
+	if obj, ok := obj.(*Registry); ok {
+		obj.Status = o.Status
+	}
+}
+
 func (o *Registry) PrepareForUpdate(ctx context.Context, old runtime.Object) {
 	or := old.(*Registry)
 	incrementGenerationIfNotEqual(o, o.Spec, or.Spec)
@@ -89,5 +109,151 @@ func validateRegistry(o *Registry) field.ErrorList {
 		))
 	}
 
+	if o.Spec.ScanSchedule != "" {
+		if _, err := cron.Parse(o.Spec.ScanSchedule); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec").Child("scanSchedule"), o.Spec.ScanSchedule, err.Error()))
+		}
+	}
+
+	if o.Spec.ScanJitter != nil && o.Spec.ScanJitter.Duration < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("spec").Child("scanJitter"), o.Spec.ScanJitter.Duration, "scanJitter must not be negative"))
+	}
+
+	if limits := o.Spec.DiscoveryLimits; limits != nil {
+		limitsPath := field.NewPath("spec").Child("discoveryLimits")
+
+		if limits.RequestInterval != nil && limits.RequestInterval.Duration < 0 {
+			errs = append(errs, field.Invalid(limitsPath.Child("requestInterval"), limits.RequestInterval.Duration, "requestInterval must not be negative"))
+		}
+
+		if limits.Burst < 0 {
+			errs = append(errs, field.Invalid(limitsPath.Child("burst"), limits.Burst, "burst must not be negative"))
+		}
+
+		if limits.MaxConcurrency < 0 {
+			errs = append(errs, field.Invalid(limitsPath.Child("maxConcurrency"), limits.MaxConcurrency, "maxConcurrency must not be negative"))
+		}
+	}
+
+	if ref := o.Spec.PullSecretRef; ref != nil {
+		refPath := field.NewPath("spec").Child("pullSecretRef")
+
+		if o.Spec.SolarSecretRef != nil {
+			errs = append(errs, field.Forbidden(refPath, "pullSecretRef cannot be combined with solarSecretRef"))
+		}
+
+		if ref.Name == "" {
+			errs = append(errs, field.Required(refPath.Child("name"), "pullSecretRef must reference a Secret"))
+		}
+	}
+
+	if tls := o.Spec.TLS; tls != nil {
+		tlsPath := field.NewPath("spec").Child("tls")
+
+		if o.Spec.PlainHTTP {
+			errs = append(errs, field.Forbidden(tlsPath, "tls cannot be combined with plainHTTP"))
+		}
+
+		if tls.CASecretRef != nil && tls.CASecretRef.Name == "" {
+			errs = append(errs, field.Required(tlsPath.Child("caSecretRef").Child("name"), "caSecretRef must reference a Secret"))
+		}
+
+		if tls.ClientCertSecretRef != nil && tls.ClientCertSecretRef.Name == "" {
+			errs = append(errs, field.Required(tlsPath.Child("clientCertSecretRef").Child("name"), "clientCertSecretRef must reference a Secret"))
+		}
+	}
+
+	labels := map[string]bool{}
+	for i, m := range o.Spec.LabelMappings {
+		mappingPath := field.NewPath("spec").Child("labelMappings").Index(i)
+
+		switch {
+		case m.Annotation == "" && m.ComponentLabel == "":
+			errs = append(errs, field.Required(mappingPath, "one of annotation and componentLabel must be set"))
+		case m.Annotation != "" && m.ComponentLabel != "":
+			errs = append(errs, field.Forbidden(mappingPath, "only one of annotation and componentLabel may be set"))
+		}
+
+		for _, msg := range validation.IsQualifiedName(m.Label) {
+			errs = append(errs, field.Invalid(mappingPath.Child("label"), m.Label, msg))
+		}
+		if strings.HasPrefix(m.Label, reservedLabelPrefix) {
+			errs = append(errs, field.Invalid(mappingPath.Child("label"), m.Label, "labels with the prefix "+reservedLabelPrefix+" are reserved"))
+		}
+		if labels[m.Label] {
+			errs = append(errs, field.Duplicate(mappingPath.Child("label"), m.Label))
+		}
+		labels[m.Label] = true
+	}
+
+	if policy := o.Spec.VersionPolicy; policy != nil {
+		policyPath := field.NewPath("spec").Child("versionPolicy")
+
+		if policy.SemverConstraint != "" {
+			if _, err := semver.NewConstraint(policy.SemverConstraint); err != nil {
+				errs = append(errs, field.Invalid(policyPath.Child("semverConstraint"), policy.SemverConstraint, err.Error()))
+			}
+		}
+
+		channels := map[string]bool{}
+		for i, c := range policy.Channels {
+			namePath := policyPath.Child("channels").Index(i).Child("name")
+
+			if c.Name == "" {
+				errs = append(errs, field.Required(namePath, "channel name must be set"))
+				continue
+			}
+			for _, msg := range validation.IsValidLabelValue(c.Name) {
+				errs = append(errs, field.Invalid(namePath, c.Name, msg))
+			}
+			if channels[c.Name] {
+				errs = append(errs, field.Duplicate(namePath, c.Name))
+			}
+			channels[c.Name] = true
+		}
+	}
+
+	if filter := o.Spec.RepositoryFilter; filter != nil {
+		filterPath := field.NewPath("spec").Child("repositoryFilter")
+
+		for i, prefix := range filter.IncludePrefixes {
+			if prefix == "" {
+				errs = append(errs, field.Required(filterPath.Child("includePrefixes").Index(i), "prefix must not be empty"))
+			}
+		}
+
+		for i, prefix := range filter.ExcludePrefixes {
+			if prefix == "" {
+				errs = append(errs, field.Required(filterPath.Child("excludePrefixes").Index(i), "prefix must not be empty"))
+			}
+		}
+	}
+
+	if auth := o.Spec.WebhookAuth; auth != nil {
+		authPath := field.NewPath("spec").Child("webhookAuth")
+
+		if o.Spec.WebhookPath == "" {
+			errs = append(errs, field.Forbidden(authPath, "webhookAuth requires webhookPath to be set"))
+		}
+
+		switch auth.Type {
+		case WebhookAuthTypeHMAC:
+			if auth.Algorithm != "" && !slices.Contains(webhookAuthAlgorithms, auth.Algorithm) {
+				errs = append(errs, field.NotSupported(authPath.Child("algorithm"), auth.Algorithm, webhookAuthAlgorithms))
+			}
+		case WebhookAuthTypeBearer:
+			if auth.SignatureHeader != "" || auth.Algorithm != "" {
+				errs = append(errs, field.Forbidden(authPath, "signatureHeader and algorithm are only supported for type HMAC"))
+			}
+		default:
+			errs = append(errs, field.NotSupported(authPath.Child("type"), auth.Type,
+				[]WebhookAuthType{WebhookAuthTypeHMAC, WebhookAuthTypeBearer}))
+		}
+
+		if auth.SecretRef.Name == "" {
+			errs = append(errs, field.Required(authPath.Child("secretRef").Child("name"), "secretRef must reference a Secret"))
+		}
+	}
+
 	return errs
 }
@@ -5,7 +5,9 @@ package solar_test
 
 import (
 	"context"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"go.opendefense.cloud/solar/api/solar"
@@ -67,6 +69,279 @@ var _ = Describe("Registry REST", func() {
 			}
 			Expect(r.Validate(context.Background())).To(BeEmpty())
 		})
+
+		It("accepts a scanSchedule with a scanJitter", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname:     "registry.example.com:5000",
+					ScanSchedule: "0 2 * * 1-5",
+					ScanJitter:   &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects an invalid scanSchedule and a negative scanJitter", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname:     "registry.example.com:5000",
+					ScanSchedule: "0 25 * * *",
+					ScanJitter:   &metav1.Duration{Duration: -time.Minute},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("spec.scanSchedule"))
+			Expect(errs[1].Field).To(Equal("spec.scanJitter"))
+		})
+
+		It("accepts labelMappings", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					LabelMappings: []solar.LabelMapping{
+						{Annotation: "org.opencontainers.image.vendor", Label: "catalog.example.com/vendor"},
+						{ComponentLabel: "team", Label: "team"},
+					},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects invalid labelMappings", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					LabelMappings: []solar.LabelMapping{
+						{Label: "vendor"},
+						{Annotation: "a", ComponentLabel: "b", Label: "not a label"},
+						{ComponentLabel: "digest", Label: "solar.opendefense.cloud/digest"},
+						{ComponentLabel: "team", Label: "vendor"},
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(5))
+			Expect(errs[0].Field).To(Equal("spec.labelMappings[0]"))
+			Expect(errs[1].Field).To(Equal("spec.labelMappings[1]"))
+			Expect(errs[2].Field).To(Equal("spec.labelMappings[1].label"))
+			Expect(errs[3].Field).To(Equal("spec.labelMappings[2].label"))
+			Expect(errs[4].Field).To(Equal("spec.labelMappings[3].label"))
+		})
+
+		It("accepts a versionPolicy", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					VersionPolicy: &solar.VersionPolicy{
+						SemverConstraint: ">= 1.0.0-0",
+						Channels: []solar.VersionChannel{
+							{Name: "stable"},
+							{Name: "edge", Prerelease: []string{"rc", "beta"}},
+						},
+					},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects an invalid versionPolicy", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					VersionPolicy: &solar.VersionPolicy{
+						SemverConstraint: "not a constraint",
+						Channels: []solar.VersionChannel{
+							{},
+							{Name: "not a label value"},
+							{Name: "edge"},
+							{Name: "edge", Prerelease: []string{"*"}},
+						},
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(4))
+			Expect(errs[0].Field).To(Equal("spec.versionPolicy.semverConstraint"))
+			Expect(errs[1].Field).To(Equal("spec.versionPolicy.channels[0].name"))
+			Expect(errs[2].Field).To(Equal("spec.versionPolicy.channels[1].name"))
+			Expect(errs[3].Field).To(Equal("spec.versionPolicy.channels[3].name"))
+		})
+
+		It("rejects empty repositoryFilter prefixes", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					RepositoryFilter: &solar.RepositoryFilter{
+						IncludePrefixes: []string{"component-descriptors/", ""},
+						ExcludePrefixes: []string{""},
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("spec.repositoryFilter.includePrefixes[1]"))
+			Expect(errs[1].Field).To(Equal("spec.repositoryFilter.excludePrefixes[0]"))
+		})
+
+		It("accepts discoveryLimits", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					DiscoveryLimits: &solar.DiscoveryLimits{
+						RequestInterval: &metav1.Duration{Duration: time.Second},
+						Burst:           5,
+						MaxConcurrency:  4,
+					},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects negative discoveryLimits", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					DiscoveryLimits: &solar.DiscoveryLimits{
+						RequestInterval: &metav1.Duration{Duration: -time.Second},
+						Burst:           -1,
+						MaxConcurrency:  -1,
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field).To(Equal("spec.discoveryLimits.requestInterval"))
+			Expect(errs[1].Field).To(Equal("spec.discoveryLimits.burst"))
+			Expect(errs[2].Field).To(Equal("spec.discoveryLimits.maxConcurrency"))
+		})
+
+		It("accepts tls settings", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname: "registry.example.com:5000",
+					TLS: &solar.RegistryTLS{
+						CASecretRef:         &corev1.LocalObjectReference{Name: "registry-ca"},
+						ClientCertSecretRef: &corev1.LocalObjectReference{Name: "registry-client"},
+					},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects tls with plainHTTP or empty secret refs", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname:  "registry.example.com:5000",
+					PlainHTTP: true,
+					TLS: &solar.RegistryTLS{
+						CASecretRef:         &corev1.LocalObjectReference{},
+						ClientCertSecretRef: &corev1.LocalObjectReference{},
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field).To(Equal("spec.tls"))
+			Expect(errs[1].Field).To(Equal("spec.tls.caSecretRef.name"))
+			Expect(errs[2].Field).To(Equal("spec.tls.clientCertSecretRef.name"))
+		})
+
+		It("accepts a pullSecretRef", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname:      "registry.example.com:5000",
+					PullSecretRef: &corev1.SecretReference{Name: "regcred", Namespace: "shared"},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects a pullSecretRef combined with a solarSecretRef or without a name", func() {
+			r := &solar.Registry{
+				Spec: solar.RegistrySpec{
+					Hostname:       "registry.example.com:5000",
+					SolarSecretRef: &corev1.LocalObjectReference{Name: "registry-auth"},
+					PullSecretRef:  &corev1.SecretReference{Namespace: "shared"},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("spec.pullSecretRef"))
+			Expect(errs[1].Field).To(Equal("spec.pullSecretRef.name"))
+		})
+
+		Describe("webhookAuth", func() {
+			newRegistry := func(auth *solar.WebhookAuth) *solar.Registry {
+				return &solar.Registry{
+					Spec: solar.RegistrySpec{
+						Hostname:    "registry.example.com:5000",
+						WebhookPath: "my-registry",
+						Flavor:      "zot",
+						WebhookAuth: auth,
+					},
+				}
+			}
+
+			It("accepts HMAC and bearer authentication", func() {
+				Expect(newRegistry(&solar.WebhookAuth{
+					Type:            solar.WebhookAuthTypeHMAC,
+					SecretRef:       corev1.LocalObjectReference{Name: "webhook-secret"},
+					SignatureHeader: "X-Signature",
+					Algorithm:       "sha512",
+				}).Validate(context.Background())).To(BeEmpty())
+				Expect(newRegistry(&solar.WebhookAuth{
+					Type:      solar.WebhookAuthTypeBearer,
+					SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+				}).Validate(context.Background())).To(BeEmpty())
+			})
+
+			It("rejects an unknown type", func() {
+				errs := newRegistry(&solar.WebhookAuth{
+					Type:      "Basic",
+					SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+				}).Validate(context.Background())
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Field).To(Equal("spec.webhookAuth.type"))
+			})
+
+			It("rejects an unsupported algorithm", func() {
+				errs := newRegistry(&solar.WebhookAuth{
+					Type:      solar.WebhookAuthTypeHMAC,
+					SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+					Algorithm: "md5",
+				}).Validate(context.Background())
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Field).To(Equal("spec.webhookAuth.algorithm"))
+			})
+
+			It("rejects HMAC settings for bearer authentication", func() {
+				errs := newRegistry(&solar.WebhookAuth{
+					Type:      solar.WebhookAuthTypeBearer,
+					SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+					Algorithm: "sha256",
+				}).Validate(context.Background())
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Field).To(Equal("spec.webhookAuth"))
+			})
+
+			It("requires a secretRef", func() {
+				errs := newRegistry(&solar.WebhookAuth{Type: solar.WebhookAuthTypeBearer}).Validate(context.Background())
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Field).To(Equal("spec.webhookAuth.secretRef.name"))
+			})
+
+			It("rejects webhookAuth without a webhookPath", func() {
+				r := newRegistry(&solar.WebhookAuth{
+					Type:      solar.WebhookAuthTypeBearer,
+					SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+				})
+				r.Spec.WebhookPath = ""
+				errs := r.Validate(context.Background())
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Field).To(Equal("spec.webhookAuth"))
+			})
+		})
 	})
 
 	Describe("ValidateUpdate (update path)", func() {
@@ -22,6 +22,12 @@ type RegistrySpec struct {
 	// is used as a render target.
 	// +optional
 	SolarSecretRef *corev1.LocalObjectReference `json:"solarSecretRef,omitempty"`
+	// PullSecretRef references a Secret of type kubernetes.io/dockerconfigjson
+	// the discovery worker reads the credentials for Hostname from. Namespace
+	// defaults to the namespace of the Registry. Changes to the Secret are
+	// picked up without a restart. Cannot be combined with SolarSecretRef.
+	// +optional
+	PullSecretRef *corev1.SecretReference `json:"pullSecretRef,omitempty"`
 	// TargetPullSecretName is the name of the Secret on the target cluster that
 	// contains credentials to pull from this registry. SolAr renders this name
 	// into target manifests (e.g. Flux OCIRepository.spec.secretRef.name) but
@@ -29,7 +35,7 @@ type RegistrySpec struct {
 	// Secret with this name on each target. Omit for anonymous pull.
 	// +optional
 	TargetPullSecretName string `json:"targetPullSecretName,omitempty"`
-	// Flavor identifies the registry type for discovery webhook routing (e.g. "zot").
+	// Flavor identifies the registry type for discovery webhook routing (e.g. "zot", "harbor", "cloudevents").
 	// Required when WebhookPath is set.
 	// +optional
 	Flavor string `json:"flavor,omitempty"`
@@ -39,9 +45,179 @@ type RegistrySpec struct {
 	// +optional
 	WebhookPath string `json:"webhookPath,omitempty"`
 	// ScanInterval controls how often the discovery worker performs a full scan
-	// of this registry. Leave unset to disable scan mode entirely.
+	// of this registry. Leave unset and ScanSchedule empty to disable scan mode
+	// entirely.
 	// +optional
 	ScanInterval *metav1.Duration `json:"scanInterval,omitempty"`
+	// ScanSchedule is a cron expression of the five fields minute, hour, day of
+	// month, month and day of week, e.g. "0 2 * * *", evaluated in UTC. When
+	// set, full scans run at the scheduled times instead of every ScanInterval.
+	// +optional
+	ScanSchedule string `json:"scanSchedule,omitempty"`
+	// ScanJitter delays every scan of this registry by a random duration of up
+	// to ScanJitter, so registries sharing an interval or schedule do not all
+	// put load on their backends at the same moment.
+	// +optional
+	ScanJitter *metav1.Duration `json:"scanJitter,omitempty"`
+	// WebhookAuth configures how requests to WebhookPath are authenticated.
+	// Leave unset to accept unauthenticated webhook requests.
+	// +optional
+	WebhookAuth *WebhookAuth `json:"webhookAuth,omitempty"`
+	// DiscoveryLimits bounds the load the discovery worker puts on this
+	// registry. Leave unset to process its events one at a time without rate
+	// limiting.
+	// +optional
+	DiscoveryLimits *DiscoveryLimits `json:"discoveryLimits,omitempty"`
+	// TLS configures the certificates the discovery worker uses when
+	// connecting to this registry. Leave unset to verify the registry against
+	// the system trust store without presenting a client certificate.
+	// +optional
+	TLS *RegistryTLS `json:"tls,omitempty"`
+	// LabelMappings copy OCI manifest annotations and OCM component labels of
+	// the component versions discovered in this registry into labels of their
+	// ComponentVersions, so the catalog can be filtered by them.
+	// +listType=atomic
+	// +optional
+	LabelMappings []LabelMapping `json:"labelMappings,omitempty"`
+	// VersionPolicy selects which component versions discovered in this
+	// registry qualify and assigns them to release channels. Leave unset to
+	// discover every version without a channel.
+	// +optional
+	VersionPolicy *VersionPolicy `json:"versionPolicy,omitempty"`
+	// RepositoryFilter restricts discovery to the repositories of this
+	// registry matching its path prefixes, e.g. to skip unrelated images
+	// stored next to the component descriptors. Leave unset to discover
+	// every repository.
+	// +optional
+	RepositoryFilter *RepositoryFilter `json:"repositoryFilter,omitempty"`
+}
+
+// DiscoveryLimits bounds how the discovery worker processes events of a Registry.
+// Events of different registries are processed independently of each other.
+type DiscoveryLimits struct {
+	// RequestInterval is the minimum time between two events of this registry
+	// being processed. Leave unset to disable rate limiting.
+	// +optional
+	RequestInterval *metav1.Duration `json:"requestInterval,omitempty"`
+	// Burst is the number of events that may be processed in quick succession
+	// before RequestInterval applies. Defaults to 1.
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+	// MaxConcurrency is the number of repositories of this registry processed
+	// in parallel. Events of a repository are always processed in order.
+	// Defaults to the number of registry workers of the discovery worker.
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+}
+
+// LabelMapping copies a piece of metadata of discovered component versions
+// into a label of their ComponentVersions. Exactly one of Annotation and
+// ComponentLabel must be set.
+type LabelMapping struct {
+	// Annotation is the OCI manifest annotation of the component descriptor to
+	// copy, e.g. "org.opencontainers.image.vendor".
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+	// ComponentLabel is the name of the OCM component label to copy.
+	// +optional
+	ComponentLabel string `json:"componentLabel,omitempty"`
+	// Label is the key of the ComponentVersion label the value is copied to,
+	// e.g. "catalog.example.com/vendor". Values that are not valid label
+	// values are skipped.
+	Label string `json:"label"`
+}
+
+// VersionPolicy selects the component versions discovered in a Registry and
+// maps them to release channels.
+type VersionPolicy struct {
+	// SemverConstraint restricts discovery to versions that are valid semantic
+	// versions matching the constraint, e.g. ">= 1.2, < 2". Prerelease
+	// versions only match constraints that contain a prerelease themselves,
+	// e.g. ">= 1.2.0-0". If empty, every version is discovered.
+	// +optional
+	SemverConstraint string `json:"semverConstraint,omitempty"`
+	// Channels map qualifying versions to release channels by their
+	// prerelease identifiers. A version is assigned to the first channel it
+	// matches and to no channel if it matches none.
+	// +listType=atomic
+	// +optional
+	Channels []VersionChannel `json:"channels,omitempty"`
+}
+
+// VersionChannel is a release channel, such as "stable" or "edge", of the
+// component versions discovered in a Registry.
+type VersionChannel struct {
+	// Name is the name of the channel. It is copied into the
+	// solar.opendefense.cloud/channel label of the ComponentVersions in the
+	// channel, so it must be a valid label value.
+	Name string `json:"name"`
+	// Prerelease lists the first prerelease identifiers of the versions in
+	// this channel, e.g. "rc" for "1.2.0-rc.1". "*" matches any prerelease.
+	// Leave empty to match versions without a prerelease.
+	// +listType=atomic
+	// +optional
+	Prerelease []string `json:"prerelease,omitempty"`
+}
+
+// RepositoryFilter selects the repositories of a Registry by their path
+// prefixes. Prefixes are matched against the repository names as the
+// registry reports them, e.g. "component-descriptors/example.com/app".
+type RepositoryFilter struct {
+	// IncludePrefixes lists the prefixes of the repositories to discover,
+	// e.g. "component-descriptors/". If empty, every repository is included.
+	// +listType=atomic
+	// +optional
+	IncludePrefixes []string `json:"includePrefixes,omitempty"`
+	// ExcludePrefixes lists the prefixes of the repositories to skip. They
+	// take precedence over IncludePrefixes.
+	// +listType=atomic
+	// +optional
+	ExcludePrefixes []string `json:"excludePrefixes,omitempty"`
+}
+
+// RegistryTLS configures TLS for connections from the discovery worker to a Registry.
+type RegistryTLS struct {
+	// CASecretRef references a Secret in the same namespace holding a PEM
+	// encoded CA bundle under the key "ca.crt". The bundle is trusted in
+	// addition to the system trust store.
+	// +optional
+	CASecretRef *corev1.LocalObjectReference `json:"caSecretRef,omitempty"`
+	// ClientCertSecretRef references a Secret in the same namespace holding a
+	// client certificate and key under the keys "tls.crt" and "tls.key", as
+	// found in Secrets of type kubernetes.io/tls.
+	// +optional
+	ClientCertSecretRef *corev1.LocalObjectReference `json:"clientCertSecretRef,omitempty"`
+	// InsecureSkipVerify disables verification of the registry's certificate.
+	// Only use this for testing.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// WebhookAuthType is the authentication scheme used for registry webhooks.
+type WebhookAuthType string
+
+const (
+	// WebhookAuthTypeHMAC verifies an HMAC signature of the request body sent in a header.
+	WebhookAuthTypeHMAC WebhookAuthType = "HMAC"
+	// WebhookAuthTypeBearer expects the shared secret as bearer token in the Authorization header.
+	WebhookAuthTypeBearer WebhookAuthType = "Bearer"
+)
+
+// WebhookAuth configures authentication of incoming webhook requests for a Registry.
+type WebhookAuth struct {
+	// Type is the authentication scheme, either "HMAC" or "Bearer".
+	Type WebhookAuthType `json:"type"`
+	// SecretRef references a Secret in the same namespace holding the shared
+	// secret under the key "token".
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// SignatureHeader is the request header carrying the HMAC signature.
+	// Only used for type HMAC. Defaults to "X-Hub-Signature-256".
+	// +optional
+	SignatureHeader string `json:"signatureHeader,omitempty"`
+	// Algorithm is the HMAC hash algorithm, one of "sha1", "sha256" or "sha512".
+	// Only used for type HMAC. Defaults to "sha256".
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
 }
 
 // RegistryStatus defines the observed state of a Registry.
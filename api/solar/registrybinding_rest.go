@@ -12,12 +12,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ resource.Object = &RegistryBinding{}
+var _ resource.ObjectWithStatusSubResource = &RegistryBinding{}
 var _ rest.PrepareForUpdater = &RegistryBinding{}
 var _ rest.PrepareForCreater = &RegistryBinding{}
 var _ rest.TableConverter = &RegistryBinding{}
+var _ rest.Validater = &RegistryBinding{}
+var _ rest.ValidateUpdater = &RegistryBinding{}
 
 func (o *RegistryBinding) GetObjectMeta() *metav1.ObjectMeta {
 	return &o.ObjectMeta
@@ -39,6 +43,12 @@ func (o *RegistryBinding) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("registrybindings").GroupResource()
 }
 
+func (o *RegistryBinding) CopyStatusTo(obj runtime.Object) {
+	if obj, ok := obj.(*RegistryBinding); ok {
+		obj.Status = o.Status
+	}
+}
+
 func (o *RegistryBinding) PrepareForUpdate(ctx context.Context, old runtime.Object) {
 	or := old.(*RegistryBinding)
 	incrementGenerationIfNotEqual(o, o.Spec, or.Spec)
@@ -48,14 +58,45 @@ func (o *RegistryBinding) PrepareForCreate(ctx context.Context) {
 	o.Generation = 1
 }
 
+func (o *RegistryBinding) Validate(_ context.Context) field.ErrorList {
+	return validateRegistryBinding(o)
+}
+
+func (o *RegistryBinding) ValidateUpdate(_ context.Context, _ runtime.Object) field.ErrorList {
+	return validateRegistryBinding(o)
+}
+
+func validateRegistryBinding(o *RegistryBinding) field.ErrorList {
+	var errs field.ErrorList
+
+	switch o.Spec.Role {
+	case "", RegistryBindingRoleSource, RegistryBindingRoleDeploy:
+	default:
+		errs = append(errs, field.NotSupported(field.NewPath("spec").Child("role"), o.Spec.Role,
+			[]RegistryBindingRole{RegistryBindingRoleSource, RegistryBindingRoleDeploy}))
+	}
+
+	return errs
+}
+
+// role returns the role of the binding, defaulting to source.
+func (o *RegistryBinding) role() RegistryBindingRole {
+	if o.Spec.Role == "" {
+		return RegistryBindingRoleSource
+	}
+
+	return o.Spec.Role
+}
+
 func (o *RegistryBinding) ConvertToTable(ctx context.Context, tableOptions runtime.Object) (*metav1.Table, error) {
 	return newTable(o,
 		[]metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Target", Type: "string"},
 			{Name: "Registry", Type: "string"},
+			{Name: "Role", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
-		[]any{o.Name, o.Spec.TargetRef.Name, o.Spec.RegistryRef.Name, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
+		[]any{o.Name, o.Spec.TargetRef.Name, o.Spec.RegistryRef.Name, string(o.role()), duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
 	), nil
 }
@@ -0,0 +1,52 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package solar_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"go.opendefense.cloud/solar/api/solar"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RegistryBinding REST", func() {
+	newBinding := func(role solar.RegistryBindingRole) *solar.RegistryBinding {
+		return &solar.RegistryBinding{
+			Spec: solar.RegistryBindingSpec{
+				TargetRef:   corev1.LocalObjectReference{Name: "prod"},
+				RegistryRef: corev1.LocalObjectReference{Name: "harbor"},
+				Role:        role,
+			},
+		}
+	}
+
+	Describe("Validate (create path)", func() {
+		DescribeTable("accepts supported roles",
+			func(role solar.RegistryBindingRole) {
+				Expect(newBinding(role).Validate(context.Background())).To(BeEmpty())
+			},
+			Entry("default", solar.RegistryBindingRole("")),
+			Entry("source", solar.RegistryBindingRoleSource),
+			Entry("deploy", solar.RegistryBindingRoleDeploy),
+		)
+
+		It("rejects unknown roles", func() {
+			errs := newBinding("mirror").Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.role"))
+		})
+	})
+
+	Describe("ValidateUpdate (update path)", func() {
+		It("rejects the same invalid state as Validate", func() {
+			errs := newBinding("mirror").ValidateUpdate(context.Background(), newBinding(""))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.role"))
+		})
+	})
+})
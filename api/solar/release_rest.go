@@ -5,6 +5,10 @@ package solar
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"go.opendefense.cloud/kit/apiserver/resource"
 	"go.opendefense.cloud/kit/apiserver/rest"
@@ -13,6 +17,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"go.opendefense.cloud/solar/pkg/cron"
 )
 
 var (
@@ -69,14 +75,22 @@ func (o *Release) ConvertToTable(ctx context.Context, tableOptions runtime.Objec
 		}
 	}
 
+	// History is kept newest first, so this is the chart rendered last.
+	chartURL := ""
+	if len(o.Status.History) > 0 {
+		chartURL = o.Status.History[0].ChartURL
+	}
+
 	return newTable(o,
 		[]metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "ComponentVersion Ref", Type: "string"},
+			{Name: "Phase", Type: "string"},
 			{Name: "Status", Type: "string"},
+			{Name: "Chart URL", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
-		[]any{o.Name, o.Spec.ComponentVersionRef.Name, status, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
+		[]any{o.Name, o.Spec.ComponentVersionRef.Name, o.Status.Phase, status, chartURL, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
 	), nil
 }
 
@@ -90,10 +104,33 @@ func (o *Release) ValidateUpdate(ctx context.Context, old runtime.Object) field.
 	if o.Spec.UniqueName != or.Spec.UniqueName {
 		errors = append(errors, field.Forbidden(field.NewPath("spec").Child("uniqueName"), "uniqueName is immutable"))
 	}
+	errors = append(errors, validateComponentVersionUpdate(o, or)...)
 
 	return errors
 }
 
+// validateComponentVersionUpdate rejects changing the ComponentVersion of a
+// Release that has been rendered for a Target, unless its
+// ComponentVersionUpdatePolicy allows it or the update names the new
+// ComponentVersion in AnnotationUpgradeTo. The policy of the old Release
+// applies, so an update cannot relax the policy and change the
+// ComponentVersion at once.
+func validateComponentVersionUpdate(o, old *Release) field.ErrorList {
+	if o.Spec.ComponentVersionRef == old.Spec.ComponentVersionRef &&
+		o.Spec.ComponentVersionNamespace == old.Spec.ComponentVersionNamespace {
+		return nil
+	}
+	if len(old.Status.History) == 0 || old.Spec.ComponentVersionUpdatePolicy == ComponentVersionUpdatePolicyAllow {
+		return nil
+	}
+	if o.Annotations[AnnotationUpgradeTo] == o.Spec.ComponentVersionRef.Name {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(field.NewPath("spec").Child("componentVersionRef"),
+		fmt.Sprintf("the Release has been rendered; set the annotation %s to %q to upgrade it", AnnotationUpgradeTo, o.Spec.ComponentVersionRef.Name))}
+}
+
 func validateRelease(o *Release) field.ErrorList {
 	var errors field.ErrorList
 	if o.Spec.ComponentVersionRef.Name == "" {
@@ -103,5 +140,75 @@ func validateRelease(o *Release) field.ErrorList {
 		))
 	}
 
+	if o.Spec.RollbackTo != nil && *o.Spec.RollbackTo < 1 {
+		errors = append(errors, field.Invalid(field.NewPath("spec").Child("rollbackTo"), *o.Spec.RollbackTo, "rollbackTo must be a revision greater than 0"))
+	}
+
+	if o.Spec.HistoryLimit != nil && *o.Spec.HistoryLimit < 1 {
+		errors = append(errors, field.Invalid(field.NewPath("spec").Child("historyLimit"), *o.Spec.HistoryLimit, "historyLimit must be greater than 0"))
+	}
+
+	switch o.Spec.ComponentVersionUpdatePolicy {
+	case "", ComponentVersionUpdatePolicyUpgrade, ComponentVersionUpdatePolicyAllow:
+	default:
+		errors = append(errors, field.NotSupported(field.NewPath("spec").Child("componentVersionUpdatePolicy"), o.Spec.ComponentVersionUpdatePolicy,
+			[]ComponentVersionUpdatePolicy{ComponentVersionUpdatePolicyUpgrade, ComponentVersionUpdatePolicyAllow}))
+	}
+
+	errors = append(errors, validateRenderJobSettings(field.NewPath("spec"), o.Spec.FailedJobTTL, o.Spec.BackoffLimit, o.Spec.ActiveDeadlineSeconds)...)
+
+	for i, ref := range o.Spec.ValuesFrom {
+		path := field.NewPath("spec").Child("valuesFrom").Index(i)
+		switch {
+		case (ref.ConfigMapKeyRef == nil) == (ref.SecretKeyRef == nil):
+			errors = append(errors, field.Invalid(path, ref, "exactly one of configMapKeyRef and secretKeyRef must be set"))
+		case ref.ConfigMapKeyRef != nil && (ref.ConfigMapKeyRef.Name == "" || ref.ConfigMapKeyRef.Key == ""):
+			errors = append(errors, field.Required(path.Child("configMapKeyRef"), "name and key must not be empty"))
+		case ref.SecretKeyRef != nil && (ref.SecretKeyRef.Name == "" || ref.SecretKeyRef.Key == ""):
+			errors = append(errors, field.Required(path.Child("secretKeyRef"), "name and key must not be empty"))
+		}
+	}
+
+	seen := make(map[string]bool, len(o.Spec.SensitivePaths))
+	for i, p := range o.Spec.SensitivePaths {
+		path := field.NewPath("spec").Child("sensitivePaths").Index(i)
+		switch {
+		case slices.Contains(strings.Split(p, "."), ""):
+			errors = append(errors, field.Invalid(path, p, "must be a dot-separated path of non-empty keys"))
+		case seen[p]:
+			errors = append(errors, field.Duplicate(path, p))
+		}
+		seen[p] = true
+	}
+
+	if o.Spec.Schedule != nil {
+		errors = append(errors, validateReleaseSchedule(field.NewPath("spec").Child("schedule"), o.Spec.Schedule)...)
+	}
+
+	return errors
+}
+
+func validateReleaseSchedule(path *field.Path, s *ReleaseSchedule) field.ErrorList {
+	var errors field.ErrorList
+	if len(s.Windows) == 0 {
+		errors = append(errors, field.Required(path.Child("windows"), "at least one window must be set"))
+	}
+
+	for i, w := range s.Windows {
+		windowPath := path.Child("windows").Index(i)
+		if _, err := cron.Parse(w.Start); err != nil {
+			errors = append(errors, field.Invalid(windowPath.Child("start"), w.Start, err.Error()))
+		}
+		if w.Duration.Duration <= 0 {
+			errors = append(errors, field.Invalid(windowPath.Child("duration"), w.Duration.Duration.String(), "duration must be greater than 0"))
+		}
+	}
+
+	if s.TimeZone != "" {
+		if _, err := time.LoadLocation(s.TimeZone); err != nil {
+			errors = append(errors, field.Invalid(path.Child("timeZone"), s.TimeZone, "unknown time zone"))
+		}
+	}
+
 	return errors
 }
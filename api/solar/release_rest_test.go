@@ -6,8 +6,11 @@ package solar_test
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"go.opendefense.cloud/solar/api/solar"
 
@@ -38,6 +41,182 @@ var _ = Describe("Release REST", func() {
 			}
 			Expect(r.Validate(context.Background())).To(BeEmpty())
 		})
+
+		It("accepts a rollback to a prior revision with a history limit", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					RollbackTo:          new(int64(2)),
+					HistoryLimit:        new(int32(5)),
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects a non-positive rollbackTo", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					RollbackTo:          new(int64(0)),
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.rollbackTo"))
+		})
+
+		It("rejects a non-positive historyLimit", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					HistoryLimit:        new(int32(0)),
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.historyLimit"))
+		})
+
+		It("rejects invalid render job settings", func() {
+			rel := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef:   corev1.LocalObjectReference{Name: "my-cv"},
+					FailedJobTTL:          new(int32(-1)),
+					BackoffLimit:          new(int32(-1)),
+					ActiveDeadlineSeconds: new(int64(0)),
+				},
+			}
+			errs := rel.Validate(context.Background())
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field).To(Equal("spec.failedJobTTL"))
+			Expect(errs[1].Field).To(Equal("spec.backoffLimit"))
+			Expect(errs[2].Field).To(Equal("spec.activeDeadlineSeconds"))
+		})
+
+		It("accepts valuesFrom ConfigMap and Secret keys", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					ValuesFrom: []solar.ValuesReference{
+						{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "env"}, Key: "values.yaml"}},
+						{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "values.yaml"}},
+					},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects valuesFrom entries without exactly one source", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					ValuesFrom: []solar.ValuesReference{
+						{},
+						{
+							ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "env"}, Key: "values.yaml"},
+							SecretKeyRef:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "values.yaml"},
+						},
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("spec.valuesFrom[0]"))
+			Expect(errs[1].Field).To(Equal("spec.valuesFrom[1]"))
+		})
+
+		It("rejects a valuesFrom selector without a key", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					ValuesFrom: []solar.ValuesReference{
+						{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}}},
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.valuesFrom[0].secretKeyRef"))
+		})
+
+		It("rejects an unknown componentVersionUpdatePolicy", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef:          corev1.LocalObjectReference{Name: "kyverno-v1"},
+					ComponentVersionUpdatePolicy: "Never",
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.componentVersionUpdatePolicy"))
+		})
+
+		It("accepts a schedule with maintenance windows", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					Schedule: &solar.ReleaseSchedule{
+						Windows:  []solar.MaintenanceWindow{{Start: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 4 * time.Hour}}},
+						TimeZone: "Europe/Berlin",
+					},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects an invalid schedule", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					Schedule: &solar.ReleaseSchedule{
+						Windows:  []solar.MaintenanceWindow{{Start: "0 25 * * *"}},
+						TimeZone: "Mars/Olympus",
+					},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field).To(Equal("spec.schedule.windows[0].start"))
+			Expect(errs[1].Field).To(Equal("spec.schedule.windows[0].duration"))
+			Expect(errs[2].Field).To(Equal("spec.schedule.timeZone"))
+		})
+
+		It("rejects a schedule without windows", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					Schedule:            &solar.ReleaseSchedule{},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.schedule.windows"))
+		})
+
+		It("accepts sensitive paths", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					SensitivePaths:      []string{"database.password", "apiToken"},
+				},
+			}
+			Expect(r.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects empty and duplicate sensitive paths", func() {
+			r := &solar.Release{
+				Spec: solar.ReleaseSpec{
+					ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					SensitivePaths:      []string{"database..password", "apiToken", "apiToken", ""},
+				},
+			}
+			errs := r.Validate(context.Background())
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field).To(Equal("spec.sensitivePaths[0]"))
+			Expect(errs[1].Field).To(Equal("spec.sensitivePaths[2]"))
+			Expect(errs[1].Type).To(Equal(field.ErrorTypeDuplicate))
+			Expect(errs[2].Field).To(Equal("spec.sensitivePaths[3]"))
+		})
 	})
 
 	Describe("ValidateUpdate (update path)", func() {
@@ -78,6 +257,63 @@ var _ = Describe("Release REST", func() {
 			}
 			Expect(r.ValidateUpdate(context.Background(), r.DeepCopy())).To(BeEmpty())
 		})
+
+		Context("changing the componentVersionRef", func() {
+			var old *solar.Release
+
+			BeforeEach(func() {
+				old = &solar.Release{
+					Spec: solar.ReleaseSpec{
+						ComponentVersionRef: corev1.LocalObjectReference{Name: "kyverno-v1"},
+					},
+					Status: solar.ReleaseStatus{
+						History: []solar.ReleaseRevision{{Revision: 1, ChartURL: "oci://registry.example.com/kyverno:v1"}},
+					},
+				}
+			})
+
+			It("accepts it before the Release has been rendered", func() {
+				old.Status.History = nil
+				updated := old.DeepCopy()
+				updated.Spec.ComponentVersionRef.Name = "kyverno-v2"
+				Expect(updated.ValidateUpdate(context.Background(), old)).To(BeEmpty())
+			})
+
+			It("rejects it after the Release has been rendered", func() {
+				updated := old.DeepCopy()
+				updated.Spec.ComponentVersionRef.Name = "kyverno-v2"
+				errs := updated.ValidateUpdate(context.Background(), old)
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Field).To(Equal("spec.componentVersionRef"))
+				Expect(errs[0].Detail).To(ContainSubstring(solar.AnnotationUpgradeTo))
+			})
+
+			It("rejects a changed componentVersionNamespace after the Release has been rendered", func() {
+				updated := old.DeepCopy()
+				updated.Spec.ComponentVersionNamespace = "catalog"
+				Expect(updated.ValidateUpdate(context.Background(), old)).To(HaveLen(1))
+			})
+
+			It("accepts it with the upgrade annotation naming the new ComponentVersion", func() {
+				updated := old.DeepCopy()
+				updated.Spec.ComponentVersionRef.Name = "kyverno-v2"
+				updated.Annotations = map[string]string{solar.AnnotationUpgradeTo: "kyverno-v1"}
+				Expect(updated.ValidateUpdate(context.Background(), old)).To(HaveLen(1))
+
+				updated.Annotations[solar.AnnotationUpgradeTo] = "kyverno-v2"
+				Expect(updated.ValidateUpdate(context.Background(), old)).To(BeEmpty())
+			})
+
+			It("accepts it if the policy of the old Release allows it", func() {
+				updated := old.DeepCopy()
+				updated.Spec.ComponentVersionRef.Name = "kyverno-v2"
+				updated.Spec.ComponentVersionUpdatePolicy = solar.ComponentVersionUpdatePolicyAllow
+				Expect(updated.ValidateUpdate(context.Background(), old)).To(HaveLen(1))
+
+				old.Spec.ComponentVersionUpdatePolicy = solar.ComponentVersionUpdatePolicyAllow
+				Expect(updated.ValidateUpdate(context.Background(), old)).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("ReleaseSpec JSON", func() {
@@ -36,21 +36,154 @@ type ReleaseSpec struct {
 	// These values override defaults from the component version and are used during deployment.
 	// +optional
 	Values runtime.RawExtension `json:"values,omitempty"`
+	// ValuesFrom lists ConfigMap and Secret keys in the Release's namespace
+	// holding values as YAML. They are merged in the given order, and Values
+	// is merged on top of them. Both are merged on top of the values.yaml key
+	// of the solar-release-defaults ConfigMap of the namespace, if present.
+	// Targets render the Release again when the referenced objects change.
+	// +listType=atomic
+	// +optional
+	ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`
+	// SensitivePaths lists dot-separated paths of the merged values, such as
+	// "database.password", that hold credentials or other secrets. Targets
+	// keep them out of the RenderTask spec and pass them to the render job in
+	// a separate Secret, and their values are replaced by a hash in the
+	// renderer's output, job failure messages and events.
+	// +listType=atomic
+	// +optional
+	SensitivePaths []string `json:"sensitivePaths,omitempty"`
 	// failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up.
 	// After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete
 	// the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately.
-	// If not set, defaults to 3600 (1 hour).
+	// If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.
 	// +optional
 	FailedJobTTL *int32 `json:"failedJobTTL,omitempty"`
+	// BackoffLimit is the number of times a failed renderer pod of a render job
+	// of this Release is retried before the job fails. If not set, the
+	// controller default applies, 3 unless configured otherwise.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is how long a render job of this Release may run
+	// before it is failed. If not set, the controller default applies, which
+	// does not limit the run time unless configured otherwise.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 	// Priority determines which Release takes precedence when multiple Releases
 	// share the same unique name on a Target. Higher values indicate higher priority.
 	// If not set, defaults to 0.
 	// +optional
 	Priority int32 `json:"priority,omitempty"`
+	// RollbackTo is the revision to roll back to, as listed in Status.History.
+	// While set, Targets deploy the chart rendered for that revision instead of
+	// rendering the current spec. Clear it to roll forward again.
+	// +optional
+	RollbackTo *int64 `json:"rollbackTo,omitempty"`
+	// HistoryLimit is the number of rendered revisions kept in Status.History
+	// per Target. The charts of these revisions are retained in the render
+	// registry so they can be rolled back to. If not set, defaults to 10.
+	// +optional
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+	// Suspend stops Targets from rendering this Release. Targets keep the
+	// chart last rendered for the Release, and render the current spec again
+	// once it is resumed.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+	// ComponentVersionUpdatePolicy controls whether ComponentVersionRef and
+	// ComponentVersionNamespace may be changed once the Release has been
+	// rendered for a Target. If not set, defaults to "Upgrade".
+	// +optional
+	ComponentVersionUpdatePolicy ComponentVersionUpdatePolicy `json:"componentVersionUpdatePolicy,omitempty"`
+	// Schedule restricts rendering of this Release to maintenance windows.
+	// Outside of them Targets keep the chart last rendered for the Release,
+	// and render the current spec once the next window opens.
+	// +optional
+	Schedule *ReleaseSchedule `json:"schedule,omitempty"`
+}
+
+// ReleaseSchedule defines the maintenance windows a Release is rendered in.
+type ReleaseSchedule struct {
+	// Windows are the maintenance windows. The Release is rendered while any
+	// of them is open.
+	// +listType=atomic
+	Windows []MaintenanceWindow `json:"windows"`
+	// TimeZone is the IANA name of the time zone the windows are evaluated
+	// in, e.g. "Europe/Berlin". If not set, defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period of time.
+type MaintenanceWindow struct {
+	// Start is a cron expression of the five fields minute, hour, day of
+	// month, month and day of week, giving the times the window opens at,
+	// e.g. "0 22 * * 1-5" for 22:00 on weekdays.
+	Start string `json:"start"`
+	// Duration is how long the window stays open after each start.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ComponentVersionUpdatePolicy controls changes of the ComponentVersion of a
+// rendered Release.
+type ComponentVersionUpdatePolicy string
+
+const (
+	// ComponentVersionUpdatePolicyUpgrade only accepts an update changing the
+	// ComponentVersion of a rendered Release if it sets the
+	// solar.opendefense.cloud/upgrade-to annotation to the name of the new
+	// ComponentVersion.
+	ComponentVersionUpdatePolicyUpgrade ComponentVersionUpdatePolicy = "Upgrade"
+	// ComponentVersionUpdatePolicyAllow accepts changes of the
+	// ComponentVersion at any time.
+	ComponentVersionUpdatePolicyAllow ComponentVersionUpdatePolicy = "Allow"
+)
+
+// AnnotationUpgradeTo confirms changing the ComponentVersion of a rendered
+// Release with the ComponentVersionUpdatePolicy "Upgrade". Its value must be
+// the name of the new ComponentVersion.
+const AnnotationUpgradeTo = "solar.opendefense.cloud/upgrade-to"
+
+// ValuesReference selects a key of a ConfigMap or Secret holding Helm values.
+// Exactly one of ConfigMapKeyRef and SecretKeyRef must be set. Unless the
+// selector is marked optional, a missing object or key blocks rendering.
+type ValuesReference struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
+// ReleasePhase summarizes the state of a Release.
+// +enum
+type ReleasePhase string
+
+const (
+	// ReleasePhasePending means the current generation of the Release has not
+	// been rendered yet, e.g. because it is not bound to a Target or waits for
+	// its maintenance window.
+	ReleasePhasePending ReleasePhase = "Pending"
+	// ReleasePhaseRendering means a render job for the current generation of
+	// the Release is queued or running.
+	ReleasePhaseRendering ReleasePhase = "Rendering"
+	// ReleasePhaseDeployed means a chart of the current generation of the
+	// Release, or of the revision it is rolled back to, has been rendered.
+	ReleasePhaseDeployed ReleasePhase = "Deployed"
+	// ReleasePhaseFailed means the ComponentVersion of the Release cannot be
+	// resolved or a render job for its current generation failed.
+	ReleasePhaseFailed ReleasePhase = "Failed"
+	// ReleasePhaseSuspended means spec.suspend stops Targets from rendering
+	// the Release.
+	ReleasePhaseSuspended ReleasePhase = "Suspended"
+)
+
 // ReleaseStatus defines the observed state of a Release.
 type ReleaseStatus struct {
+	// Phase summarizes the conditions of the Release and the state of its
+	// render jobs.
+	// +optional
+	Phase ReleasePhase `json:"phase,omitempty"`
+
 	// Conditions represent the latest available observations of a Release's state.
 	// +optional
 	// +patchMergeKey=type
@@ -64,6 +197,44 @@ type ReleaseStatus struct {
 	// EffectiveUniqueName is the unique name used for deduplication on Targets.
 	// +optional
 	EffectiveUniqueName string `json:"effectiveUniqueName,omitempty"`
+
+	// History lists the charts rendered for this Release, newest first.
+	// +optional
+	History []ReleaseRevision `json:"history,omitempty"`
+}
+
+// ReleaseRevision records a chart rendered for a revision of a Release on a Target.
+type ReleaseRevision struct {
+	// Revision is the generation of the Release the chart was rendered from.
+	Revision int64 `json:"revision"`
+	// TargetRef is the Target the chart was rendered for.
+	TargetRef corev1.ObjectReference `json:"targetRef"`
+	// ChartURL is the OCI reference of the rendered chart.
+	ChartURL string `json:"chartURL"`
+	// ArtifactName is the name of the RenderArtifact holding the chart, in the
+	// Target's namespace.
+	ArtifactName string `json:"artifactName"`
+	// ComponentVersionRef is the ComponentVersion the chart was rendered from.
+	// +optional
+	ComponentVersionRef *corev1.ObjectReference `json:"componentVersionRef,omitempty"`
+	// ValuesHash is the SHA-256 digest of the values the chart was rendered with.
+	// +optional
+	ValuesHash string `json:"valuesHash,omitempty"`
+	// ChartDigest is the digest of the manifest of the rendered chart, if
+	// reported by the renderer.
+	// +optional
+	ChartDigest string `json:"chartDigest,omitempty"`
+	// ChartSize is the size in bytes of the packaged chart, if reported by the
+	// renderer.
+	// +optional
+	ChartSize int64 `json:"chartSize,omitempty"`
+	// SignatureRef is the OCI reference of the cosign signature of the
+	// rendered chart, if it was signed.
+	// +optional
+	SignatureRef string `json:"signatureRef,omitempty"`
+	// RenderedAt is the time the chart was rendered, or recorded if the time
+	// of rendering is unknown.
+	RenderedAt metav1.Time `json:"renderedAt"`
 }
 
 // +genclient
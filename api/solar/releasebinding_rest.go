@@ -5,6 +5,7 @@ package solar
 
 import (
 	"context"
+	"encoding/json"
 
 	"go.opendefense.cloud/kit/apiserver/resource"
 	"go.opendefense.cloud/kit/apiserver/rest"
@@ -12,12 +13,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ resource.Object = &ReleaseBinding{}
+var _ resource.ObjectWithStatusSubResource = &ReleaseBinding{}
 var _ rest.PrepareForUpdater = &ReleaseBinding{}
 var _ rest.PrepareForCreater = &ReleaseBinding{}
 var _ rest.TableConverter = &ReleaseBinding{}
+var _ rest.Validater = &ReleaseBinding{}
+var _ rest.ValidateUpdater = &ReleaseBinding{}
 
 func (o *ReleaseBinding) GetObjectMeta() *metav1.ObjectMeta {
 	return &o.ObjectMeta
@@ -39,6 +44,12 @@ func (o *ReleaseBinding) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("releasebindings").GroupResource()
 }
 
+func (o *ReleaseBinding) CopyStatusTo(obj runtime.Object) {
+	if obj, ok := obj.(*ReleaseBinding); ok {
+		obj.Status = o.Status
+	}
+}
+
 func (o *ReleaseBinding) PrepareForUpdate(ctx context.Context, old runtime.Object) {
 	or := old.(*ReleaseBinding)
 	incrementGenerationIfNotEqual(o, o.Spec, or.Spec)
@@ -48,6 +59,29 @@ func (o *ReleaseBinding) PrepareForCreate(ctx context.Context) {
 	o.Generation = 1
 }
 
+func (o *ReleaseBinding) Validate(_ context.Context) field.ErrorList {
+	return validateReleaseBinding(o)
+}
+
+func (o *ReleaseBinding) ValidateUpdate(_ context.Context, _ runtime.Object) field.ErrorList {
+	return validateReleaseBinding(o)
+}
+
+func validateReleaseBinding(o *ReleaseBinding) field.ErrorList {
+	var errs field.ErrorList
+
+	// Values are merged into the Release's values key by key, so they must be
+	// an object.
+	if len(o.Spec.Values.Raw) > 0 {
+		var values map[string]any
+		if err := json.Unmarshal(o.Spec.Values.Raw, &values); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec").Child("values"), string(o.Spec.Values.Raw), "values must be an object"))
+		}
+	}
+
+	return errs
+}
+
 func (o *ReleaseBinding) ConvertToTable(ctx context.Context, tableOptions runtime.Object) (*metav1.Table, error) {
 	return newTable(o,
 		[]metav1.TableColumnDefinition{
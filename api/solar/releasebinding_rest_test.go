@@ -0,0 +1,52 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package solar_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"go.opendefense.cloud/solar/api/solar"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReleaseBinding REST", func() {
+	newBinding := func(values string) *solar.ReleaseBinding {
+		return &solar.ReleaseBinding{
+			Spec: solar.ReleaseBindingSpec{
+				TargetRef:  corev1.LocalObjectReference{Name: "prod"},
+				ReleaseRef: corev1.LocalObjectReference{Name: "kyverno"},
+				Values:     runtime.RawExtension{Raw: []byte(values)},
+			},
+		}
+	}
+
+	Describe("Validate (create path)", func() {
+		It("accepts a binding without values", func() {
+			Expect(newBinding("").Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("accepts object values", func() {
+			Expect(newBinding(`{"replicas":3,"ingress":{"host":"prod.example.com"}}`).Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects values that are not an object", func() {
+			errs := newBinding(`[1,2]`).Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.values"))
+		})
+	})
+
+	Describe("ValidateUpdate (update path)", func() {
+		It("rejects the same invalid state as Validate", func() {
+			errs := newBinding(`"replicas"`).ValidateUpdate(context.Background(), newBinding(""))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.values"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ package solar
 import (
 	"os"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -62,6 +63,10 @@ type ResolvedResourceAccess struct {
 	Insecure bool `json:"insecure"`
 	// Tag of the Resource.
 	Tag string `json:"tag"`
+	// Digest of the manifest of the Resource. If set, the Resource is pulled
+	// by digest instead of by Tag.
+	// +optional
+	Digest string `json:"digest,omitempty"`
 	// Helm contains metadata for Helm chart resources, populated during discovery.
 	Helm *HelmResourceMetadata `json:"helm,omitempty"`
 	// PullSecretName is the name of the pull secret on the target cluster for
@@ -101,6 +106,34 @@ type BootstrapInput struct {
 	Userdata runtime.RawExtension `json:"userdata"`
 }
 
+// RenderReport is the outcome of a renderer run. The renderer writes it as
+// JSON to its result file, usually the termination message of its container,
+// from which the RenderTask controller records it in the RenderTask's status.
+type RenderReport struct {
+	// ContentDigest is the SHA-256 digest of the rendered chart content.
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+	// ChartDigest is the digest of the manifest of the pushed chart.
+	// +optional
+	ChartDigest string `json:"chartDigest,omitempty"`
+	// ChartSize is the size in bytes of the packaged chart.
+	// +optional
+	ChartSize int64 `json:"chartSize,omitempty"`
+	// SignatureRef is the OCI reference of the cosign signature of the
+	// pushed chart, if it was signed.
+	// +optional
+	SignatureRef string `json:"signatureRef,omitempty"`
+	// RenderedAt is the time the chart was pushed.
+	// +optional
+	RenderedAt *metav1.Time `json:"renderedAt,omitempty"`
+	// Files is the number of files in the rendered chart.
+	// +optional
+	Files int32 `json:"files,omitempty"`
+	// Size is the total size in bytes of the files in the rendered chart.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+}
+
 // RenderResult defines the Result of a render operation.
 type RenderResult struct {
 	// Dir is the directory the chart was rendered to.
@@ -115,4 +148,10 @@ func (r *RenderResult) Close() error {
 type PushResult struct {
 	// Ref is the full OCI reference of the pushed chart
 	Ref string `json:"ref"`
+	// Digest is the digest of the manifest of the pushed chart.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// Size is the size in bytes of the packaged chart.
+	// +optional
+	Size int64 `json:"size,omitempty"`
 }
@@ -20,6 +20,7 @@ var _ resource.Object = &RenderTask{}
 var _ resource.ObjectWithStatusSubResource = &RenderTask{}
 var _ rest.PrepareForUpdater = &RenderTask{}
 var _ rest.PrepareForCreater = &RenderTask{}
+var _ rest.Validater = &RenderTask{}
 var _ rest.ValidateUpdater = &RenderTask{}
 var _ rest.TableConverter = &RenderTask{}
 
@@ -66,7 +67,7 @@ func (o *RenderTask) ConvertToTable(ctx context.Context, tableOptions runtime.Ob
 			switch c.Type {
 			case "JobSucceeded", "JobFailed":
 				status = c.Reason
-			case "JobScheduled":
+			case "JobScheduled", "Pending":
 				if status == "Unknown" {
 					status = c.Reason
 				}
@@ -91,6 +92,10 @@ func (o *RenderTask) ConvertToTable(ctx context.Context, tableOptions runtime.Ob
 	), nil
 }
 
+func (o *RenderTask) Validate(_ context.Context) field.ErrorList {
+	return validateRenderTask(o)
+}
+
 func (o *RenderTask) ValidateUpdate(ctx context.Context, old runtime.Object) field.ErrorList {
 	errors := field.ErrorList{}
 	or := old.(*RenderTask)
@@ -100,5 +105,47 @@ func (o *RenderTask) ValidateUpdate(ctx context.Context, old runtime.Object) fie
 		errors = append(errors, field.Forbidden(field.NewPath("spec.rendererConfig"), "rendererConfig is immutable"))
 	}
 
+	errors = append(errors, validateRenderTask(o)...)
+
 	return errors
 }
+
+func validateRenderTask(o *RenderTask) field.ErrorList {
+	var errs field.ErrorList
+
+	if policy := o.Spec.RetryPolicy; policy != nil {
+		policyPath := field.NewPath("spec").Child("retryPolicy")
+
+		if policy.MaxRetries < 0 {
+			errs = append(errs, field.Invalid(policyPath.Child("maxRetries"), policy.MaxRetries, "maxRetries must not be negative"))
+		}
+
+		if policy.Backoff != nil && policy.Backoff.Duration <= 0 {
+			errs = append(errs, field.Invalid(policyPath.Child("backoff"), policy.Backoff.Duration, "backoff must be greater than 0"))
+		}
+	}
+
+	errs = append(errs, validateRenderJobSettings(field.NewPath("spec"), o.Spec.FailedJobTTL, o.Spec.BackoffLimit, o.Spec.ActiveDeadlineSeconds)...)
+
+	return errs
+}
+
+// validateRenderJobSettings validates the render job overrides shared by
+// RenderTasks and the Releases and Targets they are created for.
+func validateRenderJobSettings(specPath *field.Path, failedJobTTL, backoffLimit *int32, activeDeadlineSeconds *int64) field.ErrorList {
+	var errs field.ErrorList
+
+	if failedJobTTL != nil && *failedJobTTL < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("failedJobTTL"), *failedJobTTL, "failedJobTTL must not be negative"))
+	}
+
+	if backoffLimit != nil && *backoffLimit < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("backoffLimit"), *backoffLimit, "backoffLimit must not be negative"))
+	}
+
+	if activeDeadlineSeconds != nil && *activeDeadlineSeconds < 1 {
+		errs = append(errs, field.Invalid(specPath.Child("activeDeadlineSeconds"), *activeDeadlineSeconds, "activeDeadlineSeconds must be greater than 0"))
+	}
+
+	return errs
+}
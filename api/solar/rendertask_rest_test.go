@@ -5,6 +5,9 @@ package solar_test
 
 import (
 	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"go.opendefense.cloud/solar/api/solar"
 
@@ -13,6 +16,61 @@ import (
 )
 
 var _ = Describe("RenderTask REST", func() {
+	Describe("Validate (create path)", func() {
+		It("accepts a RenderTask without retryPolicy", func() {
+			Expect((&solar.RenderTask{}).Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("accepts a valid retryPolicy", func() {
+			rt := &solar.RenderTask{
+				Spec: solar.RenderTaskSpec{
+					RetryPolicy: &solar.RenderTaskRetryPolicy{MaxRetries: 3, Backoff: &metav1.Duration{Duration: 30 * time.Second}},
+				},
+			}
+			Expect(rt.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects a negative maxRetries", func() {
+			rt := &solar.RenderTask{
+				Spec: solar.RenderTaskSpec{RetryPolicy: &solar.RenderTaskRetryPolicy{MaxRetries: -1}},
+			}
+			errs := rt.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.retryPolicy.maxRetries"))
+		})
+
+		It("rejects a non-positive backoff", func() {
+			rt := &solar.RenderTask{
+				Spec: solar.RenderTaskSpec{
+					RetryPolicy: &solar.RenderTaskRetryPolicy{MaxRetries: 1, Backoff: &metav1.Duration{}},
+				},
+			}
+			errs := rt.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.retryPolicy.backoff"))
+		})
+
+		It("accepts render job settings", func() {
+			rt := &solar.RenderTask{
+				Spec: solar.RenderTaskSpec{
+					FailedJobTTL:          new(int32(0)),
+					BackoffLimit:          new(int32(0)),
+					ActiveDeadlineSeconds: new(int64(600)),
+				},
+			}
+			Expect(rt.Validate(context.Background())).To(BeEmpty())
+		})
+
+		It("rejects a non-positive activeDeadlineSeconds", func() {
+			rt := &solar.RenderTask{
+				Spec: solar.RenderTaskSpec{ActiveDeadlineSeconds: new(int64(0))},
+			}
+			errs := rt.Validate(context.Background())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.activeDeadlineSeconds"))
+		})
+	})
+
 	Describe("ValidateUpdate (update path)", func() {
 		It("accepts an unchanged rendererConfig", func() {
 			old := &solar.RenderTask{
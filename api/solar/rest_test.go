@@ -228,6 +228,27 @@ var _ = Describe("CopyStatusTo", func() {
 		Expect(dst.Status).To(Equal(src.Status))
 	})
 
+	It("copies Status from a Registry", func() {
+		src := &solar.Registry{Status: solar.RegistryStatus{Conditions: []metav1.Condition{condition}}}
+		dst := &solar.Registry{}
+		src.CopyStatusTo(dst)
+		Expect(dst.Status).To(Equal(src.Status))
+	})
+
+	It("copies Status from a RegistryBinding", func() {
+		src := &solar.RegistryBinding{Status: solar.RegistryBindingStatus{Conditions: []metav1.Condition{condition}}}
+		dst := &solar.RegistryBinding{}
+		src.CopyStatusTo(dst)
+		Expect(dst.Status).To(Equal(src.Status))
+	})
+
+	It("copies Status from a ReleaseBinding", func() {
+		src := &solar.ReleaseBinding{Status: solar.ReleaseBindingStatus{Conditions: []metav1.Condition{condition}}}
+		dst := &solar.ReleaseBinding{}
+		src.CopyStatusTo(dst)
+		Expect(dst.Status).To(Equal(src.Status))
+	})
+
 	It("copies Status from a RenderArtifact", func() {
 		src := &solar.RenderArtifact{Status: solar.RenderArtifactStatus{ChartURL: "oci://example.com/chart:v1"}}
 		dst := &solar.RenderArtifact{}
@@ -68,6 +68,7 @@ var _ = Describe("TableConverter", func() {
 					ComponentVersionRef: corev1.LocalObjectReference{Name: "my-cv"},
 				},
 				Status: solar.ReleaseStatus{
+					Phase: solar.ReleasePhaseDeployed,
 					Conditions: []metav1.Condition{
 						{
 							Type:   "ComponentVersionResolved",
@@ -75,21 +76,29 @@ var _ = Describe("TableConverter", func() {
 							Reason: "Resolved",
 						},
 					},
+					History: []solar.ReleaseRevision{
+						{Revision: 2, ChartURL: "oci://registry.example.com/my-release:v2"},
+						{Revision: 1, ChartURL: "oci://registry.example.com/my-release:v1"},
+					},
 				},
 			}
 
 			table, err := obj.ConvertToTable(ctx, nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(table.ColumnDefinitions).To(HaveLen(4))
+			Expect(table.ColumnDefinitions).To(HaveLen(6))
 			Expect(table.ColumnDefinitions[0].Name).To(Equal("Name"))
 			Expect(table.ColumnDefinitions[1].Name).To(Equal("ComponentVersion Ref"))
-			Expect(table.ColumnDefinitions[2].Name).To(Equal("Status"))
-			Expect(table.ColumnDefinitions[3].Name).To(Equal("Age"))
+			Expect(table.ColumnDefinitions[2].Name).To(Equal("Phase"))
+			Expect(table.ColumnDefinitions[3].Name).To(Equal("Status"))
+			Expect(table.ColumnDefinitions[4].Name).To(Equal("Chart URL"))
+			Expect(table.ColumnDefinitions[5].Name).To(Equal("Age"))
 			Expect(table.Rows).To(HaveLen(1))
 			Expect(table.Rows[0].Cells[0]).To(Equal("my-release"))
 			Expect(table.Rows[0].Cells[1]).To(Equal("my-cv"))
-			Expect(table.Rows[0].Cells[2]).To(Equal("Resolved"))
-			Expect(table.Rows[0].Cells[3]).To(BeAssignableToTypeOf(""))
+			Expect(table.Rows[0].Cells[2]).To(Equal(solar.ReleasePhaseDeployed))
+			Expect(table.Rows[0].Cells[3]).To(Equal("Resolved"))
+			Expect(table.Rows[0].Cells[4]).To(Equal("oci://registry.example.com/my-release:v2"))
+			Expect(table.Rows[0].Cells[5]).To(BeAssignableToTypeOf(""))
 		})
 
 		It("should return Unknown status when no condition exists", func() {
@@ -105,7 +114,42 @@ var _ = Describe("TableConverter", func() {
 
 			table, err := obj.ConvertToTable(ctx, nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(table.Rows[0].Cells[2]).To(Equal("Unknown"))
+			Expect(table.Rows[0].Cells[3]).To(Equal("Unknown"))
+			Expect(table.Rows[0].Cells[4]).To(Equal(""))
+		})
+	})
+
+	Describe("ReferenceGrant", func() {
+		It("should return correct columns and cells", func() {
+			obj := &solar.ReferenceGrant{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "my-grant",
+					CreationTimestamp: metav1.Now(),
+				},
+				Spec: solar.ReferenceGrantSpec{
+					From: []solar.ReferenceGrantFromSubject{
+						{Group: "solar.opendefense.cloud", Kind: "Profile", Namespace: "team-a"},
+						{Group: "solar.opendefense.cloud", Kind: "Release", Namespace: "team-b"},
+					},
+					To: []solar.ReferenceGrantToTarget{
+						{Group: "solar.opendefense.cloud", Kind: "Target"},
+						{Group: "solar.opendefense.cloud", Kind: "ComponentVersion"},
+					},
+				},
+			}
+
+			table, err := obj.ConvertToTable(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.ColumnDefinitions).To(HaveLen(4))
+			Expect(table.ColumnDefinitions[0].Name).To(Equal("Name"))
+			Expect(table.ColumnDefinitions[1].Name).To(Equal("From"))
+			Expect(table.ColumnDefinitions[2].Name).To(Equal("To"))
+			Expect(table.ColumnDefinitions[3].Name).To(Equal("Age"))
+			Expect(table.Rows).To(HaveLen(1))
+			Expect(table.Rows[0].Cells[0]).To(Equal("my-grant"))
+			Expect(table.Rows[0].Cells[1]).To(Equal("Profile/team-a,Release/team-b"))
+			Expect(table.Rows[0].Cells[2]).To(Equal("Target,ComponentVersion"))
+			Expect(table.Rows[0].Cells[3]).To(BeAssignableToTypeOf(""))
 		})
 	})
 
@@ -212,16 +256,18 @@ var _ = Describe("TableConverter", func() {
 
 			table, err := obj.ConvertToTable(ctx, nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(table.ColumnDefinitions).To(HaveLen(4))
+			Expect(table.ColumnDefinitions).To(HaveLen(5))
 			Expect(table.ColumnDefinitions[0].Name).To(Equal("Name"))
 			Expect(table.ColumnDefinitions[1].Name).To(Equal("Target"))
 			Expect(table.ColumnDefinitions[2].Name).To(Equal("Registry"))
-			Expect(table.ColumnDefinitions[3].Name).To(Equal("Age"))
+			Expect(table.ColumnDefinitions[3].Name).To(Equal("Role"))
+			Expect(table.ColumnDefinitions[4].Name).To(Equal("Age"))
 			Expect(table.Rows).To(HaveLen(1))
 			Expect(table.Rows[0].Cells[0]).To(Equal("my-registrybinding"))
 			Expect(table.Rows[0].Cells[1]).To(Equal("my-target"))
 			Expect(table.Rows[0].Cells[2]).To(Equal("my-registry"))
-			Expect(table.Rows[0].Cells[3]).To(BeAssignableToTypeOf(""))
+			Expect(table.Rows[0].Cells[3]).To(Equal("source"))
+			Expect(table.Rows[0].Cells[4]).To(BeAssignableToTypeOf(""))
 		})
 	})
 
@@ -311,6 +357,32 @@ var _ = Describe("TableConverter", func() {
 			Expect(table.Rows[0].Cells[3]).To(Equal("DoesNotExist"))
 		})
 
+		It("should show Queued when the RenderTask waits for a render slot", func() {
+			obj := &solar.RenderTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "my-rendertask",
+					CreationTimestamp: metav1.Now(),
+				},
+				Spec: solar.RenderTaskSpec{
+					OwnerKind: "Target",
+					OwnerName: "my-target",
+				},
+				Status: solar.RenderTaskStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   "Pending",
+							Status: metav1.ConditionTrue,
+							Reason: "Queued",
+						},
+					},
+				},
+			}
+
+			table, err := obj.ConvertToTable(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.Rows[0].Cells[3]).To(Equal("Queued"))
+		})
+
 		It("should show JobScheduled when no terminal condition exists", func() {
 			obj := &solar.RenderTask{
 				ObjectMeta: metav1.ObjectMeta{
@@ -380,20 +452,25 @@ var _ = Describe("TableConverter", func() {
 					Registry:   "registry.example.com",
 					Repository: "charts/mychart",
 				},
+				Status: solar.ComponentStatus{
+					LatestVersion: "1.2.0",
+				},
 			}
 
 			table, err := obj.ConvertToTable(ctx, nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(table.ColumnDefinitions).To(HaveLen(4))
+			Expect(table.ColumnDefinitions).To(HaveLen(5))
 			Expect(table.ColumnDefinitions[0].Name).To(Equal("Name"))
 			Expect(table.ColumnDefinitions[1].Name).To(Equal("Registry"))
 			Expect(table.ColumnDefinitions[2].Name).To(Equal("Repository"))
-			Expect(table.ColumnDefinitions[3].Name).To(Equal("Age"))
+			Expect(table.ColumnDefinitions[3].Name).To(Equal("Latest Version"))
+			Expect(table.ColumnDefinitions[4].Name).To(Equal("Age"))
 			Expect(table.Rows).To(HaveLen(1))
 			Expect(table.Rows[0].Cells[0]).To(Equal("my-component"))
 			Expect(table.Rows[0].Cells[1]).To(Equal("registry.example.com"))
 			Expect(table.Rows[0].Cells[2]).To(Equal("charts/mychart"))
-			Expect(table.Rows[0].Cells[3]).To(BeAssignableToTypeOf(""))
+			Expect(table.Rows[0].Cells[3]).To(Equal("1.2.0"))
+			Expect(table.Rows[0].Cells[4]).To(BeAssignableToTypeOf(""))
 		})
 	})
 
@@ -412,16 +489,29 @@ var _ = Describe("TableConverter", func() {
 
 			table, err := obj.ConvertToTable(ctx, nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(table.ColumnDefinitions).To(HaveLen(4))
+			Expect(table.ColumnDefinitions).To(HaveLen(5))
 			Expect(table.ColumnDefinitions[0].Name).To(Equal("Name"))
 			Expect(table.ColumnDefinitions[1].Name).To(Equal("Component Ref"))
 			Expect(table.ColumnDefinitions[2].Name).To(Equal("Tag"))
-			Expect(table.ColumnDefinitions[3].Name).To(Equal("Age"))
+			Expect(table.ColumnDefinitions[3].Name).To(Equal("Phase"))
+			Expect(table.ColumnDefinitions[4].Name).To(Equal("Age"))
 			Expect(table.Rows).To(HaveLen(1))
 			Expect(table.Rows[0].Cells[0]).To(Equal("my-cv"))
 			Expect(table.Rows[0].Cells[1]).To(Equal("my-component"))
 			Expect(table.Rows[0].Cells[2]).To(Equal("1.0.0"))
-			Expect(table.Rows[0].Cells[3]).To(BeAssignableToTypeOf(""))
+			Expect(table.Rows[0].Cells[3]).To(Equal("Available"))
+			Expect(table.Rows[0].Cells[4]).To(BeAssignableToTypeOf(""))
+		})
+
+		It("shows the phase of an unavailable ComponentVersion", func() {
+			obj := &solar.ComponentVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cv"},
+				Status:     solar.ComponentVersionStatus{Phase: solar.ComponentVersionPhaseUnavailable},
+			}
+
+			table, err := obj.ConvertToTable(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.Rows[0].Cells[3]).To(Equal("Unavailable"))
 		})
 	})
 })
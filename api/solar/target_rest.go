@@ -12,12 +12,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var _ resource.Object = &Target{}
 var _ resource.ObjectWithStatusSubResource = &Target{}
 var _ rest.PrepareForUpdater = &Target{}
 var _ rest.PrepareForCreater = &Target{}
+var _ rest.Validater = &Target{}
+var _ rest.ValidateUpdater = &Target{}
 var _ rest.TableConverter = &Target{}
 
 func (o *Target) GetObjectMeta() *metav1.ObjectMeta {
@@ -66,3 +69,15 @@ func (o *Target) ConvertToTable(ctx context.Context, tableOptions runtime.Object
 		[]any{o.Name, o.Spec.RenderRegistryRef.Name, o.Status.BootstrapVersion, duration.HumanDuration(metav1.Now().Sub(o.CreationTimestamp.Time))},
 	), nil
 }
+
+func (o *Target) Validate(_ context.Context) field.ErrorList {
+	return validateTarget(o)
+}
+
+func (o *Target) ValidateUpdate(_ context.Context, _ runtime.Object) field.ErrorList {
+	return validateTarget(o)
+}
+
+func validateTarget(o *Target) field.ErrorList {
+	return validateRenderJobSettings(field.NewPath("spec"), o.Spec.FailedJobTTL, o.Spec.BackoffLimit, o.Spec.ActiveDeadlineSeconds)
+}
@@ -14,6 +14,8 @@ import (
 type TargetSpec struct {
 	// RenderRegistryRef references the Registry to push rendered desired state to.
 	// The referenced Registry must have SolarSecretRef set for rendering to succeed.
+	// If empty, the Registry of the RegistryBinding with role deploy for this Target is used.
+	// +optional
 	RenderRegistryRef corev1.LocalObjectReference `json:"renderRegistryRef"`
 	// RenderRegistryNamespace is the namespace of the Registry when it resides in a different
 	// namespace than this Target. If empty, the Registry is assumed to be in the same namespace.
@@ -25,6 +27,51 @@ type TargetSpec struct {
 	// This enables target-specific customization and deployment parameters.
 	// +optional
 	Userdata runtime.RawExtension `json:"userdata,omitempty"`
+	// AllowPartialRender renders the bootstrap chart with the releases that are
+	// ready when others are still pending or failed to render. The missing
+	// releases are reported in status.releases and added once they are ready.
+	// By default, the bootstrap chart is only rendered once all releases are ready.
+	// +optional
+	AllowPartialRender bool `json:"allowPartialRender,omitempty"`
+	// FailedJobTTL is the TTL in seconds after which a failed bootstrap render
+	// job of this Target and its secrets are cleaned up. If not set, the
+	// controller default applies, 3600 (1 hour) unless configured otherwise.
+	// +optional
+	FailedJobTTL *int32 `json:"failedJobTTL,omitempty"`
+	// BackoffLimit is the number of times a failed renderer pod of a bootstrap
+	// render job of this Target is retried before the job fails. If not set,
+	// the controller default applies, 3 unless configured otherwise.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is how long a bootstrap render job of this Target
+	// may run before it is failed. If not set, the controller default applies,
+	// which does not limit the run time unless configured otherwise.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// TargetReleaseStatus reports whether a release bound to a Target is rendered.
+type TargetReleaseStatus struct {
+	// Name is the name of the Release.
+	Name string `json:"name"`
+	// Ready is true if the chart of the release is rendered and part of the
+	// bootstrap chart of the Target.
+	Ready bool `json:"ready"`
+	// Reason is a CamelCase reason for the readiness of the release, e.g.
+	// Rendered, Pending or ReleaseFailed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of why the release is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// ChartURL is the OCI reference of the rendered chart of the release.
+	// +optional
+	ChartURL string `json:"chartURL,omitempty"`
+	// ChartDigest is the digest of the rendered chart of the release, by which
+	// the bootstrap chart of the Target references it. Empty if the render job
+	// did not report the digest, in which case the chart is referenced by tag.
+	// +optional
+	ChartDigest string `json:"chartDigest,omitempty"`
 }
 
 // TargetStatus defines the observed state of a Target.
@@ -35,6 +82,12 @@ type TargetStatus struct {
 	// +optional
 	BootstrapVersion int64 `json:"bootstrapVersion,omitempty"`
 
+	// Releases reports the readiness of each release bound to this Target.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Releases []TargetReleaseStatus `json:"releases,omitempty"`
+
 	// Conditions represent the latest available observations of a Target's state.
 	// +optional
 	// +patchMergeKey=type
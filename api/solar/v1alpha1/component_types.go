@@ -18,10 +18,58 @@ type ComponentSpec struct {
 
 	// Repository is the repository where the component is stored.
 	Repository string `json:"repository"`
+
+	// TagDiscovery enables periodic discovery of new versions of the component.
+	// When set, the discovery worker lists the tags of the component in its
+	// repository and creates a ComponentVersion for every new tag.
+	// +optional
+	TagDiscovery *TagDiscovery `json:"tagDiscovery,omitempty"`
+}
+
+// TagDiscovery configures periodic discovery of new tags for a Component.
+type TagDiscovery struct {
+	// Interval is the time between two listings of the component's tags.
+	// Defaults to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// SemverConstraint restricts discovery to tags that are valid semantic
+	// versions matching the constraint, e.g. ">= 1.2, < 2". If empty, every
+	// tag is discovered.
+	// +optional
+	SemverConstraint string `json:"semverConstraint,omitempty"`
 }
 
 // ComponentStatus defines the observed state of a Component.
 type ComponentStatus struct {
+	// LatestVersion is the highest tag of Versions that is a semantic version.
+	// Empty if no tag is a semantic version.
+	// +optional
+	LatestVersion string `json:"latestVersion,omitempty"`
+
+	// Versions lists the ComponentVersions of this Component. Tags that are
+	// semantic versions come first, highest first, followed by the other tags
+	// in lexical order.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Versions []ComponentVersionSummary `json:"versions,omitempty"`
+
+	// Conditions represent the latest available observations of a Component's state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchMergeKey:"type" patchStrategy:"merge"`
+}
+
+// ComponentVersionSummary identifies a ComponentVersion of a Component.
+type ComponentVersionSummary struct {
+	// Name is the name of the ComponentVersion.
+	Name string `json:"name"`
+	// Tag is the version of the component the ComponentVersion represents.
+	Tag string `json:"tag"`
 }
 
 // +genclient
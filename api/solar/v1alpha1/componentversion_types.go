@@ -39,6 +39,21 @@ type HelmResourceMetadata struct {
 	ValuesTemplate *string `json:"valuesTemplate,omitempty"`
 }
 
+// CatalogDetails contains the documentation and icon of a ComponentVersion.
+// Discovery reads them from the OCM resources of type "docs" and "icon" and
+// leaves out resources exceeding the size limits.
+type CatalogDetails struct {
+	// Readme is the markdown documentation of the component, at most 64KiB.
+	// +optional
+	Readme string `json:"readme,omitempty"`
+	// Icon is the image shown for the component, at most 32KiB.
+	// +optional
+	Icon []byte `json:"icon,omitempty"`
+	// IconMediaType is the media type of Icon, e.g. "image/png".
+	// +optional
+	IconMediaType string `json:"iconMediaType,omitempty"`
+}
+
 // EntrypointType is the Type of Entrypoint.
 // +enum
 type EntrypointType string
@@ -61,10 +76,36 @@ type ComponentVersionSpec struct {
 	Resources map[string]ResourceAccess `json:"resources"`
 	// Entrypoint is the entrypoint for deploying a ComponentVersion.
 	Entrypoint Entrypoint `json:"entrypoint"`
+	// Catalog contains the documentation and icon shown for the
+	// ComponentVersion in the solution catalog, populated during discovery.
+	// +optional
+	Catalog *CatalogDetails `json:"catalog,omitempty"`
 }
 
+// ComponentVersionPhase describes whether a ComponentVersion can still be
+// pulled from the registry it was discovered in.
+// +enum
+type ComponentVersionPhase string
+
+const (
+	// ComponentVersionPhaseAvailable means the ComponentVersion exists in its registry.
+	ComponentVersionPhaseAvailable ComponentVersionPhase = "Available"
+	// ComponentVersionPhaseUnavailable means discovery found the ComponentVersion
+	// removed from its registry. It is garbage collected once the retention
+	// period of the controller manager has passed.
+	ComponentVersionPhaseUnavailable ComponentVersionPhase = "Unavailable"
+)
+
 // ComponentVersionStatus defines the observed state of a ComponentVersion.
 type ComponentVersionStatus struct {
+	// Phase tells whether the ComponentVersion can still be pulled from its
+	// registry. Empty means Available.
+	// +optional
+	Phase ComponentVersionPhase `json:"phase,omitempty"`
+	// UnavailableSince is the time discovery found the ComponentVersion
+	// removed from its registry. Only set in phase Unavailable.
+	// +optional
+	UnavailableSince *metav1.Time `json:"unavailableSince,omitempty"`
 }
 
 // +genclient
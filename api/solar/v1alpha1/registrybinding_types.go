@@ -8,6 +8,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// RegistryBindingRoleSource binds a Registry the Target pulls resources
+	// from, for which the Target's pull secrets are looked up.
+	RegistryBindingRoleSource RegistryBindingRole = "source"
+	// RegistryBindingRoleDeploy binds the Registry rendered charts for the
+	// Target are pushed to, unless the Target sets a RenderRegistryRef.
+	RegistryBindingRoleDeploy RegistryBindingRole = "deploy"
+)
+
+// RegistryBindingRole is the role of the Registry for the bound Target.
+// +enum
+type RegistryBindingRole string
+
 // RegistryBindingSpec defines the desired state of a RegistryBinding.
 type RegistryBindingSpec struct {
 	// TargetRef references the Target this binding applies to.
@@ -20,6 +33,9 @@ type RegistryBindingSpec struct {
 	TargetNamespace string `json:"targetNamespace,omitempty"`
 	// RegistryRef references the Registry being bound.
 	RegistryRef corev1.LocalObjectReference `json:"registryRef"`
+	// Role is the role of the Registry for the Target. Defaults to source.
+	// +optional
+	Role RegistryBindingRole `json:"role,omitempty"`
 }
 
 // RegistryBindingStatus defines the observed state of a RegistryBinding.
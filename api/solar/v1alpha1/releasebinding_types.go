@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ReleaseBindingSpec defines the desired state of a ReleaseBinding.
@@ -20,6 +21,12 @@ type ReleaseBindingSpec struct {
 	TargetNamespace string `json:"targetNamespace,omitempty"`
 	// ReleaseRef references the Release to deploy.
 	ReleaseRef corev1.LocalObjectReference `json:"releaseRef"`
+	// Values are merged on top of the Release's values when the Release is
+	// rendered for this Target, e.g. to change replica counts or ingress hosts
+	// without creating a second Release. Maps are merged recursively, all other
+	// values replace those of the Release and null removes a key.
+	// +optional
+	Values runtime.RawExtension `json:"values,omitempty"`
 }
 
 // ReleaseBindingStatus defines the observed state of a ReleaseBinding.
@@ -29,6 +29,13 @@ type RenderTaskSpec struct {
 	// +optional
 	PushSecretRef *corev1.LocalObjectReference `json:"pushSecretRef,omitempty"`
 
+	// SensitiveValuesSecretRef references a Secret in the same namespace
+	// holding release values as JSON under the key "values.json". They are
+	// merged on top of the values of the RendererConfig by the render job,
+	// and redacted from its failure messages.
+	// +optional
+	SensitiveValuesSecretRef *corev1.LocalObjectReference `json:"sensitiveValuesSecretRef,omitempty"`
+
 	// PlainHTTP uses HTTP instead of HTTPS for OCI registry connections.
 	// +optional
 	PlainHTTP bool `json:"plainHTTP,omitempty"`
@@ -36,10 +43,34 @@ type RenderTaskSpec struct {
 	// failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up.
 	// After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete
 	// the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately.
-	// If not set, defaults to 3600 (1 hour).
+	// If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.
 	// +optional
 	FailedJobTTL *int32 `json:"failedJobTTL,omitempty"`
 
+	// BackoffLimit is the number of times a failed renderer pod is retried
+	// before the render job fails. If not set, the controller default applies,
+	// 3 unless configured otherwise.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// ActiveDeadlineSeconds is how long the render job may run before it is
+	// failed. If not set, the controller default applies, which does not
+	// limit the run time unless configured otherwise.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Priority determines the order in which queued RenderTasks are admitted when the
+	// controller limits the number of concurrently running render jobs. Higher values
+	// render first; RenderTasks with equal priority are admitted oldest first.
+	// If not set, defaults to 0.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// RetryPolicy makes the controller retry failed render jobs. If not set,
+	// a failed render job fails the RenderTask.
+	// +optional
+	RetryPolicy *RenderTaskRetryPolicy `json:"retryPolicy,omitempty"`
+
 	// OwnerName is the name of the resource that created this RenderTask.
 	// +kubebuilder:validation:MinLength=1
 	OwnerName string `json:"ownerName"`
@@ -53,6 +84,19 @@ type RenderTaskSpec struct {
 	OwnerKind string `json:"ownerKind"`
 }
 
+// RenderTaskRetryPolicy configures how failed render jobs are retried.
+type RenderTaskRetryPolicy struct {
+	// MaxRetries is the number of times a failed render job is replaced by a
+	// new one before the RenderTask fails.
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries int32 `json:"maxRetries"`
+
+	// Backoff is the time to wait before the first retry. It doubles with
+	// every further retry, up to 10 minutes. If not set, defaults to 10s.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
 // RenderTaskStatus holds the status of the rendering process
 type RenderTaskStatus struct {
 	// Conditions represent the latest available observations of a RenderTask's state.
@@ -74,6 +118,77 @@ type RenderTaskStatus struct {
 	// ChartURL represents the URL of where the rendered chart was pushed to.
 	// +optional
 	ChartURL string `json:"chartURL"`
+
+	// ConfigHash is the SHA-256 digest of the renderer config and the push
+	// destination. RenderTasks with the same hash render the same chart.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// PrimaryRef references the RenderTask in the same namespace whose render
+	// job produces the chart of this RenderTask. It is set when the controller
+	// deduplicates identical RenderTasks instead of running another job.
+	// +optional
+	PrimaryRef *corev1.LocalObjectReference `json:"primaryRef,omitempty"`
+
+	// Attempts is the number of render jobs started for this RenderTask,
+	// including retries.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// ContentDigest is the SHA-256 digest of the rendered chart content as
+	// reported by the renderer. Identical configs render the same digest.
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+
+	// ChartDigest is the digest of the manifest of the pushed chart as
+	// reported by the renderer. It identifies the chart independent of its tag.
+	// +optional
+	ChartDigest string `json:"chartDigest,omitempty"`
+
+	// ChartSize is the size in bytes of the packaged chart as reported by the
+	// renderer.
+	// +optional
+	ChartSize int64 `json:"chartSize,omitempty"`
+
+	// SignatureRef is the OCI reference of the cosign signature of the pushed
+	// chart as reported by the renderer, if the renderer signs charts.
+	// +optional
+	SignatureRef string `json:"signatureRef,omitempty"`
+
+	// RenderedAt is the time the chart was pushed as reported by the renderer,
+	// or the completion time of the render job if the renderer did not report it.
+	// +optional
+	RenderedAt *metav1.Time `json:"renderedAt,omitempty"`
+
+	// Preview summarizes the chart rendered by a dry run. It is only set for
+	// RenderTasks annotated with solar.opendefense.cloud/dry-run, whose chart
+	// is rendered but not pushed.
+	// +optional
+	Preview *RenderPreview `json:"preview,omitempty"`
+}
+
+// RenderPreview summarizes the chart rendered by a dry run and compares it to
+// the chart most recently rendered for the same owner.
+type RenderPreview struct {
+	// Files is the number of files in the rendered chart.
+	Files int32 `json:"files"`
+
+	// Size is the total size in bytes of the files in the rendered chart.
+	Size int64 `json:"size"`
+
+	// CurrentRenderTask is the name of the RenderTask in the same namespace
+	// that most recently rendered a chart for the same owner, if any.
+	// +optional
+	CurrentRenderTask string `json:"currentRenderTask,omitempty"`
+
+	// CurrentContentDigest is the ContentDigest of the chart rendered by
+	// CurrentRenderTask.
+	// +optional
+	CurrentContentDigest string `json:"currentContentDigest,omitempty"`
+
+	// Changed reports whether the dry run rendered a chart with different
+	// content than CurrentRenderTask. It is true if there is no current chart.
+	Changed bool `json:"changed"`
 }
 
 // +genclient
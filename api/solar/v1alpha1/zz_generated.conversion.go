@@ -45,6 +45,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CatalogDetails)(nil), (*solar.CatalogDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CatalogDetails_To_solar_CatalogDetails(a.(*CatalogDetails), b.(*solar.CatalogDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.CatalogDetails)(nil), (*CatalogDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_CatalogDetails_To_v1alpha1_CatalogDetails(a.(*solar.CatalogDetails), b.(*CatalogDetails), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChartConfig)(nil), (*solar.ChartConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ChartConfig_To_solar_ChartConfig(a.(*ChartConfig), b.(*solar.ChartConfig), scope)
 	}); err != nil {
@@ -135,6 +145,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVersionSummary)(nil), (*solar.ComponentVersionSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVersionSummary_To_solar_ComponentVersionSummary(a.(*ComponentVersionSummary), b.(*solar.ComponentVersionSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.ComponentVersionSummary)(nil), (*ComponentVersionSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_ComponentVersionSummary_To_v1alpha1_ComponentVersionSummary(a.(*solar.ComponentVersionSummary), b.(*ComponentVersionSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiscoveryLimits)(nil), (*solar.DiscoveryLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiscoveryLimits_To_solar_DiscoveryLimits(a.(*DiscoveryLimits), b.(*solar.DiscoveryLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.DiscoveryLimits)(nil), (*DiscoveryLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_DiscoveryLimits_To_v1alpha1_DiscoveryLimits(a.(*solar.DiscoveryLimits), b.(*DiscoveryLimits), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Entrypoint)(nil), (*solar.Entrypoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Entrypoint_To_solar_Entrypoint(a.(*Entrypoint), b.(*solar.Entrypoint), scope)
 	}); err != nil {
@@ -155,6 +185,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LabelMapping)(nil), (*solar.LabelMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LabelMapping_To_solar_LabelMapping(a.(*LabelMapping), b.(*solar.LabelMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.LabelMapping)(nil), (*LabelMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_LabelMapping_To_v1alpha1_LabelMapping(a.(*solar.LabelMapping), b.(*LabelMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*solar.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_solar_MaintenanceWindow(a.(*MaintenanceWindow), b.(*solar.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*solar.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Profile)(nil), (*solar.Profile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Profile_To_solar_Profile(a.(*Profile), b.(*solar.Profile), scope)
 	}); err != nil {
@@ -335,6 +385,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RegistryTLS)(nil), (*solar.RegistryTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryTLS_To_solar_RegistryTLS(a.(*RegistryTLS), b.(*solar.RegistryTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.RegistryTLS)(nil), (*RegistryTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_RegistryTLS_To_v1alpha1_RegistryTLS(a.(*solar.RegistryTLS), b.(*RegistryTLS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Release)(nil), (*solar.Release)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Release_To_solar_Release(a.(*Release), b.(*solar.Release), scope)
 	}); err != nil {
@@ -425,6 +485,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseRevision)(nil), (*solar.ReleaseRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseRevision_To_solar_ReleaseRevision(a.(*ReleaseRevision), b.(*solar.ReleaseRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.ReleaseRevision)(nil), (*ReleaseRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_ReleaseRevision_To_v1alpha1_ReleaseRevision(a.(*solar.ReleaseRevision), b.(*ReleaseRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseSchedule)(nil), (*solar.ReleaseSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseSchedule_To_solar_ReleaseSchedule(a.(*ReleaseSchedule), b.(*solar.ReleaseSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.ReleaseSchedule)(nil), (*ReleaseSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_ReleaseSchedule_To_v1alpha1_ReleaseSchedule(a.(*solar.ReleaseSchedule), b.(*ReleaseSchedule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ReleaseSpec)(nil), (*solar.ReleaseSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ReleaseSpec_To_solar_ReleaseSpec(a.(*ReleaseSpec), b.(*solar.ReleaseSpec), scope)
 	}); err != nil {
@@ -515,6 +595,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RenderPreview)(nil), (*solar.RenderPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RenderPreview_To_solar_RenderPreview(a.(*RenderPreview), b.(*solar.RenderPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.RenderPreview)(nil), (*RenderPreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_RenderPreview_To_v1alpha1_RenderPreview(a.(*solar.RenderPreview), b.(*RenderPreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RenderReport)(nil), (*solar.RenderReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RenderReport_To_solar_RenderReport(a.(*RenderReport), b.(*solar.RenderReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.RenderReport)(nil), (*RenderReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_RenderReport_To_v1alpha1_RenderReport(a.(*solar.RenderReport), b.(*RenderReport), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RenderResult)(nil), (*solar.RenderResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RenderResult_To_solar_RenderResult(a.(*RenderResult), b.(*solar.RenderResult), scope)
 	}); err != nil {
@@ -545,6 +645,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RenderTaskRetryPolicy)(nil), (*solar.RenderTaskRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RenderTaskRetryPolicy_To_solar_RenderTaskRetryPolicy(a.(*RenderTaskRetryPolicy), b.(*solar.RenderTaskRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.RenderTaskRetryPolicy)(nil), (*RenderTaskRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_RenderTaskRetryPolicy_To_v1alpha1_RenderTaskRetryPolicy(a.(*solar.RenderTaskRetryPolicy), b.(*RenderTaskRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RenderTaskSpec)(nil), (*solar.RenderTaskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RenderTaskSpec_To_solar_RenderTaskSpec(a.(*RenderTaskSpec), b.(*solar.RenderTaskSpec), scope)
 	}); err != nil {
@@ -575,6 +685,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RepositoryFilter)(nil), (*solar.RepositoryFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RepositoryFilter_To_solar_RepositoryFilter(a.(*RepositoryFilter), b.(*solar.RepositoryFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.RepositoryFilter)(nil), (*RepositoryFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_RepositoryFilter_To_v1alpha1_RepositoryFilter(a.(*solar.RepositoryFilter), b.(*RepositoryFilter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResolvedResourceAccess)(nil), (*solar.ResolvedResourceAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResolvedResourceAccess_To_solar_ResolvedResourceAccess(a.(*ResolvedResourceAccess), b.(*solar.ResolvedResourceAccess), scope)
 	}); err != nil {
@@ -595,6 +715,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TagDiscovery)(nil), (*solar.TagDiscovery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TagDiscovery_To_solar_TagDiscovery(a.(*TagDiscovery), b.(*solar.TagDiscovery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.TagDiscovery)(nil), (*TagDiscovery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_TagDiscovery_To_v1alpha1_TagDiscovery(a.(*solar.TagDiscovery), b.(*TagDiscovery), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Target)(nil), (*solar.Target)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Target_To_solar_Target(a.(*Target), b.(*solar.Target), scope)
 	}); err != nil {
@@ -615,6 +745,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetReleaseStatus)(nil), (*solar.TargetReleaseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetReleaseStatus_To_solar_TargetReleaseStatus(a.(*TargetReleaseStatus), b.(*solar.TargetReleaseStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.TargetReleaseStatus)(nil), (*TargetReleaseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_TargetReleaseStatus_To_v1alpha1_TargetReleaseStatus(a.(*solar.TargetReleaseStatus), b.(*TargetReleaseStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSpec)(nil), (*solar.TargetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSpec_To_solar_TargetSpec(a.(*TargetSpec), b.(*solar.TargetSpec), scope)
 	}); err != nil {
@@ -635,6 +775,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ValuesReference)(nil), (*solar.ValuesReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValuesReference_To_solar_ValuesReference(a.(*ValuesReference), b.(*solar.ValuesReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.ValuesReference)(nil), (*ValuesReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_ValuesReference_To_v1alpha1_ValuesReference(a.(*solar.ValuesReference), b.(*ValuesReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionChannel)(nil), (*solar.VersionChannel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionChannel_To_solar_VersionChannel(a.(*VersionChannel), b.(*solar.VersionChannel), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.VersionChannel)(nil), (*VersionChannel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_VersionChannel_To_v1alpha1_VersionChannel(a.(*solar.VersionChannel), b.(*VersionChannel), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionPolicy)(nil), (*solar.VersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionPolicy_To_solar_VersionPolicy(a.(*VersionPolicy), b.(*solar.VersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.VersionPolicy)(nil), (*VersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_VersionPolicy_To_v1alpha1_VersionPolicy(a.(*solar.VersionPolicy), b.(*VersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WebhookAuth)(nil), (*solar.WebhookAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WebhookAuth_To_solar_WebhookAuth(a.(*WebhookAuth), b.(*solar.WebhookAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*solar.WebhookAuth)(nil), (*WebhookAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_solar_WebhookAuth_To_v1alpha1_WebhookAuth(a.(*solar.WebhookAuth), b.(*WebhookAuth), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -690,6 +870,30 @@ func Convert_solar_BootstrapInput_To_v1alpha1_BootstrapInput(in *solar.Bootstrap
 	return autoConvert_solar_BootstrapInput_To_v1alpha1_BootstrapInput(in, out, s)
 }
 
+func autoConvert_v1alpha1_CatalogDetails_To_solar_CatalogDetails(in *CatalogDetails, out *solar.CatalogDetails, s conversion.Scope) error {
+	out.Readme = in.Readme
+	out.Icon = *(*[]byte)(unsafe.Pointer(&in.Icon))
+	out.IconMediaType = in.IconMediaType
+	return nil
+}
+
+// Convert_v1alpha1_CatalogDetails_To_solar_CatalogDetails is an autogenerated conversion function.
+func Convert_v1alpha1_CatalogDetails_To_solar_CatalogDetails(in *CatalogDetails, out *solar.CatalogDetails, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CatalogDetails_To_solar_CatalogDetails(in, out, s)
+}
+
+func autoConvert_solar_CatalogDetails_To_v1alpha1_CatalogDetails(in *solar.CatalogDetails, out *CatalogDetails, s conversion.Scope) error {
+	out.Readme = in.Readme
+	out.Icon = *(*[]byte)(unsafe.Pointer(&in.Icon))
+	out.IconMediaType = in.IconMediaType
+	return nil
+}
+
+// Convert_solar_CatalogDetails_To_v1alpha1_CatalogDetails is an autogenerated conversion function.
+func Convert_solar_CatalogDetails_To_v1alpha1_CatalogDetails(in *solar.CatalogDetails, out *CatalogDetails, s conversion.Scope) error {
+	return autoConvert_solar_CatalogDetails_To_v1alpha1_CatalogDetails(in, out, s)
+}
+
 func autoConvert_v1alpha1_ChartConfig_To_solar_ChartConfig(in *ChartConfig, out *solar.ChartConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Description = in.Description
@@ -774,6 +978,7 @@ func autoConvert_v1alpha1_ComponentSpec_To_solar_ComponentSpec(in *ComponentSpec
 	out.Scheme = in.Scheme
 	out.Registry = in.Registry
 	out.Repository = in.Repository
+	out.TagDiscovery = (*solar.TagDiscovery)(unsafe.Pointer(in.TagDiscovery))
 	return nil
 }
 
@@ -786,6 +991,7 @@ func autoConvert_solar_ComponentSpec_To_v1alpha1_ComponentSpec(in *solar.Compone
 	out.Scheme = in.Scheme
 	out.Registry = in.Registry
 	out.Repository = in.Repository
+	out.TagDiscovery = (*TagDiscovery)(unsafe.Pointer(in.TagDiscovery))
 	return nil
 }
 
@@ -795,6 +1001,9 @@ func Convert_solar_ComponentSpec_To_v1alpha1_ComponentSpec(in *solar.ComponentSp
 }
 
 func autoConvert_v1alpha1_ComponentStatus_To_solar_ComponentStatus(in *ComponentStatus, out *solar.ComponentStatus, s conversion.Scope) error {
+	out.LatestVersion = in.LatestVersion
+	out.Versions = *(*[]solar.ComponentVersionSummary)(unsafe.Pointer(&in.Versions))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -804,6 +1013,9 @@ func Convert_v1alpha1_ComponentStatus_To_solar_ComponentStatus(in *ComponentStat
 }
 
 func autoConvert_solar_ComponentStatus_To_v1alpha1_ComponentStatus(in *solar.ComponentStatus, out *ComponentStatus, s conversion.Scope) error {
+	out.LatestVersion = in.LatestVersion
+	out.Versions = *(*[]ComponentVersionSummary)(unsafe.Pointer(&in.Versions))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -873,6 +1085,7 @@ func autoConvert_v1alpha1_ComponentVersionSpec_To_solar_ComponentVersionSpec(in
 	if err := Convert_v1alpha1_Entrypoint_To_solar_Entrypoint(&in.Entrypoint, &out.Entrypoint, s); err != nil {
 		return err
 	}
+	out.Catalog = (*solar.CatalogDetails)(unsafe.Pointer(in.Catalog))
 	return nil
 }
 
@@ -888,6 +1101,7 @@ func autoConvert_solar_ComponentVersionSpec_To_v1alpha1_ComponentVersionSpec(in
 	if err := Convert_solar_Entrypoint_To_v1alpha1_Entrypoint(&in.Entrypoint, &out.Entrypoint, s); err != nil {
 		return err
 	}
+	out.Catalog = (*CatalogDetails)(unsafe.Pointer(in.Catalog))
 	return nil
 }
 
@@ -897,6 +1111,8 @@ func Convert_solar_ComponentVersionSpec_To_v1alpha1_ComponentVersionSpec(in *sol
 }
 
 func autoConvert_v1alpha1_ComponentVersionStatus_To_solar_ComponentVersionStatus(in *ComponentVersionStatus, out *solar.ComponentVersionStatus, s conversion.Scope) error {
+	out.Phase = solar.ComponentVersionPhase(in.Phase)
+	out.UnavailableSince = (*v1.Time)(unsafe.Pointer(in.UnavailableSince))
 	return nil
 }
 
@@ -906,6 +1122,8 @@ func Convert_v1alpha1_ComponentVersionStatus_To_solar_ComponentVersionStatus(in
 }
 
 func autoConvert_solar_ComponentVersionStatus_To_v1alpha1_ComponentVersionStatus(in *solar.ComponentVersionStatus, out *ComponentVersionStatus, s conversion.Scope) error {
+	out.Phase = ComponentVersionPhase(in.Phase)
+	out.UnavailableSince = (*v1.Time)(unsafe.Pointer(in.UnavailableSince))
 	return nil
 }
 
@@ -914,6 +1132,52 @@ func Convert_solar_ComponentVersionStatus_To_v1alpha1_ComponentVersionStatus(in
 	return autoConvert_solar_ComponentVersionStatus_To_v1alpha1_ComponentVersionStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentVersionSummary_To_solar_ComponentVersionSummary(in *ComponentVersionSummary, out *solar.ComponentVersionSummary, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Tag = in.Tag
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVersionSummary_To_solar_ComponentVersionSummary is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVersionSummary_To_solar_ComponentVersionSummary(in *ComponentVersionSummary, out *solar.ComponentVersionSummary, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVersionSummary_To_solar_ComponentVersionSummary(in, out, s)
+}
+
+func autoConvert_solar_ComponentVersionSummary_To_v1alpha1_ComponentVersionSummary(in *solar.ComponentVersionSummary, out *ComponentVersionSummary, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Tag = in.Tag
+	return nil
+}
+
+// Convert_solar_ComponentVersionSummary_To_v1alpha1_ComponentVersionSummary is an autogenerated conversion function.
+func Convert_solar_ComponentVersionSummary_To_v1alpha1_ComponentVersionSummary(in *solar.ComponentVersionSummary, out *ComponentVersionSummary, s conversion.Scope) error {
+	return autoConvert_solar_ComponentVersionSummary_To_v1alpha1_ComponentVersionSummary(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiscoveryLimits_To_solar_DiscoveryLimits(in *DiscoveryLimits, out *solar.DiscoveryLimits, s conversion.Scope) error {
+	out.RequestInterval = (*v1.Duration)(unsafe.Pointer(in.RequestInterval))
+	out.Burst = in.Burst
+	out.MaxConcurrency = in.MaxConcurrency
+	return nil
+}
+
+// Convert_v1alpha1_DiscoveryLimits_To_solar_DiscoveryLimits is an autogenerated conversion function.
+func Convert_v1alpha1_DiscoveryLimits_To_solar_DiscoveryLimits(in *DiscoveryLimits, out *solar.DiscoveryLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiscoveryLimits_To_solar_DiscoveryLimits(in, out, s)
+}
+
+func autoConvert_solar_DiscoveryLimits_To_v1alpha1_DiscoveryLimits(in *solar.DiscoveryLimits, out *DiscoveryLimits, s conversion.Scope) error {
+	out.RequestInterval = (*v1.Duration)(unsafe.Pointer(in.RequestInterval))
+	out.Burst = in.Burst
+	out.MaxConcurrency = in.MaxConcurrency
+	return nil
+}
+
+// Convert_solar_DiscoveryLimits_To_v1alpha1_DiscoveryLimits is an autogenerated conversion function.
+func Convert_solar_DiscoveryLimits_To_v1alpha1_DiscoveryLimits(in *solar.DiscoveryLimits, out *DiscoveryLimits, s conversion.Scope) error {
+	return autoConvert_solar_DiscoveryLimits_To_v1alpha1_DiscoveryLimits(in, out, s)
+}
+
 func autoConvert_v1alpha1_Entrypoint_To_solar_Entrypoint(in *Entrypoint, out *solar.Entrypoint, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	out.Type = solar.EntrypointType(in.Type)
@@ -964,6 +1228,52 @@ func Convert_solar_HelmResourceMetadata_To_v1alpha1_HelmResourceMetadata(in *sol
 	return autoConvert_solar_HelmResourceMetadata_To_v1alpha1_HelmResourceMetadata(in, out, s)
 }
 
+func autoConvert_v1alpha1_LabelMapping_To_solar_LabelMapping(in *LabelMapping, out *solar.LabelMapping, s conversion.Scope) error {
+	out.Annotation = in.Annotation
+	out.ComponentLabel = in.ComponentLabel
+	out.Label = in.Label
+	return nil
+}
+
+// Convert_v1alpha1_LabelMapping_To_solar_LabelMapping is an autogenerated conversion function.
+func Convert_v1alpha1_LabelMapping_To_solar_LabelMapping(in *LabelMapping, out *solar.LabelMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LabelMapping_To_solar_LabelMapping(in, out, s)
+}
+
+func autoConvert_solar_LabelMapping_To_v1alpha1_LabelMapping(in *solar.LabelMapping, out *LabelMapping, s conversion.Scope) error {
+	out.Annotation = in.Annotation
+	out.ComponentLabel = in.ComponentLabel
+	out.Label = in.Label
+	return nil
+}
+
+// Convert_solar_LabelMapping_To_v1alpha1_LabelMapping is an autogenerated conversion function.
+func Convert_solar_LabelMapping_To_v1alpha1_LabelMapping(in *solar.LabelMapping, out *LabelMapping, s conversion.Scope) error {
+	return autoConvert_solar_LabelMapping_To_v1alpha1_LabelMapping(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceWindow_To_solar_MaintenanceWindow(in *MaintenanceWindow, out *solar.MaintenanceWindow, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_solar_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_solar_MaintenanceWindow(in *MaintenanceWindow, out *solar.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_solar_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_solar_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *solar.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_solar_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_solar_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *solar.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_solar_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_Profile_To_solar_Profile(in *Profile, out *solar.Profile, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ProfileSpec_To_solar_ProfileSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1066,6 +1376,8 @@ func Convert_solar_ProfileStatus_To_v1alpha1_ProfileStatus(in *solar.ProfileStat
 
 func autoConvert_v1alpha1_PushResult_To_solar_PushResult(in *PushResult, out *solar.PushResult, s conversion.Scope) error {
 	out.Ref = in.Ref
+	out.Digest = in.Digest
+	out.Size = in.Size
 	return nil
 }
 
@@ -1076,6 +1388,8 @@ func Convert_v1alpha1_PushResult_To_solar_PushResult(in *PushResult, out *solar.
 
 func autoConvert_solar_PushResult_To_v1alpha1_PushResult(in *solar.PushResult, out *PushResult, s conversion.Scope) error {
 	out.Ref = in.Ref
+	out.Digest = in.Digest
+	out.Size = in.Size
 	return nil
 }
 
@@ -1290,6 +1604,7 @@ func autoConvert_v1alpha1_RegistryBindingSpec_To_solar_RegistryBindingSpec(in *R
 	out.TargetRef = in.TargetRef
 	out.TargetNamespace = in.TargetNamespace
 	out.RegistryRef = in.RegistryRef
+	out.Role = solar.RegistryBindingRole(in.Role)
 	return nil
 }
 
@@ -1302,6 +1617,7 @@ func autoConvert_solar_RegistryBindingSpec_To_v1alpha1_RegistryBindingSpec(in *s
 	out.TargetRef = in.TargetRef
 	out.TargetNamespace = in.TargetNamespace
 	out.RegistryRef = in.RegistryRef
+	out.Role = RegistryBindingRole(in.Role)
 	return nil
 }
 
@@ -1356,10 +1672,19 @@ func autoConvert_v1alpha1_RegistrySpec_To_solar_RegistrySpec(in *RegistrySpec, o
 	out.Hostname = in.Hostname
 	out.PlainHTTP = in.PlainHTTP
 	out.SolarSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SolarSecretRef))
+	out.PullSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.PullSecretRef))
 	out.TargetPullSecretName = in.TargetPullSecretName
 	out.Flavor = in.Flavor
 	out.WebhookPath = in.WebhookPath
 	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanSchedule = in.ScanSchedule
+	out.ScanJitter = (*v1.Duration)(unsafe.Pointer(in.ScanJitter))
+	out.WebhookAuth = (*solar.WebhookAuth)(unsafe.Pointer(in.WebhookAuth))
+	out.DiscoveryLimits = (*solar.DiscoveryLimits)(unsafe.Pointer(in.DiscoveryLimits))
+	out.TLS = (*solar.RegistryTLS)(unsafe.Pointer(in.TLS))
+	out.LabelMappings = *(*[]solar.LabelMapping)(unsafe.Pointer(&in.LabelMappings))
+	out.VersionPolicy = (*solar.VersionPolicy)(unsafe.Pointer(in.VersionPolicy))
+	out.RepositoryFilter = (*solar.RepositoryFilter)(unsafe.Pointer(in.RepositoryFilter))
 	return nil
 }
 
@@ -1372,10 +1697,19 @@ func autoConvert_solar_RegistrySpec_To_v1alpha1_RegistrySpec(in *solar.RegistryS
 	out.Hostname = in.Hostname
 	out.PlainHTTP = in.PlainHTTP
 	out.SolarSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SolarSecretRef))
+	out.PullSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.PullSecretRef))
 	out.TargetPullSecretName = in.TargetPullSecretName
 	out.Flavor = in.Flavor
 	out.WebhookPath = in.WebhookPath
 	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanSchedule = in.ScanSchedule
+	out.ScanJitter = (*v1.Duration)(unsafe.Pointer(in.ScanJitter))
+	out.WebhookAuth = (*WebhookAuth)(unsafe.Pointer(in.WebhookAuth))
+	out.DiscoveryLimits = (*DiscoveryLimits)(unsafe.Pointer(in.DiscoveryLimits))
+	out.TLS = (*RegistryTLS)(unsafe.Pointer(in.TLS))
+	out.LabelMappings = *(*[]LabelMapping)(unsafe.Pointer(&in.LabelMappings))
+	out.VersionPolicy = (*VersionPolicy)(unsafe.Pointer(in.VersionPolicy))
+	out.RepositoryFilter = (*RepositoryFilter)(unsafe.Pointer(in.RepositoryFilter))
 	return nil
 }
 
@@ -1404,6 +1738,30 @@ func Convert_solar_RegistryStatus_To_v1alpha1_RegistryStatus(in *solar.RegistryS
 	return autoConvert_solar_RegistryStatus_To_v1alpha1_RegistryStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_RegistryTLS_To_solar_RegistryTLS(in *RegistryTLS, out *solar.RegistryTLS, s conversion.Scope) error {
+	out.CASecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.CASecretRef))
+	out.ClientCertSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClientCertSecretRef))
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1alpha1_RegistryTLS_To_solar_RegistryTLS is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryTLS_To_solar_RegistryTLS(in *RegistryTLS, out *solar.RegistryTLS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryTLS_To_solar_RegistryTLS(in, out, s)
+}
+
+func autoConvert_solar_RegistryTLS_To_v1alpha1_RegistryTLS(in *solar.RegistryTLS, out *RegistryTLS, s conversion.Scope) error {
+	out.CASecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.CASecretRef))
+	out.ClientCertSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClientCertSecretRef))
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_solar_RegistryTLS_To_v1alpha1_RegistryTLS is an autogenerated conversion function.
+func Convert_solar_RegistryTLS_To_v1alpha1_RegistryTLS(in *solar.RegistryTLS, out *RegistryTLS, s conversion.Scope) error {
+	return autoConvert_solar_RegistryTLS_To_v1alpha1_RegistryTLS(in, out, s)
+}
+
 func autoConvert_v1alpha1_Release_To_solar_Release(in *Release, out *solar.Release, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ReleaseSpec_To_solar_ReleaseSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1494,6 +1852,7 @@ func autoConvert_v1alpha1_ReleaseBindingSpec_To_solar_ReleaseBindingSpec(in *Rel
 	out.TargetRef = in.TargetRef
 	out.TargetNamespace = in.TargetNamespace
 	out.ReleaseRef = in.ReleaseRef
+	out.Values = in.Values
 	return nil
 }
 
@@ -1506,6 +1865,7 @@ func autoConvert_solar_ReleaseBindingSpec_To_v1alpha1_ReleaseBindingSpec(in *sol
 	out.TargetRef = in.TargetRef
 	out.TargetNamespace = in.TargetNamespace
 	out.ReleaseRef = in.ReleaseRef
+	out.Values = in.Values
 	return nil
 }
 
@@ -1642,6 +2002,66 @@ func Convert_solar_ReleaseList_To_v1alpha1_ReleaseList(in *solar.ReleaseList, ou
 	return autoConvert_solar_ReleaseList_To_v1alpha1_ReleaseList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ReleaseRevision_To_solar_ReleaseRevision(in *ReleaseRevision, out *solar.ReleaseRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.TargetRef = in.TargetRef
+	out.ChartURL = in.ChartURL
+	out.ArtifactName = in.ArtifactName
+	out.ComponentVersionRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ComponentVersionRef))
+	out.ValuesHash = in.ValuesHash
+	out.ChartDigest = in.ChartDigest
+	out.ChartSize = in.ChartSize
+	out.SignatureRef = in.SignatureRef
+	out.RenderedAt = in.RenderedAt
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseRevision_To_solar_ReleaseRevision is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseRevision_To_solar_ReleaseRevision(in *ReleaseRevision, out *solar.ReleaseRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseRevision_To_solar_ReleaseRevision(in, out, s)
+}
+
+func autoConvert_solar_ReleaseRevision_To_v1alpha1_ReleaseRevision(in *solar.ReleaseRevision, out *ReleaseRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.TargetRef = in.TargetRef
+	out.ChartURL = in.ChartURL
+	out.ArtifactName = in.ArtifactName
+	out.ComponentVersionRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ComponentVersionRef))
+	out.ValuesHash = in.ValuesHash
+	out.ChartDigest = in.ChartDigest
+	out.ChartSize = in.ChartSize
+	out.SignatureRef = in.SignatureRef
+	out.RenderedAt = in.RenderedAt
+	return nil
+}
+
+// Convert_solar_ReleaseRevision_To_v1alpha1_ReleaseRevision is an autogenerated conversion function.
+func Convert_solar_ReleaseRevision_To_v1alpha1_ReleaseRevision(in *solar.ReleaseRevision, out *ReleaseRevision, s conversion.Scope) error {
+	return autoConvert_solar_ReleaseRevision_To_v1alpha1_ReleaseRevision(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReleaseSchedule_To_solar_ReleaseSchedule(in *ReleaseSchedule, out *solar.ReleaseSchedule, s conversion.Scope) error {
+	out.Windows = *(*[]solar.MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseSchedule_To_solar_ReleaseSchedule is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseSchedule_To_solar_ReleaseSchedule(in *ReleaseSchedule, out *solar.ReleaseSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseSchedule_To_solar_ReleaseSchedule(in, out, s)
+}
+
+func autoConvert_solar_ReleaseSchedule_To_v1alpha1_ReleaseSchedule(in *solar.ReleaseSchedule, out *ReleaseSchedule, s conversion.Scope) error {
+	out.Windows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_solar_ReleaseSchedule_To_v1alpha1_ReleaseSchedule is an autogenerated conversion function.
+func Convert_solar_ReleaseSchedule_To_v1alpha1_ReleaseSchedule(in *solar.ReleaseSchedule, out *ReleaseSchedule, s conversion.Scope) error {
+	return autoConvert_solar_ReleaseSchedule_To_v1alpha1_ReleaseSchedule(in, out, s)
+}
+
 func autoConvert_v1alpha1_ReleaseSpec_To_solar_ReleaseSpec(in *ReleaseSpec, out *solar.ReleaseSpec, s conversion.Scope) error {
 	out.ComponentVersionRef = in.ComponentVersionRef
 	out.ComponentVersionNamespace = in.ComponentVersionNamespace
@@ -1649,8 +2069,17 @@ func autoConvert_v1alpha1_ReleaseSpec_To_solar_ReleaseSpec(in *ReleaseSpec, out
 	out.UniqueName = in.UniqueName
 	out.AntiAffinity = (*v1.LabelSelector)(unsafe.Pointer(in.AntiAffinity))
 	out.Values = in.Values
+	out.ValuesFrom = *(*[]solar.ValuesReference)(unsafe.Pointer(&in.ValuesFrom))
+	out.SensitivePaths = *(*[]string)(unsafe.Pointer(&in.SensitivePaths))
 	out.FailedJobTTL = (*int32)(unsafe.Pointer(in.FailedJobTTL))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
 	out.Priority = in.Priority
+	out.RollbackTo = (*int64)(unsafe.Pointer(in.RollbackTo))
+	out.HistoryLimit = (*int32)(unsafe.Pointer(in.HistoryLimit))
+	out.Suspend = in.Suspend
+	out.ComponentVersionUpdatePolicy = solar.ComponentVersionUpdatePolicy(in.ComponentVersionUpdatePolicy)
+	out.Schedule = (*solar.ReleaseSchedule)(unsafe.Pointer(in.Schedule))
 	return nil
 }
 
@@ -1666,8 +2095,17 @@ func autoConvert_solar_ReleaseSpec_To_v1alpha1_ReleaseSpec(in *solar.ReleaseSpec
 	out.UniqueName = in.UniqueName
 	out.AntiAffinity = (*v1.LabelSelector)(unsafe.Pointer(in.AntiAffinity))
 	out.Values = in.Values
+	out.ValuesFrom = *(*[]ValuesReference)(unsafe.Pointer(&in.ValuesFrom))
+	out.SensitivePaths = *(*[]string)(unsafe.Pointer(&in.SensitivePaths))
 	out.FailedJobTTL = (*int32)(unsafe.Pointer(in.FailedJobTTL))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
 	out.Priority = in.Priority
+	out.RollbackTo = (*int64)(unsafe.Pointer(in.RollbackTo))
+	out.HistoryLimit = (*int32)(unsafe.Pointer(in.HistoryLimit))
+	out.Suspend = in.Suspend
+	out.ComponentVersionUpdatePolicy = ComponentVersionUpdatePolicy(in.ComponentVersionUpdatePolicy)
+	out.Schedule = (*ReleaseSchedule)(unsafe.Pointer(in.Schedule))
 	return nil
 }
 
@@ -1677,9 +2115,11 @@ func Convert_solar_ReleaseSpec_To_v1alpha1_ReleaseSpec(in *solar.ReleaseSpec, ou
 }
 
 func autoConvert_v1alpha1_ReleaseStatus_To_solar_ReleaseStatus(in *ReleaseStatus, out *solar.ReleaseStatus, s conversion.Scope) error {
+	out.Phase = solar.ReleasePhase(in.Phase)
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.RenderTaskRef = (*corev1.ObjectReference)(unsafe.Pointer(in.RenderTaskRef))
 	out.EffectiveUniqueName = in.EffectiveUniqueName
+	out.History = *(*[]solar.ReleaseRevision)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -1689,9 +2129,11 @@ func Convert_v1alpha1_ReleaseStatus_To_solar_ReleaseStatus(in *ReleaseStatus, ou
 }
 
 func autoConvert_solar_ReleaseStatus_To_v1alpha1_ReleaseStatus(in *solar.ReleaseStatus, out *ReleaseStatus, s conversion.Scope) error {
+	out.Phase = ReleasePhase(in.Phase)
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.RenderTaskRef = (*corev1.ObjectReference)(unsafe.Pointer(in.RenderTaskRef))
 	out.EffectiveUniqueName = in.EffectiveUniqueName
+	out.History = *(*[]ReleaseRevision)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -1884,6 +2326,66 @@ func Convert_solar_RenderBindingSpec_To_v1alpha1_RenderBindingSpec(in *solar.Ren
 	return autoConvert_solar_RenderBindingSpec_To_v1alpha1_RenderBindingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_RenderPreview_To_solar_RenderPreview(in *RenderPreview, out *solar.RenderPreview, s conversion.Scope) error {
+	out.Files = in.Files
+	out.Size = in.Size
+	out.CurrentRenderTask = in.CurrentRenderTask
+	out.CurrentContentDigest = in.CurrentContentDigest
+	out.Changed = in.Changed
+	return nil
+}
+
+// Convert_v1alpha1_RenderPreview_To_solar_RenderPreview is an autogenerated conversion function.
+func Convert_v1alpha1_RenderPreview_To_solar_RenderPreview(in *RenderPreview, out *solar.RenderPreview, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RenderPreview_To_solar_RenderPreview(in, out, s)
+}
+
+func autoConvert_solar_RenderPreview_To_v1alpha1_RenderPreview(in *solar.RenderPreview, out *RenderPreview, s conversion.Scope) error {
+	out.Files = in.Files
+	out.Size = in.Size
+	out.CurrentRenderTask = in.CurrentRenderTask
+	out.CurrentContentDigest = in.CurrentContentDigest
+	out.Changed = in.Changed
+	return nil
+}
+
+// Convert_solar_RenderPreview_To_v1alpha1_RenderPreview is an autogenerated conversion function.
+func Convert_solar_RenderPreview_To_v1alpha1_RenderPreview(in *solar.RenderPreview, out *RenderPreview, s conversion.Scope) error {
+	return autoConvert_solar_RenderPreview_To_v1alpha1_RenderPreview(in, out, s)
+}
+
+func autoConvert_v1alpha1_RenderReport_To_solar_RenderReport(in *RenderReport, out *solar.RenderReport, s conversion.Scope) error {
+	out.ContentDigest = in.ContentDigest
+	out.ChartDigest = in.ChartDigest
+	out.ChartSize = in.ChartSize
+	out.SignatureRef = in.SignatureRef
+	out.RenderedAt = (*v1.Time)(unsafe.Pointer(in.RenderedAt))
+	out.Files = in.Files
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1alpha1_RenderReport_To_solar_RenderReport is an autogenerated conversion function.
+func Convert_v1alpha1_RenderReport_To_solar_RenderReport(in *RenderReport, out *solar.RenderReport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RenderReport_To_solar_RenderReport(in, out, s)
+}
+
+func autoConvert_solar_RenderReport_To_v1alpha1_RenderReport(in *solar.RenderReport, out *RenderReport, s conversion.Scope) error {
+	out.ContentDigest = in.ContentDigest
+	out.ChartDigest = in.ChartDigest
+	out.ChartSize = in.ChartSize
+	out.SignatureRef = in.SignatureRef
+	out.RenderedAt = (*v1.Time)(unsafe.Pointer(in.RenderedAt))
+	out.Files = in.Files
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_solar_RenderReport_To_v1alpha1_RenderReport is an autogenerated conversion function.
+func Convert_solar_RenderReport_To_v1alpha1_RenderReport(in *solar.RenderReport, out *RenderReport, s conversion.Scope) error {
+	return autoConvert_solar_RenderReport_To_v1alpha1_RenderReport(in, out, s)
+}
+
 func autoConvert_v1alpha1_RenderResult_To_solar_RenderResult(in *RenderResult, out *solar.RenderResult, s conversion.Scope) error {
 	out.Dir = in.Dir
 	return nil
@@ -1958,6 +2460,28 @@ func Convert_solar_RenderTaskList_To_v1alpha1_RenderTaskList(in *solar.RenderTas
 	return autoConvert_solar_RenderTaskList_To_v1alpha1_RenderTaskList(in, out, s)
 }
 
+func autoConvert_v1alpha1_RenderTaskRetryPolicy_To_solar_RenderTaskRetryPolicy(in *RenderTaskRetryPolicy, out *solar.RenderTaskRetryPolicy, s conversion.Scope) error {
+	out.MaxRetries = in.MaxRetries
+	out.Backoff = (*v1.Duration)(unsafe.Pointer(in.Backoff))
+	return nil
+}
+
+// Convert_v1alpha1_RenderTaskRetryPolicy_To_solar_RenderTaskRetryPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RenderTaskRetryPolicy_To_solar_RenderTaskRetryPolicy(in *RenderTaskRetryPolicy, out *solar.RenderTaskRetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RenderTaskRetryPolicy_To_solar_RenderTaskRetryPolicy(in, out, s)
+}
+
+func autoConvert_solar_RenderTaskRetryPolicy_To_v1alpha1_RenderTaskRetryPolicy(in *solar.RenderTaskRetryPolicy, out *RenderTaskRetryPolicy, s conversion.Scope) error {
+	out.MaxRetries = in.MaxRetries
+	out.Backoff = (*v1.Duration)(unsafe.Pointer(in.Backoff))
+	return nil
+}
+
+// Convert_solar_RenderTaskRetryPolicy_To_v1alpha1_RenderTaskRetryPolicy is an autogenerated conversion function.
+func Convert_solar_RenderTaskRetryPolicy_To_v1alpha1_RenderTaskRetryPolicy(in *solar.RenderTaskRetryPolicy, out *RenderTaskRetryPolicy, s conversion.Scope) error {
+	return autoConvert_solar_RenderTaskRetryPolicy_To_v1alpha1_RenderTaskRetryPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_RenderTaskSpec_To_solar_RenderTaskSpec(in *RenderTaskSpec, out *solar.RenderTaskSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_RendererConfig_To_solar_RendererConfig(&in.RendererConfig, &out.RendererConfig, s); err != nil {
 		return err
@@ -1966,8 +2490,13 @@ func autoConvert_v1alpha1_RenderTaskSpec_To_solar_RenderTaskSpec(in *RenderTaskS
 	out.Tag = in.Tag
 	out.BaseURL = in.BaseURL
 	out.PushSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PushSecretRef))
+	out.SensitiveValuesSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SensitiveValuesSecretRef))
 	out.PlainHTTP = in.PlainHTTP
 	out.FailedJobTTL = (*int32)(unsafe.Pointer(in.FailedJobTTL))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.Priority = in.Priority
+	out.RetryPolicy = (*solar.RenderTaskRetryPolicy)(unsafe.Pointer(in.RetryPolicy))
 	out.OwnerName = in.OwnerName
 	out.OwnerNamespace = in.OwnerNamespace
 	out.OwnerKind = in.OwnerKind
@@ -1987,8 +2516,13 @@ func autoConvert_solar_RenderTaskSpec_To_v1alpha1_RenderTaskSpec(in *solar.Rende
 	out.Tag = in.Tag
 	out.BaseURL = in.BaseURL
 	out.PushSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PushSecretRef))
+	out.SensitiveValuesSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.SensitiveValuesSecretRef))
 	out.PlainHTTP = in.PlainHTTP
 	out.FailedJobTTL = (*int32)(unsafe.Pointer(in.FailedJobTTL))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.Priority = in.Priority
+	out.RetryPolicy = (*RenderTaskRetryPolicy)(unsafe.Pointer(in.RetryPolicy))
 	out.OwnerName = in.OwnerName
 	out.OwnerNamespace = in.OwnerNamespace
 	out.OwnerKind = in.OwnerKind
@@ -2005,6 +2539,15 @@ func autoConvert_v1alpha1_RenderTaskStatus_To_solar_RenderTaskStatus(in *RenderT
 	out.JobRef = (*corev1.ObjectReference)(unsafe.Pointer(in.JobRef))
 	out.ConfigSecretRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigSecretRef))
 	out.ChartURL = in.ChartURL
+	out.ConfigHash = in.ConfigHash
+	out.PrimaryRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PrimaryRef))
+	out.Attempts = in.Attempts
+	out.ContentDigest = in.ContentDigest
+	out.ChartDigest = in.ChartDigest
+	out.ChartSize = in.ChartSize
+	out.SignatureRef = in.SignatureRef
+	out.RenderedAt = (*v1.Time)(unsafe.Pointer(in.RenderedAt))
+	out.Preview = (*solar.RenderPreview)(unsafe.Pointer(in.Preview))
 	return nil
 }
 
@@ -2018,6 +2561,15 @@ func autoConvert_solar_RenderTaskStatus_To_v1alpha1_RenderTaskStatus(in *solar.R
 	out.JobRef = (*corev1.ObjectReference)(unsafe.Pointer(in.JobRef))
 	out.ConfigSecretRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigSecretRef))
 	out.ChartURL = in.ChartURL
+	out.ConfigHash = in.ConfigHash
+	out.PrimaryRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PrimaryRef))
+	out.Attempts = in.Attempts
+	out.ContentDigest = in.ContentDigest
+	out.ChartDigest = in.ChartDigest
+	out.ChartSize = in.ChartSize
+	out.SignatureRef = in.SignatureRef
+	out.RenderedAt = (*v1.Time)(unsafe.Pointer(in.RenderedAt))
+	out.Preview = (*RenderPreview)(unsafe.Pointer(in.Preview))
 	return nil
 }
 
@@ -2058,10 +2610,33 @@ func Convert_solar_RendererConfig_To_v1alpha1_RendererConfig(in *solar.RendererC
 	return autoConvert_solar_RendererConfig_To_v1alpha1_RendererConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_RepositoryFilter_To_solar_RepositoryFilter(in *RepositoryFilter, out *solar.RepositoryFilter, s conversion.Scope) error {
+	out.IncludePrefixes = *(*[]string)(unsafe.Pointer(&in.IncludePrefixes))
+	out.ExcludePrefixes = *(*[]string)(unsafe.Pointer(&in.ExcludePrefixes))
+	return nil
+}
+
+// Convert_v1alpha1_RepositoryFilter_To_solar_RepositoryFilter is an autogenerated conversion function.
+func Convert_v1alpha1_RepositoryFilter_To_solar_RepositoryFilter(in *RepositoryFilter, out *solar.RepositoryFilter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RepositoryFilter_To_solar_RepositoryFilter(in, out, s)
+}
+
+func autoConvert_solar_RepositoryFilter_To_v1alpha1_RepositoryFilter(in *solar.RepositoryFilter, out *RepositoryFilter, s conversion.Scope) error {
+	out.IncludePrefixes = *(*[]string)(unsafe.Pointer(&in.IncludePrefixes))
+	out.ExcludePrefixes = *(*[]string)(unsafe.Pointer(&in.ExcludePrefixes))
+	return nil
+}
+
+// Convert_solar_RepositoryFilter_To_v1alpha1_RepositoryFilter is an autogenerated conversion function.
+func Convert_solar_RepositoryFilter_To_v1alpha1_RepositoryFilter(in *solar.RepositoryFilter, out *RepositoryFilter, s conversion.Scope) error {
+	return autoConvert_solar_RepositoryFilter_To_v1alpha1_RepositoryFilter(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResolvedResourceAccess_To_solar_ResolvedResourceAccess(in *ResolvedResourceAccess, out *solar.ResolvedResourceAccess, s conversion.Scope) error {
 	out.Repository = in.Repository
 	out.Insecure = in.Insecure
 	out.Tag = in.Tag
+	out.Digest = in.Digest
 	out.Helm = (*solar.HelmResourceMetadata)(unsafe.Pointer(in.Helm))
 	out.PullSecretName = in.PullSecretName
 	return nil
@@ -2076,6 +2651,7 @@ func autoConvert_solar_ResolvedResourceAccess_To_v1alpha1_ResolvedResourceAccess
 	out.Repository = in.Repository
 	out.Insecure = in.Insecure
 	out.Tag = in.Tag
+	out.Digest = in.Digest
 	out.Helm = (*HelmResourceMetadata)(unsafe.Pointer(in.Helm))
 	out.PullSecretName = in.PullSecretName
 	return nil
@@ -2112,6 +2688,28 @@ func Convert_solar_ResourceAccess_To_v1alpha1_ResourceAccess(in *solar.ResourceA
 	return autoConvert_solar_ResourceAccess_To_v1alpha1_ResourceAccess(in, out, s)
 }
 
+func autoConvert_v1alpha1_TagDiscovery_To_solar_TagDiscovery(in *TagDiscovery, out *solar.TagDiscovery, s conversion.Scope) error {
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.SemverConstraint = in.SemverConstraint
+	return nil
+}
+
+// Convert_v1alpha1_TagDiscovery_To_solar_TagDiscovery is an autogenerated conversion function.
+func Convert_v1alpha1_TagDiscovery_To_solar_TagDiscovery(in *TagDiscovery, out *solar.TagDiscovery, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TagDiscovery_To_solar_TagDiscovery(in, out, s)
+}
+
+func autoConvert_solar_TagDiscovery_To_v1alpha1_TagDiscovery(in *solar.TagDiscovery, out *TagDiscovery, s conversion.Scope) error {
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.SemverConstraint = in.SemverConstraint
+	return nil
+}
+
+// Convert_solar_TagDiscovery_To_v1alpha1_TagDiscovery is an autogenerated conversion function.
+func Convert_solar_TagDiscovery_To_v1alpha1_TagDiscovery(in *solar.TagDiscovery, out *TagDiscovery, s conversion.Scope) error {
+	return autoConvert_solar_TagDiscovery_To_v1alpha1_TagDiscovery(in, out, s)
+}
+
 func autoConvert_v1alpha1_Target_To_solar_Target(in *Target, out *solar.Target, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TargetSpec_To_solar_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2166,10 +2764,44 @@ func Convert_solar_TargetList_To_v1alpha1_TargetList(in *solar.TargetList, out *
 	return autoConvert_solar_TargetList_To_v1alpha1_TargetList(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetReleaseStatus_To_solar_TargetReleaseStatus(in *TargetReleaseStatus, out *solar.TargetReleaseStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = in.Ready
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ChartURL = in.ChartURL
+	out.ChartDigest = in.ChartDigest
+	return nil
+}
+
+// Convert_v1alpha1_TargetReleaseStatus_To_solar_TargetReleaseStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TargetReleaseStatus_To_solar_TargetReleaseStatus(in *TargetReleaseStatus, out *solar.TargetReleaseStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetReleaseStatus_To_solar_TargetReleaseStatus(in, out, s)
+}
+
+func autoConvert_solar_TargetReleaseStatus_To_v1alpha1_TargetReleaseStatus(in *solar.TargetReleaseStatus, out *TargetReleaseStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = in.Ready
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ChartURL = in.ChartURL
+	out.ChartDigest = in.ChartDigest
+	return nil
+}
+
+// Convert_solar_TargetReleaseStatus_To_v1alpha1_TargetReleaseStatus is an autogenerated conversion function.
+func Convert_solar_TargetReleaseStatus_To_v1alpha1_TargetReleaseStatus(in *solar.TargetReleaseStatus, out *TargetReleaseStatus, s conversion.Scope) error {
+	return autoConvert_solar_TargetReleaseStatus_To_v1alpha1_TargetReleaseStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSpec_To_solar_TargetSpec(in *TargetSpec, out *solar.TargetSpec, s conversion.Scope) error {
 	out.RenderRegistryRef = in.RenderRegistryRef
 	out.RenderRegistryNamespace = in.RenderRegistryNamespace
 	out.Userdata = in.Userdata
+	out.AllowPartialRender = in.AllowPartialRender
+	out.FailedJobTTL = (*int32)(unsafe.Pointer(in.FailedJobTTL))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
 	return nil
 }
 
@@ -2182,6 +2814,10 @@ func autoConvert_solar_TargetSpec_To_v1alpha1_TargetSpec(in *solar.TargetSpec, o
 	out.RenderRegistryRef = in.RenderRegistryRef
 	out.RenderRegistryNamespace = in.RenderRegistryNamespace
 	out.Userdata = in.Userdata
+	out.AllowPartialRender = in.AllowPartialRender
+	out.FailedJobTTL = (*int32)(unsafe.Pointer(in.FailedJobTTL))
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
 	return nil
 }
 
@@ -2192,6 +2828,7 @@ func Convert_solar_TargetSpec_To_v1alpha1_TargetSpec(in *solar.TargetSpec, out *
 
 func autoConvert_v1alpha1_TargetStatus_To_solar_TargetStatus(in *TargetStatus, out *solar.TargetStatus, s conversion.Scope) error {
 	out.BootstrapVersion = in.BootstrapVersion
+	out.Releases = *(*[]solar.TargetReleaseStatus)(unsafe.Pointer(&in.Releases))
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -2203,6 +2840,7 @@ func Convert_v1alpha1_TargetStatus_To_solar_TargetStatus(in *TargetStatus, out *
 
 func autoConvert_solar_TargetStatus_To_v1alpha1_TargetStatus(in *solar.TargetStatus, out *TargetStatus, s conversion.Scope) error {
 	out.BootstrapVersion = in.BootstrapVersion
+	out.Releases = *(*[]TargetReleaseStatus)(unsafe.Pointer(&in.Releases))
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -2211,3 +2849,95 @@ func autoConvert_solar_TargetStatus_To_v1alpha1_TargetStatus(in *solar.TargetSta
 func Convert_solar_TargetStatus_To_v1alpha1_TargetStatus(in *solar.TargetStatus, out *TargetStatus, s conversion.Scope) error {
 	return autoConvert_solar_TargetStatus_To_v1alpha1_TargetStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_ValuesReference_To_solar_ValuesReference(in *ValuesReference, out *solar.ValuesReference, s conversion.Scope) error {
+	out.ConfigMapKeyRef = (*corev1.ConfigMapKeySelector)(unsafe.Pointer(in.ConfigMapKeyRef))
+	out.SecretKeyRef = (*corev1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
+	return nil
+}
+
+// Convert_v1alpha1_ValuesReference_To_solar_ValuesReference is an autogenerated conversion function.
+func Convert_v1alpha1_ValuesReference_To_solar_ValuesReference(in *ValuesReference, out *solar.ValuesReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValuesReference_To_solar_ValuesReference(in, out, s)
+}
+
+func autoConvert_solar_ValuesReference_To_v1alpha1_ValuesReference(in *solar.ValuesReference, out *ValuesReference, s conversion.Scope) error {
+	out.ConfigMapKeyRef = (*corev1.ConfigMapKeySelector)(unsafe.Pointer(in.ConfigMapKeyRef))
+	out.SecretKeyRef = (*corev1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
+	return nil
+}
+
+// Convert_solar_ValuesReference_To_v1alpha1_ValuesReference is an autogenerated conversion function.
+func Convert_solar_ValuesReference_To_v1alpha1_ValuesReference(in *solar.ValuesReference, out *ValuesReference, s conversion.Scope) error {
+	return autoConvert_solar_ValuesReference_To_v1alpha1_ValuesReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_VersionChannel_To_solar_VersionChannel(in *VersionChannel, out *solar.VersionChannel, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Prerelease = *(*[]string)(unsafe.Pointer(&in.Prerelease))
+	return nil
+}
+
+// Convert_v1alpha1_VersionChannel_To_solar_VersionChannel is an autogenerated conversion function.
+func Convert_v1alpha1_VersionChannel_To_solar_VersionChannel(in *VersionChannel, out *solar.VersionChannel, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VersionChannel_To_solar_VersionChannel(in, out, s)
+}
+
+func autoConvert_solar_VersionChannel_To_v1alpha1_VersionChannel(in *solar.VersionChannel, out *VersionChannel, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Prerelease = *(*[]string)(unsafe.Pointer(&in.Prerelease))
+	return nil
+}
+
+// Convert_solar_VersionChannel_To_v1alpha1_VersionChannel is an autogenerated conversion function.
+func Convert_solar_VersionChannel_To_v1alpha1_VersionChannel(in *solar.VersionChannel, out *VersionChannel, s conversion.Scope) error {
+	return autoConvert_solar_VersionChannel_To_v1alpha1_VersionChannel(in, out, s)
+}
+
+func autoConvert_v1alpha1_VersionPolicy_To_solar_VersionPolicy(in *VersionPolicy, out *solar.VersionPolicy, s conversion.Scope) error {
+	out.SemverConstraint = in.SemverConstraint
+	out.Channels = *(*[]solar.VersionChannel)(unsafe.Pointer(&in.Channels))
+	return nil
+}
+
+// Convert_v1alpha1_VersionPolicy_To_solar_VersionPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_VersionPolicy_To_solar_VersionPolicy(in *VersionPolicy, out *solar.VersionPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VersionPolicy_To_solar_VersionPolicy(in, out, s)
+}
+
+func autoConvert_solar_VersionPolicy_To_v1alpha1_VersionPolicy(in *solar.VersionPolicy, out *VersionPolicy, s conversion.Scope) error {
+	out.SemverConstraint = in.SemverConstraint
+	out.Channels = *(*[]VersionChannel)(unsafe.Pointer(&in.Channels))
+	return nil
+}
+
+// Convert_solar_VersionPolicy_To_v1alpha1_VersionPolicy is an autogenerated conversion function.
+func Convert_solar_VersionPolicy_To_v1alpha1_VersionPolicy(in *solar.VersionPolicy, out *VersionPolicy, s conversion.Scope) error {
+	return autoConvert_solar_VersionPolicy_To_v1alpha1_VersionPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_WebhookAuth_To_solar_WebhookAuth(in *WebhookAuth, out *solar.WebhookAuth, s conversion.Scope) error {
+	out.Type = solar.WebhookAuthType(in.Type)
+	out.SecretRef = in.SecretRef
+	out.SignatureHeader = in.SignatureHeader
+	out.Algorithm = in.Algorithm
+	return nil
+}
+
+// Convert_v1alpha1_WebhookAuth_To_solar_WebhookAuth is an autogenerated conversion function.
+func Convert_v1alpha1_WebhookAuth_To_solar_WebhookAuth(in *WebhookAuth, out *solar.WebhookAuth, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WebhookAuth_To_solar_WebhookAuth(in, out, s)
+}
+
+func autoConvert_solar_WebhookAuth_To_v1alpha1_WebhookAuth(in *solar.WebhookAuth, out *WebhookAuth, s conversion.Scope) error {
+	out.Type = WebhookAuthType(in.Type)
+	out.SecretRef = in.SecretRef
+	out.SignatureHeader = in.SignatureHeader
+	out.Algorithm = in.Algorithm
+	return nil
+}
+
+// Convert_solar_WebhookAuth_To_v1alpha1_WebhookAuth is an autogenerated conversion function.
+func Convert_solar_WebhookAuth_To_v1alpha1_WebhookAuth(in *solar.WebhookAuth, out *WebhookAuth, s conversion.Scope) error {
+	return autoConvert_solar_WebhookAuth_To_v1alpha1_WebhookAuth(in, out, s)
+}
@@ -18,6 +18,11 @@ func (in BootstrapInput) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.BootstrapInput"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CatalogDetails) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.CatalogDetails"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ChartConfig) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.ChartConfig"
@@ -63,6 +68,16 @@ func (in ComponentVersionStatus) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.ComponentVersionStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ComponentVersionSummary) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.ComponentVersionSummary"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DiscoveryLimits) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.DiscoveryLimits"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Entrypoint) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.Entrypoint"
@@ -73,6 +88,16 @@ func (in HelmResourceMetadata) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.HelmResourceMetadata"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LabelMapping) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.LabelMapping"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MaintenanceWindow) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.MaintenanceWindow"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Profile) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.Profile"
@@ -163,6 +188,11 @@ func (in RegistryStatus) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.RegistryStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RegistryTLS) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.RegistryTLS"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Release) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.Release"
@@ -208,6 +238,16 @@ func (in ReleaseList) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.ReleaseList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ReleaseRevision) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.ReleaseRevision"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ReleaseSchedule) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.ReleaseSchedule"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ReleaseSpec) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.ReleaseSpec"
@@ -253,6 +293,16 @@ func (in RenderBindingSpec) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.RenderBindingSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RenderPreview) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.RenderPreview"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RenderReport) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.RenderReport"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in RenderResult) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.RenderResult"
@@ -268,6 +318,11 @@ func (in RenderTaskList) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.RenderTaskList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RenderTaskRetryPolicy) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.RenderTaskRetryPolicy"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in RenderTaskSpec) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.RenderTaskSpec"
@@ -283,6 +338,11 @@ func (in RendererConfig) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.RendererConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RepositoryFilter) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.RepositoryFilter"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ResolvedResourceAccess) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.ResolvedResourceAccess"
@@ -293,6 +353,11 @@ func (in ResourceAccess) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.ResourceAccess"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TagDiscovery) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.TagDiscovery"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Target) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.Target"
@@ -303,6 +368,11 @@ func (in TargetList) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.TargetList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TargetReleaseStatus) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.TargetReleaseStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in TargetSpec) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.TargetSpec"
@@ -312,3 +382,23 @@ func (in TargetSpec) OpenAPIModelName() string {
 func (in TargetStatus) OpenAPIModelName() string {
 	return "cloud.opendefense.solar.v1alpha1.TargetStatus"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ValuesReference) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.ValuesReference"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VersionChannel) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.VersionChannel"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VersionPolicy) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.VersionPolicy"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WebhookAuth) OpenAPIModelName() string {
+	return "cloud.opendefense.solar.v1alpha1.WebhookAuth"
+}
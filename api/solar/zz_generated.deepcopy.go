@@ -56,6 +56,27 @@ func (in *BootstrapInput) DeepCopy() *BootstrapInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogDetails) DeepCopyInto(out *CatalogDetails) {
+	*out = *in
+	if in.Icon != nil {
+		in, out := &in.Icon, &out.Icon
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogDetails.
+func (in *CatalogDetails) DeepCopy() *CatalogDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartConfig) DeepCopyInto(out *ChartConfig) {
 	*out = *in
@@ -77,8 +98,8 @@ func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -136,6 +157,11 @@ func (in *ComponentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 	*out = *in
+	if in.TagDiscovery != nil {
+		in, out := &in.TagDiscovery, &out.TagDiscovery
+		*out = new(TagDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -152,6 +178,18 @@ func (in *ComponentSpec) DeepCopy() *ComponentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ComponentVersionSummary, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -171,7 +209,7 @@ func (in *ComponentVersion) DeepCopyInto(out *ComponentVersion) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -238,6 +276,11 @@ func (in *ComponentVersionSpec) DeepCopyInto(out *ComponentVersionSpec) {
 		}
 	}
 	out.Entrypoint = in.Entrypoint
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = new(CatalogDetails)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -254,6 +297,10 @@ func (in *ComponentVersionSpec) DeepCopy() *ComponentVersionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentVersionStatus) DeepCopyInto(out *ComponentVersionStatus) {
 	*out = *in
+	if in.UnavailableSince != nil {
+		in, out := &in.UnavailableSince, &out.UnavailableSince
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -267,6 +314,43 @@ func (in *ComponentVersionStatus) DeepCopy() *ComponentVersionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionSummary) DeepCopyInto(out *ComponentVersionSummary) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionSummary.
+func (in *ComponentVersionSummary) DeepCopy() *ComponentVersionSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveryLimits) DeepCopyInto(out *DiscoveryLimits) {
+	*out = *in
+	if in.RequestInterval != nil {
+		in, out := &in.RequestInterval, &out.RequestInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveryLimits.
+func (in *DiscoveryLimits) DeepCopy() *DiscoveryLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveryLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Entrypoint) DeepCopyInto(out *Entrypoint) {
 	*out = *in
@@ -304,6 +388,39 @@ func (in *HelmResourceMetadata) DeepCopy() *HelmResourceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelMapping) DeepCopyInto(out *LabelMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelMapping.
+func (in *LabelMapping) DeepCopy() *LabelMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Profile) DeepCopyInto(out *Profile) {
 	*out = *in
@@ -712,11 +829,51 @@ func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
 	if in.ScanInterval != nil {
 		in, out := &in.ScanInterval, &out.ScanInterval
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.ScanJitter != nil {
+		in, out := &in.ScanJitter, &out.ScanJitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WebhookAuth != nil {
+		in, out := &in.WebhookAuth, &out.WebhookAuth
+		*out = new(WebhookAuth)
+		**out = **in
+	}
+	if in.DiscoveryLimits != nil {
+		in, out := &in.DiscoveryLimits, &out.DiscoveryLimits
+		*out = new(DiscoveryLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(RegistryTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelMappings != nil {
+		in, out := &in.LabelMappings, &out.LabelMappings
+		*out = make([]LabelMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.VersionPolicy != nil {
+		in, out := &in.VersionPolicy, &out.VersionPolicy
+		*out = new(VersionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositoryFilter != nil {
+		in, out := &in.RepositoryFilter, &out.RepositoryFilter
+		*out = new(RepositoryFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -753,6 +910,32 @@ func (in *RegistryStatus) DeepCopy() *RegistryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryTLS) DeepCopyInto(out *RegistryTLS) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryTLS.
+func (in *RegistryTLS) DeepCopy() *RegistryTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Release) DeepCopyInto(out *Release) {
 	*out = *in
@@ -786,7 +969,7 @@ func (in *ReleaseBinding) DeepCopyInto(out *ReleaseBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -847,6 +1030,7 @@ func (in *ReleaseBindingSpec) DeepCopyInto(out *ReleaseBindingSpec) {
 	*out = *in
 	out.TargetRef = in.TargetRef
 	out.ReleaseRef = in.ReleaseRef
+	in.Values.DeepCopyInto(&out.Values)
 	return
 }
 
@@ -976,6 +1160,50 @@ func (in *ReleaseList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseRevision) DeepCopyInto(out *ReleaseRevision) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+	if in.ComponentVersionRef != nil {
+		in, out := &in.ComponentVersionRef, &out.ComponentVersionRef
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	in.RenderedAt.DeepCopyInto(&out.RenderedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseRevision.
+func (in *ReleaseRevision) DeepCopy() *ReleaseRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseSchedule) DeepCopyInto(out *ReleaseSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSchedule.
+func (in *ReleaseSchedule) DeepCopy() *ReleaseSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
 	*out = *in
@@ -991,11 +1219,48 @@ func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Values.DeepCopyInto(&out.Values)
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]ValuesReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SensitivePaths != nil {
+		in, out := &in.SensitivePaths, &out.SensitivePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.FailedJobTTL != nil {
 		in, out := &in.FailedJobTTL, &out.FailedJobTTL
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RollbackTo != nil {
+		in, out := &in.RollbackTo, &out.RollbackTo
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ReleaseSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1024,6 +1289,13 @@ func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
 		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ReleaseRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1219,6 +1491,42 @@ func (in *RenderBindingSpec) DeepCopy() *RenderBindingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderPreview) DeepCopyInto(out *RenderPreview) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderPreview.
+func (in *RenderPreview) DeepCopy() *RenderPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderReport) DeepCopyInto(out *RenderReport) {
+	*out = *in
+	if in.RenderedAt != nil {
+		in, out := &in.RenderedAt, &out.RenderedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderReport.
+func (in *RenderReport) DeepCopy() *RenderReport {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RenderResult) DeepCopyInto(out *RenderResult) {
 	*out = *in
@@ -1296,6 +1604,27 @@ func (in *RenderTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderTaskRetryPolicy) DeepCopyInto(out *RenderTaskRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderTaskRetryPolicy.
+func (in *RenderTaskRetryPolicy) DeepCopy() *RenderTaskRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderTaskRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RenderTaskSpec) DeepCopyInto(out *RenderTaskSpec) {
 	*out = *in
@@ -1305,11 +1634,31 @@ func (in *RenderTaskSpec) DeepCopyInto(out *RenderTaskSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.SensitiveValuesSecretRef != nil {
+		in, out := &in.SensitiveValuesSecretRef, &out.SensitiveValuesSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	if in.FailedJobTTL != nil {
 		in, out := &in.FailedJobTTL, &out.FailedJobTTL
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RenderTaskRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1343,6 +1692,20 @@ func (in *RenderTaskStatus) DeepCopyInto(out *RenderTaskStatus) {
 		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
+	if in.PrimaryRef != nil {
+		in, out := &in.PrimaryRef, &out.PrimaryRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.RenderedAt != nil {
+		in, out := &in.RenderedAt, &out.RenderedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(RenderPreview)
+		**out = **in
+	}
 	return
 }
 
@@ -1374,6 +1737,32 @@ func (in *RendererConfig) DeepCopy() *RendererConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFilter) DeepCopyInto(out *RepositoryFilter) {
+	*out = *in
+	if in.IncludePrefixes != nil {
+		in, out := &in.IncludePrefixes, &out.IncludePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludePrefixes != nil {
+		in, out := &in.ExcludePrefixes, &out.ExcludePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFilter.
+func (in *RepositoryFilter) DeepCopy() *RepositoryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedResourceAccess) DeepCopyInto(out *ResolvedResourceAccess) {
 	*out = *in
@@ -1416,6 +1805,27 @@ func (in *ResourceAccess) DeepCopy() *ResourceAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagDiscovery) DeepCopyInto(out *TagDiscovery) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagDiscovery.
+func (in *TagDiscovery) DeepCopy() *TagDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(TagDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Target) DeepCopyInto(out *Target) {
 	*out = *in
@@ -1477,11 +1887,42 @@ func (in *TargetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetReleaseStatus) DeepCopyInto(out *TargetReleaseStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetReleaseStatus.
+func (in *TargetReleaseStatus) DeepCopy() *TargetReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 	*out = *in
 	out.RenderRegistryRef = in.RenderRegistryRef
 	in.Userdata.DeepCopyInto(&out.Userdata)
+	if in.FailedJobTTL != nil {
+		in, out := &in.FailedJobTTL, &out.FailedJobTTL
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1498,6 +1939,11 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
 	*out = *in
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]TargetReleaseStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1517,3 +1963,89 @@ func (in *TargetStatus) DeepCopy() *TargetStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesReference) DeepCopyInto(out *ValuesReference) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesReference.
+func (in *ValuesReference) DeepCopy() *ValuesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionChannel) DeepCopyInto(out *VersionChannel) {
+	*out = *in
+	if in.Prerelease != nil {
+		in, out := &in.Prerelease, &out.Prerelease
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionChannel.
+func (in *VersionChannel) DeepCopy() *VersionChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionPolicy) DeepCopyInto(out *VersionPolicy) {
+	*out = *in
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]VersionChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionPolicy.
+func (in *VersionPolicy) DeepCopy() *VersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookAuth) DeepCopyInto(out *WebhookAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAuth.
+func (in *WebhookAuth) DeepCopy() *WebhookAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookAuth)
+	in.DeepCopyInto(out)
+	return out
+}
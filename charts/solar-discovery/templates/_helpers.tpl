@@ -66,3 +66,16 @@ Discovery image
 {{- $tag := .Values.image.tag | default .Chart.AppVersion }}
 {{- printf "%s:%s" .Values.image.repository $tag }}
 {{- end }}
+
+{{/*
+Name of the Secret holding the webhook server certificate
+*/}}
+{{- define "solar-discovery.webhookTLSSecretName" -}}
+{{- if .Values.webhookTLS.secretName }}
+{{- .Values.webhookTLS.secretName }}
+{{- else if .Values.webhookTLS.certManager.enabled }}
+{{- printf "%s-webhook-tls" (include "solar-discovery.fullname" .) }}
+{{- else }}
+{{- fail "webhookTLS.secretName must be set when webhookTLS.enabled=true and webhookTLS.certManager.enabled=false" }}
+{{- end }}
+{{- end }}
@@ -6,7 +6,6 @@
 package v1alpha1
 
 import (
-	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -19,8 +18,8 @@ import (
 type ComponentApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *ComponentSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *solarv1alpha1.ComponentStatus   `json:"status,omitempty"`
+	Spec                             *ComponentSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *ComponentStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // Component constructs a declarative configuration of the Component type for use with
@@ -205,8 +204,8 @@ func (b *ComponentApplyConfiguration) WithSpec(value *ComponentSpecApplyConfigur
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *ComponentApplyConfiguration) WithStatus(value solarv1alpha1.ComponentStatus) *ComponentApplyConfiguration {
-	b.Status = &value
+func (b *ComponentApplyConfiguration) WithStatus(value *ComponentStatusApplyConfiguration) *ComponentApplyConfiguration {
+	b.Status = value
 	return b
 }
 
@@ -17,6 +17,10 @@ type ComponentSpecApplyConfiguration struct {
 	Registry *string `json:"registry,omitempty"`
 	// Repository is the repository where the component is stored.
 	Repository *string `json:"repository,omitempty"`
+	// TagDiscovery enables periodic discovery of new versions of the component.
+	// When set, the discovery worker lists the tags of the component in its
+	// repository and creates a ComponentVersion for every new tag.
+	TagDiscovery *TagDiscoveryApplyConfiguration `json:"tagDiscovery,omitempty"`
 }
 
 // ComponentSpecApplyConfiguration constructs a declarative configuration of the ComponentSpec type for use with
@@ -48,3 +52,11 @@ func (b *ComponentSpecApplyConfiguration) WithRepository(value string) *Componen
 	b.Repository = &value
 	return b
 }
+
+// WithTagDiscovery sets the TagDiscovery field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TagDiscovery field is set to the value of the last call.
+func (b *ComponentSpecApplyConfiguration) WithTagDiscovery(value *TagDiscoveryApplyConfiguration) *ComponentSpecApplyConfiguration {
+	b.TagDiscovery = value
+	return b
+}
@@ -6,7 +6,6 @@
 package v1alpha1
 
 import (
-	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -19,8 +18,8 @@ import (
 type ComponentVersionApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *ComponentVersionSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *solarv1alpha1.ComponentVersionStatus   `json:"status,omitempty"`
+	Spec                             *ComponentVersionSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *ComponentVersionStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // ComponentVersion constructs a declarative configuration of the ComponentVersion type for use with
@@ -205,8 +204,8 @@ func (b *ComponentVersionApplyConfiguration) WithSpec(value *ComponentVersionSpe
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *ComponentVersionApplyConfiguration) WithStatus(value solarv1alpha1.ComponentVersionStatus) *ComponentVersionApplyConfiguration {
-	b.Status = &value
+func (b *ComponentVersionApplyConfiguration) WithStatus(value *ComponentVersionStatusApplyConfiguration) *ComponentVersionApplyConfiguration {
+	b.Status = value
 	return b
 }
 
@@ -22,6 +22,9 @@ type ComponentVersionSpecApplyConfiguration struct {
 	Resources map[string]ResourceAccessApplyConfiguration `json:"resources,omitempty"`
 	// Entrypoint is the entrypoint for deploying a ComponentVersion.
 	Entrypoint *EntrypointApplyConfiguration `json:"entrypoint,omitempty"`
+	// Catalog contains the documentation and icon shown for the
+	// ComponentVersion in the solution catalog, populated during discovery.
+	Catalog *CatalogDetailsApplyConfiguration `json:"catalog,omitempty"`
 }
 
 // ComponentVersionSpecApplyConfiguration constructs a declarative configuration of the ComponentVersionSpec type for use with
@@ -67,3 +70,11 @@ func (b *ComponentVersionSpecApplyConfiguration) WithEntrypoint(value *Entrypoin
 	b.Entrypoint = value
 	return b
 }
+
+// WithCatalog sets the Catalog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Catalog field is set to the value of the last call.
+func (b *ComponentVersionSpecApplyConfiguration) WithCatalog(value *CatalogDetailsApplyConfiguration) *ComponentVersionSpecApplyConfiguration {
+	b.Catalog = value
+	return b
+}
@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -23,6 +24,8 @@ type RegistryBindingSpecApplyConfiguration struct {
 	TargetNamespace *string `json:"targetNamespace,omitempty"`
 	// RegistryRef references the Registry being bound.
 	RegistryRef *v1.LocalObjectReference `json:"registryRef,omitempty"`
+	// Role is the role of the Registry for the Target. Defaults to source.
+	Role *solarv1alpha1.RegistryBindingRole `json:"role,omitempty"`
 }
 
 // RegistryBindingSpecApplyConfiguration constructs a declarative configuration of the RegistryBindingSpec type for use with
@@ -54,3 +57,11 @@ func (b *RegistryBindingSpecApplyConfiguration) WithRegistryRef(value v1.LocalOb
 	b.RegistryRef = &value
 	return b
 }
+
+// WithRole sets the Role field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Role field is set to the value of the last call.
+func (b *RegistryBindingSpecApplyConfiguration) WithRole(value solarv1alpha1.RegistryBindingRole) *RegistryBindingSpecApplyConfiguration {
+	b.Role = &value
+	return b
+}
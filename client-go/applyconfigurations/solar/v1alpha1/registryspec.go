@@ -23,13 +23,18 @@ type RegistrySpecApplyConfiguration struct {
 	// to access this registry from the SolAr cluster. Required if this registry
 	// is used as a render target.
 	SolarSecretRef *v1.LocalObjectReference `json:"solarSecretRef,omitempty"`
+	// PullSecretRef references a Secret of type kubernetes.io/dockerconfigjson
+	// the discovery worker reads the credentials for Hostname from. Namespace
+	// defaults to the namespace of the Registry. Changes to the Secret are
+	// picked up without a restart. Cannot be combined with SolarSecretRef.
+	PullSecretRef *v1.SecretReference `json:"pullSecretRef,omitempty"`
 	// TargetPullSecretName is the name of the Secret on the target cluster that
 	// contains credentials to pull from this registry. SolAr renders this name
 	// into target manifests (e.g. Flux OCIRepository.spec.secretRef.name) but
 	// never reads the Secret itself. The cluster maintainer must provision a
 	// Secret with this name on each target. Omit for anonymous pull.
 	TargetPullSecretName *string `json:"targetPullSecretName,omitempty"`
-	// Flavor identifies the registry type for discovery webhook routing (e.g. "zot").
+	// Flavor identifies the registry type for discovery webhook routing (e.g. "zot", "harbor", "cloudevents").
 	// Required when WebhookPath is set.
 	Flavor *string `json:"flavor,omitempty"`
 	// WebhookPath is the HTTP path on which the discovery worker listens for
@@ -37,8 +42,41 @@ type RegistrySpecApplyConfiguration struct {
 	// discovery; set ScanInterval to enable scan mode instead.
 	WebhookPath *string `json:"webhookPath,omitempty"`
 	// ScanInterval controls how often the discovery worker performs a full scan
-	// of this registry. Leave unset to disable scan mode entirely.
+	// of this registry. Leave unset and ScanSchedule empty to disable scan mode
+	// entirely.
 	ScanInterval *metav1.Duration `json:"scanInterval,omitempty"`
+	// ScanSchedule is a cron expression of the five fields minute, hour, day of
+	// month, month and day of week, e.g. "0 2 * * *", evaluated in UTC. When
+	// set, full scans run at the scheduled times instead of every ScanInterval.
+	ScanSchedule *string `json:"scanSchedule,omitempty"`
+	// ScanJitter delays every scan of this registry by a random duration of up
+	// to ScanJitter, so registries sharing an interval or schedule do not all
+	// put load on their backends at the same moment.
+	ScanJitter *metav1.Duration `json:"scanJitter,omitempty"`
+	// WebhookAuth configures how requests to WebhookPath are authenticated.
+	// Leave unset to accept unauthenticated webhook requests.
+	WebhookAuth *WebhookAuthApplyConfiguration `json:"webhookAuth,omitempty"`
+	// DiscoveryLimits bounds the load the discovery worker puts on this
+	// registry. Leave unset to process its events one at a time without rate
+	// limiting.
+	DiscoveryLimits *DiscoveryLimitsApplyConfiguration `json:"discoveryLimits,omitempty"`
+	// TLS configures the certificates the discovery worker uses when
+	// connecting to this registry. Leave unset to verify the registry against
+	// the system trust store without presenting a client certificate.
+	TLS *RegistryTLSApplyConfiguration `json:"tls,omitempty"`
+	// LabelMappings copy OCI manifest annotations and OCM component labels of
+	// the component versions discovered in this registry into labels of their
+	// ComponentVersions, so the catalog can be filtered by them.
+	LabelMappings []LabelMappingApplyConfiguration `json:"labelMappings,omitempty"`
+	// VersionPolicy selects which component versions discovered in this
+	// registry qualify and assigns them to release channels. Leave unset to
+	// discover every version without a channel.
+	VersionPolicy *VersionPolicyApplyConfiguration `json:"versionPolicy,omitempty"`
+	// RepositoryFilter restricts discovery to the repositories of this
+	// registry matching its path prefixes, e.g. to skip unrelated images
+	// stored next to the component descriptors. Leave unset to discover
+	// every repository.
+	RepositoryFilter *RepositoryFilterApplyConfiguration `json:"repositoryFilter,omitempty"`
 }
 
 // RegistrySpecApplyConfiguration constructs a declarative configuration of the RegistrySpec type for use with
@@ -71,6 +109,14 @@ func (b *RegistrySpecApplyConfiguration) WithSolarSecretRef(value v1.LocalObject
 	return b
 }
 
+// WithPullSecretRef sets the PullSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PullSecretRef field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithPullSecretRef(value v1.SecretReference) *RegistrySpecApplyConfiguration {
+	b.PullSecretRef = &value
+	return b
+}
+
 // WithTargetPullSecretName sets the TargetPullSecretName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TargetPullSecretName field is set to the value of the last call.
@@ -102,3 +148,72 @@ func (b *RegistrySpecApplyConfiguration) WithScanInterval(value metav1.Duration)
 	b.ScanInterval = &value
 	return b
 }
+
+// WithScanSchedule sets the ScanSchedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScanSchedule field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithScanSchedule(value string) *RegistrySpecApplyConfiguration {
+	b.ScanSchedule = &value
+	return b
+}
+
+// WithScanJitter sets the ScanJitter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScanJitter field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithScanJitter(value metav1.Duration) *RegistrySpecApplyConfiguration {
+	b.ScanJitter = &value
+	return b
+}
+
+// WithWebhookAuth sets the WebhookAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WebhookAuth field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithWebhookAuth(value *WebhookAuthApplyConfiguration) *RegistrySpecApplyConfiguration {
+	b.WebhookAuth = value
+	return b
+}
+
+// WithDiscoveryLimits sets the DiscoveryLimits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DiscoveryLimits field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithDiscoveryLimits(value *DiscoveryLimitsApplyConfiguration) *RegistrySpecApplyConfiguration {
+	b.DiscoveryLimits = value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithTLS(value *RegistryTLSApplyConfiguration) *RegistrySpecApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithLabelMappings adds the given value to the LabelMappings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LabelMappings field.
+func (b *RegistrySpecApplyConfiguration) WithLabelMappings(values ...*LabelMappingApplyConfiguration) *RegistrySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLabelMappings")
+		}
+		b.LabelMappings = append(b.LabelMappings, *values[i])
+	}
+	return b
+}
+
+// WithVersionPolicy sets the VersionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VersionPolicy field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithVersionPolicy(value *VersionPolicyApplyConfiguration) *RegistrySpecApplyConfiguration {
+	b.VersionPolicy = value
+	return b
+}
+
+// WithRepositoryFilter sets the RepositoryFilter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RepositoryFilter field is set to the value of the last call.
+func (b *RegistrySpecApplyConfiguration) WithRepositoryFilter(value *RepositoryFilterApplyConfiguration) *RegistrySpecApplyConfiguration {
+	b.RepositoryFilter = value
+	return b
+}
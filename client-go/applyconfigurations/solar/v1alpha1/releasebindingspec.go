@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // ReleaseBindingSpecApplyConfiguration represents a declarative configuration of the ReleaseBindingSpec type for use
@@ -23,6 +24,11 @@ type ReleaseBindingSpecApplyConfiguration struct {
 	TargetNamespace *string `json:"targetNamespace,omitempty"`
 	// ReleaseRef references the Release to deploy.
 	ReleaseRef *v1.LocalObjectReference `json:"releaseRef,omitempty"`
+	// Values are merged on top of the Release's values when the Release is
+	// rendered for this Target, e.g. to change replica counts or ingress hosts
+	// without creating a second Release. Maps are merged recursively, all other
+	// values replace those of the Release and null removes a key.
+	Values *runtime.RawExtension `json:"values,omitempty"`
 }
 
 // ReleaseBindingSpecApplyConfiguration constructs a declarative configuration of the ReleaseBindingSpec type for use with
@@ -54,3 +60,11 @@ func (b *ReleaseBindingSpecApplyConfiguration) WithReleaseRef(value v1.LocalObje
 	b.ReleaseRef = &value
 	return b
 }
+
+// WithValues sets the Values field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Values field is set to the value of the last call.
+func (b *ReleaseBindingSpecApplyConfiguration) WithValues(value runtime.RawExtension) *ReleaseBindingSpecApplyConfiguration {
+	b.Values = &value
+	return b
+}
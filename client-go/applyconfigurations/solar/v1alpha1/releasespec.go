@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -39,15 +40,55 @@ type ReleaseSpecApplyConfiguration struct {
 	// Values contains deployment-specific values or configuration for the release.
 	// These values override defaults from the component version and are used during deployment.
 	Values *runtime.RawExtension `json:"values,omitempty"`
+	// ValuesFrom lists ConfigMap and Secret keys in the Release's namespace
+	// holding values as YAML. They are merged in the given order, and Values
+	// is merged on top of them. Both are merged on top of the values.yaml key
+	// of the solar-release-defaults ConfigMap of the namespace, if present.
+	// Targets render the Release again when the referenced objects change.
+	ValuesFrom []ValuesReferenceApplyConfiguration `json:"valuesFrom,omitempty"`
+	// SensitivePaths lists dot-separated paths of the merged values, such as
+	// "database.password", that hold credentials or other secrets. Targets
+	// keep them out of the RenderTask spec and pass them to the render job in
+	// a separate Secret, and their values are replaced by a hash in the
+	// renderer's output, job failure messages and events.
+	SensitivePaths []string `json:"sensitivePaths,omitempty"`
 	// failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up.
 	// After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete
 	// the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately.
-	// If not set, defaults to 3600 (1 hour).
+	// If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.
 	FailedJobTTL *int32 `json:"failedJobTTL,omitempty"`
+	// BackoffLimit is the number of times a failed renderer pod of a render job
+	// of this Release is retried before the job fails. If not set, the
+	// controller default applies, 3 unless configured otherwise.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is how long a render job of this Release may run
+	// before it is failed. If not set, the controller default applies, which
+	// does not limit the run time unless configured otherwise.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 	// Priority determines which Release takes precedence when multiple Releases
 	// share the same unique name on a Target. Higher values indicate higher priority.
 	// If not set, defaults to 0.
 	Priority *int32 `json:"priority,omitempty"`
+	// RollbackTo is the revision to roll back to, as listed in Status.History.
+	// While set, Targets deploy the chart rendered for that revision instead of
+	// rendering the current spec. Clear it to roll forward again.
+	RollbackTo *int64 `json:"rollbackTo,omitempty"`
+	// HistoryLimit is the number of rendered revisions kept in Status.History
+	// per Target. The charts of these revisions are retained in the render
+	// registry so they can be rolled back to. If not set, defaults to 10.
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+	// Suspend stops Targets from rendering this Release. Targets keep the
+	// chart last rendered for the Release, and render the current spec again
+	// once it is resumed.
+	Suspend *bool `json:"suspend,omitempty"`
+	// ComponentVersionUpdatePolicy controls whether ComponentVersionRef and
+	// ComponentVersionNamespace may be changed once the Release has been
+	// rendered for a Target. If not set, defaults to "Upgrade".
+	ComponentVersionUpdatePolicy *solarv1alpha1.ComponentVersionUpdatePolicy `json:"componentVersionUpdatePolicy,omitempty"`
+	// Schedule restricts rendering of this Release to maintenance windows.
+	// Outside of them Targets keep the chart last rendered for the Release,
+	// and render the current spec once the next window opens.
+	Schedule *ReleaseScheduleApplyConfiguration `json:"schedule,omitempty"`
 }
 
 // ReleaseSpecApplyConfiguration constructs a declarative configuration of the ReleaseSpec type for use with
@@ -104,6 +145,29 @@ func (b *ReleaseSpecApplyConfiguration) WithValues(value runtime.RawExtension) *
 	return b
 }
 
+// WithValuesFrom adds the given value to the ValuesFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ValuesFrom field.
+func (b *ReleaseSpecApplyConfiguration) WithValuesFrom(values ...*ValuesReferenceApplyConfiguration) *ReleaseSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithValuesFrom")
+		}
+		b.ValuesFrom = append(b.ValuesFrom, *values[i])
+	}
+	return b
+}
+
+// WithSensitivePaths adds the given value to the SensitivePaths field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SensitivePaths field.
+func (b *ReleaseSpecApplyConfiguration) WithSensitivePaths(values ...string) *ReleaseSpecApplyConfiguration {
+	for i := range values {
+		b.SensitivePaths = append(b.SensitivePaths, values[i])
+	}
+	return b
+}
+
 // WithFailedJobTTL sets the FailedJobTTL field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the FailedJobTTL field is set to the value of the last call.
@@ -112,6 +176,22 @@ func (b *ReleaseSpecApplyConfiguration) WithFailedJobTTL(value int32) *ReleaseSp
 	return b
 }
 
+// WithBackoffLimit sets the BackoffLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackoffLimit field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithBackoffLimit(value int32) *ReleaseSpecApplyConfiguration {
+	b.BackoffLimit = &value
+	return b
+}
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64) *ReleaseSpecApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
+
 // WithPriority sets the Priority field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Priority field is set to the value of the last call.
@@ -119,3 +199,43 @@ func (b *ReleaseSpecApplyConfiguration) WithPriority(value int32) *ReleaseSpecAp
 	b.Priority = &value
 	return b
 }
+
+// WithRollbackTo sets the RollbackTo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollbackTo field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithRollbackTo(value int64) *ReleaseSpecApplyConfiguration {
+	b.RollbackTo = &value
+	return b
+}
+
+// WithHistoryLimit sets the HistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HistoryLimit field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithHistoryLimit(value int32) *ReleaseSpecApplyConfiguration {
+	b.HistoryLimit = &value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithSuspend(value bool) *ReleaseSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithComponentVersionUpdatePolicy sets the ComponentVersionUpdatePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ComponentVersionUpdatePolicy field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithComponentVersionUpdatePolicy(value solarv1alpha1.ComponentVersionUpdatePolicy) *ReleaseSpecApplyConfiguration {
+	b.ComponentVersionUpdatePolicy = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *ReleaseSpecApplyConfiguration) WithSchedule(value *ReleaseScheduleApplyConfiguration) *ReleaseSpecApplyConfiguration {
+	b.Schedule = value
+	return b
+}
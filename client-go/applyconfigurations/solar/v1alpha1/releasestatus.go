@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
@@ -15,6 +16,9 @@ import (
 //
 // ReleaseStatus defines the observed state of a Release.
 type ReleaseStatusApplyConfiguration struct {
+	// Phase summarizes the conditions of the Release and the state of its
+	// render jobs.
+	Phase *solarv1alpha1.ReleasePhase `json:"phase,omitempty"`
 	// Conditions represent the latest available observations of a Release's state.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 	// RenderTaskRef is a reference to the RenderTask responsible for this Release.
@@ -23,6 +27,8 @@ type ReleaseStatusApplyConfiguration struct {
 	// Equals Spec.UniqueName when set; otherwise the parent Component name derived
 	// from the referenced ComponentVersion.
 	EffectiveUniqueName *string `json:"effectiveUniqueName,omitempty"`
+	// History lists the charts rendered for this Release, newest first.
+	History []ReleaseRevisionApplyConfiguration `json:"history,omitempty"`
 }
 
 // ReleaseStatusApplyConfiguration constructs a declarative configuration of the ReleaseStatus type for use with
@@ -31,6 +37,14 @@ func ReleaseStatus() *ReleaseStatusApplyConfiguration {
 	return &ReleaseStatusApplyConfiguration{}
 }
 
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *ReleaseStatusApplyConfiguration) WithPhase(value solarv1alpha1.ReleasePhase) *ReleaseStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -59,3 +73,16 @@ func (b *ReleaseStatusApplyConfiguration) WithEffectiveUniqueName(value string)
 	b.EffectiveUniqueName = &value
 	return b
 }
+
+// WithHistory adds the given value to the History field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the History field.
+func (b *ReleaseStatusApplyConfiguration) WithHistory(values ...*ReleaseRevisionApplyConfiguration) *ReleaseStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHistory")
+		}
+		b.History = append(b.History, *values[i])
+	}
+	return b
+}
@@ -28,13 +28,34 @@ type RenderTaskSpecApplyConfiguration struct {
 	// PushSecretRef references a Secret in the same namespace with registry credentials
 	// for pushing the rendered chart.
 	PushSecretRef *v1.LocalObjectReference `json:"pushSecretRef,omitempty"`
+	// SensitiveValuesSecretRef references a Secret in the same namespace
+	// holding release values as JSON under the key "values.json". They are
+	// merged on top of the values of the RendererConfig by the render job,
+	// and redacted from its failure messages.
+	SensitiveValuesSecretRef *v1.LocalObjectReference `json:"sensitiveValuesSecretRef,omitempty"`
 	// PlainHTTP uses HTTP instead of HTTPS for OCI registry connections.
 	PlainHTTP *bool `json:"plainHTTP,omitempty"`
 	// failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up.
 	// After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete
 	// the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately.
-	// If not set, defaults to 3600 (1 hour).
+	// If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.
 	FailedJobTTL *int32 `json:"failedJobTTL,omitempty"`
+	// BackoffLimit is the number of times a failed renderer pod is retried
+	// before the render job fails. If not set, the controller default applies,
+	// 3 unless configured otherwise.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is how long the render job may run before it is
+	// failed. If not set, the controller default applies, which does not
+	// limit the run time unless configured otherwise.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// Priority determines the order in which queued RenderTasks are admitted when the
+	// controller limits the number of concurrently running render jobs. Higher values
+	// render first; RenderTasks with equal priority are admitted oldest first.
+	// If not set, defaults to 0.
+	Priority *int32 `json:"priority,omitempty"`
+	// RetryPolicy makes the controller retry failed render jobs. If not set,
+	// a failed render job fails the RenderTask.
+	RetryPolicy *RenderTaskRetryPolicyApplyConfiguration `json:"retryPolicy,omitempty"`
 	// OwnerName is the name of the resource that created this RenderTask.
 	OwnerName *string `json:"ownerName,omitempty"`
 	// OwnerNamespace is the namespace of the resource that created this RenderTask.
@@ -105,6 +126,14 @@ func (b *RenderTaskSpecApplyConfiguration) WithPushSecretRef(value v1.LocalObjec
 	return b
 }
 
+// WithSensitiveValuesSecretRef sets the SensitiveValuesSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SensitiveValuesSecretRef field is set to the value of the last call.
+func (b *RenderTaskSpecApplyConfiguration) WithSensitiveValuesSecretRef(value v1.LocalObjectReference) *RenderTaskSpecApplyConfiguration {
+	b.SensitiveValuesSecretRef = &value
+	return b
+}
+
 // WithPlainHTTP sets the PlainHTTP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PlainHTTP field is set to the value of the last call.
@@ -121,6 +150,38 @@ func (b *RenderTaskSpecApplyConfiguration) WithFailedJobTTL(value int32) *Render
 	return b
 }
 
+// WithBackoffLimit sets the BackoffLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackoffLimit field is set to the value of the last call.
+func (b *RenderTaskSpecApplyConfiguration) WithBackoffLimit(value int32) *RenderTaskSpecApplyConfiguration {
+	b.BackoffLimit = &value
+	return b
+}
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *RenderTaskSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64) *RenderTaskSpecApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *RenderTaskSpecApplyConfiguration) WithPriority(value int32) *RenderTaskSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *RenderTaskSpecApplyConfiguration) WithRetryPolicy(value *RenderTaskRetryPolicyApplyConfiguration) *RenderTaskSpecApplyConfiguration {
+	b.RetryPolicy = value
+	return b
+}
+
 // WithOwnerName sets the OwnerName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OwnerName field is set to the value of the last call.
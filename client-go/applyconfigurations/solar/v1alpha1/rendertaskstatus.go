@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -23,6 +24,35 @@ type RenderTaskStatusApplyConfiguration struct {
 	ConfigSecretRef *corev1.ObjectReference `json:"configSecretRef,omitempty"`
 	// ChartURL represents the URL of where the rendered chart was pushed to.
 	ChartURL *string `json:"chartURL,omitempty"`
+	// ConfigHash is the SHA-256 digest of the renderer config and the push
+	// destination. RenderTasks with the same hash render the same chart.
+	ConfigHash *string `json:"configHash,omitempty"`
+	// PrimaryRef references the RenderTask in the same namespace whose render
+	// job produces the chart of this RenderTask. It is set when the controller
+	// deduplicates identical RenderTasks instead of running another job.
+	PrimaryRef *corev1.LocalObjectReference `json:"primaryRef,omitempty"`
+	// Attempts is the number of render jobs started for this RenderTask,
+	// including retries.
+	Attempts *int32 `json:"attempts,omitempty"`
+	// ContentDigest is the SHA-256 digest of the rendered chart content as
+	// reported by the renderer. Identical configs render the same digest.
+	ContentDigest *string `json:"contentDigest,omitempty"`
+	// ChartDigest is the digest of the manifest of the pushed chart as
+	// reported by the renderer. It identifies the chart independent of its tag.
+	ChartDigest *string `json:"chartDigest,omitempty"`
+	// ChartSize is the size in bytes of the packaged chart as reported by the
+	// renderer.
+	ChartSize *int64 `json:"chartSize,omitempty"`
+	// SignatureRef is the OCI reference of the cosign signature of the pushed
+	// chart as reported by the renderer, if the renderer signs charts.
+	SignatureRef *string `json:"signatureRef,omitempty"`
+	// RenderedAt is the time the chart was pushed as reported by the renderer,
+	// or the completion time of the render job if the renderer did not report it.
+	RenderedAt *metav1.Time `json:"renderedAt,omitempty"`
+	// Preview summarizes the chart rendered by a dry run. It is only set for
+	// RenderTasks annotated with solar.opendefense.cloud/dry-run, whose chart
+	// is rendered but not pushed.
+	Preview *RenderPreviewApplyConfiguration `json:"preview,omitempty"`
 }
 
 // RenderTaskStatusApplyConfiguration constructs a declarative configuration of the RenderTaskStatus type for use with
@@ -67,3 +97,75 @@ func (b *RenderTaskStatusApplyConfiguration) WithChartURL(value string) *RenderT
 	b.ChartURL = &value
 	return b
 }
+
+// WithConfigHash sets the ConfigHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigHash field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithConfigHash(value string) *RenderTaskStatusApplyConfiguration {
+	b.ConfigHash = &value
+	return b
+}
+
+// WithPrimaryRef sets the PrimaryRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrimaryRef field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithPrimaryRef(value corev1.LocalObjectReference) *RenderTaskStatusApplyConfiguration {
+	b.PrimaryRef = &value
+	return b
+}
+
+// WithAttempts sets the Attempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Attempts field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithAttempts(value int32) *RenderTaskStatusApplyConfiguration {
+	b.Attempts = &value
+	return b
+}
+
+// WithContentDigest sets the ContentDigest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContentDigest field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithContentDigest(value string) *RenderTaskStatusApplyConfiguration {
+	b.ContentDigest = &value
+	return b
+}
+
+// WithChartDigest sets the ChartDigest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChartDigest field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithChartDigest(value string) *RenderTaskStatusApplyConfiguration {
+	b.ChartDigest = &value
+	return b
+}
+
+// WithChartSize sets the ChartSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChartSize field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithChartSize(value int64) *RenderTaskStatusApplyConfiguration {
+	b.ChartSize = &value
+	return b
+}
+
+// WithSignatureRef sets the SignatureRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SignatureRef field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithSignatureRef(value string) *RenderTaskStatusApplyConfiguration {
+	b.SignatureRef = &value
+	return b
+}
+
+// WithRenderedAt sets the RenderedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RenderedAt field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithRenderedAt(value metav1.Time) *RenderTaskStatusApplyConfiguration {
+	b.RenderedAt = &value
+	return b
+}
+
+// WithPreview sets the Preview field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Preview field is set to the value of the last call.
+func (b *RenderTaskStatusApplyConfiguration) WithPreview(value *RenderPreviewApplyConfiguration) *RenderTaskStatusApplyConfiguration {
+	b.Preview = value
+	return b
+}
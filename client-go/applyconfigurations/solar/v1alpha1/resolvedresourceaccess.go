@@ -17,6 +17,9 @@ type ResolvedResourceAccessApplyConfiguration struct {
 	Insecure *bool `json:"insecure,omitempty"`
 	// Tag of the Resource.
 	Tag *string `json:"tag,omitempty"`
+	// Digest of the manifest of the Resource. If set, the Resource is pulled
+	// by digest instead of by Tag.
+	Digest *string `json:"digest,omitempty"`
 	// Helm contains metadata for Helm chart resources, populated during discovery.
 	Helm *HelmResourceMetadataApplyConfiguration `json:"helm,omitempty"`
 	// PullSecretName is the name of the pull secret on the target cluster for
@@ -55,6 +58,14 @@ func (b *ResolvedResourceAccessApplyConfiguration) WithTag(value string) *Resolv
 	return b
 }
 
+// WithDigest sets the Digest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Digest field is set to the value of the last call.
+func (b *ResolvedResourceAccessApplyConfiguration) WithDigest(value string) *ResolvedResourceAccessApplyConfiguration {
+	b.Digest = &value
+	return b
+}
+
 // WithHelm sets the Helm field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Helm field is set to the value of the last call.
@@ -18,6 +18,7 @@ import (
 type TargetSpecApplyConfiguration struct {
 	// RenderRegistryRef references the Registry to push rendered desired state to.
 	// The referenced Registry must have SolarSecretRef set for rendering to succeed.
+	// If empty, the Registry of the RegistryBinding with role deploy for this Target is used.
 	RenderRegistryRef *v1.LocalObjectReference `json:"renderRegistryRef,omitempty"`
 	// RenderRegistryNamespace is the namespace of the Registry when it resides in a different
 	// namespace than this Target. If empty, the Registry is assumed to be in the same namespace.
@@ -27,6 +28,23 @@ type TargetSpecApplyConfiguration struct {
 	// Userdata contains arbitrary custom data or configuration specific to this target.
 	// This enables target-specific customization and deployment parameters.
 	Userdata *runtime.RawExtension `json:"userdata,omitempty"`
+	// AllowPartialRender renders the bootstrap chart with the releases that are
+	// ready when others are still pending or failed to render. The missing
+	// releases are reported in status.releases and added once they are ready.
+	// By default, the bootstrap chart is only rendered once all releases are ready.
+	AllowPartialRender *bool `json:"allowPartialRender,omitempty"`
+	// FailedJobTTL is the TTL in seconds after which a failed bootstrap render
+	// job of this Target and its secrets are cleaned up. If not set, the
+	// controller default applies, 3600 (1 hour) unless configured otherwise.
+	FailedJobTTL *int32 `json:"failedJobTTL,omitempty"`
+	// BackoffLimit is the number of times a failed renderer pod of a bootstrap
+	// render job of this Target is retried before the job fails. If not set,
+	// the controller default applies, 3 unless configured otherwise.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is how long a bootstrap render job of this Target
+	// may run before it is failed. If not set, the controller default applies,
+	// which does not limit the run time unless configured otherwise.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 }
 
 // TargetSpecApplyConfiguration constructs a declarative configuration of the TargetSpec type for use with
@@ -58,3 +76,35 @@ func (b *TargetSpecApplyConfiguration) WithUserdata(value runtime.RawExtension)
 	b.Userdata = &value
 	return b
 }
+
+// WithAllowPartialRender sets the AllowPartialRender field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowPartialRender field is set to the value of the last call.
+func (b *TargetSpecApplyConfiguration) WithAllowPartialRender(value bool) *TargetSpecApplyConfiguration {
+	b.AllowPartialRender = &value
+	return b
+}
+
+// WithFailedJobTTL sets the FailedJobTTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedJobTTL field is set to the value of the last call.
+func (b *TargetSpecApplyConfiguration) WithFailedJobTTL(value int32) *TargetSpecApplyConfiguration {
+	b.FailedJobTTL = &value
+	return b
+}
+
+// WithBackoffLimit sets the BackoffLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackoffLimit field is set to the value of the last call.
+func (b *TargetSpecApplyConfiguration) WithBackoffLimit(value int32) *TargetSpecApplyConfiguration {
+	b.BackoffLimit = &value
+	return b
+}
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *TargetSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64) *TargetSpecApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
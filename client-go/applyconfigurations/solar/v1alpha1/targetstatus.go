@@ -18,6 +18,8 @@ type TargetStatusApplyConfiguration struct {
 	// chart version. It is incremented each time the bootstrap chart is re-rendered,
 	// e.g. when the set of bound releases changes.
 	BootstrapVersion *int64 `json:"bootstrapVersion,omitempty"`
+	// Releases reports the readiness of each release bound to this Target.
+	Releases []TargetReleaseStatusApplyConfiguration `json:"releases,omitempty"`
 	// Conditions represent the latest available observations of a Target's state.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
@@ -36,6 +38,19 @@ func (b *TargetStatusApplyConfiguration) WithBootstrapVersion(value int64) *Targ
 	return b
 }
 
+// WithReleases adds the given value to the Releases field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Releases field.
+func (b *TargetStatusApplyConfiguration) WithReleases(values ...*TargetReleaseStatusApplyConfiguration) *TargetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReleases")
+		}
+		b.Releases = append(b.Releases, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -23,20 +23,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &solarv1alpha1.BootstrapConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("BootstrapInput"):
 		return &solarv1alpha1.BootstrapInputApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CatalogDetails"):
+		return &solarv1alpha1.CatalogDetailsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ChartConfig"):
 		return &solarv1alpha1.ChartConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Component"):
 		return &solarv1alpha1.ComponentApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ComponentSpec"):
 		return &solarv1alpha1.ComponentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ComponentStatus"):
+		return &solarv1alpha1.ComponentStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ComponentVersion"):
 		return &solarv1alpha1.ComponentVersionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ComponentVersionSpec"):
 		return &solarv1alpha1.ComponentVersionSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ComponentVersionStatus"):
+		return &solarv1alpha1.ComponentVersionStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ComponentVersionSummary"):
+		return &solarv1alpha1.ComponentVersionSummaryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiscoveryLimits"):
+		return &solarv1alpha1.DiscoveryLimitsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Entrypoint"):
 		return &solarv1alpha1.EntrypointApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HelmResourceMetadata"):
 		return &solarv1alpha1.HelmResourceMetadataApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LabelMapping"):
+		return &solarv1alpha1.LabelMappingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &solarv1alpha1.MaintenanceWindowApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Profile"):
 		return &solarv1alpha1.ProfileApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ProfileSpec"):
@@ -63,6 +77,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &solarv1alpha1.RegistrySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RegistryStatus"):
 		return &solarv1alpha1.RegistryStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RegistryTLS"):
+		return &solarv1alpha1.RegistryTLSApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Release"):
 		return &solarv1alpha1.ReleaseApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ReleaseBinding"):
@@ -77,6 +93,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &solarv1alpha1.ReleaseConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ReleaseInput"):
 		return &solarv1alpha1.ReleaseInputApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReleaseRevision"):
+		return &solarv1alpha1.ReleaseRevisionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReleaseSchedule"):
+		return &solarv1alpha1.ReleaseScheduleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ReleaseSpec"):
 		return &solarv1alpha1.ReleaseSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ReleaseStatus"):
@@ -91,6 +111,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &solarv1alpha1.RenderBindingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RenderBindingSpec"):
 		return &solarv1alpha1.RenderBindingSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RenderPreview"):
+		return &solarv1alpha1.RenderPreviewApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RenderTaskRetryPolicy"):
+		return &solarv1alpha1.RenderTaskRetryPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RendererConfig"):
 		return &solarv1alpha1.RendererConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RenderTask"):
@@ -99,16 +123,30 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &solarv1alpha1.RenderTaskSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RenderTaskStatus"):
 		return &solarv1alpha1.RenderTaskStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RepositoryFilter"):
+		return &solarv1alpha1.RepositoryFilterApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResolvedResourceAccess"):
 		return &solarv1alpha1.ResolvedResourceAccessApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceAccess"):
 		return &solarv1alpha1.ResourceAccessApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TagDiscovery"):
+		return &solarv1alpha1.TagDiscoveryApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Target"):
 		return &solarv1alpha1.TargetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TargetReleaseStatus"):
+		return &solarv1alpha1.TargetReleaseStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TargetSpec"):
 		return &solarv1alpha1.TargetSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TargetStatus"):
 		return &solarv1alpha1.TargetStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ValuesReference"):
+		return &solarv1alpha1.ValuesReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VersionChannel"):
+		return &solarv1alpha1.VersionChannelApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VersionPolicy"):
+		return &solarv1alpha1.VersionPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WebhookAuth"):
+		return &solarv1alpha1.WebhookAuthApplyConfiguration{}
 
 	}
 	return nil
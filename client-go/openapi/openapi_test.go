@@ -0,0 +1,129 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// modelNamer is implemented by every type openapi-gen emits a definition for.
+type modelNamer interface {
+	OpenAPIModelName() string
+}
+
+// TestDefinitionsMatchTypes guards against the published OpenAPI drifting
+// from the Go structs in api/solar/v1alpha1. It walks every registered kind
+// and every struct reachable from it and compares the json field names with
+// the properties of the corresponding definition. Run `make codegen` when it
+// fails.
+func TestDefinitionsMatchTypes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := solarv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("adding v1alpha1 to scheme: %v", err)
+	}
+
+	defs := GetOpenAPIDefinitions(func(path string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + common.EscapeJsonPointer(path))
+	})
+
+	types := map[reflect.Type]struct{}{}
+	for _, typ := range scheme.KnownTypes(solarv1alpha1.SchemeGroupVersion) {
+		collectAPITypes(typ, types)
+	}
+	if len(types) == 0 {
+		t.Fatal("no v1alpha1 types found in scheme")
+	}
+
+	for typ := range types {
+		namer, ok := reflect.New(typ).Elem().Interface().(modelNamer)
+		if !ok {
+			t.Errorf("%s: missing OpenAPIModelName, regenerate model names", typ.Name())
+			continue
+		}
+		def, ok := defs[namer.OpenAPIModelName()]
+		if !ok {
+			t.Errorf("%s: no OpenAPI definition for %q", typ.Name(), namer.OpenAPIModelName())
+			continue
+		}
+
+		fields := jsonFields(typ)
+		var want, got []string
+		for name := range fields {
+			want = append(want, name)
+		}
+		for name := range def.Schema.Properties {
+			got = append(got, name)
+		}
+		sort.Strings(want)
+		sort.Strings(got)
+		if !slices.Equal(want, got) {
+			t.Errorf("%s: OpenAPI properties %v do not match json fields %v", typ.Name(), got, want)
+		}
+
+		for _, name := range def.Schema.Required {
+			if omitempty, ok := fields[name]; ok && omitempty {
+				t.Errorf("%s: field %q is required in OpenAPI but omitempty in Go", typ.Name(), name)
+			}
+		}
+	}
+}
+
+// collectAPITypes records typ and every struct type of the v1alpha1 package
+// reachable through its fields.
+func collectAPITypes(typ reflect.Type, seen map[reflect.Type]struct{}) {
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return
+	}
+	if typ.PkgPath() == reflect.TypeFor[solarv1alpha1.Component]().PkgPath() {
+		if _, ok := seen[typ]; ok {
+			return
+		}
+		seen[typ] = struct{}{}
+	}
+	for i := range typ.NumField() {
+		collectAPITypes(typ.Field(i).Type, seen)
+	}
+}
+
+// jsonFields returns the json names of the exported fields of typ, mapped to
+// whether they are tagged omitempty. Embedded structs without a name are
+// inlined the way encoding/json does.
+func jsonFields(typ reflect.Type) map[string]bool {
+	fields := map[string]bool{}
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" && (field.Anonymous || strings.Contains(opts, "inline")) {
+			for inlined, omitempty := range jsonFields(field.Type) {
+				fields[inlined] = omitempty
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = slices.Contains(strings.Split(opts, ","), "omitempty")
+	}
+
+	return fields
+}
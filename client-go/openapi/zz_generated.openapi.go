@@ -23,6 +23,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	return map[string]common.OpenAPIDefinition{
 		v1alpha1.BootstrapConfig{}.OpenAPIModelName():              schema_solar_api_solar_v1alpha1_BootstrapConfig(ref),
 		v1alpha1.BootstrapInput{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_BootstrapInput(ref),
+		v1alpha1.CatalogDetails{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_CatalogDetails(ref),
 		v1alpha1.ChartConfig{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_ChartConfig(ref),
 		v1alpha1.Component{}.OpenAPIModelName():                    schema_solar_api_solar_v1alpha1_Component(ref),
 		v1alpha1.ComponentList{}.OpenAPIModelName():                schema_solar_api_solar_v1alpha1_ComponentList(ref),
@@ -32,8 +33,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha1.ComponentVersionList{}.OpenAPIModelName():         schema_solar_api_solar_v1alpha1_ComponentVersionList(ref),
 		v1alpha1.ComponentVersionSpec{}.OpenAPIModelName():         schema_solar_api_solar_v1alpha1_ComponentVersionSpec(ref),
 		v1alpha1.ComponentVersionStatus{}.OpenAPIModelName():       schema_solar_api_solar_v1alpha1_ComponentVersionStatus(ref),
+		v1alpha1.ComponentVersionSummary{}.OpenAPIModelName():      schema_solar_api_solar_v1alpha1_ComponentVersionSummary(ref),
+		v1alpha1.DiscoveryLimits{}.OpenAPIModelName():              schema_solar_api_solar_v1alpha1_DiscoveryLimits(ref),
 		v1alpha1.Entrypoint{}.OpenAPIModelName():                   schema_solar_api_solar_v1alpha1_Entrypoint(ref),
 		v1alpha1.HelmResourceMetadata{}.OpenAPIModelName():         schema_solar_api_solar_v1alpha1_HelmResourceMetadata(ref),
+		v1alpha1.LabelMapping{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_LabelMapping(ref),
+		v1alpha1.MaintenanceWindow{}.OpenAPIModelName():            schema_solar_api_solar_v1alpha1_MaintenanceWindow(ref),
 		v1alpha1.Profile{}.OpenAPIModelName():                      schema_solar_api_solar_v1alpha1_Profile(ref),
 		v1alpha1.ProfileList{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_ProfileList(ref),
 		v1alpha1.ProfileSpec{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_ProfileSpec(ref),
@@ -52,6 +57,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha1.RegistryList{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_RegistryList(ref),
 		v1alpha1.RegistrySpec{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_RegistrySpec(ref),
 		v1alpha1.RegistryStatus{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_RegistryStatus(ref),
+		v1alpha1.RegistryTLS{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_RegistryTLS(ref),
 		v1alpha1.Release{}.OpenAPIModelName():                      schema_solar_api_solar_v1alpha1_Release(ref),
 		v1alpha1.ReleaseBinding{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_ReleaseBinding(ref),
 		v1alpha1.ReleaseBindingList{}.OpenAPIModelName():           schema_solar_api_solar_v1alpha1_ReleaseBindingList(ref),
@@ -61,6 +67,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha1.ReleaseConfig{}.OpenAPIModelName():                schema_solar_api_solar_v1alpha1_ReleaseConfig(ref),
 		v1alpha1.ReleaseInput{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_ReleaseInput(ref),
 		v1alpha1.ReleaseList{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_ReleaseList(ref),
+		v1alpha1.ReleaseRevision{}.OpenAPIModelName():              schema_solar_api_solar_v1alpha1_ReleaseRevision(ref),
+		v1alpha1.ReleaseSchedule{}.OpenAPIModelName():              schema_solar_api_solar_v1alpha1_ReleaseSchedule(ref),
 		v1alpha1.ReleaseSpec{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_ReleaseSpec(ref),
 		v1alpha1.ReleaseStatus{}.OpenAPIModelName():                schema_solar_api_solar_v1alpha1_ReleaseStatus(ref),
 		v1alpha1.RenderArtifact{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_RenderArtifact(ref),
@@ -70,18 +78,28 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha1.RenderBinding{}.OpenAPIModelName():                schema_solar_api_solar_v1alpha1_RenderBinding(ref),
 		v1alpha1.RenderBindingList{}.OpenAPIModelName():            schema_solar_api_solar_v1alpha1_RenderBindingList(ref),
 		v1alpha1.RenderBindingSpec{}.OpenAPIModelName():            schema_solar_api_solar_v1alpha1_RenderBindingSpec(ref),
+		v1alpha1.RenderPreview{}.OpenAPIModelName():                schema_solar_api_solar_v1alpha1_RenderPreview(ref),
+		v1alpha1.RenderReport{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_RenderReport(ref),
 		v1alpha1.RenderResult{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_RenderResult(ref),
 		v1alpha1.RenderTask{}.OpenAPIModelName():                   schema_solar_api_solar_v1alpha1_RenderTask(ref),
 		v1alpha1.RenderTaskList{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_RenderTaskList(ref),
+		v1alpha1.RenderTaskRetryPolicy{}.OpenAPIModelName():        schema_solar_api_solar_v1alpha1_RenderTaskRetryPolicy(ref),
 		v1alpha1.RenderTaskSpec{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_RenderTaskSpec(ref),
 		v1alpha1.RenderTaskStatus{}.OpenAPIModelName():             schema_solar_api_solar_v1alpha1_RenderTaskStatus(ref),
 		v1alpha1.RendererConfig{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_RendererConfig(ref),
+		v1alpha1.RepositoryFilter{}.OpenAPIModelName():             schema_solar_api_solar_v1alpha1_RepositoryFilter(ref),
 		v1alpha1.ResolvedResourceAccess{}.OpenAPIModelName():       schema_solar_api_solar_v1alpha1_ResolvedResourceAccess(ref),
 		v1alpha1.ResourceAccess{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_ResourceAccess(ref),
+		v1alpha1.TagDiscovery{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_TagDiscovery(ref),
 		v1alpha1.Target{}.OpenAPIModelName():                       schema_solar_api_solar_v1alpha1_Target(ref),
 		v1alpha1.TargetList{}.OpenAPIModelName():                   schema_solar_api_solar_v1alpha1_TargetList(ref),
+		v1alpha1.TargetReleaseStatus{}.OpenAPIModelName():          schema_solar_api_solar_v1alpha1_TargetReleaseStatus(ref),
 		v1alpha1.TargetSpec{}.OpenAPIModelName():                   schema_solar_api_solar_v1alpha1_TargetSpec(ref),
 		v1alpha1.TargetStatus{}.OpenAPIModelName():                 schema_solar_api_solar_v1alpha1_TargetStatus(ref),
+		v1alpha1.ValuesReference{}.OpenAPIModelName():              schema_solar_api_solar_v1alpha1_ValuesReference(ref),
+		v1alpha1.VersionChannel{}.OpenAPIModelName():               schema_solar_api_solar_v1alpha1_VersionChannel(ref),
+		v1alpha1.VersionPolicy{}.OpenAPIModelName():                schema_solar_api_solar_v1alpha1_VersionPolicy(ref),
+		v1alpha1.WebhookAuth{}.OpenAPIModelName():                  schema_solar_api_solar_v1alpha1_WebhookAuth(ref),
 		v1.AWSElasticBlockStoreVolumeSource{}.OpenAPIModelName():   schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		v1.Affinity{}.OpenAPIModelName():                           schema_k8sio_api_core_v1_Affinity(ref),
 		v1.AppArmorProfile{}.OpenAPIModelName():                    schema_k8sio_api_core_v1_AppArmorProfile(ref),
@@ -446,6 +464,40 @@ func schema_solar_api_solar_v1alpha1_BootstrapInput(ref common.ReferenceCallback
 	}
 }
 
+func schema_solar_api_solar_v1alpha1_CatalogDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CatalogDetails contains the documentation and icon of a ComponentVersion. Discovery reads them from the OCM resources of type \"docs\" and \"icon\" and leaves out resources exceeding the size limits.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"readme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Readme is the markdown documentation of the component, at most 64KiB.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"icon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icon is the image shown for the component, at most 32KiB.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"iconMediaType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IconMediaType is the media type of Icon, e.g. \"image/png\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_solar_api_solar_v1alpha1_ChartConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -619,10 +671,18 @@ func schema_solar_api_solar_v1alpha1_ComponentSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"tagDiscovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TagDiscovery enables periodic discovery of new versions of the component. When set, the discovery worker lists the tags of the component in its repository and creates a ComponentVersion for every new tag.",
+							Ref:         ref(v1alpha1.TagDiscovery{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"scheme", "registry", "repository"},
 			},
 		},
+		Dependencies: []string{
+			v1alpha1.TagDiscovery{}.OpenAPIModelName()},
 	}
 }
 
@@ -632,8 +692,65 @@ func schema_solar_api_solar_v1alpha1_ComponentStatus(ref common.ReferenceCallbac
 			SchemaProps: spec.SchemaProps{
 				Description: "ComponentStatus defines the observed state of a Component.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"latestVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatestVersion is the highest tag of Versions that is a semantic version. Empty if no tag is a semantic version.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"versions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Versions lists the ComponentVersions of this Component. Tags that are semantic versions come first, highest first, followed by the other tags in lexical order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.ComponentVersionSummary{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of a Component's state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			v1alpha1.ComponentVersionSummary{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName()},
 	}
 }
 
@@ -777,12 +894,18 @@ func schema_solar_api_solar_v1alpha1_ComponentVersionSpec(ref common.ReferenceCa
 							Ref:         ref(v1alpha1.Entrypoint{}.OpenAPIModelName()),
 						},
 					},
+					"catalog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Catalog contains the documentation and icon shown for the ComponentVersion in the solution catalog, populated during discovery.",
+							Ref:         ref(v1alpha1.CatalogDetails{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"componentRef", "tag", "resources", "entrypoint"},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.Entrypoint{}.OpenAPIModelName(), v1alpha1.ResourceAccess{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
+			v1alpha1.CatalogDetails{}.OpenAPIModelName(), v1alpha1.Entrypoint{}.OpenAPIModelName(), v1alpha1.ResourceAccess{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
 	}
 }
 
@@ -792,8 +915,91 @@ func schema_solar_api_solar_v1alpha1_ComponentVersionStatus(ref common.Reference
 			SchemaProps: spec.SchemaProps{
 				Description: "ComponentVersionStatus defines the observed state of a ComponentVersion.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase tells whether the ComponentVersion can still be pulled from its registry. Empty means Available.\n\nPossible enum values:\n - `\"Available\"` means the ComponentVersion exists in its registry.\n - `\"Unavailable\"` means discovery found the ComponentVersion removed from its registry. It is garbage collected once the retention period of the controller manager has passed.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Available", "Unavailable"},
+						},
+					},
+					"unavailableSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnavailableSince is the time discovery found the ComponentVersion removed from its registry. Only set in phase Unavailable.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_ComponentVersionSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionSummary identifies a ComponentVersion of a Component.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the ComponentVersion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tag is the version of the component the ComponentVersion represents.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "tag"},
+			},
+		},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_DiscoveryLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiscoveryLimits bounds how the discovery worker processes events of a Registry. Events of different registries are processed independently of each other.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requestInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestInterval is the minimum time between two events of this registry being processed. Leave unset to disable rate limiting.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of events that may be processed in quick succession before RequestInterval applies. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrency is the number of repositories of this registry processed in parallel. Events of a repository are always processed in order. Defaults to the number of registry workers of the discovery worker.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
@@ -879,6 +1085,73 @@ func schema_solar_api_solar_v1alpha1_HelmResourceMetadata(ref common.ReferenceCa
 	}
 }
 
+func schema_solar_api_solar_v1alpha1_LabelMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LabelMapping copies a piece of metadata of discovered component versions into a label of their ComponentVersions. Exactly one of Annotation and ComponentLabel must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"annotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotation is the OCI manifest annotation of the component descriptor to copy, e.g. \"org.opencontainers.image.vendor\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"componentLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentLabel is the name of the OCM component label to copy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"label": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label is the key of the ComponentVersion label the value is copied to, e.g. \"catalog.example.com/vendor\". Values that are not valid label values are skipped.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"label"},
+			},
+		},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a recurring period of time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression of the five fields minute, hour, day of month, month and day of week, giving the times the window opens at, e.g. \"0 22 * * 1-5\" for 22:00 on weekdays.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open after each start.",
+							Default:     0,
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"start", "duration"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_solar_api_solar_v1alpha1_Profile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1072,6 +1345,20 @@ func schema_solar_api_solar_v1alpha1_PushResult(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest is the digest of the manifest of the pushed chart.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the size in bytes of the packaged chart.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"ref"},
 			},
@@ -1462,6 +1749,14 @@ func schema_solar_api_solar_v1alpha1_RegistryBindingSpec(ref common.ReferenceCal
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role is the role of the Registry for the Target. Defaults to source.\n\nPossible enum values:\n - `\"deploy\"` binds the Registry rendered charts for the Target are pushed to, unless the Target sets a RenderRegistryRef.\n - `\"source\"` binds a Registry the Target pulls resources from, for which the Target's pull secrets are looked up.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"deploy", "source"},
+						},
+					},
 				},
 				Required: []string{"targetRef", "registryRef"},
 			},
@@ -1587,6 +1882,12 @@ func schema_solar_api_solar_v1alpha1_RegistrySpec(ref common.ReferenceCallback)
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"pullSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullSecretRef references a Secret of type kubernetes.io/dockerconfigjson the discovery worker reads the credentials for Hostname from. Namespace defaults to the namespace of the Registry. Changes to the Secret are picked up without a restart. Cannot be combined with SolarSecretRef.",
+							Ref:         ref(v1.SecretReference{}.OpenAPIModelName()),
+						},
+					},
 					"targetPullSecretName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetPullSecretName is the name of the Secret on the target cluster that contains credentials to pull from this registry. SolAr renders this name into target manifests (e.g. Flux OCIRepository.spec.secretRef.name) but never reads the Secret itself. The cluster maintainer must provision a Secret with this name on each target. Omit for anonymous pull.",
@@ -1596,7 +1897,7 @@ func schema_solar_api_solar_v1alpha1_RegistrySpec(ref common.ReferenceCallback)
 					},
 					"flavor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Flavor identifies the registry type for discovery webhook routing (e.g. \"zot\"). Required when WebhookPath is set.",
+							Description: "Flavor identifies the registry type for discovery webhook routing (e.g. \"zot\", \"harbor\", \"cloudevents\"). Required when WebhookPath is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1610,46 +1911,108 @@ func schema_solar_api_solar_v1alpha1_RegistrySpec(ref common.ReferenceCallback)
 					},
 					"scanInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScanInterval controls how often the discovery worker performs a full scan of this registry. Leave unset to disable scan mode entirely.",
+							Description: "ScanInterval controls how often the discovery worker performs a full scan of this registry. Leave unset and ScanSchedule empty to disable scan mode entirely.",
 							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
-				},
-				Required: []string{"hostname"},
-			},
-		},
-		Dependencies: []string{
-			v1.LocalObjectReference{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
-	}
-}
-
-func schema_solar_api_solar_v1alpha1_RegistryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RegistryStatus defines the observed state of a Registry.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type":       "map",
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+					"scanSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScanSchedule is a cron expression of the five fields minute, hour, day of month, month and day of week, e.g. \"0 2 * * *\", evaluated in UTC. When set, full scans run at the scheduled times instead of every ScanInterval.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"scanJitter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represent the latest available observations of a Registry's state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
-									},
+							Description: "ScanJitter delays every scan of this registry by a random duration of up to ScanJitter, so registries sharing an interval or schedule do not all put load on their backends at the same moment.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"webhookAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookAuth configures how requests to WebhookPath are authenticated. Leave unset to accept unauthenticated webhook requests.",
+							Ref:         ref(v1alpha1.WebhookAuth{}.OpenAPIModelName()),
+						},
+					},
+					"discoveryLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiscoveryLimits bounds the load the discovery worker puts on this registry. Leave unset to process its events one at a time without rate limiting.",
+							Ref:         ref(v1alpha1.DiscoveryLimits{}.OpenAPIModelName()),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configures the certificates the discovery worker uses when connecting to this registry. Leave unset to verify the registry against the system trust store without presenting a client certificate.",
+							Ref:         ref(v1alpha1.RegistryTLS{}.OpenAPIModelName()),
+						},
+					},
+					"labelMappings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelMappings copy OCI manifest annotations and OCM component labels of the component versions discovered in this registry into labels of their ComponentVersions, so the catalog can be filtered by them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.LabelMapping{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"versionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionPolicy selects which component versions discovered in this registry qualify and assigns them to release channels. Leave unset to discover every version without a channel.",
+							Ref:         ref(v1alpha1.VersionPolicy{}.OpenAPIModelName()),
+						},
+					},
+					"repositoryFilter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryFilter restricts discovery to the repositories of this registry matching its path prefixes, e.g. to skip unrelated images stored next to the component descriptors. Leave unset to discover every repository.",
+							Ref:         ref(v1alpha1.RepositoryFilter{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"hostname"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.DiscoveryLimits{}.OpenAPIModelName(), v1alpha1.LabelMapping{}.OpenAPIModelName(), v1alpha1.RegistryTLS{}.OpenAPIModelName(), v1alpha1.RepositoryFilter{}.OpenAPIModelName(), v1alpha1.VersionPolicy{}.OpenAPIModelName(), v1alpha1.WebhookAuth{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), v1.SecretReference{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RegistryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryStatus defines the observed state of a Registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of a Registry's state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
 								},
 							},
 						},
@@ -1662,6 +2025,40 @@ func schema_solar_api_solar_v1alpha1_RegistryStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_solar_api_solar_v1alpha1_RegistryTLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryTLS configures TLS for connections from the discovery worker to a Registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"caSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CASecretRef references a Secret in the same namespace holding a PEM encoded CA bundle under the key \"ca.crt\". The bundle is trusted in addition to the system trust store.",
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"clientCertSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientCertSecretRef references a Secret in the same namespace holding a client certificate and key under the keys \"tls.crt\" and \"tls.key\", as found in Secrets of type kubernetes.io/tls.",
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"insecureSkipVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipVerify disables verification of the registry's certificate. Only use this for testing.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
 func schema_solar_api_solar_v1alpha1_Release(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1833,12 +2230,18 @@ func schema_solar_api_solar_v1alpha1_ReleaseBindingSpec(ref common.ReferenceCall
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are merged on top of the Release's values when the Release is rendered for this Target, e.g. to change replica counts or ingress hosts without creating a second Release. Maps are merged recursively, all other values replace those of the Release and null removes a key.",
+							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"targetRef", "releaseRef"},
 			},
 		},
 		Dependencies: []string{
-			v1.LocalObjectReference{}.OpenAPIModelName()},
+			v1.LocalObjectReference{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -2041,311 +2444,306 @@ func schema_solar_api_solar_v1alpha1_ReleaseList(ref common.ReferenceCallback) c
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_ReleaseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_ReleaseRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReleaseSpec defines the desired state of a Release. It specifies which component version to release and its deployment configuration.",
+				Description: "ReleaseRevision records a chart rendered for a revision of a Release on a Target.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentVersionRef": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionRef is a reference to the ComponentVersion to be released. It points to the specific version of a component that this release is based on.",
+							Description: "Revision is the generation of the Release the chart was rendered from.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"targetRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetRef is the Target the chart was rendered for.",
 							Default:     map[string]interface{}{},
-							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+							Ref:         ref(v1.ObjectReference{}.OpenAPIModelName()),
 						},
 					},
-					"componentVersionNamespace": {
+					"chartURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionNamespace is the namespace where ComponentVersionRef is resolved. When set, the Release references a ComponentVersion in another namespace. Cross-namespace references require a ReferenceGrant in the ComponentVersion's namespace that grants access to this Release's namespace.",
+							Description: "ChartURL is the OCI reference of the rendered chart.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetNamespace": {
+					"artifactName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetNamespace is the namespace the ComponentVersion gets deployed to.",
+							Description: "ArtifactName is the name of the RenderArtifact holding the chart, in the Target's namespace.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"uniqueName": {
+					"componentVersionRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UniqueName is a logical identifier that ensures only one Release of this component is deployed per Target when multiple Profiles match. If not set, it defaults to the parent Component name (derived from the referenced ComponentVersion). Immutable once set.",
+							Description: "ComponentVersionRef is the ComponentVersion the chart was rendered from.",
+							Ref:         ref(v1.ObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"valuesHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesHash is the SHA-256 digest of the values the chart was rendered with.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"antiAffinity": {
+					"chartDigest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AntiAffinity defines exclusion rules. If another Release matching this label selector is already bound to the same Target, this Release should not be deployed there (or a conflict condition should be raised).",
-							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+							Description: "ChartDigest is the digest of the manifest of the rendered chart, if reported by the renderer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"values": {
+					"chartSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Values contains deployment-specific values or configuration for the release. These values override defaults from the component version and are used during deployment.",
-							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
+							Description: "ChartSize is the size in bytes of the packaged chart, if reported by the renderer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"failedJobTTL": {
+					"signatureRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up. After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately. If not set, defaults to 3600 (1 hour).",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "SignatureRef is the OCI reference of the cosign signature of the rendered chart, if it was signed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"priority": {
+					"renderedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Priority determines which Release takes precedence when multiple Releases share the same unique name on a Target. Higher values indicate higher priority. If not set, defaults to 0.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "RenderedAt is the time the chart was rendered, or recorded if the time of rendering is unknown.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"componentVersionRef"},
+				Required: []string{"revision", "targetRef", "chartURL", "artifactName", "renderedAt"},
 			},
 		},
 		Dependencies: []string{
-			v1.LocalObjectReference{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			v1.ObjectReference{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_ReleaseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_ReleaseSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReleaseStatus defines the observed state of a Release.",
+				Description: "ReleaseSchedule defines the maintenance windows a Release is rendered in.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"windows": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type":       "map",
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represent the latest available observations of a Release's state.",
+							Description: "Windows are the maintenance windows. The Release is rendered while any of them is open.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+										Ref:     ref(v1alpha1.MaintenanceWindow{}.OpenAPIModelName()),
 									},
 								},
 							},
 						},
 					},
-					"renderTaskRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RenderTaskRef is a reference to the RenderTask responsible for this Release.",
-							Ref:         ref(v1.ObjectReference{}.OpenAPIModelName()),
-						},
-					},
-					"effectiveUniqueName": {
+					"timeZone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EffectiveUniqueName is the unique name used for deduplication on Targets. Equals Spec.UniqueName when set; otherwise the parent Component name derived from the referenced ComponentVersion.",
+							Description: "TimeZone is the IANA name of the time zone the windows are evaluated in, e.g. \"Europe/Berlin\". If not set, defaults to UTC.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"windows"},
 			},
 		},
 		Dependencies: []string{
-			v1.ObjectReference{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName()},
+			v1alpha1.MaintenanceWindow{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_ReleaseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderArtifact represents a successfully pushed OCI artifact produced by a RenderTask.",
+				Description: "ReleaseSpec defines the desired state of a Release. It specifies which component version to release and its deployment configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"componentVersionRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ComponentVersionRef is a reference to the ComponentVersion to be released. It points to the specific version of a component that this release is based on.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
-					"apiVersion": {
+					"componentVersionNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ComponentVersionNamespace is the namespace where ComponentVersionRef is resolved. When set, the Release references a ComponentVersion in another namespace. Cross-namespace references require a ReferenceGrant in the ComponentVersion's namespace that grants access to this Release's namespace.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.RenderArtifactSpec{}.OpenAPIModelName()),
-						},
-					},
-					"status": {
+					"targetNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.RenderArtifactStatus{}.OpenAPIModelName()),
+							Description: "TargetNamespace is the namespace the ComponentVersion gets deployed to.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			v1alpha1.RenderArtifactSpec{}.OpenAPIModelName(), v1alpha1.RenderArtifactStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
-	}
-}
-
-func schema_solar_api_solar_v1alpha1_RenderArtifactList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RenderArtifactList contains a list of RenderArtifact resources.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"uniqueName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "UniqueName is a logical identifier that ensures only one Release of this component is deployed per Target when multiple Profiles match. If not set, it defaults to the parent Component name (derived from the referenced ComponentVersion). Immutable once set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"antiAffinity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AntiAffinity defines exclusion rules. If another Release matching this label selector is already bound to the same Target, this Release should not be deployed there (or a conflict condition should be raised).",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
 						},
 					},
-					"metadata": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+							Description: "Values contains deployment-specific values or configuration for the release. These values override defaults from the component version and are used during deployment.",
+							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
 						},
 					},
-					"items": {
+					"valuesFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "ValuesFrom lists ConfigMap and Secret keys in the Release's namespace holding values as YAML. They are merged in the given order, and Values is merged on top of them. Both are merged on top of the values.yaml key of the solar-release-defaults ConfigMap of the namespace, if present. Targets render the Release again when the referenced objects change.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.RenderArtifact{}.OpenAPIModelName()),
+										Ref:     ref(v1alpha1.ValuesReference{}.OpenAPIModelName()),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			v1alpha1.RenderArtifact{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
-	}
-}
-
-func schema_solar_api_solar_v1alpha1_RenderArtifactSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RenderArtifactSpec holds the OCI coordinates of a successfully pushed artifact.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"baseURL": {
+					"sensitivePaths": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "BaseURL is the registry's base URL (e.g. \"registry.example.com:5000\").",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SensitivePaths lists dot-separated paths of the merged values, such as \"database.password\", that hold credentials or other secrets. Targets keep them out of the RenderTask spec and pass them to the render job in a separate Secret, and their values are replaced by a hash in the renderer's output, job failure messages and events.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"repository": {
+					"failedJobTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Repository is the repository path within the registry.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up. After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately. If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"tag": {
+					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tag is the OCI tag that was pushed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BackoffLimit is the number of times a failed renderer pod of a render job of this Release is retried before the job fails. If not set, the controller default applies, 3 unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"renderTaskRef": {
+					"activeDeadlineSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RenderTaskRef is the name of the RenderTask that produced this artifact.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ActiveDeadlineSeconds is how long a render job of this Release may run before it is failed. If not set, the controller default applies, which does not limit the run time unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"pushSecretRef": {
+					"priority": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PushSecretRef references a Secret with push credentials. Used for tag deletion during GC.",
-							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+							Description: "Priority determines which Release takes precedence when multiple Releases share the same unique name on a Target. Higher values indicate higher priority. If not set, defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"pushSecretNamespace": {
+					"rollbackTo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PushSecretNamespace is the namespace of the Secret referenced by PushSecretRef. When empty, defaults to the RenderArtifact's own namespace. Set when the Registry lives in a different namespace from the Target (cross-namespace).",
-							Type:        []string{"string"},
+							Description: "RollbackTo is the revision to roll back to, as listed in Status.History. While set, Targets deploy the chart rendered for that revision instead of rendering the current spec. Clear it to roll forward again.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"historyLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HistoryLimit is the number of rendered revisions kept in Status.History per Target. The charts of these revisions are retained in the render registry so they can be rolled back to. If not set, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops Targets from rendering this Release. Targets keep the chart last rendered for the Release, and render the current spec again once it is resumed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"registryFlavor": {
+					"componentVersionUpdatePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryFlavor identifies the registry implementation (e.g. \"zot\", \"harbor\").",
+							Description: "ComponentVersionUpdatePolicy controls whether ComponentVersionRef and ComponentVersionNamespace may be changed once the Release has been rendered for a Target. If not set, defaults to \"Upgrade\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"plainHTTP": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PlainHTTP uses HTTP instead of HTTPS for OCI registry connections.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Schedule restricts rendering of this Release to maintenance windows. Outside of them Targets keep the chart last rendered for the Release, and render the current spec once the next window opens.",
+							Ref:         ref(v1alpha1.ReleaseSchedule{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"baseURL", "repository", "tag", "renderTaskRef"},
+				Required: []string{"componentVersionRef"},
 			},
 		},
 		Dependencies: []string{
-			v1.LocalObjectReference{}.OpenAPIModelName()},
+			v1alpha1.ReleaseSchedule{}.OpenAPIModelName(), v1alpha1.ValuesReference{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderArtifactStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_ReleaseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderArtifactStatus holds the observed state of a RenderArtifact.",
+				Description: "ReleaseStatus defines the observed state of a Release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"chartURL": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ChartURL is the fully-qualified OCI reference for this artifact.",
+							Description: "Phase summarizes the conditions of the Release and the state of its render jobs.\n\nPossible enum values:\n - `\"Deployed\"` means a chart of the current generation of the Release, or of the revision it is rolled back to, has been rendered.\n - `\"Failed\"` means the ComponentVersion of the Release cannot be resolved or a render job for its current generation failed.\n - `\"Pending\"` means the current generation of the Release has not been rendered yet, e.g. because it is not bound to a Target or waits for its maintenance window.\n - `\"Rendering\"` means a render job for the current generation of the Release is queued or running.\n - `\"Suspended\"` means spec.suspend stops Targets from rendering the Release.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Deployed", "Failed", "Pending", "Rendering", "Suspended"},
 						},
 					},
 					"conditions": {
@@ -2360,7 +2758,7 @@ func schema_solar_api_solar_v1alpha1_RenderArtifactStatus(ref common.ReferenceCa
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represent the latest available observations of a RenderArtifact's state.",
+							Description: "Conditions represent the latest available observations of a Release's state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2372,19 +2770,46 @@ func schema_solar_api_solar_v1alpha1_RenderArtifactStatus(ref common.ReferenceCa
 							},
 						},
 					},
+					"renderTaskRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenderTaskRef is a reference to the RenderTask responsible for this Release.",
+							Ref:         ref(v1.ObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"effectiveUniqueName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EffectiveUniqueName is the unique name used for deduplication on Targets. Equals Spec.UniqueName when set; otherwise the parent Component name derived from the referenced ComponentVersion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History lists the charts rendered for this Release, newest first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.ReleaseRevision{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			metav1.Condition{}.OpenAPIModelName()},
+			v1alpha1.ReleaseRevision{}.OpenAPIModelName(), v1.ObjectReference{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderBinding declares that a consumer resource (e.g. a Target) is using a RenderArtifact.",
+				Description: "RenderArtifact represents a successfully pushed OCI artifact produced by a RenderTask.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2410,22 +2835,28 @@ func schema_solar_api_solar_v1alpha1_RenderBinding(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.RenderBindingSpec{}.OpenAPIModelName()),
+							Ref:     ref(v1alpha1.RenderArtifactSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.RenderArtifactStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.RenderBindingSpec{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+			v1alpha1.RenderArtifactSpec{}.OpenAPIModelName(), v1alpha1.RenderArtifactStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderArtifactList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderBindingList contains a list of RenderBinding resources.",
+				Description: "RenderArtifactList contains a list of RenderArtifact resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2455,7 +2886,7 @@ func schema_solar_api_solar_v1alpha1_RenderBindingList(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.RenderBinding{}.OpenAPIModelName()),
+										Ref:     ref(v1alpha1.RenderArtifact{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -2466,50 +2897,78 @@ func schema_solar_api_solar_v1alpha1_RenderBindingList(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.RenderBinding{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+			v1alpha1.RenderArtifact{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderBindingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderArtifactSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderBindingSpec links a consumer resource to a RenderArtifact for ref-counting.",
+				Description: "RenderArtifactSpec holds the OCI coordinates of a successfully pushed artifact.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"renderArtifactRef": {
+					"baseURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RenderArtifactRef is the name of the RenderArtifact in the same namespace.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+							Description: "BaseURL is the registry's base URL (e.g. \"registry.example.com:5000\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ownerKind": {
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OwnerKind is the kind of the consuming resource (e.g. \"Target\").",
+							Description: "Repository is the repository path within the registry.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ownerName": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OwnerName is the name of the consuming resource.",
+							Description: "Tag is the OCI tag that was pushed.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ownerNamespace": {
+					"renderTaskRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OwnerNamespace is the namespace of the consuming resource.",
+							Description: "RenderTaskRef is the name of the RenderTask that produced this artifact.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"pushSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PushSecretRef references a Secret with push credentials. Used for tag deletion during GC.",
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"pushSecretNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PushSecretNamespace is the namespace of the Secret referenced by PushSecretRef. When empty, defaults to the RenderArtifact's own namespace. Set when the Registry lives in a different namespace from the Target (cross-namespace).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"registryFlavor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryFlavor identifies the registry implementation (e.g. \"zot\", \"harbor\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"plainHTTP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlainHTTP uses HTTP instead of HTTPS for OCI registry connections.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"renderArtifactRef", "ownerKind", "ownerName", "ownerNamespace"},
+				Required: []string{"baseURL", "repository", "tag", "renderTaskRef"},
 			},
 		},
 		Dependencies: []string{
@@ -2517,33 +2976,57 @@ func schema_solar_api_solar_v1alpha1_RenderBindingSpec(ref common.ReferenceCallb
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderArtifactStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderResult defines the Result of a render operation.",
+				Description: "RenderArtifactStatus holds the observed state of a RenderArtifact.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dir": {
+					"chartURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dir is the directory the chart was rendered to.",
-							Default:     "",
+							Description: "ChartURL is the fully-qualified OCI reference for this artifact.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of a RenderArtifact's state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"dir"},
 			},
 		},
+		Dependencies: []string{
+			metav1.Condition{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderTask(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderTask manages a rendering job",
+				Description: "RenderBinding declares that a consumer resource (e.g. a Target) is using a RenderArtifact.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2569,28 +3052,22 @@ func schema_solar_api_solar_v1alpha1_RenderTask(ref common.ReferenceCallback) co
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.RenderTaskSpec{}.OpenAPIModelName()),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1alpha1.RenderTaskStatus{}.OpenAPIModelName()),
+							Ref:     ref(v1alpha1.RenderBindingSpec{}.OpenAPIModelName()),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.RenderTaskSpec{}.OpenAPIModelName(), v1alpha1.RenderTaskStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+			v1alpha1.RenderBindingSpec{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderTaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderTaskList contains a list of RenderTask resources.",
+				Description: "RenderBindingList contains a list of RenderBinding resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2620,7 +3097,7 @@ func schema_solar_api_solar_v1alpha1_RenderTaskList(ref common.ReferenceCallback
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref(v1alpha1.RenderTask{}.OpenAPIModelName()),
+										Ref:     ref(v1alpha1.RenderBinding{}.OpenAPIModelName()),
 									},
 								},
 							},
@@ -2631,18 +3108,328 @@ func schema_solar_api_solar_v1alpha1_RenderTaskList(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.RenderTask{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+			v1alpha1.RenderBinding{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_solar_api_solar_v1alpha1_RenderTaskSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_solar_api_solar_v1alpha1_RenderBindingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RenderTaskSpec holds the specification for a RenderTask",
+				Description: "RenderBindingSpec links a consumer resource to a RenderArtifact for ref-counting.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"renderArtifactRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenderArtifactRef is the name of the RenderArtifact in the same namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"ownerKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OwnerKind is the kind of the consuming resource (e.g. \"Target\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ownerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OwnerName is the name of the consuming resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ownerNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OwnerNamespace is the namespace of the consuming resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"renderArtifactRef", "ownerKind", "ownerName", "ownerNamespace"},
+			},
+		},
+		Dependencies: []string{
+			v1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderPreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderPreview summarizes the chart rendered by a dry run and compares it to the chart most recently rendered for the same owner.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files is the number of files in the rendered chart.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the total size in bytes of the files in the rendered chart.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"currentRenderTask": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentRenderTask is the name of the RenderTask in the same namespace that most recently rendered a chart for the same owner, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentContentDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentContentDigest is the ContentDigest of the chart rendered by CurrentRenderTask.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changed reports whether the dry run rendered a chart with different content than CurrentRenderTask. It is true if there is no current chart.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"files", "size", "changed"},
+			},
+		},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderReport is the outcome of a renderer run. The renderer writes it as JSON to its result file, usually the termination message of its container, from which the RenderTask controller records it in the RenderTask's status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"contentDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentDigest is the SHA-256 digest of the rendered chart content.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartDigest is the digest of the manifest of the pushed chart.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartSize is the size in bytes of the packaged chart.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"signatureRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureRef is the OCI reference of the cosign signature of the pushed chart, if it was signed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"renderedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenderedAt is the time the chart was pushed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files is the number of files in the rendered chart.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the total size in bytes of the files in the rendered chart.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderResult defines the Result of a render operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dir is the directory the chart was rendered to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"dir"},
+			},
+		},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderTask(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderTask manages a rendering job",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.RenderTaskSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1alpha1.RenderTaskStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.RenderTaskSpec{}.OpenAPIModelName(), v1alpha1.RenderTaskStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderTaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderTaskList contains a list of RenderTask resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.RenderTask{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.RenderTask{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderTaskRetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderTaskRetryPolicy configures how failed render jobs are retried.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of times a failed render job is replaced by a new one before the RenderTask fails.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is the time to wait before the first retry. It doubles with every further retry, up to 10 minutes. If not set, defaults to 10s.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"maxRetries"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RenderTaskSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderTaskSpec holds the specification for a RenderTask",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Type defines the output type of the renderer.\n\nPossible enum values:\n - `\"bootstrap\"`\n - `\"profile\"`\n - `\"release\"`",
 							Default:     "",
@@ -2695,6 +3482,12 @@ func schema_solar_api_solar_v1alpha1_RenderTaskSpec(ref common.ReferenceCallback
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"sensitiveValuesSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SensitiveValuesSecretRef references a Secret in the same namespace holding release values as JSON under the key \"values.json\". They are merged on top of the values of the RendererConfig by the render job, and redacted from its failure messages.",
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
 					"plainHTTP": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PlainHTTP uses HTTP instead of HTTPS for OCI registry connections.",
@@ -2704,11 +3497,38 @@ func schema_solar_api_solar_v1alpha1_RenderTaskSpec(ref common.ReferenceCallback
 					},
 					"failedJobTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up. After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately. If not set, defaults to 3600 (1 hour).",
+							Description: "failedJobTTL is the TTL in seconds after which a failed render job and its secrets are cleaned up. After this duration, the Kubernetes TTL controller will delete the Job and the controller will delete the Secrets (ConfigSecret, AuthSecret). On success, Job and Secrets are deleted immediately. If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit is the number of times a failed renderer pod is retried before the render job fails. If not set, the controller default applies, 3 unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds is how long the render job may run before it is failed. If not set, the controller default applies, which does not limit the run time unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority determines the order in which queued RenderTasks are admitted when the controller limits the number of concurrently running render jobs. Higher values render first; RenderTasks with equal priority are admitted oldest first. If not set, defaults to 0.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy makes the controller retry failed render jobs. If not set, a failed render job fails the RenderTask.",
+							Ref:         ref(v1alpha1.RenderTaskRetryPolicy{}.OpenAPIModelName()),
+						},
+					},
 					"ownerName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OwnerName is the name of the resource that created this RenderTask.",
@@ -2738,7 +3558,7 @@ func schema_solar_api_solar_v1alpha1_RenderTaskSpec(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			v1alpha1.BootstrapConfig{}.OpenAPIModelName(), v1alpha1.ReleaseConfig{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
+			v1alpha1.BootstrapConfig{}.OpenAPIModelName(), v1alpha1.ReleaseConfig{}.OpenAPIModelName(), v1alpha1.RenderTaskRetryPolicy{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName()},
 	}
 }
 
@@ -2793,11 +3613,71 @@ func schema_solar_api_solar_v1alpha1_RenderTaskStatus(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"configHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigHash is the SHA-256 digest of the renderer config and the push destination. RenderTasks with the same hash render the same chart.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"primaryRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrimaryRef references the RenderTask in the same namespace whose render job produces the chart of this RenderTask. It is set when the controller deduplicates identical RenderTasks instead of running another job.",
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of render jobs started for this RenderTask, including retries.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"contentDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentDigest is the SHA-256 digest of the rendered chart content as reported by the renderer. Identical configs render the same digest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartDigest is the digest of the manifest of the pushed chart as reported by the renderer. It identifies the chart independent of its tag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartSize is the size in bytes of the packaged chart as reported by the renderer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"signatureRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureRef is the OCI reference of the cosign signature of the pushed chart as reported by the renderer, if the renderer signs charts.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"renderedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenderedAt is the time the chart was pushed as reported by the renderer, or the completion time of the render job if the renderer did not report it.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"preview": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preview summarizes the chart rendered by a dry run. It is only set for RenderTasks annotated with solar.opendefense.cloud/dry-run, whose chart is rendered but not pushed.",
+							Ref:         ref(v1alpha1.RenderPreview{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1.ObjectReference{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName()},
+			v1alpha1.RenderPreview{}.OpenAPIModelName(), v1.LocalObjectReference{}.OpenAPIModelName(), v1.ObjectReference{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -2832,11 +3712,64 @@ func schema_solar_api_solar_v1alpha1_RendererConfig(ref common.ReferenceCallback
 						},
 					},
 				},
-				Required: []string{"type", "release", "bootstrap"},
+				Required: []string{"type", "release", "bootstrap"},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.BootstrapConfig{}.OpenAPIModelName(), v1alpha1.ReleaseConfig{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_RepositoryFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RepositoryFilter selects the repositories of a Registry by their path prefixes. Prefixes are matched against the repository names as the registry reports them, e.g. \"component-descriptors/example.com/app\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"includePrefixes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludePrefixes lists the prefixes of the repositories to discover, e.g. \"component-descriptors/\". If empty, every repository is included.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"excludePrefixes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludePrefixes lists the prefixes of the repositories to skip. They take precedence over IncludePrefixes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
-		Dependencies: []string{
-			v1alpha1.BootstrapConfig{}.OpenAPIModelName(), v1alpha1.ReleaseConfig{}.OpenAPIModelName()},
 	}
 }
 
@@ -2871,6 +3804,13 @@ func schema_solar_api_solar_v1alpha1_ResolvedResourceAccess(ref common.Reference
 							Format:      "",
 						},
 					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest of the manifest of the Resource. If set, the Resource is pulled by digest instead of by Tag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"helm": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Helm contains metadata for Helm chart resources, populated during discovery.",
@@ -2939,6 +3879,34 @@ func schema_solar_api_solar_v1alpha1_ResourceAccess(ref common.ReferenceCallback
 	}
 }
 
+func schema_solar_api_solar_v1alpha1_TagDiscovery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TagDiscovery configures periodic discovery of new tags for a Component.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between two listings of the component's tags. Defaults to 5m.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"semverConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SemverConstraint restricts discovery to tags that are valid semantic versions matching the constraint, e.g. \">= 1.2, < 2\". If empty, every tag is discovered.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_solar_api_solar_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3035,6 +4003,64 @@ func schema_solar_api_solar_v1alpha1_TargetList(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_solar_api_solar_v1alpha1_TargetReleaseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetReleaseStatus reports whether a release bound to a Target is rendered.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Release.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true if the chart of the release is rendered and part of the bootstrap chart of the Target.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a CamelCase reason for the readiness of the release, e.g. Rendered, Pending or ReleaseFailed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of why the release is not ready.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartURL is the OCI reference of the rendered chart of the release.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chartDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartDigest is the digest of the rendered chart of the release, by which the bootstrap chart of the Target references it. Empty if the render job did not report the digest, in which case the chart is referenced by tag.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "ready"},
+			},
+		},
+	}
+}
+
 func schema_solar_api_solar_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3044,7 +4070,7 @@ func schema_solar_api_solar_v1alpha1_TargetSpec(ref common.ReferenceCallback) co
 				Properties: map[string]spec.Schema{
 					"renderRegistryRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RenderRegistryRef references the Registry to push rendered desired state to. The referenced Registry must have SolarSecretRef set for rendering to succeed.",
+							Description: "RenderRegistryRef references the Registry to push rendered desired state to. The referenced Registry must have SolarSecretRef set for rendering to succeed. If empty, the Registry of the RegistryBinding with role deploy for this Target is used.",
 							Default:     map[string]interface{}{},
 							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
 						},
@@ -3062,8 +4088,35 @@ func schema_solar_api_solar_v1alpha1_TargetSpec(ref common.ReferenceCallback) co
 							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
 						},
 					},
+					"allowPartialRender": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPartialRender renders the bootstrap chart with the releases that are ready when others are still pending or failed to render. The missing releases are reported in status.releases and added once they are ready. By default, the bootstrap chart is only rendered once all releases are ready.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failedJobTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedJobTTL is the TTL in seconds after which a failed bootstrap render job of this Target and its secrets are cleaned up. If not set, the controller default applies, 3600 (1 hour) unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit is the number of times a failed renderer pod of a bootstrap render job of this Target is retried before the job fails. If not set, the controller default applies, 3 unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds is how long a bootstrap render job of this Target may run before it is failed. If not set, the controller default applies, which does not limit the run time unless configured otherwise.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"renderRegistryRef"},
 			},
 		},
 		Dependencies: []string{
@@ -3085,6 +4138,28 @@ func schema_solar_api_solar_v1alpha1_TargetStatus(ref common.ReferenceCallback)
 							Format:      "int64",
 						},
 					},
+					"releases": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Releases reports the readiness of each release bound to this Target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.TargetReleaseStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -3113,7 +4188,162 @@ func schema_solar_api_solar_v1alpha1_TargetStatus(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			metav1.Condition{}.OpenAPIModelName()},
+			v1alpha1.TargetReleaseStatus{}.OpenAPIModelName(), metav1.Condition{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_ValuesReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValuesReference selects a key of a ConfigMap or Secret holding Helm values. Exactly one of ConfigMapKeyRef and SecretKeyRef must be set. Unless the selector is marked optional, a missing object or key blocks rendering.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapKeyRef selects a key of a ConfigMap.",
+							Ref:         ref(v1.ConfigMapKeySelector{}.OpenAPIModelName()),
+						},
+					},
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKeyRef selects a key of a Secret.",
+							Ref:         ref(v1.SecretKeySelector{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1.ConfigMapKeySelector{}.OpenAPIModelName(), v1.SecretKeySelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_VersionChannel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionChannel is a release channel, such as \"stable\" or \"edge\", of the component versions discovered in a Registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the channel. It is copied into the solar.opendefense.cloud/channel label of the ComponentVersions in the channel, so it must be a valid label value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prerelease": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Prerelease lists the first prerelease identifiers of the versions in this channel, e.g. \"rc\" for \"1.2.0-rc.1\". \"*\" matches any prerelease. Leave empty to match versions without a prerelease.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_VersionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionPolicy selects the component versions discovered in a Registry and maps them to release channels.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"semverConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SemverConstraint restricts discovery to versions that are valid semantic versions matching the constraint, e.g. \">= 1.2, < 2\". Prerelease versions only match constraints that contain a prerelease themselves, e.g. \">= 1.2.0-0\". If empty, every version is discovered.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"channels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Channels map qualifying versions to release channels by their prerelease identifiers. A version is assigned to the first channel it matches and to no channel if it matches none.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1alpha1.VersionChannel{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1alpha1.VersionChannel{}.OpenAPIModelName()},
+	}
+}
+
+func schema_solar_api_solar_v1alpha1_WebhookAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookAuth configures authentication of incoming webhook requests for a Registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the authentication scheme, either \"HMAC\" or \"Bearer\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a Secret in the same namespace holding the shared secret under the key \"token\".",
+							Default:     map[string]interface{}{},
+							Ref:         ref(v1.LocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"signatureHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureHeader is the request header carrying the HMAC signature. Only used for type HMAC. Defaults to \"X-Hub-Signature-256\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the HMAC hash algorithm, one of \"sha1\", \"sha256\" or \"sha512\". Only used for type HMAC. Defaults to \"sha256\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			v1.LocalObjectReference{}.OpenAPIModelName()},
 	}
 }
 
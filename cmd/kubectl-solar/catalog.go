@@ -0,0 +1,131 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func newCatalogCmd(newClient clientFunc) *cobra.Command {
+	catalogCmd := &cobra.Command{
+		Use:   "catalog",
+		Short: "Browse the ComponentVersions available for Releases",
+	}
+
+	var allNamespaces bool
+	var selector string
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the ComponentVersions in the catalog",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, namespace, err := newClient()
+			if err != nil {
+				return err
+			}
+			if allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+
+			list, err := client.ComponentVersions(namespace).List(cmd.Context(), metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				return fmt.Errorf("failed to list ComponentVersions: %w", err)
+			}
+
+			return printCatalog(cmd.OutOrStdout(), list.Items, allNamespaces)
+		},
+	}
+	listCmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "list ComponentVersions of all namespaces")
+	listCmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector to filter ComponentVersions by, e.g. vendor=example")
+
+	showCmd := &cobra.Command{
+		Use:   "show NAME",
+		Short: "Show the details of a ComponentVersion",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, namespace, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			cv, err := client.ComponentVersions(namespace).Get(cmd.Context(), args[0], metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get ComponentVersion %s: %w", args[0], err)
+			}
+
+			return printCatalogItem(cmd.OutOrStdout(), cv)
+		},
+	}
+
+	catalogCmd.AddCommand(listCmd, showCmd)
+
+	return catalogCmd
+}
+
+func printCatalog(out io.Writer, items []solarv1alpha1.ComponentVersion, withNamespace bool) error {
+	if len(items) == 0 {
+		_, err := fmt.Fprintln(out, "No ComponentVersions found.")
+
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	if withNamespace {
+		_, _ = fmt.Fprint(w, "NAMESPACE\t")
+	}
+	_, _ = fmt.Fprintln(w, "NAME\tCOMPONENT\tVERSION\tAGE")
+	for _, cv := range items {
+		if withNamespace {
+			_, _ = fmt.Fprintf(w, "%s\t", cv.Namespace)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", cv.Name, cv.Spec.ComponentRef.Name, cv.Spec.Tag, age(cv.CreationTimestamp.Time))
+	}
+
+	return w.Flush()
+}
+
+func printCatalogItem(out io.Writer, cv *solarv1alpha1.ComponentVersion) error {
+	w := tabwriter.NewWriter(out, 0, 8, 1, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Name:\t%s\n", cv.Name)
+	_, _ = fmt.Fprintf(w, "Namespace:\t%s\n", cv.Namespace)
+	_, _ = fmt.Fprintf(w, "Component:\t%s\n", cv.Spec.ComponentRef.Name)
+	_, _ = fmt.Fprintf(w, "Version:\t%s\n", cv.Spec.Tag)
+	if len(cv.Labels) > 0 {
+		_, _ = fmt.Fprintf(w, "Labels:\t%s\n", labels.FormatLabels(cv.Labels))
+	}
+	_, _ = fmt.Fprintf(w, "Entrypoint:\t%s (%s)\n", cv.Spec.Entrypoint.ResourceName, cv.Spec.Entrypoint.Type)
+	_, _ = fmt.Fprintln(w, "Resources:")
+
+	names := make([]string, 0, len(cv.Spec.Resources))
+	for name := range cv.Spec.Resources {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		res := cv.Spec.Resources[name]
+		_, _ = fmt.Fprintf(w, "  %s:\t%s:%s\n", name, res.Repository, res.Tag)
+		if res.Helm != nil {
+			chart := []string{res.Helm.Name + " " + res.Helm.Version}
+			if res.Helm.AppVersion != "" {
+				chart = append(chart, "app "+res.Helm.AppVersion)
+			}
+			_, _ = fmt.Fprintf(w, "    Chart:\t%s\n", strings.Join(chart, ", "))
+			if res.Helm.Description != "" {
+				_, _ = fmt.Fprintf(w, "    Description:\t%s\n", res.Helm.Description)
+			}
+		}
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// conditionTypeBootstrapReady mirrors the Target condition set by the Target
+// controller once the bootstrap chart of a cluster is rendered.
+const conditionTypeBootstrapReady = "BootstrapReady"
+
+func newClusterCmd(newClient clientFunc) *cobra.Command {
+	clusterCmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Inspect the Targets (clusters) Releases are deployed to",
+	}
+
+	var allNamespaces bool
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the Targets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, namespace, err := newClient()
+			if err != nil {
+				return err
+			}
+			if allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+
+			list, err := client.Targets(namespace).List(cmd.Context(), metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list Targets: %w", err)
+			}
+
+			return printClusters(cmd.OutOrStdout(), list.Items, allNamespaces)
+		},
+	}
+	listCmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "list Targets of all namespaces")
+
+	clusterCmd.AddCommand(listCmd)
+
+	return clusterCmd
+}
+
+func printClusters(out io.Writer, items []solarv1alpha1.Target, withNamespace bool) error {
+	if len(items) == 0 {
+		_, err := fmt.Fprintln(out, "No Targets found.")
+
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	if withNamespace {
+		_, _ = fmt.Fprint(w, "NAMESPACE\t")
+	}
+	_, _ = fmt.Fprintln(w, "NAME\tREGISTRY\tBOOTSTRAP\tREADY\tAGE")
+	for _, t := range items {
+		ready := "Unknown"
+		if c := meta.FindStatusCondition(t.Status.Conditions, conditionTypeBootstrapReady); c != nil {
+			ready = string(c.Status)
+		}
+		if withNamespace {
+			_, _ = fmt.Fprintf(w, "%s\t", t.Namespace)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", t.Name, t.Spec.RenderRegistryRef.Name, t.Status.BootstrapVersion, ready, age(t.CreationTimestamp.Time))
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// kubectl-solar is a kubectl plugin for browsing the SolAr catalog and
+// creating Releases without writing YAML by hand. Installed on the PATH it is
+// invoked as "kubectl solar".
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
+)
+
+// clientFunc returns a client for the SolAr API and the namespace selected by
+// the kubeconfig or --namespace flag.
+type clientFunc func() (solarclient.SolarV1alpha1Interface, string, error)
+
+func newRootCmd(newClient clientFunc) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:           "kubectl-solar",
+		Short:         "Browse the SolAr catalog and manage Releases",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	rootCmd.AddCommand(
+		newCatalogCmd(newClient),
+		newReleaseCmd(newClient),
+		newClusterCmd(newClient),
+	)
+
+	return rootCmd
+}
+
+// kubeconfigClient returns a clientFunc using the kubeconfig selected by the
+// standard kubectl flags.
+func kubeconfigClient(flags *genericclioptions.ConfigFlags) clientFunc {
+	return func() (solarclient.SolarV1alpha1Interface, string, error) {
+		cfg, err := flags.ToRESTConfig()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to load kubeconfig: %w", err)
+		}
+		namespace, _, err := flags.ToRawKubeConfigLoader().Namespace()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to determine namespace: %w", err)
+		}
+		client, err := solarclient.NewForConfig(cfg)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create SolAr client: %w", err)
+		}
+
+		return client, namespace, nil
+	}
+}
+
+// age formats the time since t like kubectl get.
+func age(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(time.Since(t))
+}
+
+func main() {
+	flags := genericclioptions.NewConfigFlags(true)
+	rootCmd := newRootCmd(kubeconfigClient(flags))
+	flags.AddFlags(rootCmd.PersistentFlags())
+
+	if err := rootCmd.Execute(); err != nil {
+		if _, err := fmt.Fprintln(os.Stderr, "Error:", err); err != nil {
+			panic(err)
+		}
+
+		os.Exit(1)
+	}
+}
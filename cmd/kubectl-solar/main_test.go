@@ -0,0 +1,191 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/client-go/clientset/versioned/fake"
+	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKubectlSolar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "kubectl-solar Suite")
+}
+
+var _ = Describe("kubectl-solar", func() {
+	var clientset *fake.Clientset
+
+	run := func(args ...string) (string, error) {
+		cmd := newRootCmd(func() (solarclient.SolarV1alpha1Interface, string, error) {
+			return clientset.SolarV1alpha1(), "team-a", nil
+		})
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(context.Background())
+
+		return out.String(), err
+	}
+
+	BeforeEach(func() {
+		// FIXME: Use NewClientset() for better field management (blocked by https://github.com/kubernetes/kubernetes/issues/126850)
+		clientset = fake.NewSimpleClientset(
+			&solarv1alpha1.ComponentVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: "arc-v1-0-0", Namespace: "team-a"},
+				Spec: solarv1alpha1.ComponentVersionSpec{
+					ComponentRef: corev1.LocalObjectReference{Name: "arc"},
+					Tag:          "v1.0.0",
+					Resources: map[string]solarv1alpha1.ResourceAccess{
+						"helm-chart": {
+							Repository: "registry.example.com/charts/arc",
+							Tag:        "1.0.0",
+							Helm:       &solarv1alpha1.HelmResourceMetadata{Name: "arc", Version: "1.0.0", AppVersion: "2.3"},
+						},
+					},
+					Entrypoint: solarv1alpha1.Entrypoint{ResourceName: "helm-chart", Type: solarv1alpha1.EntrypointTypeHelm},
+				},
+			},
+			&solarv1alpha1.ComponentVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shared-v2-0-0",
+					Namespace: "catalog",
+					Labels:    map[string]string{"team": "platform"},
+				},
+				Spec: solarv1alpha1.ComponentVersionSpec{
+					ComponentRef: corev1.LocalObjectReference{Name: "shared"},
+					Tag:          "v2.0.0",
+				},
+			},
+			&solarv1alpha1.Target{
+				ObjectMeta: metav1.ObjectMeta{Name: "edge-1", Namespace: "team-a"},
+				Spec: solarv1alpha1.TargetSpec{
+					RenderRegistryRef: corev1.LocalObjectReference{Name: "render"},
+				},
+				Status: solarv1alpha1.TargetStatus{
+					BootstrapVersion: 3,
+					Conditions: []metav1.Condition{{
+						Type:   conditionTypeBootstrapReady,
+						Status: metav1.ConditionTrue,
+					}},
+				},
+			},
+		)
+	})
+
+	Describe("catalog", func() {
+		It("should list the ComponentVersions of the namespace", func() {
+			out, err := run("catalog", "list")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(MatchRegexp(`^NAME\s+COMPONENT\s+VERSION\s+AGE\n`))
+			Expect(out).To(MatchRegexp(`arc-v1-0-0\s+arc\s+v1\.0\.0`))
+			Expect(out).NotTo(ContainSubstring("shared"))
+		})
+
+		It("should list the ComponentVersions of all namespaces", func() {
+			out, err := run("catalog", "list", "-A")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(MatchRegexp(`^NAMESPACE\s+NAME`))
+			Expect(out).To(MatchRegexp(`catalog\s+shared-v2-0-0\s+shared\s+v2\.0\.0`))
+		})
+
+		It("should filter the ComponentVersions by label", func() {
+			out, err := run("catalog", "list", "-A", "-l", "team=platform")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring("shared-v2-0-0"))
+			Expect(out).NotTo(ContainSubstring("arc-v1-0-0"))
+		})
+
+		It("should show a ComponentVersion", func() {
+			out, err := run("catalog", "show", "arc-v1-0-0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(MatchRegexp(`Component:\s+arc\n`))
+			Expect(out).To(MatchRegexp(`Entrypoint:\s+helm-chart \(helm\)\n`))
+			Expect(out).To(MatchRegexp(`helm-chart:\s+registry\.example\.com/charts/arc:1\.0\.0\n`))
+			Expect(out).To(MatchRegexp(`Chart:\s+arc 1\.0\.0, app 2\.3\n`))
+		})
+
+		It("should fail for unknown ComponentVersions", func() {
+			_, err := run("catalog", "show", "missing")
+			Expect(err).To(MatchError(ContainSubstring("failed to get ComponentVersion missing")))
+		})
+	})
+
+	Describe("release create", func() {
+		It("should create a Release with the given values", func() {
+			valuesFile := filepath.Join(GinkgoT().TempDir(), "values.yaml")
+			Expect(os.WriteFile(valuesFile, []byte("replicas: 2\ningress:\n  host: arc.example.com\n"), 0o600)).To(Succeed())
+
+			out, err := run("release", "create", "arc", "--from-catalog-item", "arc-v1-0-0", "--values-file", valuesFile, "--target-namespace", "arc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal("release.solar.opendefense.cloud/arc created\n"))
+
+			rel, err := clientset.SolarV1alpha1().Releases("team-a").Get(context.Background(), "arc", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rel.Spec.ComponentVersionRef.Name).To(Equal("arc-v1-0-0"))
+			Expect(rel.Spec.TargetNamespace).To(HaveValue(Equal("arc")))
+			Expect(string(rel.Spec.Values.Raw)).To(MatchJSON(`{"replicas":2,"ingress":{"host":"arc.example.com"}}`))
+		})
+
+		It("should reference ComponentVersions of another namespace", func() {
+			out, err := run("release", "create", "shared", "--from-catalog-item", "shared-v2-0-0", "--catalog-namespace", "catalog", "--dry-run")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring("componentVersionNamespace: catalog\n"))
+
+			_, err = clientset.SolarV1alpha1().Releases("team-a").Get(context.Background(), "shared", metav1.GetOptions{})
+			Expect(err).To(HaveOccurred(), "dry run must not create the Release")
+		})
+
+		It("should fail for unknown ComponentVersions", func() {
+			_, err := run("release", "create", "arc", "--from-catalog-item", "missing")
+			Expect(err).To(MatchError(ContainSubstring("failed to get ComponentVersion missing")))
+		})
+
+		It("should reject values files that are not a mapping", func() {
+			valuesFile := filepath.Join(GinkgoT().TempDir(), "values.yaml")
+			Expect(os.WriteFile(valuesFile, []byte("- a\n- b\n"), 0o600)).To(Succeed())
+
+			_, err := run("release", "create", "arc", "--from-catalog-item", "arc-v1-0-0", "--values-file", valuesFile)
+			Expect(err).To(MatchError("values file must contain a YAML mapping"))
+		})
+
+		It("should require --from-catalog-item", func() {
+			_, err := run("release", "create", "arc")
+			Expect(err).To(MatchError(ContainSubstring(`"from-catalog-item" not set`)))
+		})
+	})
+
+	Describe("cluster list", func() {
+		It("should list the Targets with their bootstrap state", func() {
+			out, err := run("cluster", "list")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(MatchRegexp(`^NAME\s+REGISTRY\s+BOOTSTRAP\s+READY\s+AGE\n`))
+			Expect(out).To(MatchRegexp(`edge-1\s+render\s+3\s+True`))
+		})
+
+		It("should report when no Targets exist", func() {
+			out, err := run("cluster", "list", "-A")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(ContainSubstring("No Targets found."))
+
+			clientset = fake.NewSimpleClientset()
+			out, err = run("cluster", "list")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal("No Targets found.\n"))
+		})
+	})
+})
@@ -0,0 +1,128 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+type releaseCreateOptions struct {
+	catalogItem      string
+	catalogNamespace string
+	targetNamespace  string
+	valuesFile       string
+	dryRun           bool
+}
+
+func newReleaseCmd(newClient clientFunc) *cobra.Command {
+	releaseCmd := &cobra.Command{
+		Use:   "release",
+		Short: "Manage Releases",
+	}
+
+	opts := &releaseCreateOptions{}
+	createCmd := &cobra.Command{
+		Use:   "create NAME --from-catalog-item COMPONENTVERSION",
+		Short: "Create a Release of a ComponentVersion from the catalog",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, namespace, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			cvNamespace := namespace
+			if opts.catalogNamespace != "" {
+				cvNamespace = opts.catalogNamespace
+			}
+			// Fail early on typos instead of leaving a Release that never renders.
+			if _, err := client.ComponentVersions(cvNamespace).Get(cmd.Context(), opts.catalogItem, metav1.GetOptions{}); err != nil {
+				return fmt.Errorf("failed to get ComponentVersion %s: %w", opts.catalogItem, err)
+			}
+
+			rel, err := opts.release(args[0], namespace)
+			if err != nil {
+				return err
+			}
+
+			if opts.dryRun {
+				out, err := yaml.Marshal(rel)
+				if err != nil {
+					return err
+				}
+				_, err = cmd.OutOrStdout().Write(out)
+
+				return err
+			}
+
+			created, err := client.Releases(namespace).Create(cmd.Context(), rel, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to create Release %s: %w", rel.Name, err)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "release.solar.opendefense.cloud/%s created\n", created.Name)
+
+			return err
+		},
+	}
+
+	flags := createCmd.Flags()
+	flags.StringVar(&opts.catalogItem, "from-catalog-item", "", "name of the ComponentVersion to release")
+	flags.StringVar(&opts.catalogNamespace, "catalog-namespace", "", "namespace of the ComponentVersion if it differs from the Release's (requires a ReferenceGrant)")
+	flags.StringVar(&opts.targetNamespace, "target-namespace", "", "namespace the release is deployed to on the Targets")
+	flags.StringVarP(&opts.valuesFile, "values-file", "f", "", "YAML file with the Helm values of the release")
+	flags.BoolVar(&opts.dryRun, "dry-run", false, "print the Release instead of creating it")
+	_ = createCmd.MarkFlagRequired("from-catalog-item")
+
+	releaseCmd.AddCommand(createCmd)
+
+	return releaseCmd
+}
+
+// release builds the Release described by the options.
+func (o *releaseCreateOptions) release(name, namespace string) (*solarv1alpha1.Release, error) {
+	rel := &solarv1alpha1.Release{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: solarv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Release",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: solarv1alpha1.ReleaseSpec{
+			ComponentVersionRef:       corev1.LocalObjectReference{Name: o.catalogItem},
+			ComponentVersionNamespace: o.catalogNamespace,
+		},
+	}
+	if o.targetNamespace != "" {
+		rel.Spec.TargetNamespace = &o.targetNamespace
+	}
+
+	if o.valuesFile != "" {
+		data, err := os.ReadFile(o.valuesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values file: %w", err)
+		}
+		values, err := yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse values file: %w", err)
+		}
+		if len(values) == 0 || values[0] != '{' {
+			return nil, errors.New("values file must contain a YAML mapping")
+		}
+		rel.Spec.Values = runtime.RawExtension{Raw: values}
+	}
+
+	return rel, nil
+}
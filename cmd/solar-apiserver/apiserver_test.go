@@ -6,10 +6,12 @@ package main_test
 import (
 	"go.opendefense.cloud/kit/envtest"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	applysolarv1alpha1 "go.opendefense.cloud/solar/client-go/applyconfigurations/solar/v1alpha1"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -97,6 +99,47 @@ var _ = Describe("Release", func() {
 			Expect(k8sClient.Delete(ctx, rel)).To(Succeed())
 		})
 	})
+
+	Context("server-side apply", func() {
+		It("should track field ownership of releases", func() {
+			By("applying a release as a first field manager")
+			Expect(k8sClient.Apply(ctx, applysolarv1alpha1.Release("applied", ns.Name).
+				WithSpec(applysolarv1alpha1.ReleaseSpec().
+					WithComponentVersionRef(corev1.LocalObjectReference{Name: "my-component-v1"}).
+					WithUniqueName("my-component")),
+				client.FieldOwner("first"))).To(Succeed())
+
+			By("applying another field as a second field manager")
+			Expect(k8sClient.Apply(ctx, applysolarv1alpha1.Release("applied", ns.Name).
+				WithSpec(applysolarv1alpha1.ReleaseSpec().WithHistoryLimit(5)),
+				client.FieldOwner("second"))).To(Succeed())
+
+			applied := &solarv1alpha1.Release{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "applied", Namespace: ns.Name}, applied)).To(Succeed())
+			Expect(applied.Spec.UniqueName).To(Equal("my-component"))
+			Expect(applied.Spec.HistoryLimit).To(HaveValue(BeEquivalentTo(5)))
+			managers := []string{}
+			for _, entry := range applied.ManagedFields {
+				managers = append(managers, entry.Manager)
+			}
+			Expect(managers).To(ContainElements("first", "second"))
+
+			By("rejecting a change of a field owned by another manager")
+			err := k8sClient.Apply(ctx, applysolarv1alpha1.Release("applied", ns.Name).
+				WithSpec(applysolarv1alpha1.ReleaseSpec().WithUniqueName("other")),
+				client.FieldOwner("second"))
+			Expect(apierrors.IsConflict(err)).To(BeTrue(), "expected a conflict, got %v", err)
+
+			By("taking over the field when forced")
+			Expect(k8sClient.Apply(ctx, applysolarv1alpha1.Release("applied", ns.Name).
+				WithSpec(applysolarv1alpha1.ReleaseSpec().WithUniqueName("other").WithHistoryLimit(5)),
+				client.FieldOwner("second"), client.ForceOwnership)).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "applied", Namespace: ns.Name}, applied)).To(Succeed())
+			Expect(applied.Spec.UniqueName).To(Equal("other"))
+
+			Expect(k8sClient.Delete(ctx, applied)).To(Succeed())
+		})
+	})
 })
 
 var _ = Describe("Target", func() {
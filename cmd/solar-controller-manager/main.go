@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -24,6 +26,7 @@ import (
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	"go.opendefense.cloud/solar/pkg/controller"
+	"go.opendefense.cloud/solar/pkg/observability"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 )
@@ -47,6 +50,7 @@ func main() {
 		metricsCertPath, metricsCertName, metricsCertKey string
 		enableHTTP2                                      bool
 		enableLeaderElection                             bool
+		gracefulShutdownTimeout                          time.Duration
 		probeAddr                                        string
 		pprofAddr                                        string
 		prefixAllocationTimeout                          time.Duration
@@ -56,9 +60,22 @@ func main() {
 		tlsOpts                                          []func(*tls.Config)
 		rendererImage, rendererCommand                   string
 		rendererArgs                                     string
+		rendererTypeImages, rendererTypeCommands         string
+		rendererTypeArgs                                 string
 		rendererCAConfigMap                              string
 		rendererImagePullSecrets                         string
 		registryBindingStrict                            bool
+		maxConcurrentRenders                             int
+		renderTaskDedupe                                 bool
+		rendererReportDigest                             bool
+		rendererSigningKeySecret                         string
+		rendererKeylessSigning                           bool
+		renderJobBackoffLimit                            int
+		renderJobTTL                                     int
+		renderJobActiveDeadline                          int64
+		artifactGCRetention                              time.Duration
+		artifactGCDryRun                                 bool
+		componentVersionGCRetention                      time.Duration
 	)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0",
 		"The address the metrics endpoint binds to. "+
@@ -80,6 +97,8 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager."+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&gracefulShutdownTimeout, "graceful-shutdown-timeout", 30*time.Second,
+		"Time running reconciles are given to finish on shutdown before the manager exits.")
 	flag.DurationVar(&prefixAllocationTimeout, "prefix-allocation-timeout", 1*time.Second,
 		"Time to wait until considering a pending allocation failed.")
 	flag.DurationVar(&volumeBindTimeout, "volume-bind-timeout", 10*time.Second,
@@ -96,10 +115,38 @@ func main() {
 		"ConfigMap name containing CA bundle for registry connections.")
 	flag.StringVar(&rendererArgs, "renderer-args", "",
 		"Comma separated list of additional args for the renderer cli.")
+	flag.StringVar(&rendererTypeImages, "renderer-type-images", "",
+		"Comma separated list of type=image pairs overriding the renderer image for RenderTasks of a renderer config type (release, bootstrap or profile).")
+	flag.StringVar(&rendererTypeCommands, "renderer-type-commands", "",
+		"Comma separated list of type=command pairs overriding the renderer command for RenderTasks of a renderer config type.")
+	flag.StringVar(&rendererTypeArgs, "renderer-type-args", "",
+		"Comma separated list of type=args pairs replacing the additional renderer args for RenderTasks of a renderer config type. The args of a type are separated by semicolons.")
 	flag.StringVar(&rendererImagePullSecrets, "renderer-image-pull-secrets", "",
 		"Comma separated list of Secret names used to pull the renderer image. Each Secret must exist of type kubernetes.io/dockerconfigjson in every namespace where RenderTasks are created.")
 	flag.BoolVar(&registryBindingStrict, "registry-binding-strict", false,
 		"Enable strict registry binding mode. When true, rendering fails if a resource's registry host has no matching RegistryBinding. When false (default), unmatched hosts use anonymous pull.")
+	flag.IntVar(&maxConcurrentRenders, "max-concurrent-renders", 0,
+		"Maximum number of renderer jobs running at the same time. Further RenderTasks are queued by priority. 0 disables the limit.")
+	flag.BoolVar(&renderTaskDedupe, "rendertask-dedupe", false,
+		"Let RenderTasks with the same config hash as another RenderTask in their namespace reuse its render job and chart instead of running their own.")
+	flag.BoolVar(&rendererReportDigest, "renderer-report-digest", false,
+		"Let renderer jobs report the digests, size and push time of the rendered chart, which are recorded in the RenderTask status.")
+	flag.StringVar(&rendererSigningKeySecret, "renderer-signing-key-secret", "",
+		"Name of a Secret holding a PEM encoded ECDSA private key under the key cosign.key, which renderer jobs sign pushed charts with. The Secret must exist in every namespace where RenderTasks are created.")
+	flag.BoolVar(&rendererKeylessSigning, "renderer-keyless-signing", false,
+		"Let renderer jobs sign pushed charts keyless, with a key certified by Fulcio for the identity of their service account.")
+	flag.IntVar(&renderJobBackoffLimit, "render-job-backoff-limit", 3,
+		"Number of times a failed renderer pod is retried before its job fails, unless the RenderTask sets a backoffLimit.")
+	flag.IntVar(&renderJobTTL, "render-job-ttl-seconds", 3600,
+		"Time in seconds to keep finished renderer jobs, unless the RenderTask sets a failedJobTTL.")
+	flag.Int64Var(&renderJobActiveDeadline, "render-job-active-deadline-seconds", 0,
+		"Time in seconds a renderer job may run before it is failed, unless the RenderTask sets an activeDeadlineSeconds. 0 disables the limit.")
+	flag.DurationVar(&artifactGCRetention, "artifact-gc-retention", 0,
+		"Time to keep a RenderArtifact and its chart in the render registry after the last RenderBinding referencing it is removed.")
+	flag.BoolVar(&artifactGCDryRun, "artifact-gc-dry-run", false,
+		"Only mark unreferenced RenderArtifacts with an event and the Referenced condition instead of deleting them and their charts.")
+	flag.DurationVar(&componentVersionGCRetention, "componentversion-gc-retention", 24*time.Hour,
+		"Time to keep a ComponentVersion after discovery marked it unavailable because it was removed from its registry. 0 deletes it right away.")
 	flag.Parse()
 
 	opts := zap.Options{
@@ -186,6 +233,10 @@ func main() {
 		PprofBindAddress:       pprofAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "solar.opendefense.cloud",
+		// The process exits right after the manager stopped, so the lease
+		// can be handed over immediately instead of waiting for it to expire.
+		LeaderElectionReleaseOnCancel: true,
+		GracefulShutdownTimeout:       &gracefulShutdownTimeout,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to create manager")
@@ -200,6 +251,11 @@ func main() {
 		}
 	}
 
+	if err := observability.RegisterRuntimeMetrics(); err != nil {
+		setupLog.Error(err, "unable to register runtime metrics")
+		os.Exit(1)
+	}
+
 	// Register field indexers (must be done before controller setup)
 	if err := controller.IndexFields(context.Background(), mgr); err != nil {
 		setupLog.Error(err, "unable to register field indexers")
@@ -232,19 +288,44 @@ func main() {
 	if rendererArgs != "" {
 		rendererArgsSlice = strings.Split(rendererArgs, ",")
 	}
+	rendererTypes, err := parseRendererTypes(rendererTypeImages, rendererTypeCommands, rendererTypeArgs)
+	if err != nil {
+		setupLog.Error(err, "invalid renderer type overrides")
+		os.Exit(1)
+	}
 	var rendererImagePullSecretsSlice []string
 	if rendererImagePullSecrets != "" {
 		rendererImagePullSecretsSlice = strings.Split(rendererImagePullSecrets, ",")
 	}
+	if rendererSigningKeySecret != "" && rendererKeylessSigning {
+		setupLog.Error(nil, "--renderer-signing-key-secret and --renderer-keyless-signing are mutually exclusive")
+		os.Exit(1)
+	}
+	podClient, err := corev1client.NewForConfigAndClient(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		setupLog.Error(err, "unable to create pod client")
+		os.Exit(1)
+	}
 	if err := (&controller.RenderTaskReconciler{
 		Client:                   mgr.GetClient(),
+		APIReader:                mgr.GetAPIReader(),
 		Scheme:                   mgr.GetScheme(),
 		Recorder:                 mgr.GetEventRecorder("rendertask-controller"),
 		RendererImage:            rendererImage,
 		RendererCommand:          rendererCommand,
 		RendererArgs:             rendererArgsSlice,
 		RendererCAConfigMap:      rendererCAConfigMap,
+		RendererTypes:            rendererTypes,
 		RendererImagePullSecrets: rendererImagePullSecretsSlice,
+		MaxConcurrentRenders:     maxConcurrentRenders,
+		PodLogs:                  podClient,
+		Deduplicate:              renderTaskDedupe,
+		ReportDigest:             rendererReportDigest,
+		SigningKeySecret:         rendererSigningKeySecret,
+		KeylessSigning:           rendererKeylessSigning,
+		JobBackoffLimit:          new(int32(renderJobBackoffLimit)),
+		JobTTL:                   new(int32(renderJobTTL)),
+		JobActiveDeadlineSeconds: renderJobActiveDeadline,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "rendertask")
 		os.Exit(1)
@@ -260,18 +341,29 @@ func main() {
 	}
 
 	if err := (&controller.RenderArtifactReconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		Recorder:  mgr.GetEventRecorder("renderartifact-controller"),
-		APIReader: mgr.GetAPIReader(),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorder("renderartifact-controller"),
+		APIReader:   mgr.GetAPIReader(),
+		GCRetention: artifactGCRetention,
+		GCDryRun:    artifactGCDryRun,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "renderartifact")
 		os.Exit(1)
 	}
 
-	if err := (&controller.ComponentVersionReconciler{
+	if err := (&controller.ComponentReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "component")
+		os.Exit(1)
+	}
+
+	if err := (&controller.ComponentVersionReconciler{
+		Client:               mgr.GetClient(),
+		Scheme:               mgr.GetScheme(),
+		UnavailableRetention: componentVersionGCRetention,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "componentversion")
 		os.Exit(1)
@@ -310,3 +402,48 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// parseRendererTypes builds the renderer container overrides by renderer
+// config type from the comma separated type=value lists of the
+// --renderer-type-* flags.
+func parseRendererTypes(images, commands, args string) (map[solarv1alpha1.RendererConfigType]controller.RendererContainer, error) {
+	types := map[solarv1alpha1.RendererConfigType]controller.RendererContainer{}
+
+	for flagName, list := range map[string]string{
+		"renderer-type-images":   images,
+		"renderer-type-commands": commands,
+		"renderer-type-args":     args,
+	} {
+		if list == "" {
+			continue
+		}
+		for pair := range strings.SplitSeq(list, ",") {
+			key, value, ok := strings.Cut(pair, "=")
+			t := solarv1alpha1.RendererConfigType(key)
+			switch t {
+			case solarv1alpha1.RendererConfigTypeRelease, solarv1alpha1.RendererConfigTypeBootstrap, solarv1alpha1.RendererConfigTypeProfile:
+			default:
+				return nil, fmt.Errorf("--%s: unknown renderer config type %q", flagName, key)
+			}
+			if !ok || (value == "" && flagName != "renderer-type-args") {
+				return nil, fmt.Errorf("--%s: expected type=value, got %q", flagName, pair)
+			}
+
+			c := types[t]
+			switch flagName {
+			case "renderer-type-images":
+				c.Image = value
+			case "renderer-type-commands":
+				c.Command = value
+			default:
+				c.Args = []string{}
+				if value != "" {
+					c.Args = strings.Split(value, ";")
+				}
+			}
+			types[t] = c
+		}
+	}
+
+	return types, nil
+}
@@ -5,9 +5,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -15,13 +18,24 @@ import (
 	"github.com/go-logr/zapr"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
 	"go.opendefense.cloud/solar/pkg/discovery"
 	"go.opendefense.cloud/solar/pkg/discovery/pipeline"
+	"go.opendefense.cloud/solar/pkg/discovery/publisher"
+	"go.opendefense.cloud/solar/pkg/discovery/webhook"
+	_ "go.opendefense.cloud/solar/pkg/discovery/webhook/cloudevents"
+	_ "go.opendefense.cloud/solar/pkg/discovery/webhook/harbor"
 	_ "go.opendefense.cloud/solar/pkg/discovery/webhook/zot"
+	"go.opendefense.cloud/solar/pkg/observability"
+	"go.opendefense.cloud/solar/pkg/ociregistry"
 )
 
 var cmd = &cobra.Command{
@@ -32,7 +46,28 @@ var cmd = &cobra.Command{
 
 func init() {
 	cmd.Flags().StringP("listen", "l", "0.0.0.0:8080", "Address to listen on")
+	cmd.Flags().String("health-probe-bind-address", ":8081", "Address the /healthz and /readyz probe endpoints and the /scans status endpoint bind to (empty disables them)")
+	cmd.Flags().String("pprof-bind-address", "", "Address the /debug/pprof/ profiling endpoints bind to (empty disables them)")
 	cmd.Flags().StringP("namespace", "n", "default", "Namespace the worker is running in")
+	cmd.Flags().Int64("response-cache-size", ociregistry.DefaultCacheMaxSize, "Number of bytes of registry manifest and blob responses kept in memory (0 disables the response cache)")
+	cmd.Flags().Duration("response-cache-ttl", ociregistry.DefaultCacheTTL, "Time a cached response of a tag is served before it is revalidated with the registry")
+	cmd.Flags().Int("registry-max-attempts", ociregistry.DefaultRetryPolicy.MaxAttempts, "Number of times a registry request failing with a network error, 429 or 502 to 504 is sent (1 disables retries)")
+	cmd.Flags().Duration("registry-retry-backoff", ociregistry.DefaultRetryPolicy.InitialBackoff, "Delay before the first retry of a registry request, doubling with every further retry")
+	cmd.Flags().Duration("registry-retry-max-backoff", ociregistry.DefaultRetryPolicy.MaxBackoff, "Maximum delay between two attempts of a registry request, including delays requested by a Retry-After header")
+	cmd.Flags().StringSlice("event-sink", nil, "URL of a CloudEvents HTTP endpoint discovered component versions are published to (may be repeated)")
+	cmd.Flags().String("publisher-config", "", "Path of a YAML file configuring the CloudEvents and Kafka REST Proxy publishers discovered component versions are published to (empty disables it)")
+	cmd.Flags().Int("registry-workers", 1, "Number of repositories of a registry looked up and handled in parallel, unless the registry sets discoveryLimits.maxConcurrency; events of a repository are always processed in order")
+	cmd.Flags().Int("not-found-threshold", 0, "Number of consecutive lookups of a component version the registry must answer with not found before it is marked unavailable (0 only marks versions unavailable on delete events)")
+	cmd.Flags().Duration("scan-stagger", 0, "Window the scans of all scanned registries are spread over, so they do not start at the same time (0 disables staggering)")
+	cmd.Flags().String("webhook-cert-path", "", "Directory containing the certificate the webhook server is served with over HTTPS; reloaded when it changes (empty serves HTTP)")
+	cmd.Flags().String("webhook-cert-name", "tls.crt", "Name of the webhook server certificate file")
+	cmd.Flags().String("webhook-cert-key", "tls.key", "Name of the webhook server key file")
+	cmd.Flags().Int64("webhook-max-body-bytes", webhook.DefaultRequestLimits.MaxBodyBytes, "Maximum size of a webhook request body; larger requests are answered with 413 (0 disables the limit)")
+	cmd.Flags().Float64("webhook-rate-limit", float64(webhook.DefaultRequestLimits.Rate), "Webhook requests per second accepted from a source; further requests are answered with 429 (0 disables rate limiting)")
+	cmd.Flags().Int("webhook-rate-burst", webhook.DefaultRequestLimits.Burst, "Webhook requests accepted from a source at once")
+	cmd.Flags().String("webhook-rate-limit-key", string(webhook.DefaultRequestLimits.Key), "Source webhook requests are rate limited by: remoteAddr (per webhook path and client address) or registry (per webhook path)")
+	cmd.Flags().Duration("shutdown-timeout", 20*time.Second, "Time queued events are processed for on shutdown before the remaining ones are dropped; webhook requests are rejected and scans stopped meanwhile")
+	cmd.Flags().String("digest-cache", "solar-discovery-digests", "Name of the ConfigMap persisting the digests of discovered versions, so scans skip unchanged versions (empty disables incremental scans)")
 }
 
 func runE(cmd *cobra.Command, _ []string) error {
@@ -49,6 +84,10 @@ func runE(cmd *cobra.Command, _ []string) error {
 	log = zapr.NewLogger(zapLog)
 	ctx = logr.NewContext(ctx, log)
 
+	if err := observability.RegisterRuntimeMetrics(); err != nil {
+		return fmt.Errorf("failed to register runtime metrics: %w", err)
+	}
+
 	namespace := cmd.Flag("namespace").Value.String()
 	if namespace == "" {
 		return fmt.Errorf("--namespace is required")
@@ -56,12 +95,19 @@ func runE(cmd *cobra.Command, _ []string) error {
 
 	cfg := config.GetConfigOrDie()
 	solarClient := solarclient.NewForConfigOrDie(cfg)
-	coreClient := kubernetes.NewForConfigOrDie(cfg).CoreV1()
+	clientset := kubernetes.NewForConfigOrDie(cfg)
+	coreClient := clientset.CoreV1()
+
+	scanStagger, err := cmd.Flags().GetDuration("scan-stagger")
+	if err != nil {
+		return err
+	}
 
-	registries := discovery.NewRegistryProvider()
+	registries := discovery.NewRegistryProvider(discovery.WithScanStagger(scanStagger))
 	if err := registries.LoadFromAPI(ctx, solarClient, coreClient, namespace); err != nil {
 		return fmt.Errorf("failed to load registries: %w", err)
 	}
+	go registries.WatchPullSecrets(ctx, clientset)
 
 	addr := cmd.Flag("listen").Value.String()
 	if addr == "" {
@@ -69,32 +115,217 @@ func runE(cmd *cobra.Command, _ []string) error {
 		log.Info(fmt.Sprintf("no listen address specified, using fallback '%s'", addr))
 	}
 
-	errChan := make(chan discovery.ErrorEvent, 1)
+	// Errors of single events and the outcomes of scans are recorded as
+	// Kubernetes events on their Registry; fatal errors stop the pipeline.
+	scheme := runtime.NewScheme()
+	utilruntime.Must(solarv1alpha1.AddToScheme(scheme))
+	broadcaster := events.NewBroadcaster(&events.EventSinkImpl{Interface: clientset.EventsV1()})
+	if err := broadcaster.StartRecordingToSinkWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to start event recording: %w", err)
+	}
+	defer broadcaster.Shutdown()
+	recorder := broadcaster.NewRecorder(scheme, "solar-discovery")
+	errRecorder := discovery.NewErrorRecorder(registries, recorder, log)
+	scanRecorder := discovery.NewScanRecorder(registries, recorder, log)
+
+	errChan := make(chan discovery.ErrorEvent, 100)
 
-	p, err := pipeline.NewPipeline(namespace, registries, addr, errChan, log, solarClient)
+	registryWorkers, err := cmd.Flags().GetInt("registry-workers")
 	if err != nil {
-		return fmt.Errorf("failed to create discovery pipeline: %w", err)
+		return err
 	}
-	if err := p.Start(ctx); err != nil {
-		return fmt.Errorf("failed to start discovery pipeline: %w", err)
+	notFoundThreshold, err := cmd.Flags().GetInt("not-found-threshold")
+	if err != nil {
+		return err
+	}
+	opts := []pipeline.Option{
+		pipeline.WithRegistryWorkers(registryWorkers),
+		pipeline.WithNotFoundThreshold(notFoundThreshold),
+		pipeline.WithScanObserver(scanRecorder.Record),
+	}
+	if name := cmd.Flag("digest-cache").Value.String(); name != "" {
+		store := discovery.NewConfigMapDigestStore(coreClient, namespace, name)
+		opts = append(opts, pipeline.WithDigestCache(discovery.NewDigestCache(store, discovery.WithDigestCacheLogger(log))))
+	}
+
+	cacheSize, err := cmd.Flags().GetInt64("response-cache-size")
+	if err != nil {
+		return err
+	}
+	if cacheSize > 0 {
+		cacheTTL, err := cmd.Flags().GetDuration("response-cache-ttl")
+		if err != nil {
+			return err
+		}
+		opts = append(opts, pipeline.WithResponseCache(ociregistry.NewResponseCache(
+			ociregistry.WithCacheMaxSize(cacheSize),
+			ociregistry.WithCacheTTL(cacheTTL),
+		)))
+	}
+
+	maxAttempts, err := cmd.Flags().GetInt("registry-max-attempts")
+	if err != nil {
+		return err
+	}
+	if maxAttempts > 1 {
+		retryBackoff, err := cmd.Flags().GetDuration("registry-retry-backoff")
+		if err != nil {
+			return err
+		}
+		retryMaxBackoff, err := cmd.Flags().GetDuration("registry-retry-max-backoff")
+		if err != nil {
+			return err
+		}
+		opts = append(opts, pipeline.WithRetrier(ociregistry.NewRetrier(ociregistry.WithRetryPolicy(ociregistry.RetryPolicy{
+			MaxAttempts:    maxAttempts,
+			InitialBackoff: retryBackoff,
+			MaxBackoff:     retryMaxBackoff,
+		}))))
+	}
+
+	sinks, err := cmd.Flags().GetStringSlice("event-sink")
+	if err != nil {
+		return err
+	}
+	publisherConfig := &publisher.Config{}
+	if path := cmd.Flag("publisher-config").Value.String(); path != "" {
+		if publisherConfig, err = publisher.LoadConfig(path); err != nil {
+			return err
+		}
+	}
+	for _, sink := range sinks {
+		publisherConfig.Publishers = append(publisherConfig.Publishers, publisher.PublisherConfig{
+			CloudEvents: &publisher.CloudEventsConfig{URL: sink},
+		})
+	}
+	if len(publisherConfig.Publishers) > 0 {
+		publishers, err := publisherConfig.NewPublishers("/solar-discovery/" + namespace)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, pipeline.WithPublishers(publishers, publisherConfig.DispatcherOptions()...))
+	}
+
+	maxBodyBytes, err := cmd.Flags().GetInt64("webhook-max-body-bytes")
+	if err != nil {
+		return err
+	}
+	rateLimit, err := cmd.Flags().GetFloat64("webhook-rate-limit")
+	if err != nil {
+		return err
+	}
+	rateBurst, err := cmd.Flags().GetInt("webhook-rate-burst")
+	if err != nil {
+		return err
+	}
+	webhookLimits := webhook.RequestLimits{
+		MaxBodyBytes: maxBodyBytes,
+		Rate:         rate.Limit(rateLimit),
+		Burst:        rateBurst,
+		Key:          webhook.RateLimitKey(cmd.Flag("webhook-rate-limit-key").Value.String()),
+	}
+	if err := webhookLimits.Validate(); err != nil {
+		return fmt.Errorf("invalid webhook limits: %w", err)
 	}
+	opts = append(opts, pipeline.WithWebhookLimits(webhookLimits))
 
-	select {
-	case pipelineErr := <-errChan:
-		if stopErr := p.Stop(ctx); stopErr != nil {
-			log.Error(stopErr, "error stopping discovery pipeline")
+	if certPath := cmd.Flag("webhook-cert-path").Value.String(); certPath != "" {
+		opts = append(opts, pipeline.WithWebhookTLS(
+			filepath.Join(certPath, cmd.Flag("webhook-cert-name").Value.String()),
+			filepath.Join(certPath, cmd.Flag("webhook-cert-key").Value.String()),
+		))
+	}
+
+	p, err := pipeline.NewPipeline(namespace, registries, addr, errChan, log, solarClient, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create discovery pipeline: %w", err)
+	}
+
+	serve := func(name, addr string, handler http.Handler) (stop func()) {
+		server := &http.Server{
+			Addr:              addr,
+			Handler:           handler,
+			ReadHeaderTimeout: 3 * time.Second,
 		}
+		go func() {
+			log.Info("Starting "+name+" server", "addr", addr)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				discovery.Publish(&log, errChan, discovery.ErrorEvent{
+					Source:    name + " server",
+					Severity:  discovery.SeverityFatal,
+					Error:     fmt.Errorf("%s server: %w", name, err),
+					Timestamp: time.Now().UTC(),
+				})
+			}
+		}()
 
-		return fmt.Errorf("non-recoverable error occurred in discovery pipeline: %w", pipelineErr.Error)
-	case <-ctx.Done():
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-		if err := p.Stop(shutdownCtx); err != nil {
-			log.Error(err, "error stopping discovery pipeline")
+		return func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				log.Error(err, "error stopping "+name+" server")
+			}
 		}
 	}
 
-	return nil
+	// Serve the probes before starting the pipeline, so /readyz reports a
+	// pipeline that is still starting.
+	if probeAddr := cmd.Flag("health-probe-bind-address").Value.String(); probeAddr != "" {
+		defer serve("health probe", probeAddr, p.HealthHandler())()
+	}
+
+	if pprofAddr := cmd.Flag("pprof-bind-address").Value.String(); pprofAddr != "" {
+		mux := http.NewServeMux()
+		observability.EnableProfiling(mux)
+		defer serve("pprof", pprofAddr, mux)()
+	}
+
+	shutdownTimeout, err := cmd.Flags().GetDuration("shutdown-timeout")
+	if err != nil {
+		return err
+	}
+
+	// The pipeline outlives the signal context, so queued events can be
+	// drained after a signal was received.
+	pipelineCtx, cancelPipeline := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelPipeline()
+
+	if err := p.Start(pipelineCtx); err != nil {
+		return fmt.Errorf("failed to start discovery pipeline: %w", err)
+	}
+
+	for {
+		select {
+		case pipelineErr := <-errChan:
+			if pipelineErr.Severity != discovery.SeverityFatal {
+				errRecorder.Record(pipelineErr)
+
+				continue
+			}
+
+			if stopErr := p.Stop(ctx); stopErr != nil {
+				log.Error(stopErr, "error stopping discovery pipeline")
+			}
+
+			return fmt.Errorf("non-recoverable error occurred in discovery pipeline: %w", pipelineErr.Error)
+		case <-ctx.Done():
+			log.Info("draining discovery pipeline", "timeout", shutdownTimeout)
+			drainCtx, cancelDrain := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancelDrain()
+			if err := p.Drain(drainCtx); err != nil {
+				log.Error(err, "error draining discovery pipeline")
+			}
+
+			cancelPipeline()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := p.Stop(shutdownCtx); err != nil {
+				log.Error(err, "error stopping discovery pipeline")
+			}
+
+			return nil
+		}
+	}
 }
 
 func main() {
@@ -0,0 +1,111 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validates the Registries of a namespace and, with --connect, checks that each of them is reachable with its credentials",
+	Args:  cobra.NoArgs,
+	RunE:  validateE,
+}
+
+func init() {
+	validateCmd.Flags().StringP("namespace", "n", "default", "Namespace the Registries are read from")
+	validateCmd.Flags().Bool("connect", false, "Dial each registry, authenticate and list one page of its repositories")
+	cmd.AddCommand(validateCmd)
+}
+
+func validateE(cmd *cobra.Command, _ []string) error {
+	namespace := cmd.Flag("namespace").Value.String()
+	if namespace == "" {
+		return fmt.Errorf("--namespace is required")
+	}
+
+	connect, err := cmd.Flags().GetBool("connect")
+	if err != nil {
+		return err
+	}
+
+	cfg := config.GetConfigOrDie()
+	solarClient := solarclient.NewForConfigOrDie(cfg)
+	clientset := kubernetes.NewForConfigOrDie(cfg)
+
+	registries := discovery.NewRegistryProvider()
+	if err := registries.LoadFromAPI(cmd.Context(), solarClient, clientset.CoreV1(), namespace); err != nil {
+		return fmt.Errorf("failed to load registries: %w", err)
+	}
+
+	var checks []discovery.RegistryCheck
+	if connect {
+		checks = registries.CheckAll(cmd.Context())
+	} else {
+		checks = validateRegistries(registries)
+	}
+
+	return writeChecks(cmd.OutOrStdout(), checks)
+}
+
+// validateRegistries checks the settings of the registries without dialing them.
+func validateRegistries(registries *discovery.RegistryProvider) []discovery.RegistryCheck {
+	regs := registries.GetAll()
+	slices.SortFunc(regs, func(a, b *solarv1alpha1.Registry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	checks := make([]discovery.RegistryCheck, 0, len(regs))
+	for _, reg := range regs {
+		check := discovery.RegistryCheck{Registry: reg.Name, Hostname: reg.Spec.Hostname, Status: discovery.RegistryCheckOK}
+		if _, err := registries.GetTLS(reg.Name).TLSConfig(); err != nil {
+			check.Status, check.Err = discovery.RegistryCheckFailed, fmt.Errorf("invalid TLS settings: %w", err)
+		}
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+// writeChecks prints checks as a table and returns an error if any of them failed.
+func writeChecks(out io.Writer, checks []discovery.RegistryCheck) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if _, err := fmt.Fprintln(w, "REGISTRY\tHOSTNAME\tSTATUS\tMESSAGE"); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, check := range checks {
+		msg := ""
+		if check.Err != nil {
+			failed++
+			msg = check.Err.Error()
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", check.Registry, check.Hostname, check.Status, msg); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d registries failed validation", failed, len(checks))
+	}
+
+	return nil
+}
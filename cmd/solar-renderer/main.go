@@ -4,18 +4,24 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
-	"path"
 
 	"github.com/spf13/cobra"
-	"helm.sh/helm/v4/pkg/registry"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 	"go.opendefense.cloud/solar/pkg/renderer"
 )
 
+const tracerName = "go.opendefense.cloud/solar/cmd/solar-renderer"
+
 var (
 	skipPush      bool
 	url           string
@@ -24,9 +30,16 @@ var (
 	passwordStdIn bool
 	plainHTTP     bool
 	dockerconfig  string
+	digestFile    string
+	resultFile    string
+	valuesFile    string
+	transformers  []string
+
+	rendererConfig renderer.Config
+	signOptions    renderer.SignOptions
 )
 
-func rootFunc(cmd *cobra.Command, args []string) error {
+func rootFunc(cmd *cobra.Command, args []string) (err error) {
 	data, err := os.ReadFile(args[0])
 	if err != nil {
 		return fmt.Errorf("failed to read config-file: %w", err)
@@ -37,6 +50,26 @@ func rootFunc(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse config-file: %w", err)
 	}
 
+	// Continue the trace of the RenderTask reconcile that created the job.
+	// The span ends after errors have been redacted below.
+	ctx, span := otel.Tracer(tracerName).Start(observability.ContextFromEnv(cmd.Context()), "render "+string(config.Type),
+		trace.WithSpanKind(trace.SpanKindInternal))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	if valuesFile != "" {
+		var redactor *renderer.Redactor
+		if redactor, err = mergeValuesFile(&config); err != nil {
+			return err
+		}
+		defer func() { err = redactor.RedactError(err) }()
+	}
+
 	if skipPush {
 		return renderOnly(cmd, config)
 	}
@@ -47,7 +80,14 @@ func rootFunc(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	pushOpts := buildPushOptions()
+	pushOpts, err := buildPushOptions()
+	if err != nil {
+		return err
+	}
+
+	if err := signOptions.Validate(); err != nil {
+		return fmt.Errorf("invalid signing options: %w", err)
+	}
 
 	// Check if the chart already exists in the registry before doing any work.
 	// This allows multiple targets sharing the same release to create their own
@@ -69,6 +109,10 @@ func rootFunc(cmd *cobra.Command, args []string) error {
 
 	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Rendered %s to %s\n", config.Type, result.Dir)
 
+	if err := writeDigest(result); err != nil {
+		return err
+	}
+
 	pushResult, err := renderer.PushChart(result, pushOpts)
 	if err != nil {
 		return fmt.Errorf("failed to push result: %w", err)
@@ -76,15 +120,51 @@ func rootFunc(cmd *cobra.Command, args []string) error {
 
 	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Pushed result to %s\n", pushResult.Ref)
 
-	return nil
+	signatureRef := ""
+	if signOptions.Enabled() {
+		signatureRef, err = renderer.SignChart(ctx, pushResult, pushOpts, signOptions)
+		if err != nil {
+			return fmt.Errorf("failed to sign result: %w", err)
+		}
+
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Pushed signature to %s\n", signatureRef)
+	}
+
+	return writeReport(result, pushResult, signatureRef)
+}
+
+// mergeValuesFile merges the sensitive values of valuesFile into the release
+// values of config. It returns a Redactor for them, so they do not show up in
+// errors, which end up in the job's logs and the RenderTask's events.
+func mergeValuesFile(config *solarv1alpha1.RendererConfig) (*renderer.Redactor, error) {
+	data, err := os.ReadFile(valuesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read values-file: %w", err)
+	}
+
+	redactor, err := renderer.NewRedactor(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse values-file: %w", err)
+	}
+	config.ReleaseConfig.Values, err = renderer.MergeSensitiveValues(config.ReleaseConfig.Values, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge values-file: %w", err)
+	}
+
+	return redactor, nil
 }
 
 func render(config solarv1alpha1.RendererConfig) (*solarv1alpha1.RenderResult, error) {
+	cfg := rendererConfig
+	for _, path := range transformers {
+		cfg.Transformers = append(cfg.Transformers, renderer.ExecTransformer{Path: path})
+	}
+
 	switch config.Type {
 	case solarv1alpha1.RendererConfigTypeRelease:
-		return renderer.RenderRelease(config.ReleaseConfig)
+		return renderer.RenderRelease(config.ReleaseConfig, cfg)
 	case solarv1alpha1.RendererConfigTypeBootstrap:
-		return renderer.RenderBootstrap(config.BootstrapConfig)
+		return renderer.RenderBootstrap(config.BootstrapConfig, cfg)
 	default:
 		return nil, fmt.Errorf("unknown type specified in config: %s", config.Type)
 	}
@@ -99,42 +179,96 @@ func renderOnly(cmd *cobra.Command, config solarv1alpha1.RendererConfig) error {
 
 	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Rendered %s to %s (skip-push)\n", config.Type, result.Dir)
 
+	if err := writeDigest(result); err != nil {
+		return err
+	}
+
+	return writeReport(result, nil, "")
+}
+
+// writeDigest writes the digest of the rendered chart to digestFile, if set.
+func writeDigest(result *solarv1alpha1.RenderResult) error {
+	if digestFile == "" {
+		return nil
+	}
+
+	digest, err := renderer.Digest(result)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(digestFile, []byte(digest), 0o644); err != nil {
+		return fmt.Errorf("failed to write digest: %w", err)
+	}
+
 	return nil
 }
 
-func buildPushOptions() renderer.PushOptions {
-	dockerconfig, _ = os.LookupEnv("DOCKER_CONFIG")
-	if dockerconfig == "" {
-		home, _ := os.UserHomeDir()
-		dockerconfig = path.Join(home, ".docker", "config.json")
+// writeReport writes a RenderReport of the rendered and, unless pushResult is
+// nil, pushed and possibly signed chart as JSON to resultFile, if set.
+func writeReport(result *solarv1alpha1.RenderResult, pushResult *solarv1alpha1.PushResult, signatureRef string) error {
+	if resultFile == "" {
+		return nil
+	}
+
+	digest, err := renderer.Digest(result)
+	if err != nil {
+		return err
+	}
+
+	files, size, err := renderer.Summary(result)
+	if err != nil {
+		return err
 	}
 
-	clientOpts := []registry.ClientOption{}
+	report := solarv1alpha1.RenderReport{ContentDigest: digest, Files: files, Size: size}
+	if pushResult != nil {
+		report.ChartDigest = pushResult.Digest
+		report.ChartSize = pushResult.Size
+		report.SignatureRef = signatureRef
+		report.RenderedAt = new(metav1.Now())
+	}
 
-	if plainHTTP {
-		clientOpts = append(clientOpts, registry.ClientOptPlainHTTP())
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
 	}
 
-	// CLI flags take precedence over env vars
-	if username == "" {
-		username = os.Getenv("REGISTRY_USERNAME")
+	if err := os.WriteFile(resultFile, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write result: %w", err)
 	}
 
-	if password == "" {
-		password = os.Getenv("REGISTRY_PASSWORD")
+	return nil
+}
+
+// buildPushOptions returns the options to push the rendered chart to url.
+// Credentials given as flags take precedence over the environment variables
+// DOCKER_CONFIG, REGISTRY_USERNAME and REGISTRY_PASSWORD, and REGISTRY_TOKEN.
+// Without any, the credentials available in the environment are used.
+func buildPushOptions() (renderer.PushOptions, error) {
+	auth := renderer.AuthSpec{
+		DockerConfig: dockerconfig,
+		Username:     username,
+		Password:     password,
+	}
+	if auth == (renderer.AuthSpec{}) {
+		auth = renderer.AuthSpec{
+			DockerConfig: os.Getenv("DOCKER_CONFIG"),
+			Username:     os.Getenv("REGISTRY_USERNAME"),
+			Password:     os.Getenv("REGISTRY_PASSWORD"),
+			Token:        os.Getenv("REGISTRY_TOKEN"),
+		}
 	}
 
-	// Use basic auth if we have both credentials, otherwise use credentials file
-	if username != "" && password != "" {
-		clientOpts = append(clientOpts, registry.ClientOptBasicAuth(username, password))
-	} else {
-		clientOpts = append(clientOpts, registry.ClientOptCredentialsFile(dockerconfig))
+	if err := auth.Validate(); err != nil {
+		return renderer.PushOptions{}, fmt.Errorf("invalid registry credentials: %w", err)
 	}
 
 	return renderer.PushOptions{
-		Reference:     url,
-		ClientOptions: clientOpts,
-	}
+		Reference: url,
+		Auth:      auth,
+		PlainHTTP: plainHTTP,
+	}, nil
 }
 
 func newRootCmd() *cobra.Command {
@@ -154,6 +288,21 @@ func newRootCmd() *cobra.Command {
 
 	flags.StringVar(&username, "username", "", "username for basic auth")
 	flags.StringVar(&password, "password", "", "password for basic auth")
+	flags.StringVar(&dockerconfig, "docker-config", "", "path to a docker config file holding the registry credentials")
+	flags.StringVar(&valuesFile, "values-file", "", "file holding sensitive release values merged on top of those of the config-file; they are redacted from errors")
+	flags.StringVar(&digestFile, "digest-file", "", "file to write the digest of the rendered chart to, e.g. /dev/termination-log")
+	flags.BoolVar(&rendererConfig.DisableTemplating, "disable-templating", false, "render template expressions in release values literally instead of letting helm evaluate them")
+	flags.IntVar(&rendererConfig.MaxValuesSize, "max-values-size", renderer.DefaultMaxValuesSize, "maximum size in bytes of the values and values template of a release")
+	flags.IntVar(&rendererConfig.MaxOutputSize, "max-output-size", renderer.DefaultMaxOutputSize, "maximum size in bytes of each rendered file")
+	flags.DurationVar(&rendererConfig.Timeout, "template-timeout", renderer.DefaultTimeout, "maximum time rendering a single file may take")
+	flags.StringArrayVar(&transformers, "transformer", nil, "program run in the rendered chart directory before it is packaged, may be repeated to run several in order")
+	flags.StringVar(&resultFile, "result-file", "", "file to write the digests, size and push time of the rendered chart to as JSON, e.g. /dev/termination-log")
+	flags.StringVar(&signOptions.KeyFile, "sign-key", "", "path to a PEM encoded ECDSA private key to sign the pushed chart with")
+	flags.BoolVar(&signOptions.Keyless, "sign-keyless", false, "sign the pushed chart with a key certified by fulcio for the identity of --identity-token")
+	flags.StringVar(&signOptions.IdentityTokenFile, "identity-token", "", "path to the OIDC token exchanged for a signing certificate with fulcio")
+	flags.StringVar(&signOptions.FulcioURL, "fulcio-url", renderer.DefaultFulcioURL, "url of fulcio for keyless signing")
+	flags.StringVar(&signOptions.RekorURL, "rekor-url", "", "url of the rekor transparency log to record signatures in, e.g. https://rekor.sigstore.dev; not recorded if empty")
+	flags.DurationVar(&signOptions.Timeout, "sign-timeout", renderer.DefaultSignTimeout, "maximum time a request to fulcio or rekor may take")
 
 	return rootCmd
 }
@@ -6,13 +6,19 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -194,6 +200,47 @@ var _ = Describe("solar-renderer command", func() {
 			Expect(output.String()).To(ContainSubstring("Rendered release"))
 		})
 
+		It("should write the digest of the rendered chart to --digest-file", func() {
+			writeToTmpConfig(validReleaseConfig())
+			digestPath := filepath.Join(GinkgoT().TempDir(), "digest")
+
+			digest := func() string {
+				cmd := newRootCmd()
+				cmd.SetArgs([]string{tmpConfigFile.Name(), "--skip-push", "--digest-file=" + digestPath})
+				_ = cmdOutput(cmd)
+				Expect(cmd.Execute()).To(Succeed())
+
+				data, err := os.ReadFile(digestPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				return string(data)
+			}
+
+			first := digest()
+			Expect(first).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+			Expect(digest()).To(Equal(first))
+		})
+
+		It("should write a summary of the rendered chart to --result-file without pushing", func() {
+			writeToTmpConfig(validReleaseConfig())
+			resultPath := filepath.Join(GinkgoT().TempDir(), "result")
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{tmpConfigFile.Name(), "--skip-push", "--result-file=" + resultPath})
+			_ = cmdOutput(cmd)
+			Expect(cmd.Execute()).To(Succeed())
+
+			data, err := os.ReadFile(resultPath)
+			Expect(err).NotTo(HaveOccurred())
+			report := solarv1alpha1.RenderReport{}
+			Expect(json.Unmarshal(data, &report)).To(Succeed())
+			Expect(report.ContentDigest).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+			Expect(report.Files).To(BeNumerically(">", 0))
+			Expect(report.Size).To(BeNumerically(">", 0))
+			Expect(report.ChartDigest).To(BeEmpty())
+			Expect(report.RenderedAt).To(BeNil())
+		})
+
 		It("should fail with invalid config file", func() {
 			cmd := newRootCmd()
 			cmd.SetArgs([]string{"/nonexistent/config.yaml", "--skip-push"})
@@ -249,6 +296,46 @@ var _ = Describe("solar-renderer command", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("unknown type specified"))
 		})
+
+		It("should render a release with sensitive values from --values-file", func() {
+			writeToTmpConfig(validReleaseConfig())
+			valuesPath := filepath.Join(GinkgoT().TempDir(), "values.json")
+			Expect(os.WriteFile(valuesPath, []byte(`{"database":{"password":"s3cr3t"}}`), 0o600)).To(Succeed())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{tmpConfigFile.Name(), "--skip-push", "--values-file=" + valuesPath})
+			output := cmdOutput(cmd)
+
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(output.String()).To(ContainSubstring("Rendered release"))
+		})
+
+		It("should redact sensitive values from errors", func() {
+			writeToTmpConfig(solarv1alpha1.RendererConfig{
+				Type: "s3cr3t",
+			})
+			valuesPath := filepath.Join(GinkgoT().TempDir(), "values.json")
+			Expect(os.WriteFile(valuesPath, []byte(`{"database":{"password":"s3cr3t"}}`), 0o600)).To(Succeed())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{tmpConfigFile.Name(), "--skip-push", "--values-file=" + valuesPath})
+			_ = cmdOutput(cmd)
+
+			err := cmd.Execute()
+			Expect(err).To(MatchError(ContainSubstring("unknown type specified")))
+			Expect(err.Error()).NotTo(ContainSubstring("s3cr3t"))
+			Expect(err.Error()).To(ContainSubstring("<redacted sha256:"))
+		})
+
+		It("should fail with a missing --values-file", func() {
+			writeToTmpConfig(validReleaseConfig())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{tmpConfigFile.Name(), "--skip-push", "--values-file=/nonexistent/values.json"})
+			_ = cmdOutput(cmd)
+
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring("failed to read values-file")))
+		})
 	})
 
 	Describe("render and push mode", func() {
@@ -274,6 +361,81 @@ var _ = Describe("solar-renderer command", func() {
 			Expect(output.String()).To(ContainSubstring("Pushed result to"))
 		})
 
+		It("should write a report of the pushed chart to --result-file", func() {
+			writeToTmpConfig(validReleaseConfig())
+			resultPath := filepath.Join(GinkgoT().TempDir(), "result")
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{
+				"--plain-http",
+				"--url=" + registryURL + "/test-chart:1.0.0",
+				"--username=" + username,
+				"--password=" + password,
+				"--result-file=" + resultPath,
+				tmpConfigFile.Name(),
+			})
+			_ = cmdOutput(cmd)
+			Expect(cmd.Execute()).To(Succeed())
+
+			data, err := os.ReadFile(resultPath)
+			Expect(err).NotTo(HaveOccurred())
+			report := solarv1alpha1.RenderReport{}
+			Expect(json.Unmarshal(data, &report)).To(Succeed())
+			Expect(report.ContentDigest).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+			Expect(report.ChartDigest).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+			Expect(report.ChartSize).To(BeNumerically(">", 0))
+			Expect(report.RenderedAt).NotTo(BeNil())
+		})
+
+		It("should sign the pushed chart and report the signature with --sign-key", func() {
+			writeToTmpConfig(validReleaseConfig())
+			resultPath := filepath.Join(GinkgoT().TempDir(), "result")
+
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalECPrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			keyPath := filepath.Join(GinkgoT().TempDir(), "cosign.key")
+			Expect(os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600)).To(Succeed())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{
+				"--plain-http",
+				"--url=" + registryURL + "/test-chart:1.0.0",
+				"--username=" + username,
+				"--password=" + password,
+				"--result-file=" + resultPath,
+				"--sign-key=" + keyPath,
+				tmpConfigFile.Name(),
+			})
+			output := cmdOutput(cmd)
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(output.String()).To(ContainSubstring("Pushed signature to"))
+
+			data, err := os.ReadFile(resultPath)
+			Expect(err).NotTo(HaveOccurred())
+			report := solarv1alpha1.RenderReport{}
+			Expect(json.Unmarshal(data, &report)).To(Succeed())
+			Expect(report.SignatureRef).To(Equal(strings.TrimPrefix(registryURL, "oci://") + "/test-chart:" +
+				strings.Replace(report.ChartDigest, ":", "-", 1) + ".sig"))
+		})
+
+		It("should reject signing with a key and keyless at once", func() {
+			writeToTmpConfig(validReleaseConfig())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{
+				"--url=" + registryURL + "/test-chart:1.0.0",
+				"--sign-key=/etc/renderer/signing/cosign.key",
+				"--sign-keyless",
+				"--identity-token=/var/run/sigstore/token",
+				tmpConfigFile.Name(),
+			})
+			_ = cmdOutput(cmd)
+
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring("invalid signing options")))
+		})
+
 		It("should render and push a release to OCI registry with dockerconfig", func() {
 			writeTmpDockerConfig()
 			oldDockerConfig := os.Getenv("DOCKER_CONFIG")
@@ -300,6 +462,41 @@ var _ = Describe("solar-renderer command", func() {
 			Expect(output.String()).To(ContainSubstring("Pushed result to"))
 		})
 
+		It("should render and push a release to OCI registry with --docker-config", func() {
+			writeTmpDockerConfig()
+			writeToTmpConfig(validReleaseConfig())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{
+				"--plain-http",
+				"--url=" + registryURL + "/test-chart:1.0.0",
+				"--docker-config=" + tmpDockerConfig.Name(),
+				tmpConfigFile.Name(),
+			})
+			output := cmdOutput(cmd)
+
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(output.String()).To(ContainSubstring("Pushed result to"))
+		})
+
+		It("should reject more than one kind of registry credentials", func() {
+			writeTmpDockerConfig()
+			writeToTmpConfig(validReleaseConfig())
+
+			cmd := newRootCmd()
+			cmd.SetArgs([]string{
+				"--url=" + registryURL + "/test-chart:1.0.0",
+				"--docker-config=" + tmpDockerConfig.Name(),
+				"--username=" + username,
+				"--password=" + password,
+				tmpConfigFile.Name(),
+			})
+			_ = cmdOutput(cmd)
+
+			err := cmd.Execute()
+			Expect(err).To(MatchError(ContainSubstring("invalid registry credentials")))
+		})
+
 		It("should fail push with invalid registry credentials", func() {
 			writeToTmpConfig(validReleaseConfig())
 
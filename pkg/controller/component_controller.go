@@ -0,0 +1,187 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
+)
+
+const (
+	// ConditionTypeReady is True while a Component has at least one
+	// ComponentVersion.
+	ConditionTypeReady = "Ready"
+)
+
+// ComponentReconciler reconciles a Component object.
+// It aggregates the ComponentVersions referencing a Component into its status,
+// so clients can show a Component with its versions without listing them.
+type ComponentReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// WatchNamespace restricts reconciliation to this namespace.
+	// Should be empty in production (watches all namespaces).
+	// Intended for use in integration tests only.
+	WatchNamespace string
+}
+
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=components,verbs=get;list;watch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=components/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions,verbs=get;list;watch
+
+func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.V(1).Info("Component is being reconciled", "req", req)
+
+	if r.WatchNamespace != "" && req.Namespace != r.WatchNamespace {
+		return ctrl.Result{}, nil
+	}
+
+	comp := &solarv1alpha1.Component{}
+	if err := r.Get(ctx, req.NamespacedName, comp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to get Component")
+	}
+
+	if !comp.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	cvList := &solarv1alpha1.ComponentVersionList{}
+	if err := r.List(ctx, cvList,
+		client.InNamespace(comp.Namespace),
+		client.MatchingFields{indexCVByComponentName: comp.Name},
+	); err != nil {
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to list ComponentVersions")
+	}
+
+	cvs := slices.DeleteFunc(cvList.Items, func(cv solarv1alpha1.ComponentVersion) bool {
+		return !cv.DeletionTimestamp.IsZero()
+	})
+	versions, latest := summarizeComponentVersions(cvs)
+
+	cond := metav1.Condition{
+		Type:               ConditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: comp.Generation,
+		Reason:             "NoVersions",
+		Message:            "No ComponentVersions reference this Component",
+	}
+	if len(versions) > 0 {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "VersionsKnown"
+		cond.Message = fmt.Sprintf("%d versions known", len(versions))
+		if latest != "" {
+			cond.Message += ", latest is " + latest
+		}
+	}
+
+	condChanged := apimeta.SetStatusCondition(&comp.Status.Conditions, cond)
+	versionsChanged := !apiequality.Semantic.DeepEqual(comp.Status.Versions, versions) || comp.Status.LatestVersion != latest
+	if condChanged || versionsChanged {
+		comp.Status.Versions = versions
+		comp.Status.LatestVersion = latest
+		if err := r.Status().Update(ctx, comp); err != nil {
+			return ctrl.Result{}, errLogAndWrap(log, err, "failed to update status")
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// summarizeComponentVersions returns the summaries of cvs ordered as
+// documented on ComponentStatus.Versions and the highest tag that is a
+// semantic version, or an empty string if there is none.
+func summarizeComponentVersions(cvs []solarv1alpha1.ComponentVersion) ([]solarv1alpha1.ComponentVersionSummary, string) {
+	if len(cvs) == 0 {
+		return nil, ""
+	}
+
+	type version struct {
+		summary solarv1alpha1.ComponentVersionSummary
+		semver  *semver.Version
+	}
+
+	versions := make([]version, 0, len(cvs))
+	for _, cv := range cvs {
+		v := version{summary: solarv1alpha1.ComponentVersionSummary{Name: cv.Name, Tag: cv.Spec.Tag}}
+		if sv, err := semver.NewVersion(cv.Spec.Tag); err == nil {
+			v.semver = sv
+		}
+		versions = append(versions, v)
+	}
+
+	slices.SortFunc(versions, func(a, b version) int {
+		switch {
+		case a.semver != nil && b.semver != nil:
+			if c := b.semver.Compare(a.semver); c != 0 {
+				return c
+			}
+		case a.semver != nil:
+			return -1
+		case b.semver != nil:
+			return 1
+		default:
+			if c := strings.Compare(a.summary.Tag, b.summary.Tag); c != 0 {
+				return c
+			}
+		}
+
+		return strings.Compare(a.summary.Name, b.summary.Name)
+	})
+
+	var latest string
+	if versions[0].semver != nil {
+		latest = versions[0].summary.Tag
+	}
+
+	summaries := make([]solarv1alpha1.ComponentVersionSummary, len(versions))
+	for i, v := range versions {
+		summaries[i] = v.summary
+	}
+
+	return summaries, latest
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ComponentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&solarv1alpha1.Component{}).
+		Watches(
+			&solarv1alpha1.ComponentVersion{},
+			handler.EnqueueRequestsFromMapFunc(r.mapComponentVersionToComponent),
+		).
+		Complete(observability.WrapReconciler("Component", r))
+}
+
+// mapComponentVersionToComponent enqueues the Component a ComponentVersion references.
+func (r *ComponentReconciler) mapComponentVersionToComponent(_ context.Context, obj client.Object) []reconcile.Request {
+	cv, ok := obj.(*solarv1alpha1.ComponentVersion)
+	if !ok || cv.Spec.ComponentRef.Name == "" {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: cv.Spec.ComponentRef.Name, Namespace: cv.Namespace}}}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func newComponentTestReconciler(objs ...client.Object) (*ComponentReconciler, client.Client) {
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	c := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(objs...).
+		WithStatusSubresource(&solarv1alpha1.Component{}).
+		WithIndex(&solarv1alpha1.ComponentVersion{}, indexCVByComponentName, func(obj client.Object) []string {
+			return []string{obj.(*solarv1alpha1.ComponentVersion).Spec.ComponentRef.Name}
+		}).
+		Build()
+
+	return &ComponentReconciler{Client: c, Scheme: sch}, c
+}
+
+func newTestComponentVersion(name, component, tag string) *solarv1alpha1.ComponentVersion {
+	return &solarv1alpha1.ComponentVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: solarv1alpha1.ComponentVersionSpec{
+			ComponentRef: corev1.LocalObjectReference{Name: component},
+			Tag:          tag,
+		},
+	}
+}
+
+func reconcileComponent(t *testing.T, r *ComponentReconciler, c client.Client, comp *solarv1alpha1.Component) *solarv1alpha1.Component {
+	t.Helper()
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(comp)}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got := &solarv1alpha1.Component{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(comp), got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	return got
+}
+
+func TestComponentReconcile_AggregatesVersions(t *testing.T) {
+	t.Parallel()
+
+	comp := &solarv1alpha1.Component{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	r, c := newComponentTestReconciler(comp,
+		newTestComponentVersion("app-v1-2-0", "app", "v1.2.0"),
+		newTestComponentVersion("app-v1-10-0", "app", "v1.10.0"),
+		newTestComponentVersion("app-latest", "app", "latest"),
+		newTestComponentVersion("other-v2-0-0", "other", "v2.0.0"),
+	)
+
+	got := reconcileComponent(t, r, c, comp)
+
+	want := []solarv1alpha1.ComponentVersionSummary{
+		{Name: "app-v1-10-0", Tag: "v1.10.0"},
+		{Name: "app-v1-2-0", Tag: "v1.2.0"},
+		{Name: "app-latest", Tag: "latest"},
+	}
+	if len(got.Status.Versions) != len(want) {
+		t.Fatalf("Versions = %+v, want %+v", got.Status.Versions, want)
+	}
+	for i := range want {
+		if got.Status.Versions[i] != want[i] {
+			t.Errorf("Versions[%d] = %+v, want %+v", i, got.Status.Versions[i], want[i])
+		}
+	}
+	if got.Status.LatestVersion != "v1.10.0" {
+		t.Errorf("LatestVersion = %q, want v1.10.0", got.Status.LatestVersion)
+	}
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionTypeReady)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Message != "3 versions known, latest is v1.10.0" {
+		t.Errorf("Ready condition = %+v, want True listing 3 versions", cond)
+	}
+}
+
+func TestComponentReconcile_NoVersions(t *testing.T) {
+	t.Parallel()
+
+	comp := &solarv1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Status: solarv1alpha1.ComponentStatus{
+			LatestVersion: "v1.0.0",
+			Versions:      []solarv1alpha1.ComponentVersionSummary{{Name: "app-v1-0-0", Tag: "v1.0.0"}},
+		},
+	}
+	r, c := newComponentTestReconciler(comp)
+
+	got := reconcileComponent(t, r, c, comp)
+
+	if len(got.Status.Versions) != 0 || got.Status.LatestVersion != "" {
+		t.Errorf("status = %+v, want no versions", got.Status)
+	}
+	if cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionTypeReady); cond == nil || cond.Reason != "NoVersions" {
+		t.Errorf("Ready condition = %+v, want reason NoVersions", cond)
+	}
+}
+
+func TestSummarizeComponentVersions_NoSemver(t *testing.T) {
+	t.Parallel()
+
+	versions, latest := summarizeComponentVersions([]solarv1alpha1.ComponentVersion{
+		*newTestComponentVersion("app-main", "app", "main"),
+		*newTestComponentVersion("app-dev", "app", "dev"),
+	})
+
+	if latest != "" {
+		t.Errorf("latest = %q, want empty", latest)
+	}
+	if len(versions) != 2 || versions[0].Tag != "dev" || versions[1].Tag != "main" {
+		t.Errorf("versions = %+v, want dev and main in lexical order", versions)
+	}
+}
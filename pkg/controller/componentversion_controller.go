@@ -6,6 +6,7 @@ package controller
 import (
 	"context"
 	"slices"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,22 +15,28 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 // ComponentVersionReconciler manages the deletion-protection finalizer on the Component
 // referenced by each ComponentVersion, preventing Component deletion while ComponentVersions exist.
+// It also garbage collects ComponentVersions that discovery marked unavailable.
 type ComponentVersionReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// UnavailableRetention is how long an unavailable ComponentVersion is kept
+	// before it is deleted. Zero deletes it right away; the controller manager
+	// defaults to 24 hours.
+	UnavailableRetention time.Duration
 	// WatchNamespace restricts reconciliation to this namespace.
 	// Should be empty in production (watches all namespaces).
 	// Intended for use in integration tests only.
 	WatchNamespace string
 }
 
-//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions,verbs=get;list;watch;update;patch;delete
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions/finalizers,verbs=update
-//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=components,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=components,verbs=get;list;watch;update;patch;delete
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=components/finalizers,verbs=update
 
 func (r *ComponentVersionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -58,8 +65,17 @@ func (r *ComponentVersionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 				if !apierrors.IsNotFound(err) {
 					return ctrl.Result{}, errLogAndWrap(log, err, "failed to get Component for finalizer cleanup")
 				}
-			} else if err := r.removeComponentRefFinalizer(ctx, cv, comp); err != nil {
-				return ctrl.Result{}, err
+			} else {
+				if err := r.removeComponentRefFinalizer(ctx, cv, comp); err != nil {
+					return ctrl.Result{}, err
+				}
+				// The Component of a version removed from the registry goes away
+				// together with its last version.
+				if cv.Status.Phase == solarv1alpha1.ComponentVersionPhaseUnavailable {
+					if err := r.deleteUnreferencedComponent(ctx, cv, comp); err != nil {
+						return ctrl.Result{}, err
+					}
+				}
 			}
 		}
 
@@ -78,6 +94,10 @@ func (r *ComponentVersionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, nil
 	}
 
+	if cv.Status.Phase == solarv1alpha1.ComponentVersionPhaseUnavailable {
+		return r.collectUnavailable(ctx, cv)
+	}
+
 	// Ensure self-finalizer exists.
 	if !slices.Contains(cv.Finalizers, componentVersionFinalizer) {
 		latest := &solarv1alpha1.ComponentVersion{}
@@ -119,12 +139,56 @@ func (r *ComponentVersionReconciler) removeComponentRefFinalizer(ctx context.Con
 		return nil
 	}
 
+	referenced, err := r.hasOtherActiveVersions(ctx, deletingCV, comp)
+	if err != nil || referenced {
+		return err
+	}
+
+	freshComp := &solarv1alpha1.Component{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(comp), freshComp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to get latest Component for finalizer removal")
+	}
+	original := freshComp.DeepCopy()
+	freshComp.Finalizers = slices.DeleteFunc(freshComp.Finalizers, func(s string) bool { return s == componentRefFinalizer })
+	if err := r.Patch(ctx, freshComp, client.MergeFrom(original)); err != nil {
+		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to remove protection finalizer from Component")
+	}
+
+	ctrl.LoggerFrom(ctx).V(1).Info("Removed protection finalizer from Component", "component", comp.Name)
+
+	return nil
+}
+
+// deleteUnreferencedComponent deletes comp when no other active ComponentVersion
+// references it (excluding the CV currently being deleted).
+func (r *ComponentVersionReconciler) deleteUnreferencedComponent(ctx context.Context, deletingCV *solarv1alpha1.ComponentVersion, comp *solarv1alpha1.Component) error {
+	referenced, err := r.hasOtherActiveVersions(ctx, deletingCV, comp)
+	if err != nil || referenced {
+		return err
+	}
+
+	if err := client.IgnoreNotFound(r.Delete(ctx, comp)); err != nil {
+		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to delete unreferenced Component")
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Deleted Component without remaining versions", "component", comp.Name)
+
+	return nil
+}
+
+// hasOtherActiveVersions reports whether a ComponentVersion other than deletingCV that is
+// not being deleted itself references comp.
+func (r *ComponentVersionReconciler) hasOtherActiveVersions(ctx context.Context, deletingCV *solarv1alpha1.ComponentVersion, comp *solarv1alpha1.Component) (bool, error) {
 	cvList := &solarv1alpha1.ComponentVersionList{}
 	if err := r.List(ctx, cvList,
 		client.InNamespace(comp.Namespace),
 		client.MatchingFields{indexCVByComponentName: comp.Name},
 	); err != nil {
-		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to list ComponentVersions for Component finalizer check")
+		return false, errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to list ComponentVersions referencing Component")
 	}
 
 	for _, cv := range cvList.Items {
@@ -135,31 +199,39 @@ func (r *ComponentVersionReconciler) removeComponentRefFinalizer(ctx context.Con
 			continue
 		}
 
-		return nil // another active ComponentVersion still references this Component
+		return true, nil
 	}
 
-	freshComp := &solarv1alpha1.Component{}
-	if err := r.Get(ctx, client.ObjectKeyFromObject(comp), freshComp); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
-		}
+	return false, nil
+}
 
-		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to get latest Component for finalizer removal")
+// collectUnavailable deletes cv once it has been unavailable for longer than
+// UnavailableRetention and requeues it until then.
+func (r *ComponentVersionReconciler) collectUnavailable(ctx context.Context, cv *solarv1alpha1.ComponentVersion) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	since := cv.CreationTimestamp
+	if cv.Status.UnavailableSince != nil {
+		since = *cv.Status.UnavailableSince
 	}
-	original := freshComp.DeepCopy()
-	freshComp.Finalizers = slices.DeleteFunc(freshComp.Finalizers, func(s string) bool { return s == componentRefFinalizer })
-	if err := r.Patch(ctx, freshComp, client.MergeFrom(original)); err != nil {
-		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to remove protection finalizer from Component")
+	if wait := r.UnavailableRetention - time.Since(since.Time); wait > 0 {
+		log.V(1).Info("Keeping unavailable ComponentVersion until its retention expires", "requeueAfter", wait)
+
+		return ctrl.Result{RequeueAfter: wait}, nil
 	}
 
-	ctrl.LoggerFrom(ctx).V(1).Info("Removed protection finalizer from Component", "component", comp.Name)
+	if err := client.IgnoreNotFound(r.Delete(ctx, cv)); err != nil {
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to delete unavailable ComponentVersion")
+	}
 
-	return nil
+	log.Info("Deleted unavailable ComponentVersion", "unavailableSince", since)
+
+	return ctrl.Result{}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ComponentVersionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&solarv1alpha1.ComponentVersion{}).
-		Complete(r)
+		Complete(observability.WrapReconciler("ComponentVersion", r))
 }
@@ -0,0 +1,163 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// These tests cover the garbage collection of ComponentVersions that
+// discovery marked unavailable. They use the fake client to stay independent
+// of envtest (which needs the kubebuilder etcd binary).
+
+func newCVGCTestComponent(name string) *solarv1alpha1.Component {
+	return &solarv1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			Finalizers: []string{componentRefFinalizer},
+		},
+	}
+}
+
+func newCVGCTestVersion(name, component string, unavailableSince *metav1.Time) *solarv1alpha1.ComponentVersion {
+	cv := &solarv1alpha1.ComponentVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			Finalizers: []string{componentVersionFinalizer},
+		},
+		Spec: solarv1alpha1.ComponentVersionSpec{
+			ComponentRef: corev1.LocalObjectReference{Name: component},
+		},
+	}
+	if unavailableSince != nil {
+		cv.Status.Phase = solarv1alpha1.ComponentVersionPhaseUnavailable
+		cv.Status.UnavailableSince = unavailableSince
+	}
+
+	return cv
+}
+
+func newCVGCTestReconciler(retention time.Duration, objs ...client.Object) (*ComponentVersionReconciler, client.Client) {
+	sch := runtime.NewScheme()
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	c := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(objs...).
+		WithStatusSubresource(&solarv1alpha1.ComponentVersion{}).
+		WithIndex(&solarv1alpha1.ComponentVersion{}, indexCVByComponentName, func(obj client.Object) []string {
+			return []string{obj.(*solarv1alpha1.ComponentVersion).Spec.ComponentRef.Name}
+		}).
+		Build()
+
+	return &ComponentVersionReconciler{Client: c, Scheme: sch, UnavailableRetention: retention}, c
+}
+
+func reconcileCVGCTest(t *testing.T, r *ComponentVersionReconciler, name string) reconcile.Result {
+	t.Helper()
+
+	res, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: name},
+	})
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	return res
+}
+
+func TestComponentVersionGC_KeepsUnavailableVersionDuringRetention(t *testing.T) {
+	since := metav1.NewTime(time.Now().Add(-time.Hour))
+	r, c := newCVGCTestReconciler(24*time.Hour,
+		newCVGCTestComponent("comp"),
+		newCVGCTestVersion("comp-v1", "comp", &since),
+	)
+
+	res := reconcileCVGCTest(t, r, "comp-v1")
+	if res.RequeueAfter <= 22*time.Hour || res.RequeueAfter > 23*time.Hour {
+		t.Fatalf("expected requeue when the retention expires in about 23h, got %s", res.RequeueAfter)
+	}
+
+	if err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "comp-v1"}, &solarv1alpha1.ComponentVersion{}); err != nil {
+		t.Fatalf("expected ComponentVersion to be kept: %v", err)
+	}
+}
+
+func TestComponentVersionGC_DeletesExpiredVersionAndItsComponent(t *testing.T) {
+	since := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	r, c := newCVGCTestReconciler(time.Hour,
+		newCVGCTestComponent("comp"),
+		newCVGCTestVersion("comp-v1", "comp", &since),
+	)
+
+	// The first reconcile deletes the ComponentVersion, the second one
+	// handles its deletion.
+	reconcileCVGCTest(t, r, "comp-v1")
+	reconcileCVGCTest(t, r, "comp-v1")
+
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "comp-v1"}, &solarv1alpha1.ComponentVersion{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ComponentVersion to be deleted, got %v", err)
+	}
+	err = c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "comp"}, &solarv1alpha1.Component{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected Component without versions to be deleted, got %v", err)
+	}
+}
+
+func TestComponentVersionGC_KeepsComponentReferencedByOtherVersion(t *testing.T) {
+	r, c := newCVGCTestReconciler(0,
+		newCVGCTestComponent("comp"),
+		newCVGCTestVersion("comp-v1", "comp", &metav1.Time{Time: time.Now()}),
+		newCVGCTestVersion("comp-v2", "comp", nil),
+	)
+
+	reconcileCVGCTest(t, r, "comp-v1")
+	reconcileCVGCTest(t, r, "comp-v1")
+
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "comp-v1"}, &solarv1alpha1.ComponentVersion{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected ComponentVersion to be deleted, got %v", err)
+	}
+	comp := &solarv1alpha1.Component{}
+	if err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "comp"}, comp); err != nil {
+		t.Fatalf("expected Component referenced by comp-v2 to be kept: %v", err)
+	}
+	if len(comp.Finalizers) != 1 || comp.Finalizers[0] != componentRefFinalizer {
+		t.Fatalf("expected Component to keep its protection finalizer, got %v", comp.Finalizers)
+	}
+}
+
+func TestComponentVersionGC_DeletesComponentWhenSiblingIsTerminating(t *testing.T) {
+	sibling := newCVGCTestVersion("comp-v2", "comp", nil)
+	sibling.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	r, c := newCVGCTestReconciler(0,
+		newCVGCTestComponent("comp"),
+		newCVGCTestVersion("comp-v1", "comp", &metav1.Time{Time: time.Now()}),
+		sibling,
+	)
+
+	reconcileCVGCTest(t, r, "comp-v1")
+	reconcileCVGCTest(t, r, "comp-v1")
+
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "comp"}, &solarv1alpha1.Component{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected Component whose only other version is terminating to be deleted, got %v", err)
+	}
+}
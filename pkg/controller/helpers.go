@@ -7,13 +7,18 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -39,6 +44,10 @@ const (
 	// Field index key for looking up RenderBindings by the RenderArtifact they reference.
 	indexRenderBindingArtifactName = "spec.renderArtifactRef.name"
 
+	// Field index key for looking up Releases by the "<Kind>/<name>" of the
+	// ConfigMaps and Secrets their values come from.
+	indexReleaseValuesFrom = "spec.valuesFrom"
+
 	// Field index keys for deletion-protection reference lookups.
 	// Release: composite "<cvNamespace>/<cvName>" resolving cross-namespace refs.
 	indexReleaseByCVRef = "dp.spec.componentVersionRef"
@@ -130,6 +139,12 @@ func releaseRenderTaskName(releaseNamespace, releaseName, targetName string, gen
 	return truncateName(fmt.Sprintf("render-rel-%s-%s", releaseName, hashStr), maxK8sObjectNameLen)
 }
 
+// sensitiveValuesSecretName returns the name of the Secret holding the
+// sensitive values of a release RenderTask.
+func sensitiveValuesSecretName(rtName string) string {
+	return truncateName(rtName+"-values", maxK8sObjectNameLen)
+}
+
 // targetRenderTaskName returns a deterministic name for a per-target bootstrap RenderTask.
 // The bootstrapVersion is incremented each time the bootstrap needs re-rendering.
 func targetRenderTaskName(targetName string, bootstrapVersion int64) string {
@@ -167,6 +182,333 @@ func renderChartURL(baseURL, repository, tag string) string {
 	return strings.TrimSuffix(base, "/") + "/" + repository + ":" + tag
 }
 
+// renderTaskConfigHash returns the SHA-256 digest of the renderer config and
+// the push destination of the RenderTask. RenderTasks with the same hash push
+// the same chart to the same location.
+func renderTaskConfigHash(rt *solarv1alpha1.RenderTask) (string, error) {
+	data, err := json.Marshal(struct {
+		Config        solarv1alpha1.RendererConfig `json:"config"`
+		BaseURL       string                       `json:"baseURL"`
+		Repository    string                       `json:"repository"`
+		Tag           string                       `json:"tag"`
+		PushSecretRef *corev1.LocalObjectReference `json:"pushSecretRef,omitempty"`
+		PlainHTTP     bool                         `json:"plainHTTP"`
+	}{
+		Config:        rt.Spec.RendererConfig,
+		BaseURL:       rt.Spec.BaseURL,
+		Repository:    rt.Spec.Repository,
+		Tag:           rt.Spec.Tag,
+		PushSecretRef: rt.Spec.PushSecretRef,
+		PlainHTTP:     rt.Spec.PlainHTTP,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// defaultReleaseHistoryLimit is the number of revisions kept per Target in a
+// Release's Status.History when Spec.HistoryLimit is not set.
+const defaultReleaseHistoryLimit = 10
+
+// releaseValuesHash returns the SHA-256 digest of the values a Release was
+// rendered with, in the form recorded in Status.History.
+func releaseValuesHash(values runtime.RawExtension) string {
+	hash := sha256.Sum256(values.Raw)
+
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+// mergeReleaseValues returns the Release's values with the overrides of a
+// ReleaseBinding merged on top. Maps are merged recursively, all other values
+// replace those of the Release and null removes a key. Without overrides the
+// Release's values are returned unchanged.
+func mergeReleaseValues(values, overrides runtime.RawExtension) (runtime.RawExtension, error) {
+	if len(overrides.Raw) == 0 {
+		return values, nil
+	}
+
+	var base, patch map[string]any
+	if len(values.Raw) > 0 {
+		if err := json.Unmarshal(values.Raw, &base); err != nil {
+			return runtime.RawExtension{}, fmt.Errorf("release values must be an object: %w", err)
+		}
+	}
+	if err := json.Unmarshal(overrides.Raw, &patch); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("binding values must be an object: %w", err)
+	}
+
+	data, err := json.Marshal(mergeValueMaps(base, patch))
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+
+	return runtime.RawExtension{Raw: data}, nil
+}
+
+// mergeValuesFrom merges a Release's values on top of the values resolved from
+// its ValuesFrom.
+func mergeValuesFrom(valuesFrom, values runtime.RawExtension) (runtime.RawExtension, error) {
+	if len(valuesFrom.Raw) == 0 {
+		return values, nil
+	}
+
+	var base, patch map[string]any
+	if err := json.Unmarshal(valuesFrom.Raw, &base); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("values from references must be an object: %w", err)
+	}
+	if len(values.Raw) > 0 {
+		if err := json.Unmarshal(values.Raw, &patch); err != nil {
+			return runtime.RawExtension{}, fmt.Errorf("release values must be an object: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(mergeValueMaps(base, patch))
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+
+	return runtime.RawExtension{Raw: data}, nil
+}
+
+// mergeValueMaps merges src into dst and returns dst.
+func mergeValueMaps(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+
+			continue
+		}
+
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			dst[k] = mergeValueMaps(dstMap, srcMap)
+
+			continue
+		}
+
+		dst[k] = v
+	}
+
+	return dst
+}
+
+// splitSensitiveValues moves the values at the given dot-separated paths and
+// key paths out of values. It returns the remaining values and the moved ones,
+// nested as in values. Paths that do not exist or hold null are ignored, and
+// the sensitive values are empty if none is left.
+func splitSensitiveValues(values runtime.RawExtension, paths []string, keyPaths [][]string) (runtime.RawExtension, runtime.RawExtension, error) {
+	keyPaths = slices.Clone(keyPaths)
+	for _, path := range paths {
+		keyPaths = append(keyPaths, strings.Split(path, "."))
+	}
+	if len(keyPaths) == 0 || len(values.Raw) == 0 {
+		return values, runtime.RawExtension{}, nil
+	}
+
+	var public map[string]any
+	if err := json.Unmarshal(values.Raw, &public); err != nil {
+		return runtime.RawExtension{}, runtime.RawExtension{}, fmt.Errorf("release values must be an object: %w", err)
+	}
+
+	var sensitive map[string]any
+	for _, keys := range keyPaths {
+		parent := public
+		for _, k := range keys[:len(keys)-1] {
+			parent, _ = parent[k].(map[string]any)
+		}
+
+		last := keys[len(keys)-1]
+		v := parent[last]
+		if v == nil {
+			continue
+		}
+		delete(parent, last)
+
+		for i := len(keys) - 1; i >= 0; i-- {
+			v = map[string]any{keys[i]: v}
+		}
+		sensitive = mergeValueMaps(sensitive, v.(map[string]any))
+	}
+
+	if sensitive == nil {
+		return values, runtime.RawExtension{}, nil
+	}
+
+	publicData, err := json.Marshal(public)
+	if err != nil {
+		return runtime.RawExtension{}, runtime.RawExtension{}, err
+	}
+	sensitiveData, err := json.Marshal(sensitive)
+	if err != nil {
+		return runtime.RawExtension{}, runtime.RawExtension{}, err
+	}
+
+	return runtime.RawExtension{Raw: publicData}, runtime.RawExtension{Raw: sensitiveData}, nil
+}
+
+// valueKeyPaths returns the key paths of the leaves of values, appended to
+// prefix. Empty objects and lists count as leaves.
+func valueKeyPaths(values map[string]any, prefix []string) [][]string {
+	var paths [][]string
+	for k, v := range values {
+		path := append(slices.Clone(prefix), k)
+		if m, ok := v.(map[string]any); ok && len(m) > 0 {
+			paths = append(paths, valueKeyPaths(m, path)...)
+
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+// valuesTag returns a short hash of a ReleaseBinding's value overrides for use
+// in chart tags, or "" if there are none.
+func valuesTag(overrides runtime.RawExtension) string {
+	if len(overrides.Raw) == 0 {
+		return ""
+	}
+
+	hash := sha256.Sum256(overrides.Raw)
+
+	return hex.EncodeToString(hash[:])[:8]
+}
+
+// releaseHistoryLimit returns the number of revisions to keep per Target.
+func releaseHistoryLimit(rel *solarv1alpha1.Release) int {
+	if rel.Spec.HistoryLimit != nil && *rel.Spec.HistoryLimit > 0 {
+		return int(*rel.Spec.HistoryLimit)
+	}
+
+	return defaultReleaseHistoryLimit
+}
+
+// findReleaseRevision returns the history entry of the given revision rendered
+// for the named Target, or nil if there is none.
+func findReleaseRevision(history []solarv1alpha1.ReleaseRevision, revision int64, targetNamespace, targetName string) *solarv1alpha1.ReleaseRevision {
+	for i := range history {
+		h := &history[i]
+		if h.Revision == revision && h.TargetRef.Namespace == targetNamespace && h.TargetRef.Name == targetName {
+			return h
+		}
+	}
+
+	return nil
+}
+
+// latestReleaseRevision returns the newest history entry rendered for the
+// named Target, or nil if there is none.
+func latestReleaseRevision(history []solarv1alpha1.ReleaseRevision, targetNamespace, targetName string) *solarv1alpha1.ReleaseRevision {
+	for i := range history {
+		h := &history[i]
+		if h.TargetRef.Namespace == targetNamespace && h.TargetRef.Name == targetName {
+			return h
+		}
+	}
+
+	return nil
+}
+
+// recordReleaseRevision returns the history with rev prepended, replacing any
+// entry for the same revision and Target. Only the newest limit entries are
+// kept per Target; entries of other Targets are left untouched.
+func recordReleaseRevision(history []solarv1alpha1.ReleaseRevision, rev solarv1alpha1.ReleaseRevision, limit int) []solarv1alpha1.ReleaseRevision {
+	result := make([]solarv1alpha1.ReleaseRevision, 0, len(history)+1)
+	result = append(result, rev)
+
+	kept := 1
+	for _, h := range history {
+		sameTarget := h.TargetRef.Namespace == rev.TargetRef.Namespace && h.TargetRef.Name == rev.TargetRef.Name
+		if sameTarget {
+			if h.Revision == rev.Revision || kept >= limit {
+				continue
+			}
+			kept++
+		}
+		result = append(result, h)
+	}
+
+	return result
+}
+
+// maxFailureLogBytes bounds the renderer log excerpt recorded on a failed
+// RenderTask, keeping the resulting event note below the 1 KiB API limit.
+const maxFailureLogBytes = 768
+
+// failedPod returns the most recently created failed Pod, or nil.
+func failedPod(pods []corev1.Pod) *corev1.Pod {
+	var latest *corev1.Pod
+	for i := range pods {
+		p := &pods[i]
+		if p.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&p.CreationTimestamp) {
+			latest = p
+		}
+	}
+
+	return latest
+}
+
+// reportedRenderReport returns the RenderReport the renderer container of a
+// succeeded Pod wrote to its termination message, or nil if there is none.
+// Renderers writing only the content digest are understood as well.
+func reportedRenderReport(pods []corev1.Pod) *solarv1alpha1.RenderReport {
+	for i := range pods {
+		if pods[i].Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+
+		for _, cs := range pods[i].Status.ContainerStatuses {
+			if cs.Name != rendererContainerName || cs.State.Terminated == nil {
+				continue
+			}
+
+			message := strings.TrimSpace(cs.State.Terminated.Message)
+			if strings.HasPrefix(message, "sha256:") {
+				return &solarv1alpha1.RenderReport{ContentDigest: message}
+			}
+
+			report := &solarv1alpha1.RenderReport{}
+			if err := json.Unmarshal([]byte(message), report); err == nil && report.ContentDigest != "" {
+				return report
+			}
+		}
+	}
+
+	return nil
+}
+
+// tailLog returns the end of the given logs, at most maxBytes long. Cut logs
+// start at a line boundary and are prefixed with "...".
+func tailLog(logs string, maxBytes int) string {
+	logs = strings.TrimSpace(logs)
+	if len(logs) <= maxBytes {
+		return logs
+	}
+
+	logs = logs[len(logs)-maxBytes:]
+	for len(logs) > 0 && !utf8.RuneStart(logs[0]) {
+		logs = logs[1:]
+	}
+	if i := strings.IndexByte(logs, '\n'); i >= 0 && i < len(logs)-1 {
+		logs = logs[i+1:]
+	}
+
+	return "...\n" + logs
+}
+
 // registryHost extracts the registry host from a repository string and
 // normalises it to lower-case (hostnames are case-insensitive per RFC 4343).
 // For example, "Registry.Example.COM:5000/foo/bar" returns "registry.example.com:5000".
@@ -246,6 +588,10 @@ func IndexFields(ctx context.Context, mgr ctrl.Manager) error {
 		return err
 	}
 
+	if err := indexReleaseValuesFromFields(ctx, mgr); err != nil {
+		return err
+	}
+
 	return indexDeletionProtectionFields(ctx, mgr)
 }
 
@@ -354,6 +700,28 @@ func indexReleaseBindingFields(ctx context.Context, mgr ctrl.Manager) error {
 	})
 }
 
+func indexReleaseValuesFromFields(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &solarv1alpha1.Release{}, indexReleaseValuesFrom, func(obj client.Object) []string {
+		return valuesFromKeys(obj.(*solarv1alpha1.Release))
+	})
+}
+
+// valuesFromKeys returns the "<Kind>/<name>" of every ConfigMap and Secret the
+// Release's values come from.
+func valuesFromKeys(rel *solarv1alpha1.Release) []string {
+	var keys []string
+	for _, ref := range rel.Spec.ValuesFrom {
+		switch {
+		case ref.ConfigMapKeyRef != nil:
+			keys = append(keys, "ConfigMap/"+ref.ConfigMapKeyRef.Name)
+		case ref.SecretKeyRef != nil:
+			keys = append(keys, "Secret/"+ref.SecretKeyRef.Name)
+		}
+	}
+
+	return keys
+}
+
 func indexRegistryBindingFields(ctx context.Context, mgr ctrl.Manager) error {
 	return mgr.GetFieldIndexer().IndexField(ctx, &solarv1alpha1.RegistryBinding{}, indexRegistryBindingTargetName, func(obj client.Object) []string {
 		rb := obj.(*solarv1alpha1.RegistryBinding)
@@ -451,6 +819,35 @@ func indexRenderBindingFields(ctx context.Context, mgr ctrl.Manager) error {
 	})
 }
 
+// patchOnConflict gets the latest version of obj, applies mutate to it and
+// patches the change with an optimistic lock. On a conflict it starts over
+// with the then latest version, so concurrent writers of the same object do
+// not have to requeue their whole reconcile. If mutate returns false, nothing
+// is patched. A deleted object is not an error. With status set, the status
+// subresource is patched.
+func patchOnConflict[T client.Object](ctx context.Context, c client.Client, obj T, status bool, mutate func(T) bool) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		original, ok := obj.DeepCopyObject().(T)
+		if !ok {
+			return fmt.Errorf("unexpected type %T", obj)
+		}
+		if !mutate(obj) {
+			return nil
+		}
+
+		patch := client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})
+		if status {
+			return c.Status().Patch(ctx, obj, patch)
+		}
+
+		return c.Patch(ctx, obj, patch)
+	})
+}
+
 // removeRegistryRefFinalizer removes registryRefFinalizer from registry when no other active
 // Target (excluding skipTarget if non-nil) or RegistryBinding (excluding skipRegistryBinding
 // if non-nil) still references it.
@@ -499,17 +896,12 @@ func removeRegistryRefFinalizer(ctx context.Context, c client.Client, skipTarget
 		return nil
 	}
 
-	freshRegistry := &solarv1alpha1.Registry{}
-	if err := c.Get(ctx, client.ObjectKeyFromObject(registry), freshRegistry); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
-		}
+	freshRegistry := &solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: registry.Name, Namespace: registry.Namespace}}
+	if err := patchOnConflict(ctx, c, freshRegistry, false, func(reg *solarv1alpha1.Registry) bool {
+		reg.Finalizers = slices.DeleteFunc(reg.Finalizers, func(s string) bool { return s == registryRefFinalizer })
 
-		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to get latest Registry for finalizer removal")
-	}
-	original := freshRegistry.DeepCopy()
-	freshRegistry.Finalizers = slices.DeleteFunc(freshRegistry.Finalizers, func(s string) bool { return s == registryRefFinalizer })
-	if err := c.Patch(ctx, freshRegistry, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		return true
+	}); err != nil {
 		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to remove protection finalizer from Registry")
 	}
 
@@ -4,8 +4,28 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 )
 
 func TestTruncateName(t *testing.T) {
@@ -49,3 +69,514 @@ func TestTruncateName(t *testing.T) {
 		}
 	})
 }
+
+func TestRecordReleaseRevision(t *testing.T) {
+	t.Parallel()
+
+	rev := func(revision int64, targetName string) solarv1alpha1.ReleaseRevision {
+		return solarv1alpha1.ReleaseRevision{
+			Revision:  revision,
+			TargetRef: corev1.ObjectReference{Namespace: "default", Name: targetName},
+		}
+	}
+	revisions := func(history []solarv1alpha1.ReleaseRevision) []string {
+		out := make([]string, 0, len(history))
+		for _, h := range history {
+			out = append(out, h.TargetRef.Name+"/"+strconv.FormatInt(h.Revision, 10))
+		}
+
+		return out
+	}
+
+	t.Run("prepends the new revision", func(t *testing.T) {
+		t.Parallel()
+		got := revisions(recordReleaseRevision([]solarv1alpha1.ReleaseRevision{rev(1, "a")}, rev(2, "a"), 10))
+		if want := []string{"a/2", "a/1"}; !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("replaces an entry for the same revision and target", func(t *testing.T) {
+		t.Parallel()
+		history := []solarv1alpha1.ReleaseRevision{rev(2, "a"), rev(1, "a")}
+		updated := rev(2, "a")
+		updated.ChartURL = "oci://registry/new:v0.0.2"
+		got := recordReleaseRevision(history, updated, 10)
+		if want := []string{"a/2", "a/1"}; !slices.Equal(revisions(got), want) {
+			t.Errorf("got %v, want %v", revisions(got), want)
+		}
+		if got[0].ChartURL != updated.ChartURL {
+			t.Errorf("got chartURL %q, want %q", got[0].ChartURL, updated.ChartURL)
+		}
+	})
+
+	t.Run("prunes per target to the limit", func(t *testing.T) {
+		t.Parallel()
+		history := []solarv1alpha1.ReleaseRevision{rev(2, "a"), rev(2, "b"), rev(1, "a"), rev(1, "b")}
+		got := revisions(recordReleaseRevision(history, rev(3, "a"), 2))
+		if want := []string{"a/3", "a/2", "b/2", "b/1"}; !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFindReleaseRevision(t *testing.T) {
+	t.Parallel()
+
+	history := []solarv1alpha1.ReleaseRevision{
+		{Revision: 2, TargetRef: corev1.ObjectReference{Namespace: "default", Name: "a"}},
+		{Revision: 1, TargetRef: corev1.ObjectReference{Namespace: "default", Name: "b"}},
+	}
+
+	if got := findReleaseRevision(history, 1, "default", "b"); got != &history[1] {
+		t.Errorf("got %v, want the entry of target b", got)
+	}
+	if got := findReleaseRevision(history, 1, "default", "a"); got != nil {
+		t.Errorf("got %v, want nil for a revision not rendered for the target", got)
+	}
+	if got := findReleaseRevision(history, 2, "other", "a"); got != nil {
+		t.Errorf("got %v, want nil for a target in another namespace", got)
+	}
+}
+
+func TestLatestReleaseRevision(t *testing.T) {
+	t.Parallel()
+
+	history := []solarv1alpha1.ReleaseRevision{
+		{Revision: 3, TargetRef: corev1.ObjectReference{Namespace: "default", Name: "b"}},
+		{Revision: 2, TargetRef: corev1.ObjectReference{Namespace: "default", Name: "a"}},
+		{Revision: 1, TargetRef: corev1.ObjectReference{Namespace: "default", Name: "a"}},
+	}
+
+	if got := latestReleaseRevision(history, "default", "a"); got != &history[1] {
+		t.Errorf("got %v, want the newest entry of target a", got)
+	}
+	if got := latestReleaseRevision(history, "other", "a"); got != nil {
+		t.Errorf("got %v, want nil for a target without entries", got)
+	}
+}
+
+func TestMergeReleaseValues(t *testing.T) {
+	t.Parallel()
+
+	release := runtime.RawExtension{Raw: []byte(`{"replicas":1,"ingress":{"host":"dev.example.com","tls":true},"debug":true}`)}
+
+	tests := []struct {
+		name      string
+		values    runtime.RawExtension
+		overrides runtime.RawExtension
+		want      string
+	}{
+		{
+			name:   "no overrides keep the release values",
+			values: release,
+			want:   string(release.Raw),
+		},
+		{
+			name:      "maps are merged recursively and null removes a key",
+			values:    release,
+			overrides: runtime.RawExtension{Raw: []byte(`{"replicas":3,"ingress":{"host":"prod.example.com"},"debug":null}`)},
+			want:      `{"ingress":{"host":"prod.example.com","tls":true},"replicas":3}`,
+		},
+		{
+			name:      "overrides apply to empty release values",
+			overrides: runtime.RawExtension{Raw: []byte(`{"replicas":3}`)},
+			want:      `{"replicas":3}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mergeReleaseValues(tt.values, tt.overrides)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got.Raw) != tt.want {
+				t.Errorf("got %s, want %s", got.Raw, tt.want)
+			}
+		})
+	}
+
+	if _, err := mergeReleaseValues(release, runtime.RawExtension{Raw: []byte(`[1]`)}); err == nil {
+		t.Error("expected an error for overrides that are not an object")
+	}
+}
+
+func TestSplitSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	values := runtime.RawExtension{Raw: []byte(`{"database":{"host":"db","password":"s3cr3t"},"token":"abc","replicas":2}`)}
+
+	tests := []struct {
+		name          string
+		paths         []string
+		keyPaths      [][]string
+		wantPublic    string
+		wantSensitive string
+	}{
+		{
+			name:       "no paths keep the values",
+			wantPublic: string(values.Raw),
+		},
+		{
+			name:          "nested and top-level paths are moved",
+			paths:         []string{"database.password", "token"},
+			wantPublic:    `{"database":{"host":"db"},"replicas":2}`,
+			wantSensitive: `{"database":{"password":"s3cr3t"},"token":"abc"}`,
+		},
+		{
+			name:          "a path to a map moves the whole map",
+			paths:         []string{"database"},
+			wantPublic:    `{"replicas":2,"token":"abc"}`,
+			wantSensitive: `{"database":{"host":"db","password":"s3cr3t"}}`,
+		},
+		{
+			name:          "key paths are moved along with paths",
+			paths:         []string{"token"},
+			keyPaths:      [][]string{{"database", "password"}},
+			wantPublic:    `{"database":{"host":"db"},"replicas":2}`,
+			wantSensitive: `{"database":{"password":"s3cr3t"},"token":"abc"}`,
+		},
+		{
+			name:       "missing paths are ignored",
+			paths:      []string{"database.user", "replicas.count", "missing"},
+			keyPaths:   [][]string{{"database", "host", "name"}},
+			wantPublic: string(values.Raw),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			public, sensitive, err := splitSensitiveValues(values, tt.paths, tt.keyPaths)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(public.Raw) != tt.wantPublic {
+				t.Errorf("public values = %s, want %s", public.Raw, tt.wantPublic)
+			}
+			if string(sensitive.Raw) != tt.wantSensitive {
+				t.Errorf("sensitive values = %s, want %s", sensitive.Raw, tt.wantSensitive)
+			}
+		})
+	}
+}
+
+func TestValueKeyPaths(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]any{
+		"database": map[string]any{"host": "db", "auth": map[string]any{"password": "s3cr3t"}},
+		"hosts":    []any{"a", "b"},
+		"extra":    map[string]any{},
+	}
+
+	got := valueKeyPaths(values, nil)
+	slices.SortFunc(got, slices.Compare)
+	want := [][]string{{"database", "auth", "password"}, {"database", "host"}, {"extra"}, {"hosts"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestValuesTag(t *testing.T) {
+	t.Parallel()
+
+	if got := valuesTag(runtime.RawExtension{}); got != "" {
+		t.Errorf("got %q, want no tag without overrides", got)
+	}
+
+	a := valuesTag(runtime.RawExtension{Raw: []byte(`{"replicas":2}`)})
+	b := valuesTag(runtime.RawExtension{Raw: []byte(`{"replicas":3}`)})
+	if len(a) != 8 || a == b {
+		t.Errorf("got %q and %q, want distinct 8 character tags", a, b)
+	}
+}
+
+func TestFailedPod(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "first", CreationTimestamp: now}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "second", CreationTimestamp: later}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "running", CreationTimestamp: later}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+	}
+
+	if got := failedPod(pods); got == nil || got.Name != "second" {
+		t.Errorf("got %v, want the most recently failed pod", got)
+	}
+	if got := failedPod(pods[2:]); got != nil {
+		t.Errorf("got %v, want nil without failed pods", got)
+	}
+}
+
+func TestReportedRenderReport(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:" + strings.Repeat("a", 64)
+	terminated := func(name, message string) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name:  name,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+		}
+	}
+	pod := func(phase corev1.PodPhase, statuses ...corev1.ContainerStatus) corev1.Pod {
+		return corev1.Pod{Status: corev1.PodStatus{Phase: phase, ContainerStatuses: statuses}}
+	}
+
+	renderedAt := metav1.NewTime(time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC))
+	report := `{"contentDigest":"` + digest + `","chartDigest":"sha256:c","chartSize":1024,"renderedAt":"2026-03-04T10:00:00Z"}`
+
+	cases := []struct {
+		name string
+		pods []corev1.Pod
+		want *solarv1alpha1.RenderReport
+	}{
+		{name: "no pods"},
+		{name: "failed pod", pods: []corev1.Pod{pod(corev1.PodFailed, terminated(rendererContainerName, digest))}},
+		{name: "other container", pods: []corev1.Pod{pod(corev1.PodSucceeded, terminated("sidecar", digest))}},
+		{name: "no digest", pods: []corev1.Pod{pod(corev1.PodSucceeded, terminated(rendererContainerName, "done"))}},
+		{
+			name: "digest only",
+			pods: []corev1.Pod{
+				pod(corev1.PodFailed),
+				pod(corev1.PodSucceeded, terminated(rendererContainerName, digest+"\n")),
+			},
+			want: &solarv1alpha1.RenderReport{ContentDigest: digest},
+		},
+		{
+			name: "report",
+			pods: []corev1.Pod{pod(corev1.PodSucceeded, terminated(rendererContainerName, report))},
+			want: &solarv1alpha1.RenderReport{
+				ContentDigest: digest,
+				ChartDigest:   "sha256:c",
+				ChartSize:     1024,
+				RenderedAt:    &renderedAt,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := reportedRenderReport(tc.pods); !equality.Semantic.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRecordRenderReport(t *testing.T) {
+	t.Parallel()
+
+	completed := metav1.NewTime(time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC))
+	pushed := metav1.NewTime(completed.Add(-time.Second))
+	job := &batchv1.Job{Status: batchv1.JobStatus{CompletionTime: &completed}}
+
+	res := &solarv1alpha1.RenderTask{}
+	if !recordRenderReport(res, nil, job) || !res.Status.RenderedAt.Equal(&completed) {
+		t.Errorf("RenderedAt = %v, want the completion time of the job without a report", res.Status.RenderedAt)
+	}
+
+	report := &solarv1alpha1.RenderReport{ContentDigest: "sha256:a", ChartDigest: "sha256:b", ChartSize: 42, SignatureRef: "example.com/chart:sha256-b.sig", RenderedAt: &pushed}
+	if !recordRenderReport(res, report, job) {
+		t.Error("recording a report did not change the status")
+	}
+	if res.Status.ContentDigest != "sha256:a" || res.Status.ChartDigest != "sha256:b" || res.Status.ChartSize != 42 ||
+		res.Status.SignatureRef != "example.com/chart:sha256-b.sig" || !res.Status.RenderedAt.Equal(&pushed) {
+		t.Errorf("status = %+v, want the reported values", res.Status)
+	}
+
+	// The pod of the job is gone, so the report is missing.
+	if recordRenderReport(res, nil, job) {
+		t.Errorf("status = %+v, want the reported values to be kept", res.Status)
+	}
+}
+
+func TestTailLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps short logs", func(t *testing.T) {
+		t.Parallel()
+		if got := tailLog("error: boom\n", 64); got != "error: boom" {
+			t.Errorf("got %q, want %q", got, "error: boom")
+		}
+	})
+
+	t.Run("keeps the end of long logs starting at a line", func(t *testing.T) {
+		t.Parallel()
+		got := tailLog("first line\nsecond line\nerror: boom", 20)
+		if got != "...\nerror: boom" {
+			t.Errorf("got %q, want %q", got, "...\nerror: boom")
+		}
+	})
+
+	t.Run("does not split multi-byte characters", func(t *testing.T) {
+		t.Parallel()
+		got := tailLog(strings.Repeat("ä", 10), 5)
+		if !utf8.ValidString(got) || got != "...\n"+"ää" {
+			t.Errorf("got %q, want two whole characters", got)
+		}
+	})
+}
+
+func TestFailedJobLogs(t *testing.T) {
+	t.Parallel()
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "render-a", Namespace: "default"}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "render-a-xyz",
+			Namespace: "default",
+			Labels:    map[string]string{batchv1.JobNameLabel: job.Name},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodFailed},
+	}
+
+	r := &RenderTaskReconciler{}
+	if got := r.failedJobLogs(context.Background(), job); got != "" {
+		t.Errorf("got %q, want no logs without a pod client", got)
+	}
+
+	r.PodLogs = k8sfake.NewClientset().CoreV1()
+	if got := r.failedJobLogs(context.Background(), job); got != "" {
+		t.Errorf("got %q, want no logs without a failed pod", got)
+	}
+
+	r.PodLogs = k8sfake.NewClientset(pod).CoreV1()
+	if got := r.failedJobLogs(context.Background(), job); got != "fake logs" {
+		t.Errorf("got %q, want the logs of the failed pod", got)
+	}
+}
+
+func TestRenderReport(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:" + strings.Repeat("b", 64)
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "render-a", Namespace: "default"}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "render-a-xyz",
+			Namespace: "default",
+			Labels:    map[string]string{batchv1.JobNameLabel: job.Name},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  rendererContainerName,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: digest}},
+			}},
+		},
+	}
+
+	res := &solarv1alpha1.RenderTask{}
+	dryRun := &solarv1alpha1.RenderTask{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotationDryRun: "true"}}}
+
+	r := &RenderTaskReconciler{PodLogs: k8sfake.NewClientset(pod).CoreV1()}
+	if got := r.renderReport(context.Background(), res, job); got != nil {
+		t.Errorf("got %+v, want no report unless ReportDigest is set", got)
+	}
+	if got := r.renderReport(context.Background(), dryRun, job); got == nil || got.ContentDigest != digest {
+		t.Errorf("got %+v, want the digest of the succeeded pod of a dry run", got)
+	}
+
+	r.KeylessSigning = true
+	if got := r.renderReport(context.Background(), res, job); got == nil || got.ContentDigest != digest {
+		t.Errorf("got %+v, want the report of the succeeded pod of a render job signing its chart", got)
+	}
+
+	r.KeylessSigning = false
+	r.ReportDigest = true
+	if got := r.renderReport(context.Background(), res, job); got == nil || got.ContentDigest != digest {
+		t.Errorf("got %+v, want the digest of the succeeded pod", got)
+	}
+}
+
+func TestPatchOnConflict(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(t *testing.T, conflicts int) (client.Client, *int) {
+		t.Helper()
+
+		sch := runtime.NewScheme()
+		_ = solarv1alpha1.AddToScheme(sch)
+		patches := 0
+		c := fake.NewClientBuilder().
+			WithScheme(sch).
+			WithObjects(&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "reg", Namespace: "default", Finalizers: []string{registryRefFinalizer, "other"}},
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					patches++
+					if patches <= conflicts {
+						return apierrors.NewConflict(schema.GroupResource{Resource: "registries"}, obj.GetName(), errors.New("modified"))
+					}
+
+					return c.Patch(ctx, obj, patch, opts...)
+				},
+			}).
+			Build()
+
+		return c, &patches
+	}
+	removeFinalizer := func(reg *solarv1alpha1.Registry) bool {
+		reg.Finalizers = slices.DeleteFunc(reg.Finalizers, func(s string) bool { return s == registryRefFinalizer })
+
+		return true
+	}
+
+	t.Run("retries the patch on conflicts", func(t *testing.T) {
+		t.Parallel()
+		c, patches := newClient(t, 2)
+		reg := &solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "reg", Namespace: "default"}}
+		if err := patchOnConflict(context.Background(), c, reg, false, removeFinalizer); err != nil {
+			t.Fatalf("patchOnConflict: %v", err)
+		}
+		if *patches != 3 {
+			t.Errorf("got %d patches, want 3", *patches)
+		}
+		got := &solarv1alpha1.Registry{}
+		if err := c.Get(context.Background(), client.ObjectKeyFromObject(reg), got); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if !slices.Equal(got.Finalizers, []string{"other"}) {
+			t.Errorf("got finalizers %v, want [other]", got.Finalizers)
+		}
+	})
+
+	t.Run("skips the patch if nothing changed", func(t *testing.T) {
+		t.Parallel()
+		c, patches := newClient(t, 0)
+		reg := &solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "reg", Namespace: "default"}}
+		if err := patchOnConflict(context.Background(), c, reg, false, func(*solarv1alpha1.Registry) bool { return false }); err != nil {
+			t.Fatalf("patchOnConflict: %v", err)
+		}
+		if *patches != 0 {
+			t.Errorf("got %d patches, want 0", *patches)
+		}
+	})
+
+	t.Run("ignores deleted objects", func(t *testing.T) {
+		t.Parallel()
+		c, _ := newClient(t, 0)
+		reg := &solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "gone", Namespace: "default"}}
+		if err := patchOnConflict(context.Background(), c, reg, false, removeFinalizer); err != nil {
+			t.Errorf("patchOnConflict: %v, want nil", err)
+		}
+	})
+
+	t.Run("gives up after repeated conflicts", func(t *testing.T) {
+		t.Parallel()
+		c, _ := newClient(t, 100)
+		reg := &solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "reg", Namespace: "default"}}
+		if err := patchOnConflict(context.Background(), c, reg, false, removeFinalizer); !apierrors.IsConflict(err) {
+			t.Errorf("patchOnConflict: %v, want a conflict", err)
+		}
+	})
+}
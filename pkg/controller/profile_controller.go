@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 // bindingTargetKey returns a stable namespace/name key for a ReleaseBinding's target reference.
@@ -383,7 +384,7 @@ func (r *ProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&solarv1alpha1.ReferenceGrant{},
 			handler.EnqueueRequestsFromMapFunc(r.mapReferenceGrantToProfiles),
 		).
-		Complete(r)
+		Complete(observability.WrapReconciler("Profile", r))
 }
 
 // mapTargetToProfiles maps a Target to all Profiles that might match it,
@@ -555,17 +556,12 @@ func (r *ProfileReconciler) removeReleaseRefFinalizerIfUnreferenced(ctx context.
 		return nil
 	}
 
-	freshRelease := &solarv1alpha1.Release{}
-	if err := r.Get(ctx, client.ObjectKeyFromObject(release), freshRelease); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
-		}
+	freshRelease := &solarv1alpha1.Release{ObjectMeta: metav1.ObjectMeta{Name: release.Name, Namespace: release.Namespace}}
+	if err := patchOnConflict(ctx, r.Client, freshRelease, false, func(rel *solarv1alpha1.Release) bool {
+		rel.Finalizers = slices.DeleteFunc(rel.Finalizers, func(s string) bool { return s == releaseRefFinalizer })
 
-		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to get latest Release for finalizer removal")
-	}
-	original := freshRelease.DeepCopy()
-	freshRelease.Finalizers = slices.DeleteFunc(freshRelease.Finalizers, func(s string) bool { return s == releaseRefFinalizer })
-	if err := r.Patch(ctx, freshRelease, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		return true
+	}); err != nil {
 		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to remove protection finalizer from Release")
 	}
 
@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 // RegistryBindingReconciler manages the deletion-protection finalizer on the Registry referenced
@@ -122,5 +123,5 @@ func (r *RegistryBindingReconciler) removeRegistryRefFinalizer(ctx context.Conte
 func (r *RegistryBindingReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&solarv1alpha1.RegistryBinding{}).
-		Complete(r)
+		Complete(observability.WrapReconciler("RegistryBinding", r))
 }
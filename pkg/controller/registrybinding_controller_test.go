@@ -184,4 +184,54 @@ var _ = Describe("RegistryBindingReconciler", Ordered, func() {
 			}, consistentlyDuration).Should(Succeed())
 		})
 	})
+
+	Describe("status subresource", func() {
+		It("ignores status changes sent with a Registry or RegistryBinding update", func() {
+			registry := validRegistry("dp-regb-registry-status")
+			Expect(k8sClient.Create(ctx, registry)).To(Succeed())
+			DeferCleanup(func() {
+				patch := client.RawPatch(types.JSONPatchType, []byte(`[{"op":"replace","path":"/metadata/finalizers","value":[]}]`))
+				_ = client.IgnoreNotFound(k8sClient.Patch(ctx, registry, patch))
+				_ = client.IgnoreNotFound(k8sClient.Delete(ctx, registry))
+			})
+
+			rb := validRegistryBinding("dp-regb-status", registry.Name)
+			Expect(k8sClient.Create(ctx, rb)).To(Succeed())
+			DeferCleanup(func() {
+				patch := client.RawPatch(types.JSONPatchType, []byte(`[{"op":"replace","path":"/metadata/finalizers","value":[]}]`))
+				_ = client.IgnoreNotFound(k8sClient.Patch(ctx, rb, patch))
+				_ = client.IgnoreNotFound(k8sClient.Delete(ctx, rb))
+			})
+
+			condition := metav1.Condition{
+				Type:               "Ready",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Test",
+				LastTransitionTime: metav1.Now(),
+			}
+
+			// Retry on conflicts with the reconciler adding its finalizers.
+			Eventually(func(g Gomega) {
+				updated := &solarv1alpha1.Registry{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(registry), updated)).To(Succeed())
+				updated.Status.Conditions = []metav1.Condition{condition}
+				g.Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+			}, eventuallyTimeout).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				updated := &solarv1alpha1.RegistryBinding{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rb), updated)).To(Succeed())
+				updated.Status.Conditions = []metav1.Condition{condition}
+				g.Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+			}, eventuallyTimeout).Should(Succeed())
+
+			updatedRegistry := &solarv1alpha1.Registry{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(registry), updatedRegistry)).To(Succeed())
+			Expect(updatedRegistry.Status.Conditions).To(BeEmpty())
+
+			updatedRB := &solarv1alpha1.RegistryBinding{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rb), updatedRB)).To(Succeed())
+			Expect(updatedRB.Status.Conditions).To(BeEmpty())
+		})
+	})
 })
@@ -5,8 +5,11 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,10 +22,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 const (
 	ConditionTypeComponentVersionResolved = "ComponentVersionResolved"
+	// ConditionTypeSuspended is True while spec.suspend stops Targets from
+	// rendering the Release.
+	ConditionTypeSuspended = "Suspended"
+	// ConditionTypeWaiting is True while spec.schedule stops Targets from
+	// rendering the Release because none of its maintenance windows is open.
+	ConditionTypeWaiting = "Waiting"
 )
 
 // ReleaseReconciler reconciles a Release object.
@@ -45,6 +55,8 @@ type ReleaseReconciler struct {
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions/finalizers,verbs=update
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=referencegrants,verbs=get;list;watch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=releasebindings,verbs=get;list;watch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=rendertasks,verbs=get;list;watch
 //+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile validates the Release by resolving its ComponentVersion reference and
@@ -117,6 +129,24 @@ func (r *ReleaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		}
 	}
 
+	if r.setSuspendedCondition(res) {
+		if err := r.Status().Update(ctx, res); err != nil {
+			return ctrlResult, errLogAndWrap(log, err, "failed to update status")
+		}
+	}
+
+	// Reconcile again when a maintenance window opens or closes, which also
+	// makes the Targets of the Release render it or hold it back.
+	changed, next := r.setWaitingCondition(res, time.Now())
+	if changed {
+		if err := r.Status().Update(ctx, res); err != nil {
+			return ctrlResult, errLogAndWrap(log, err, "failed to update status")
+		}
+	}
+	if !next.IsZero() {
+		ctrlResult.RequeueAfter = time.Until(next)
+	}
+
 	cvNamespace := res.Namespace
 	if res.Spec.ComponentVersionNamespace != "" {
 		cvNamespace = res.Spec.ComponentVersionNamespace
@@ -136,7 +166,11 @@ func (r *ReleaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				Reason:             "NotGranted",
 				Message:            "no ReferenceGrant permits access to ComponentVersion in namespace " + cvNamespace,
 			})
-			if changed {
+			phaseChanged, err := r.setPhase(ctx, res)
+			if err != nil {
+				return ctrlResult, errLogAndWrap(log, err, "failed to derive phase")
+			}
+			if changed || phaseChanged {
 				if err := r.Status().Update(ctx, res); err != nil {
 					return ctrlResult, errLogAndWrap(log, err, "failed to update status")
 				}
@@ -161,7 +195,11 @@ func (r *ReleaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				Reason:             "NotFound",
 				Message:            "ComponentVersion not found: " + res.Spec.ComponentVersionRef.Name,
 			})
-			if changed {
+			phaseChanged, err := r.setPhase(ctx, res)
+			if err != nil {
+				return ctrlResult, errLogAndWrap(log, err, "failed to derive phase")
+			}
+			if changed || phaseChanged {
 				if err := r.Status().Update(ctx, res); err != nil {
 					return ctrlResult, errLogAndWrap(log, err, "failed to update status")
 				}
@@ -193,7 +231,11 @@ func (r *ReleaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		Message:            "ComponentVersion resolved: " + cv.Name,
 	})
 	nameChanged := res.Status.EffectiveUniqueName != uname
-	if condChanged || nameChanged {
+	phaseChanged, err := r.setPhase(ctx, res)
+	if err != nil {
+		return ctrlResult, errLogAndWrap(log, err, "failed to derive phase")
+	}
+	if condChanged || nameChanged || phaseChanged {
 		res.Status.EffectiveUniqueName = uname
 		if err := r.Status().Update(ctx, res); err != nil {
 			return ctrlResult, errLogAndWrap(log, err, "failed to update status")
@@ -203,6 +245,163 @@ func (r *ReleaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	return ctrlResult, nil
 }
 
+// setSuspendedCondition reflects spec.suspend in the Suspended condition and
+// reports whether the condition changed. Releases that were never suspended
+// get no condition.
+func (r *ReleaseReconciler) setSuspendedCondition(res *solarv1alpha1.Release) bool {
+	if !res.Spec.Suspend && apimeta.FindStatusCondition(res.Status.Conditions, ConditionTypeSuspended) == nil {
+		return false
+	}
+
+	cond := metav1.Condition{
+		Type:               ConditionTypeSuspended,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: res.Generation,
+		Reason:             "Resumed",
+		Message:            "Release is rendered by its Targets",
+	}
+	if res.Spec.Suspend {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "Suspended"
+		cond.Message = "Release is suspended, Targets keep its last rendered chart"
+	}
+
+	wasSuspended := apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeSuspended)
+	if !apimeta.SetStatusCondition(&res.Status.Conditions, cond) {
+		return false
+	}
+	if wasSuspended != res.Spec.Suspend {
+		r.Recorder.Eventf(res, nil, corev1.EventTypeNormal, cond.Reason, "Reconcile", cond.Message)
+	}
+
+	return true
+}
+
+// setWaitingCondition reflects whether spec.schedule allows rendering at now
+// in the Waiting condition. It reports whether the condition changed and when
+// the next maintenance window opens or the open one closes. Releases that
+// never had a schedule get no condition.
+func (r *ReleaseReconciler) setWaitingCondition(res *solarv1alpha1.Release, now time.Time) (bool, time.Time) {
+	if res.Spec.Schedule == nil && apimeta.FindStatusCondition(res.Status.Conditions, ConditionTypeWaiting) == nil {
+		return false, time.Time{}
+	}
+
+	cond := metav1.Condition{
+		Type:               ConditionTypeWaiting,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: res.Generation,
+		Reason:             "Unscheduled",
+		Message:            "Release has no schedule and is rendered at any time",
+	}
+
+	open, next, err := releaseWindow(res.Spec.Schedule, now)
+	switch {
+	case res.Spec.Schedule == nil:
+	case err != nil:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "InvalidSchedule"
+		cond.Message = err.Error()
+	case open:
+		cond.Reason = "InMaintenanceWindow"
+		cond.Message = fmt.Sprintf("Maintenance window is open until %s", next.Format(time.RFC3339))
+	case next.IsZero():
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "OutsideMaintenanceWindow"
+		cond.Message = "No maintenance window opens within the next five years"
+	default:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "OutsideMaintenanceWindow"
+		cond.Message = fmt.Sprintf("Waiting for the next maintenance window at %s", next.Format(time.RFC3339))
+	}
+
+	wasWaiting := apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeWaiting)
+	if !apimeta.SetStatusCondition(&res.Status.Conditions, cond) {
+		return false, next
+	}
+	if wasWaiting != (cond.Status == metav1.ConditionTrue) {
+		r.Recorder.Eventf(res, nil, corev1.EventTypeNormal, cond.Reason, "Reconcile", cond.Message)
+	}
+
+	return true, next
+}
+
+// setPhase sets Status.Phase to the phase derived by releasePhase and reports
+// whether it changed.
+func (r *ReleaseReconciler) setPhase(ctx context.Context, res *solarv1alpha1.Release) (bool, error) {
+	phase, err := r.releasePhase(ctx, res)
+	if err != nil {
+		return false, err
+	}
+	if res.Status.Phase == phase {
+		return false, nil
+	}
+	res.Status.Phase = phase
+
+	return true, nil
+}
+
+// releasePhase derives the phase of res from its conditions, the RenderTasks
+// Targets created for its current generation and its history. A failed render
+// job on any Target fails the Release, a job still running on any Target keeps
+// it Rendering.
+func (r *ReleaseReconciler) releasePhase(ctx context.Context, res *solarv1alpha1.Release) (solarv1alpha1.ReleasePhase, error) {
+	if apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeSuspended) {
+		return solarv1alpha1.ReleasePhaseSuspended, nil
+	}
+	if apimeta.IsStatusConditionFalse(res.Status.Conditions, ConditionTypeComponentVersionResolved) {
+		return solarv1alpha1.ReleasePhaseFailed, nil
+	}
+
+	bindings := &solarv1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, bindings,
+		client.InNamespace(res.Namespace),
+		client.MatchingFields{indexReleaseBindingReleaseName: res.Name},
+	); err != nil {
+		return "", err
+	}
+
+	rendering := false
+	for _, rb := range bindings.Items {
+		targetNamespace := rb.Spec.TargetNamespace
+		if targetNamespace == "" {
+			targetNamespace = rb.Namespace
+		}
+
+		rt := &solarv1alpha1.RenderTask{}
+		key := client.ObjectKey{
+			Name:      releaseRenderTaskName(res.Namespace, res.Name, rb.Spec.TargetRef.Name, res.Generation),
+			Namespace: targetNamespace,
+		}
+		if err := r.Get(ctx, key, rt); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return "", err
+		}
+
+		switch {
+		case apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobFailed):
+			return solarv1alpha1.ReleasePhaseFailed, nil
+		case !apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobSucceeded):
+			rendering = true
+		}
+	}
+	if rendering {
+		return solarv1alpha1.ReleasePhaseRendering, nil
+	}
+
+	revision := res.Generation
+	if res.Spec.RollbackTo != nil {
+		revision = *res.Spec.RollbackTo
+	}
+	if slices.ContainsFunc(res.Status.History, func(h solarv1alpha1.ReleaseRevision) bool { return h.Revision == revision }) {
+		return solarv1alpha1.ReleasePhaseDeployed, nil
+	}
+
+	return solarv1alpha1.ReleasePhasePending, nil
+}
+
 // removeComponentVersionRefFinalizer removes componentVersionRefFinalizer from cv when no other
 // active Release still references it (excluding the Release that is currently being deleted).
 func (r *ReleaseReconciler) removeComponentVersionRefFinalizer(ctx context.Context, deletingRelease *solarv1alpha1.Release, cv *solarv1alpha1.ComponentVersion) error {
@@ -274,7 +473,11 @@ func (r *ReleaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&solarv1alpha1.ReferenceGrant{},
 			handler.EnqueueRequestsFromMapFunc(r.mapReferenceGrantToReleases),
 		).
-		Complete(r)
+		Watches(
+			&solarv1alpha1.RenderTask{},
+			handler.EnqueueRequestsFromMapFunc(r.mapRenderTaskToReleases),
+		).
+		Complete(observability.WrapReconciler("Release", r))
 }
 
 // mapComponentVersionToReleases enqueues all Releases that reference this ComponentVersion.
@@ -297,6 +500,42 @@ func (r *ReleaseReconciler) mapComponentVersionToReleases(ctx context.Context, o
 	return requests
 }
 
+// mapRenderTaskToReleases enqueues the Releases bound to the Target owning the
+// changed RenderTask, so their phase follows the state of its render job.
+func (r *ReleaseReconciler) mapRenderTaskToReleases(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := ctrl.LoggerFrom(ctx)
+
+	rt, ok := obj.(*solarv1alpha1.RenderTask)
+	if !ok || rt.Spec.OwnerKind != "Target" || rt.Spec.OwnerName == "" {
+		return nil
+	}
+
+	bindings := &solarv1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, bindings, client.MatchingFields{indexReleaseBindingTargetName: rt.Spec.OwnerName}); err != nil {
+		log.Error(err, "failed to list ReleaseBindings for RenderTask mapping")
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, rb := range bindings.Items {
+		targetNamespace := rb.Spec.TargetNamespace
+		if targetNamespace == "" {
+			targetNamespace = rb.Namespace
+		}
+		if targetNamespace != rt.Spec.OwnerNamespace {
+			continue
+		}
+
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: rb.Spec.ReleaseRef.Name, Namespace: rb.Namespace}}
+		if !slices.Contains(requests, req) {
+			requests = append(requests, req)
+		}
+	}
+
+	return requests
+}
+
 // mapReferenceGrantToReleases enqueues Releases whose cross-namespace ComponentVersion
 // reference is covered by the changed ReferenceGrant.
 func (r *ReleaseReconciler) mapReferenceGrantToReleases(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -0,0 +1,192 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// These tests cover the derivation of the phase of a Release. They use the
+// fake client to stay independent of envtest.
+
+func newPhaseTestRelease(conditions ...metav1.Condition) *solarv1alpha1.Release {
+	return &solarv1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "rel", Namespace: "default", Generation: 2},
+		Spec: solarv1alpha1.ReleaseSpec{
+			ComponentVersionRef: corev1.LocalObjectReference{Name: "cv"},
+		},
+		Status: solarv1alpha1.ReleaseStatus{
+			Conditions: append([]metav1.Condition{
+				{Type: ConditionTypeComponentVersionResolved, Status: metav1.ConditionTrue, Reason: "Resolved"},
+			}, conditions...),
+		},
+	}
+}
+
+func newPhaseTestBinding(name, targetNamespace string) *solarv1alpha1.ReleaseBinding {
+	return &solarv1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: solarv1alpha1.ReleaseBindingSpec{
+			TargetRef:       corev1.LocalObjectReference{Name: name},
+			TargetNamespace: targetNamespace,
+			ReleaseRef:      corev1.LocalObjectReference{Name: "rel"},
+		},
+	}
+}
+
+func newPhaseTestRenderTask(target, namespace string, generation int64, conditions ...metav1.Condition) *solarv1alpha1.RenderTask {
+	return &solarv1alpha1.RenderTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseRenderTaskName("default", "rel", target, generation),
+			Namespace: namespace,
+		},
+		Spec: solarv1alpha1.RenderTaskSpec{
+			OwnerKind:      "Target",
+			OwnerName:      target,
+			OwnerNamespace: namespace,
+		},
+		Status: solarv1alpha1.RenderTaskStatus{Conditions: conditions},
+	}
+}
+
+func newPhaseTestReconciler(objs ...client.Object) *ReleaseReconciler {
+	sch := runtime.NewScheme()
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	c := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(objs...).
+		WithIndex(&solarv1alpha1.ReleaseBinding{}, indexReleaseBindingReleaseName, func(obj client.Object) []string {
+			return []string{obj.(*solarv1alpha1.ReleaseBinding).Spec.ReleaseRef.Name}
+		}).
+		WithIndex(&solarv1alpha1.ReleaseBinding{}, indexReleaseBindingTargetName, func(obj client.Object) []string {
+			return []string{obj.(*solarv1alpha1.ReleaseBinding).Spec.TargetRef.Name}
+		}).
+		Build()
+
+	return &ReleaseReconciler{Client: c, Scheme: sch}
+}
+
+func TestReleasePhase(t *testing.T) {
+	t.Parallel()
+
+	succeeded := metav1.Condition{Type: ConditionTypeJobSucceeded, Status: metav1.ConditionTrue, Reason: "JobSucceeded"}
+	failed := metav1.Condition{Type: ConditionTypeJobFailed, Status: metav1.ConditionTrue, Reason: "JobFailed"}
+	scheduled := metav1.Condition{Type: ConditionTypeJobScheduled, Status: metav1.ConditionTrue, Reason: "JobScheduled"}
+
+	deployed := newPhaseTestRelease()
+	deployed.Status.History = []solarv1alpha1.ReleaseRevision{{Revision: 2}}
+
+	rolledBack := newPhaseTestRelease()
+	rolledBack.Spec.RollbackTo = ptr.To[int64](1)
+	rolledBack.Status.History = []solarv1alpha1.ReleaseRevision{{Revision: 1}}
+
+	tests := []struct {
+		name    string
+		release *solarv1alpha1.Release
+		objs    []client.Object
+		want    solarv1alpha1.ReleasePhase
+	}{
+		{
+			name:    "unbound",
+			release: newPhaseTestRelease(),
+			want:    solarv1alpha1.ReleasePhasePending,
+		},
+		{
+			name: "suspended",
+			release: newPhaseTestRelease(metav1.Condition{
+				Type: ConditionTypeSuspended, Status: metav1.ConditionTrue, Reason: "Suspended",
+			}),
+			objs: []client.Object{newPhaseTestBinding("a", ""), newPhaseTestRenderTask("a", "default", 2, failed)},
+			want: solarv1alpha1.ReleasePhaseSuspended,
+		},
+		{
+			name: "unresolved ComponentVersion",
+			release: func() *solarv1alpha1.Release {
+				rel := newPhaseTestRelease()
+				rel.Status.Conditions[0].Status = metav1.ConditionFalse
+				rel.Status.Conditions[0].Reason = "NotFound"
+
+				return rel
+			}(),
+			want: solarv1alpha1.ReleasePhaseFailed,
+		},
+		{
+			name:    "render job running",
+			release: newPhaseTestRelease(),
+			objs:    []client.Object{newPhaseTestBinding("a", ""), newPhaseTestRenderTask("a", "default", 2, scheduled)},
+			want:    solarv1alpha1.ReleasePhaseRendering,
+		},
+		{
+			name:    "render job failed on one Target",
+			release: newPhaseTestRelease(),
+			objs: []client.Object{
+				newPhaseTestBinding("a", ""), newPhaseTestRenderTask("a", "default", 2, scheduled),
+				newPhaseTestBinding("b", "other"), newPhaseTestRenderTask("b", "other", 2, failed),
+			},
+			want: solarv1alpha1.ReleasePhaseFailed,
+		},
+		{
+			name:    "render task of previous generation",
+			release: newPhaseTestRelease(),
+			objs:    []client.Object{newPhaseTestBinding("a", ""), newPhaseTestRenderTask("a", "default", 1, failed)},
+			want:    solarv1alpha1.ReleasePhasePending,
+		},
+		{
+			name:    "render job succeeded",
+			release: deployed,
+			objs:    []client.Object{newPhaseTestBinding("a", ""), newPhaseTestRenderTask("a", "default", 2, succeeded)},
+			want:    solarv1alpha1.ReleasePhaseDeployed,
+		},
+		{
+			name:    "rolled back",
+			release: rolledBack,
+			objs:    []client.Object{newPhaseTestBinding("a", "")},
+			want:    solarv1alpha1.ReleasePhaseDeployed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newPhaseTestReconciler(tt.objs...)
+			got, err := r.releasePhase(context.Background(), tt.release)
+			if err != nil {
+				t.Fatalf("releasePhase: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected phase %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapRenderTaskToReleases(t *testing.T) {
+	t.Parallel()
+
+	r := newPhaseTestReconciler(
+		newPhaseTestBinding("a", ""),
+		newPhaseTestBinding("b", "other"),
+	)
+
+	requests := r.mapRenderTaskToReleases(context.Background(), newPhaseTestRenderTask("b", "other", 1))
+	if len(requests) != 1 || requests[0].Name != "rel" || requests[0].Namespace != "default" {
+		t.Fatalf("expected Release default/rel to be enqueued, got %v", requests)
+	}
+
+	if requests := r.mapRenderTaskToReleases(context.Background(), newPhaseTestRenderTask("b", "default", 1)); len(requests) != 0 {
+		t.Fatalf("expected no Release for a Target in another namespace, got %v", requests)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/cron"
+)
+
+// releaseWindow reports whether a Release with the given schedule may be
+// rendered at now, which is always the case without a schedule. It also
+// returns when this changes: the time the open windows close, or the time the
+// next window opens. The time is zero if it is not known, e.g. because no
+// window opens within the next five years.
+func releaseWindow(schedule *solarv1alpha1.ReleaseSchedule, now time.Time) (bool, time.Time, error) {
+	if schedule == nil {
+		return true, time.Time{}, nil
+	}
+
+	loc := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid time zone %q: %w", schedule.TimeZone, err)
+		}
+	}
+	now = now.In(loc)
+
+	var closesAt, opensAt time.Time
+	for _, w := range schedule.Windows {
+		s, err := cron.Parse(w.Start)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid window start %q: %w", w.Start, err)
+		}
+
+		// Windows starting after now-duration are either open or upcoming.
+		start := s.Next(now.Add(-w.Duration.Duration))
+		switch {
+		case start.IsZero():
+			continue
+		case start.After(now):
+			if opensAt.IsZero() || start.Before(opensAt) {
+				opensAt = start
+			}
+
+			continue
+		}
+
+		// Overlapping windows of the same schedule are open until the last
+		// of them closes.
+		for next := s.Next(start); !next.IsZero() && !next.After(now); next = s.Next(next) {
+			start = next
+		}
+		if end := start.Add(w.Duration.Duration); end.After(closesAt) {
+			closesAt = end
+		}
+	}
+
+	if !closesAt.IsZero() {
+		return true, closesAt, nil
+	}
+
+	return false, opensAt, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func TestReleaseWindow(t *testing.T) {
+	t.Parallel()
+
+	// Weekdays from 22:00 to 02:00.
+	nightly := &solarv1alpha1.ReleaseSchedule{
+		Windows: []solarv1alpha1.MaintenanceWindow{
+			{Start: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		},
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		schedule *solarv1alpha1.ReleaseSchedule
+		now      time.Time
+		open     bool
+		next     time.Time
+	}{
+		{
+			name: "no schedule",
+			now:  time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			open: true,
+		},
+		{
+			name:     "before the window",
+			schedule: nightly,
+			now:      time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC),
+			next:     time.Date(2026, 10, 14, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "at the start of the window",
+			schedule: nightly,
+			now:      time.Date(2026, 10, 14, 22, 0, 0, 0, time.UTC),
+			open:     true,
+			next:     time.Date(2026, 10, 15, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "inside the window after midnight",
+			schedule: nightly,
+			now:      time.Date(2026, 10, 15, 1, 30, 0, 0, time.UTC),
+			open:     true,
+			next:     time.Date(2026, 10, 15, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "at the end of the window",
+			schedule: nightly,
+			now:      time.Date(2026, 10, 15, 2, 0, 0, 0, time.UTC),
+			next:     time.Date(2026, 10, 15, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "over the weekend",
+			schedule: nightly,
+			now:      time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			next:     time.Date(2026, 10, 19, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "in a time zone",
+			schedule: &solarv1alpha1.ReleaseSchedule{
+				Windows:  nightly.Windows,
+				TimeZone: "Europe/Berlin",
+			},
+			now:  time.Date(2026, 10, 14, 21, 0, 0, 0, time.UTC),
+			open: true,
+			next: time.Date(2026, 10, 15, 2, 0, 0, 0, berlin),
+		},
+		{
+			name: "overlapping windows of one schedule",
+			schedule: &solarv1alpha1.ReleaseSchedule{
+				Windows: []solarv1alpha1.MaintenanceWindow{
+					{Start: "0 * * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+				},
+			},
+			now:  time.Date(2026, 10, 14, 11, 30, 0, 0, time.UTC),
+			open: true,
+			next: time.Date(2026, 10, 14, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "earliest of several windows",
+			schedule: &solarv1alpha1.ReleaseSchedule{
+				Windows: []solarv1alpha1.MaintenanceWindow{
+					{Start: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					{Start: "0 6 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+			now:  time.Date(2026, 10, 14, 23, 30, 0, 0, time.UTC),
+			next: time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			open, next, err := releaseWindow(tt.schedule, tt.now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if open != tt.open || !next.Equal(tt.next) {
+				t.Errorf("got (%t, %s), want (%t, %s)", open, next, tt.open, tt.next)
+			}
+		})
+	}
+}
+
+func TestReleaseWindowInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, schedule := range []*solarv1alpha1.ReleaseSchedule{
+		{Windows: []solarv1alpha1.MaintenanceWindow{{Start: "every night"}}},
+		{TimeZone: "Mars/Olympus"},
+	} {
+		if _, _, err := releaseWindow(schedule, time.Now()); err == nil {
+			t.Errorf("expected an error for %+v", schedule)
+		}
+	}
+}
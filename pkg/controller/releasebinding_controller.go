@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 // ReleaseBindingReconciler manages the deletion-protection finalizer on the Release referenced
@@ -245,5 +246,5 @@ func (r *ReleaseBindingReconciler) removeReleaseRefFinalizer(ctx context.Context
 func (r *ReleaseBindingReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&solarv1alpha1.ReleaseBinding{}).
-		Complete(r)
+		Complete(observability.WrapReconciler("ReleaseBinding", r))
 }
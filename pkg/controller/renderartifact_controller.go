@@ -28,12 +28,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 	"go.opendefense.cloud/solar/pkg/ociregistry"
 )
 
 const (
 	renderArtifactFinalizer = "solar.opendefense.cloud/render-artifact-finalizer"
 	ConditionTypeOCICleanup = "OCICleanup"
+	// ConditionTypeReferenced reports whether any RenderBinding references the
+	// RenderArtifact. Its last transition to False starts the GC retention window.
+	ConditionTypeReferenced = "Referenced"
+
+	reasonGCDryRun = "GCDryRun"
 )
 
 // RenderArtifactReconciler reconciles RenderArtifact objects.
@@ -41,6 +47,10 @@ const (
 // referencing a RenderArtifact is removed, it attempts to delete the OCI tag
 // and then deletes the RenderArtifact object itself.
 //
+// Unreferenced artifacts are kept for GCRetention before they are deleted. With
+// GCDryRun set they are only marked in the Referenced condition and an event,
+// which allows reviewing what GC would remove before enabling it.
+//
 // OCI tag deletion failures are surfaced as a status condition and a Warning event
 // so users have visibility; the finalizer is kept until the deletion succeeds,
 // making the artifact object "stuck" in a visible state.
@@ -52,6 +62,11 @@ type RenderArtifactReconciler struct {
 	// DeleteTag overrides the OCI tag deletion function used during GC.
 	// Defaults to ociregistry.DeleteTag; replaced in tests.
 	DeleteTag func(ctx context.Context, rawRef string, auth authn.Authenticator, insecure bool) error
+	// GCRetention is how long a RenderArtifact is kept after its last
+	// RenderBinding is removed. Zero deletes it immediately.
+	GCRetention time.Duration
+	// GCDryRun disables deleting unreferenced RenderArtifacts and their OCI tags.
+	GCDryRun bool
 	// WatchNamespace restricts reconciliation to this namespace.
 	// Should be empty in production (watches all namespaces).
 	// Intended for use in integration tests only.
@@ -135,30 +150,108 @@ func (r *RenderArtifactReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, errLogAndWrap(log, err, "failed to list RenderBindings for RenderArtifact")
 	}
 
-	// If no bindings remain, trigger GC by deleting this object.
-	// The finalizer above will intercept the deletion and handle OCI cleanup.
-	if len(bindingList.Items) == 0 {
-		// Confirm via direct API call — cache may lag on concurrent creates.
-		confirmed := &solarv1alpha1.RenderBindingList{}
-		if err := r.APIReader.List(ctx, confirmed, client.InNamespace(artifact.Namespace)); err != nil {
-			return ctrl.Result{}, errLogAndWrap(log, err, "failed to confirm RenderBinding absence via API")
-		}
-		for i := range confirmed.Items {
-			if confirmed.Items[i].Spec.RenderArtifactRef.Name == artifact.Name {
-				// A binding exists in the API server that the cache missed.
-				return ctrl.Result{}, nil
-			}
-		}
-		log.V(1).Info("No RenderBindings remain for RenderArtifact — triggering GC",
-			"artifact", artifact.Name)
-		if err := r.Delete(ctx, artifact); client.IgnoreNotFound(err) != nil {
-			return ctrl.Result{}, errLogAndWrap(log, err, "failed to delete orphaned RenderArtifact")
+	if len(bindingList.Items) > 0 {
+		return ctrl.Result{}, r.setReferenced(ctx, artifact, metav1.ConditionTrue, "Bound",
+			fmt.Sprintf("Referenced by %d RenderBinding(s)", len(bindingList.Items)))
+	}
+
+	// Confirm via direct API call — cache may lag on concurrent creates.
+	confirmed := &solarv1alpha1.RenderBindingList{}
+	if err := r.APIReader.List(ctx, confirmed, client.InNamespace(artifact.Namespace)); err != nil {
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to confirm RenderBinding absence via API")
+	}
+	for i := range confirmed.Items {
+		if confirmed.Items[i].Spec.RenderArtifactRef.Name == artifact.Name {
+			// A binding exists in the API server that the cache missed.
+			return ctrl.Result{}, nil
 		}
 	}
 
+	if err := r.setReferenced(ctx, artifact, metav1.ConditionFalse, "Unreferenced",
+		"No RenderBinding references this RenderArtifact"); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Keep the artifact until the retention window since the last binding was
+	// removed has passed.
+	unreferencedSince := apimeta.FindStatusCondition(artifact.Status.Conditions, ConditionTypeReferenced).LastTransitionTime
+	if wait := r.GCRetention - time.Since(unreferencedSince.Time); wait > 0 {
+		log.V(1).Info("RenderArtifact is unreferenced — retaining until the retention window passes",
+			"artifact", artifact.Name, "remaining", wait)
+
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	if r.GCDryRun {
+		return ctrl.Result{}, r.markDryRun(ctx, artifact)
+	}
+
+	// Trigger GC by deleting this object. The finalizer above will intercept
+	// the deletion and handle OCI cleanup.
+	log.V(1).Info("No RenderBindings remain for RenderArtifact — triggering GC",
+		"artifact", artifact.Name)
+	if err := r.Delete(ctx, artifact); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to delete orphaned RenderArtifact")
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// setReferenced sets the Referenced condition of artifact and patches its
+// status if the condition changed.
+func (r *RenderArtifactReconciler) setReferenced(ctx context.Context, artifact *solarv1alpha1.RenderArtifact, status metav1.ConditionStatus, reason, message string) error {
+	// Keep the reason of a dry run marker as long as the artifact stays unreferenced.
+	if cond := apimeta.FindStatusCondition(artifact.Status.Conditions, ConditionTypeReferenced); cond != nil &&
+		cond.Status == status && cond.Reason == reasonGCDryRun {
+		return nil
+	}
+
+	base := artifact.DeepCopy()
+	if !apimeta.SetStatusCondition(&artifact.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeReferenced,
+		Status:             status,
+		ObservedGeneration: artifact.Generation,
+		Reason:             reason,
+		Message:            message,
+	}) {
+		return nil
+	}
+	if err := r.Status().Patch(ctx, artifact, client.MergeFrom(base)); err != nil {
+		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to update RenderArtifact status")
+	}
+
+	return nil
+}
+
+// markDryRun records in the Referenced condition and an event that GC would
+// delete artifact. The event is only emitted once per unreferenced period.
+func (r *RenderArtifactReconciler) markDryRun(ctx context.Context, artifact *solarv1alpha1.RenderArtifact) error {
+	if apimeta.FindStatusCondition(artifact.Status.Conditions, ConditionTypeReferenced).Reason == reasonGCDryRun {
+		return nil
+	}
+
+	chartURL := renderChartURL(artifact.Spec.BaseURL, artifact.Spec.Repository, artifact.Spec.Tag)
+	ctrl.LoggerFrom(ctx).Info("GC dry run: would delete unreferenced RenderArtifact",
+		"artifact", artifact.Name, "chartURL", chartURL)
+	r.Recorder.Eventf(artifact, nil, corev1.EventTypeNormal,
+		reasonGCDryRun, "Delete",
+		"Dry run: would delete unreferenced RenderArtifact and OCI tag %s", chartURL)
+
+	base := artifact.DeepCopy()
+	apimeta.SetStatusCondition(&artifact.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeReferenced,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: artifact.Generation,
+		Reason:             reasonGCDryRun,
+		Message:            "No RenderBinding references this RenderArtifact; GC dry run is enabled, so it is not deleted",
+	})
+	if err := r.Status().Patch(ctx, artifact, client.MergeFrom(base)); err != nil {
+		return errLogAndWrap(ctrl.LoggerFrom(ctx), err, "failed to update RenderArtifact status")
+	}
+
+	return nil
+}
+
 // cleanupOCIArtifact attempts to delete the OCI tag from the registry.
 // On failure it sets a status condition and fires a Warning event so the user
 // can see why the RenderArtifact is stuck, then returns the error to keep the
@@ -287,6 +380,10 @@ func ociAuthFromSecret(secret *corev1.Secret, registryHost string) (authn.Authen
 		return authn.Anonymous, nil
 	}
 
+	if token := secret.Data[pushSecretTokenKey]; secret.Type == corev1.SecretTypeOpaque && len(token) > 0 {
+		return authn.FromConfig(authn.AuthConfig{RegistryToken: string(token)}), nil
+	}
+
 	data := secret.Data[corev1.DockerConfigJsonKey]
 	if len(data) == 0 {
 		return authn.Anonymous, nil
@@ -341,5 +438,5 @@ func (r *RenderArtifactReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&solarv1alpha1.RenderBinding{},
 			handler.EnqueueRequestsFromMapFunc(mapRenderBindingToArtifact),
 		).
-		Complete(r)
+		Complete(observability.WrapReconciler("RenderArtifact", r))
 }
@@ -256,6 +256,22 @@ var _ = Describe("RenderArtifactController", Ordered, func() {
 		})
 	})
 
+	Context("ociAuthFromSecret: bearer token", Label("renderartifact"), func() {
+		It("should use the token of an Opaque secret", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "push-token", Namespace: ns.Name},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       map[string][]byte{"token": []byte("my-token")},
+			}
+
+			auth, err := ociAuthFromSecret(secret, "registry.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := auth.Authorization()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.RegistryToken).To(Equal("my-token"))
+		})
+	})
+
 	Context("OCI delete failure surfaces as condition", Label("renderartifact"), func() {
 		It("should set OCICleanup=False condition and keep the finalizer when DeleteTag fails", func() {
 			art := newArtifact("art-oci-fail")
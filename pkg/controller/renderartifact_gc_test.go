@@ -0,0 +1,168 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func newGCTestArtifact(name string, conditions ...metav1.Condition) *solarv1alpha1.RenderArtifact {
+	spec := solarv1alpha1.RenderArtifactSpec{
+		BaseURL:    "oci://registry.example.com",
+		Repository: "default/release-" + name,
+		Tag:        "v0.0.0",
+	}
+
+	return &solarv1alpha1.RenderArtifact{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			Finalizers: []string{renderArtifactFinalizer},
+		},
+		Spec: spec,
+		Status: solarv1alpha1.RenderArtifactStatus{
+			ChartURL:   renderChartURL(spec.BaseURL, spec.Repository, spec.Tag),
+			Conditions: conditions,
+		},
+	}
+}
+
+func newGCTestReconciler(objs ...client.Object) (*RenderArtifactReconciler, client.Client, *events.FakeRecorder) {
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	c := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(objs...).
+		WithStatusSubresource(&solarv1alpha1.RenderArtifact{}).
+		WithIndex(&solarv1alpha1.RenderBinding{}, indexRenderBindingArtifactName, func(obj client.Object) []string {
+			return []string{obj.(*solarv1alpha1.RenderBinding).Spec.RenderArtifactRef.Name}
+		}).
+		Build()
+	recorder := events.NewFakeRecorder(64)
+
+	return &RenderArtifactReconciler{
+		Client:    c,
+		Scheme:    sch,
+		Recorder:  recorder,
+		APIReader: c,
+	}, c, recorder
+}
+
+func reconcileGC(t *testing.T, r *RenderArtifactReconciler, c client.Client, artifact *solarv1alpha1.RenderArtifact) (reconcile.Result, *solarv1alpha1.RenderArtifact) {
+	t.Helper()
+
+	res, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(artifact)})
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got := &solarv1alpha1.RenderArtifact{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(artifact), got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	return res, got
+}
+
+func unreferencedSince(d time.Duration) metav1.Condition {
+	return metav1.Condition{
+		Type:               ConditionTypeReferenced,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Unreferenced",
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-d)),
+	}
+}
+
+func TestReconcileRenderArtifact_MarksReferencedArtifacts(t *testing.T) {
+	t.Parallel()
+
+	artifact := newGCTestArtifact("bound", unreferencedSince(time.Hour))
+	r, c, _ := newGCTestReconciler(artifact, &solarv1alpha1.RenderBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: artifact.Namespace},
+		Spec: solarv1alpha1.RenderBindingSpec{
+			RenderArtifactRef: corev1.LocalObjectReference{Name: artifact.Name},
+		},
+	})
+
+	_, got := reconcileGC(t, r, c, artifact)
+
+	if !apimeta.IsStatusConditionTrue(got.Status.Conditions, ConditionTypeReferenced) {
+		t.Errorf("Referenced condition = %+v, want True", got.Status.Conditions)
+	}
+	if got.DeletionTimestamp != nil {
+		t.Error("referenced RenderArtifact was deleted")
+	}
+}
+
+func TestReconcileRenderArtifact_RetainsUnreferencedArtifacts(t *testing.T) {
+	t.Parallel()
+
+	artifact := newGCTestArtifact("retained")
+	r, c, _ := newGCTestReconciler(artifact)
+	r.GCRetention = time.Hour
+
+	res, got := reconcileGC(t, r, c, artifact)
+
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionTypeReferenced)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != "Unreferenced" {
+		t.Errorf("Referenced condition = %+v, want False with reason Unreferenced", cond)
+	}
+	if got.DeletionTimestamp != nil {
+		t.Error("RenderArtifact was deleted within the retention window")
+	}
+	if res.RequeueAfter <= 0 || res.RequeueAfter > time.Hour {
+		t.Errorf("RequeueAfter = %s, want the remaining retention", res.RequeueAfter)
+	}
+}
+
+func TestReconcileRenderArtifact_DeletesAfterRetention(t *testing.T) {
+	t.Parallel()
+
+	artifact := newGCTestArtifact("expired", unreferencedSince(2*time.Hour))
+	r, c, _ := newGCTestReconciler(artifact)
+	r.GCRetention = time.Hour
+
+	_, got := reconcileGC(t, r, c, artifact)
+
+	if got.DeletionTimestamp == nil {
+		t.Error("RenderArtifact was not deleted after the retention window")
+	}
+}
+
+func TestReconcileRenderArtifact_DryRun(t *testing.T) {
+	t.Parallel()
+
+	artifact := newGCTestArtifact("dryrun")
+	r, c, recorder := newGCTestReconciler(artifact)
+	r.GCDryRun = true
+
+	_, got := reconcileGC(t, r, c, artifact)
+	_, got = reconcileGC(t, r, c, got)
+
+	if got.DeletionTimestamp != nil {
+		t.Error("RenderArtifact was deleted in dry run mode")
+	}
+	if cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionTypeReferenced); cond == nil || cond.Reason != reasonGCDryRun {
+		t.Errorf("Referenced condition = %+v, want reason %s", cond, reasonGCDryRun)
+	}
+	if n := len(recorder.Events); n != 1 {
+		t.Errorf("got %d events, want a single dry run event", n)
+	}
+}
@@ -7,27 +7,42 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 const (
 	annotationJobName    = "solar.opendefense.cloud/job-name"
 	annotationSecretName = "solar.opendefense.cloud/secret-name"
+	// sensitiveValuesKey is the key of the Secret referenced by
+	// Spec.SensitiveValuesSecretRef holding the sensitive values.
+	sensitiveValuesKey = "values.json"
+	// annotationDisableDedupe set to "true" makes a RenderTask run its own
+	// render job even if the controller deduplicates RenderTasks.
+	annotationDisableDedupe = "solar.opendefense.cloud/disable-dedupe"
+	// annotationDryRun set to "true" makes a RenderTask render its chart
+	// without pushing it and record a summary in Status.Preview instead.
+	annotationDryRun = "solar.opendefense.cloud/dry-run"
 
 	// Condition types
 	ConditionTypeJobScheduled = "JobScheduled"
@@ -36,23 +51,103 @@ const (
 
 	ConditionTypeTaskCompleted = "TaskCompleted"
 	ConditionTypeTaskFailed    = "TaskFailed"
+
+	// ConditionTypePending is True while a RenderTask waits for a free render slot
+	// because MaxConcurrentRenders render jobs are already running.
+	ConditionTypePending = "Pending"
+
+	// ConditionTypeRetrying is True while a RenderTask waits out the backoff of
+	// its RetryPolicy before replacing a failed render job.
+	ConditionTypeRetrying = "Retrying"
+
+	// rendererContainerName is the name of the renderer container in the job's pod.
+	rendererContainerName = "renderer"
+	// pushSecretTokenKey is the key of the bearer token in an Opaque push Secret.
+	pushSecretTokenKey = "token"
+	// signingKeySecretKey is the key of the private key in the signing key Secret.
+	signingKeySecretKey = "cosign.key"
+	// signingTokenAudience is the audience of the service account token
+	// render jobs exchange for a signing certificate with Fulcio.
+	signingTokenAudience = "sigstore"
+	// signingTokenExpirationSeconds is the lifetime of that token.
+	signingTokenExpirationSeconds = int64(600)
+	// failureLogTailLines is the number of renderer log lines fetched from a failed pod.
+	failureLogTailLines = 20
+
+	// renderQueueRequeueInterval is how often a queued RenderTask re-checks for a free slot.
+	renderQueueRequeueInterval = 15 * time.Second
+
+	// defaultRenderRetryBackoff is the time to wait before the first retry if
+	// the RetryPolicy sets no backoff.
+	defaultRenderRetryBackoff = 10 * time.Second
+	// maxRenderRetryBackoff caps the exponential backoff between retries.
+	maxRenderRetryBackoff = 10 * time.Minute
+
+	// defaultJobBackoffLimit is the backoff limit of render jobs if neither
+	// the RenderTask nor the reconciler set one.
+	defaultJobBackoffLimit = int32(3)
+	// defaultJobTTL is the TTL of finished render jobs in seconds if neither
+	// the RenderTask nor the reconciler set one.
+	defaultJobTTL = int32(3600)
 )
 
 // RenderTaskReconciler reconciles a RenderTask object.
 // Each RenderTask carries its own BaseURL and PushSecretRef for the target registry.
 type RenderTaskReconciler struct {
 	client.Client
+	// APIReader reads RenderTasks past the informer cache before recreating
+	// a missing job or config secret, see isStale. Nil disables the check.
+	APIReader           client.Reader
 	Scheme              *runtime.Scheme
 	Recorder            events.EventRecorder
 	RendererImage       string
 	RendererCommand     string
 	RendererArgs        []string
 	RendererCAConfigMap string
+	// RendererTypes overrides the renderer container of render jobs by the
+	// Type of the RenderTask's RendererConfig, so each type can be rendered
+	// with its own toolchain. Types without an entry use RendererImage,
+	// RendererCommand and RendererArgs.
+	RendererTypes map[solarv1alpha1.RendererConfigType]RendererContainer
 	// RendererImagePullSecrets is the list of Secret names that kubelets in
 	// each RenderTask namespace should use to pull the renderer image. Each
 	// name must reference an existing Secret of type
 	// kubernetes.io/dockerconfigjson in the RenderTask's namespace.
 	RendererImagePullSecrets []string
+	// MaxConcurrentRenders limits the number of render jobs running at the same
+	// time. RenderTasks beyond the limit are queued with a Pending condition and
+	// admitted by descending Spec.Priority, oldest first. Zero disables the limit.
+	MaxConcurrentRenders int
+	// PodLogs is used to read the logs of failed renderer pods, which are
+	// recorded on the RenderTask to ease troubleshooting. Nil disables this.
+	PodLogs corev1client.PodsGetter
+	// ReportDigest makes render jobs report the digests, size and push time
+	// of the rendered chart in their termination message, which are recorded
+	// in Status.ContentDigest, Status.ChartDigest, Status.ChartSize and
+	// Status.RenderedAt. Requires PodLogs.
+	ReportDigest bool
+	// SigningKeySecret is the name of a Secret holding a PEM encoded ECDSA
+	// private key under the key cosign.key, which render jobs sign pushed
+	// charts with. It must exist in every namespace where RenderTasks are
+	// created.
+	SigningKeySecret string
+	// KeylessSigning makes render jobs sign pushed charts with a key Fulcio
+	// certifies for the identity of the job's service account. Cannot be
+	// combined with SigningKeySecret.
+	KeylessSigning bool
+	// Deduplicate makes RenderTasks with the same config hash as a RenderTask
+	// in the same namespace that is rendering or has rendered the chart wait
+	// for that RenderTask instead of running another render job.
+	Deduplicate bool
+	// JobBackoffLimit is the backoff limit of render jobs whose RenderTask
+	// sets none. Nil defaults to 3.
+	JobBackoffLimit *int32
+	// JobTTL is the time in seconds to keep finished render jobs whose
+	// RenderTask sets no FailedJobTTL. Nil defaults to one hour.
+	JobTTL *int32
+	// JobActiveDeadlineSeconds limits the run time of render jobs whose
+	// RenderTask sets no ActiveDeadlineSeconds. Zero disables the limit.
+	JobActiveDeadlineSeconds int64
 	// WatchNamespace restricts reconciliation to this namespace.
 	// Should be empty in production (watches all namespaces).
 	// Intended for use in integration tests only.
@@ -60,11 +155,26 @@ type RenderTaskReconciler struct {
 	WatchNamespace string
 }
 
+// RendererContainer configures the renderer container of render jobs for one
+// RendererConfig type. Empty fields fall back to the settings of the
+// RenderTaskReconciler.
+type RendererContainer struct {
+	// Image is the image of the renderer container.
+	Image string
+	// Command is the command of the renderer container.
+	Command string
+	// Args are additional args for the renderer CLI. They replace
+	// RendererArgs unless nil.
+	Args []string
+}
+
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=rendertasks,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=rendertasks/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=rendertasks/finalizers,verbs=update
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list
+//+kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 //+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile moves the current state of the cluster closer to the desired state
@@ -104,13 +214,38 @@ func (r *RenderTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrlResult, nil
 	}
 
+	// Record the config hash identical RenderTasks are found by
+	hash, err := renderTaskConfigHash(res)
+	if err != nil {
+		return ctrlResult, errLogAndWrap(log, err, "failed to compute config hash")
+	}
+	if res.Status.ConfigHash != hash {
+		res.Status.ConfigHash = hash
+		if err := r.Status().Update(ctx, res); err != nil {
+			return ctrlResult, errLogAndWrap(log, err, "failed to update status")
+		}
+	}
+
+	if r.Deduplicate && res.Status.JobRef == nil && res.Annotations[annotationDisableDedupe] != "true" && !isDryRun(res) {
+		deduplicated, result, err := r.reconcileDuplicate(ctx, res)
+		if err != nil || deduplicated {
+			return result, err
+		}
+	}
+
 	// Determine the namespace for Jobs/Secrets — use the RenderTask's namespace
 	jobNS := r.taskNamespace(res)
 
 	// Reconcile Config Secret
 	configSecret := &corev1.Secret{}
-	err := r.Get(ctx, r.configSecretKey(res, jobNS), configSecret)
+	err = r.Get(ctx, r.configSecretKey(res, jobNS), configSecret)
 	if err != nil && apierrors.IsNotFound(err) {
+		if res.Status.ConfigSecretRef != nil {
+			if stale, err := r.isStale(ctx, res); err != nil || stale {
+				return ctrlResult, err
+			}
+		}
+
 		createdSecret, err := r.createConfigSecret(ctx, res, jobNS)
 		if err != nil {
 			r.Recorder.Eventf(res, nil, corev1.EventTypeWarning, "CreateSecretFailed", "CreateConfigSecret", "Failed to create config secret: %s", err)
@@ -123,6 +258,13 @@ func (r *RenderTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrlResult, errLogAndWrap(log, err, "could not get secret")
 	}
 
+	// Record references the status update after creating the resources lost.
+	refsChanged := false
+	if res.Status.ConfigSecretRef == nil {
+		res.Status.ConfigSecretRef = configSecretRef(configSecret)
+		refsChanged = true
+	}
+
 	// Resolve push secret from the RenderTask's PushSecretRef
 	var pushSecret *corev1.Secret
 	if res.Spec.PushSecretRef != nil {
@@ -132,11 +274,79 @@ func (r *RenderTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	// Wait out the backoff before replacing a failed render job
+	retrying := apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeRetrying)
+	if retrying {
+		cond := apimeta.FindStatusCondition(res.Status.Conditions, ConditionTypeRetrying)
+		if wait := renderRetryBackoff(res) - time.Since(cond.LastTransitionTime.Time); wait > 0 {
+			log.V(1).Info("Waiting before retrying render job", "attempts", res.Status.Attempts, "remainingSeconds", wait.Seconds())
+
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
 	// Reconcile Job
 	job := &batchv1.Job{}
 	err = r.Get(ctx, r.renderJobKey(res, jobNS), job)
 	if err != nil && apierrors.IsNotFound(err) {
-		err := r.createRenderJob(ctx, res, configSecret, pushSecret, jobNS)
+		if res.Status.JobRef != nil && !retrying {
+			if stale, err := r.isStale(ctx, res); err != nil || stale {
+				return ctrlResult, err
+			}
+		}
+
+		// A RenderTask replacing a failed render job keeps its render slot.
+		admitted := retrying
+		if !admitted {
+			admitted, err = r.admitRenderJob(ctx, res)
+			if err != nil {
+				return ctrlResult, errLogAndWrap(log, err, "failed to evaluate render queue")
+			}
+		}
+
+		if !admitted {
+			if apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypePending,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: res.Generation,
+				Reason:             "Queued",
+				Message:            fmt.Sprintf("Waiting for a free render slot (max concurrent renders: %d)", r.MaxConcurrentRenders),
+			}) {
+				r.Recorder.Eventf(res, nil, corev1.EventTypeNormal, "Queued", "QueueJob", "Render job queued with priority %d, waiting for a free render slot", res.Spec.Priority)
+				if err := r.Status().Update(ctx, res); err != nil {
+					return ctrlResult, errLogAndWrap(log, err, "failed to update status")
+				}
+			}
+
+			log.V(1).Info("Render job queued", "priority", res.Spec.Priority)
+
+			return ctrl.Result{RequeueAfter: renderQueueRequeueInterval}, nil
+		}
+
+		if apimeta.FindStatusCondition(res.Status.Conditions, ConditionTypePending) != nil {
+			// Persisted together with the JobRef by createRenderJob.
+			apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypePending,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: res.Generation,
+				Reason:             "Admitted",
+				Message:            "Render slot acquired",
+			})
+		}
+
+		if retrying {
+			// Persisted together with the JobRef by createRenderJob.
+			apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypeRetrying,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: res.Generation,
+				Reason:             "Retried",
+				Message:            fmt.Sprintf("Started renderer job attempt %d", res.Status.Attempts+1),
+			})
+			r.Recorder.Eventf(res, nil, corev1.EventTypeNormal, "Retrying", "RetryJob", "Starting renderer job attempt %d", res.Status.Attempts+1)
+		}
+
+		err = r.createRenderJob(ctx, res, configSecret, pushSecret, jobNS)
 		if err != nil {
 			r.Recorder.Eventf(res, nil, corev1.EventTypeWarning, "CreateJobFailed", "CreateJob", "Failed to create job: %s", err)
 
@@ -144,16 +354,23 @@ func (r *RenderTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	} else if err != nil {
 		return ctrlResult, errLogAndWrap(log, err, "could not get job")
+	} else if !job.DeletionTimestamp.IsZero() {
+		// The failed job is still being deleted, its deletion triggers the retry.
+		return ctrlResult, nil
+	} else if res.Status.JobRef == nil {
+		res.Status.JobRef = jobRef(job)
+		res.Status.Attempts = max(res.Status.Attempts, 1)
+		refsChanged = true
 	}
 
 	// Update Status
-	if changed := r.updateResourceStatusFromJob(ctx, res, job); changed {
+	if changed := r.updateResourceStatusFromJob(ctx, res, job); changed || refsChanged {
 		if err := r.Status().Update(ctx, res); err != nil {
 			return ctrlResult, errLogAndWrap(log, err, "failed to update status")
 		}
 	}
 
-	ttlDuration := time.Duration(ttlSeconds(res.Spec.FailedJobTTL)) * time.Second
+	ttlDuration := time.Duration(r.jobTTL(res)) * time.Second
 
 	switch {
 	case job.Status.Succeeded > 0:
@@ -163,6 +380,15 @@ func (r *RenderTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrlResult, nil
 
 	case job.Status.Failed > 0:
+		if apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeRetrying) {
+			// The config secret is kept for the next render job.
+			if err := r.deleteRenderJob(ctx, res, jobNS); err != nil && !apierrors.IsNotFound(err) {
+				return ctrlResult, errLogAndWrap(log, err, "failed to delete failed job")
+			}
+
+			return ctrl.Result{RequeueAfter: renderRetryBackoff(res)}, nil
+		}
+
 		if shouldCleanupSecrets(res, ttlDuration) {
 			cleanupSecrets(ctx, r, res, jobNS)
 			log.V(1).Info("Cleaned up secrets after failed job TTL")
@@ -179,6 +405,224 @@ func (r *RenderTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrlResult, nil
 }
 
+// admitRenderJob reports whether res may start its render job without exceeding
+// MaxConcurrentRenders. The limit is enforced on a best-effort basis from the
+// informer cache, so it may briefly be exceeded while the cache catches up.
+func (r *RenderTaskReconciler) admitRenderJob(ctx context.Context, res *solarv1alpha1.RenderTask) (bool, error) {
+	if r.MaxConcurrentRenders <= 0 {
+		return true, nil
+	}
+
+	var opts []client.ListOption
+	if r.WatchNamespace != "" {
+		opts = append(opts, client.InNamespace(r.WatchNamespace))
+	}
+
+	rtList := &solarv1alpha1.RenderTaskList{}
+	if err := r.List(ctx, rtList, opts...); err != nil {
+		return false, err
+	}
+
+	return renderQueueAdmits(res, rtList.Items, r.MaxConcurrentRenders), nil
+}
+
+// renderQueueAdmits reports whether res is among the queued RenderTasks that fit
+// into the free render slots. RenderTasks with a JobRef and no terminal condition
+// occupy a slot; RenderTasks without a JobRef are queued and ordered by
+// renderQueueLess.
+func renderQueueAdmits(res *solarv1alpha1.RenderTask, tasks []solarv1alpha1.RenderTask, maxConcurrent int) bool {
+	running := 0
+	queued := []*solarv1alpha1.RenderTask{res}
+
+	for i := range tasks {
+		rt := &tasks[i]
+		if rt.Namespace == res.Namespace && rt.Name == res.Name {
+			continue
+		}
+
+		if apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobSucceeded) ||
+			apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobFailed) {
+			continue
+		}
+
+		switch {
+		case rt.Status.JobRef != nil:
+			running++
+		case rt.Status.PrimaryRef != nil:
+			// Waits for the render job of another RenderTask.
+		case rt.DeletionTimestamp.IsZero():
+			queued = append(queued, rt)
+		}
+	}
+
+	slots := maxConcurrent - running
+	if slots <= 0 {
+		return false
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool { return renderQueueLess(queued[i], queued[j]) })
+
+	for _, rt := range queued[:min(slots, len(queued))] {
+		if rt == res {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderQueueLess orders queued RenderTasks by descending priority, then by
+// creation time and finally by namespace/name to keep the order deterministic.
+func renderQueueLess(a, b *solarv1alpha1.RenderTask) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+
+	return a.Name < b.Name
+}
+
+// reconcileDuplicate links res to an identical RenderTask that is rendering or
+// has rendered the same chart, and takes over its result. It reports whether
+// res was deduplicated; otherwise res must run its own render job.
+func (r *RenderTaskReconciler) reconcileDuplicate(ctx context.Context, res *solarv1alpha1.RenderTask) (bool, ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if res.Status.PrimaryRef != nil && apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeJobFailed) {
+		return true, ctrl.Result{}, nil
+	}
+
+	rtList := &solarv1alpha1.RenderTaskList{}
+	if err := r.List(ctx, rtList, client.InNamespace(res.Namespace)); err != nil {
+		return false, ctrl.Result{}, errLogAndWrap(log, err, "failed to list RenderTasks")
+	}
+
+	primary := findPrimaryRenderTask(res, rtList.Items)
+	if primary == nil {
+		if res.Status.PrimaryRef == nil {
+			return false, ctrl.Result{}, nil
+		}
+
+		// The primary is gone before it rendered the chart, render it here.
+		log.V(1).Info("Primary RenderTask is gone, rendering", "primary", res.Status.PrimaryRef.Name)
+		res.Status.PrimaryRef = nil
+		if err := r.Status().Update(ctx, res); err != nil {
+			return false, ctrl.Result{}, errLogAndWrap(log, err, "failed to update status")
+		}
+
+		return false, ctrl.Result{}, nil
+	}
+
+	changed := res.Status.PrimaryRef == nil
+	res.Status.PrimaryRef = &corev1.LocalObjectReference{Name: primary.Name}
+	if apimeta.RemoveStatusCondition(&res.Status.Conditions, ConditionTypePending) {
+		changed = true
+	}
+
+	result := ctrl.Result{}
+	switch {
+	case apimeta.IsStatusConditionTrue(primary.Status.Conditions, ConditionTypeJobSucceeded):
+		message := "Chart rendered by RenderTask " + primary.Name
+		apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeJobSucceeded,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: res.Generation,
+			Reason:             "Deduplicated",
+			Message:            message,
+		})
+		res.Status.ChartURL = primary.Status.ChartURL
+		res.Status.ContentDigest = primary.Status.ContentDigest
+		res.Status.ChartDigest = primary.Status.ChartDigest
+		res.Status.ChartSize = primary.Status.ChartSize
+		res.Status.SignatureRef = primary.Status.SignatureRef
+		res.Status.RenderedAt = primary.Status.RenderedAt
+		changed = true
+		r.Recorder.Eventf(res, primary, corev1.EventTypeNormal, "Deduplicated", "Deduplicate", "%s", message)
+
+	case apimeta.IsStatusConditionTrue(primary.Status.Conditions, ConditionTypeJobFailed):
+		message := fmt.Sprintf("Renderer job of RenderTask %s failed", primary.Name)
+		if cond := apimeta.FindStatusCondition(primary.Status.Conditions, ConditionTypeJobFailed); cond.Message != "" {
+			message += ": " + cond.Message
+		}
+		apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeJobFailed,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: res.Generation,
+			Reason:             "JobFailed",
+			Message:            message,
+		})
+		changed = true
+		r.Recorder.Eventf(res, primary, corev1.EventTypeWarning, "JobFailed", "RunJob", "%s", message)
+
+	default:
+		if apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeJobScheduled,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: res.Generation,
+			Reason:             "Deduplicated",
+			Message:            "Waiting for the render job of RenderTask " + primary.Name,
+		}) {
+			changed = true
+			r.Recorder.Eventf(res, primary, corev1.EventTypeNormal, "Deduplicated", "Deduplicate",
+				"Waiting for the render job of RenderTask %s", primary.Name)
+		}
+		result.RequeueAfter = renderQueueRequeueInterval
+	}
+
+	if changed {
+		if err := r.Status().Update(ctx, res); err != nil {
+			return true, ctrl.Result{}, errLogAndWrap(log, err, "failed to update status")
+		}
+	}
+
+	return true, result, nil
+}
+
+// findPrimaryRenderTask returns the RenderTask res takes its chart from. That
+// is the one referenced by res.Status.PrimaryRef if it still exists, or else
+// the oldest RenderTask with the same config hash that runs or ran its own
+// render job without failing. Returns nil if there is none.
+func findPrimaryRenderTask(res *solarv1alpha1.RenderTask, tasks []solarv1alpha1.RenderTask) *solarv1alpha1.RenderTask {
+	var primary *solarv1alpha1.RenderTask
+	for i := range tasks {
+		rt := &tasks[i]
+		if rt.Namespace != res.Namespace || rt.Name == res.Name || !rt.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if res.Status.PrimaryRef != nil {
+			if rt.Name == res.Status.PrimaryRef.Name {
+				return rt
+			}
+
+			continue
+		}
+
+		if rt.Status.ConfigHash == "" || rt.Status.ConfigHash != res.Status.ConfigHash ||
+			rt.Status.PrimaryRef != nil || rt.Annotations[annotationDisableDedupe] == "true" || isDryRun(rt) ||
+			apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobFailed) {
+			continue
+		}
+		if rt.Status.JobRef == nil && !apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobSucceeded) {
+			continue
+		}
+
+		if primary == nil || rt.CreationTimestamp.Before(&primary.CreationTimestamp) ||
+			(rt.CreationTimestamp.Equal(&primary.CreationTimestamp) && rt.Name < primary.Name) {
+			primary = rt
+		}
+	}
+
+	return primary
+}
+
 // taskNamespace returns the namespace to use for Jobs/Secrets.
 func (r *RenderTaskReconciler) taskNamespace(res *solarv1alpha1.RenderTask) string {
 	return res.Namespace
@@ -209,10 +653,20 @@ func (r *RenderTaskReconciler) updateResourceStatusFromJob(ctx context.Context,
 			Message:            fmt.Sprintf("Renderer job completed successfully at %v", job.Status.CompletionTime),
 		})
 
-		chartURL := r.reference(res.Spec.BaseURL, res.Spec.Repository, res.Spec.Tag)
-		if res.Status.ChartURL != chartURL {
-			res.Status.ChartURL = chartURL
-			changed = true
+		if isDryRun(res) {
+			if r.recordRenderPreview(ctx, res, r.renderReport(ctx, res, job)) {
+				changed = true
+			}
+		} else {
+			chartURL := r.reference(res.Spec.BaseURL, res.Spec.Repository, res.Spec.Tag)
+			if res.Status.ChartURL != chartURL {
+				res.Status.ChartURL = chartURL
+				changed = true
+			}
+
+			if recordRenderReport(res, r.renderReport(ctx, res, job), job) {
+				changed = true
+			}
 		}
 
 		r.Recorder.Eventf(res, job, corev1.EventTypeNormal, "JobSucceeded", "RunJob", "Renderer job completed successfully")
@@ -221,15 +675,27 @@ func (r *RenderTaskReconciler) updateResourceStatusFromJob(ctx context.Context,
 		return changed
 	}
 
+	if job.Status.Failed > 0 && renderRetriesLeft(res) {
+		return r.setRetrying(ctx, res, job)
+	}
+
 	if job.Status.Failed > 0 {
+		// Logs are only fetched once, when the failure is first observed.
+		message := "Renderer job failed"
+		if cond := apimeta.FindStatusCondition(res.Status.Conditions, ConditionTypeJobFailed); cond != nil && cond.Status == metav1.ConditionTrue {
+			message = cond.Message
+		} else if logs := r.failedJobLogs(ctx, job); logs != "" {
+			message = fmt.Sprintf("Renderer job failed, last log lines:\n%s", logs)
+		}
+
 		changed = apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
 			Type:               ConditionTypeJobFailed,
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: res.Generation,
 			Reason:             "JobFailed",
-			Message:            "Renderer job failed",
+			Message:            message,
 		})
-		r.Recorder.Eventf(res, job, corev1.EventTypeWarning, "JobFailed", "RunJob", "Renderer job failed")
+		r.Recorder.Eventf(res, job, corev1.EventTypeWarning, "JobFailed", "RunJob", "%s", message)
 		log.V(1).Info("Job failed", "name", job.Name)
 
 		return changed
@@ -244,6 +710,240 @@ func (r *RenderTaskReconciler) updateResourceStatusFromJob(ctx context.Context,
 	})
 }
 
+// setRetrying records the failure of the render job of res as a Retrying
+// condition. The condition's transition time starts the backoff, so it is
+// left alone once set for the current attempt.
+func (r *RenderTaskReconciler) setRetrying(ctx context.Context, res *solarv1alpha1.RenderTask, job *batchv1.Job) bool {
+	log := ctrl.LoggerFrom(ctx)
+
+	if apimeta.IsStatusConditionTrue(res.Status.Conditions, ConditionTypeRetrying) {
+		return false
+	}
+
+	attempts := max(res.Status.Attempts, 1)
+	message := fmt.Sprintf("Renderer job attempt %d of %d failed, retrying in %s",
+		attempts, res.Spec.RetryPolicy.MaxRetries+1, renderRetryBackoff(res))
+	if logs := r.failedJobLogs(ctx, job); logs != "" {
+		message = fmt.Sprintf("%s, last log lines:\n%s", message, logs)
+	}
+
+	apimeta.SetStatusCondition(&res.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeRetrying,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: res.Generation,
+		Reason:             "JobFailed",
+		Message:            message,
+	})
+	r.Recorder.Eventf(res, job, corev1.EventTypeWarning, "RetryScheduled", "RunJob", "%s", message)
+	log.V(1).Info("Job failed, retrying", "name", job.Name, "attempts", attempts)
+
+	return true
+}
+
+// signsCharts reports whether render jobs sign the charts they push. Their
+// signatures are reported as with ReportDigest.
+func (r *RenderTaskReconciler) signsCharts() bool {
+	return r.SigningKeySecret != "" || r.KeylessSigning
+}
+
+// rendererContainer returns the image, command and additional args of the
+// renderer container for RenderTasks of type t.
+func (r *RenderTaskReconciler) rendererContainer(t solarv1alpha1.RendererConfigType) (image, command string, args []string) {
+	image, command, args = r.RendererImage, r.RendererCommand, r.RendererArgs
+
+	c, ok := r.RendererTypes[t]
+	if !ok {
+		return image, command, args
+	}
+	if c.Image != "" {
+		image = c.Image
+	}
+	if c.Command != "" {
+		command = c.Command
+	}
+	if c.Args != nil {
+		args = c.Args
+	}
+
+	return image, command, args
+}
+
+// renderReport returns the RenderReport in the termination message of the
+// renderer container of the job's succeeded pod. Errors are logged and yield
+// no report, since the chart was pushed anyway. Dry runs always report.
+func (r *RenderTaskReconciler) renderReport(ctx context.Context, res *solarv1alpha1.RenderTask, job *batchv1.Job) *solarv1alpha1.RenderReport {
+	if (!r.ReportDigest && !r.signsCharts() && !isDryRun(res)) || r.PodLogs == nil {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	pods, err := r.PodLogs.Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{batchv1.JobNameLabel: job.Name}.String(),
+	})
+	if err != nil {
+		log.Error(err, "failed to list renderer pods", "job", job.Name)
+		return nil
+	}
+
+	return reportedRenderReport(pods.Items)
+}
+
+// recordRenderReport records report in the status of res and reports whether
+// it changed. Without a reported push time, the completion time of the job is
+// recorded once. The report is nil if the renderer did not report one, e.g.
+// because its pod is gone already.
+func recordRenderReport(res *solarv1alpha1.RenderTask, report *solarv1alpha1.RenderReport, job *batchv1.Job) bool {
+	changed := false
+	if report == nil {
+		report = &solarv1alpha1.RenderReport{}
+	}
+
+	if report.ContentDigest != "" && res.Status.ContentDigest != report.ContentDigest {
+		res.Status.ContentDigest = report.ContentDigest
+		changed = true
+	}
+	if report.ChartDigest != "" && res.Status.ChartDigest != report.ChartDigest {
+		res.Status.ChartDigest = report.ChartDigest
+		changed = true
+	}
+	if report.ChartSize > 0 && res.Status.ChartSize != report.ChartSize {
+		res.Status.ChartSize = report.ChartSize
+		changed = true
+	}
+	if report.SignatureRef != "" && res.Status.SignatureRef != report.SignatureRef {
+		res.Status.SignatureRef = report.SignatureRef
+		changed = true
+	}
+
+	switch renderedAt := report.RenderedAt; {
+	case renderedAt != nil && !renderedAt.Equal(res.Status.RenderedAt):
+		res.Status.RenderedAt = renderedAt.DeepCopy()
+		changed = true
+	case renderedAt == nil && res.Status.RenderedAt == nil && job.Status.CompletionTime != nil:
+		res.Status.RenderedAt = job.Status.CompletionTime.DeepCopy()
+		changed = true
+	}
+
+	return changed
+}
+
+// recordRenderPreview records the report of a dry run in the status of res,
+// compared to the chart most recently rendered for the same owner, and
+// reports whether it changed. Without a report there is nothing to preview.
+func (r *RenderTaskReconciler) recordRenderPreview(ctx context.Context, res *solarv1alpha1.RenderTask, report *solarv1alpha1.RenderReport) bool {
+	if report == nil {
+		return false
+	}
+
+	rtList := &solarv1alpha1.RenderTaskList{}
+	if err := r.List(ctx, rtList,
+		client.InNamespace(res.Namespace),
+		client.MatchingFields{indexOwnerKind: res.Spec.OwnerKind},
+	); err != nil {
+		// The preview is still useful without the comparison.
+		ctrl.LoggerFrom(ctx).Error(err, "failed to list RenderTasks of owner", "owner", res.Spec.OwnerName)
+	}
+
+	preview := renderPreview(report, currentRenderTask(res, rtList.Items))
+	if res.Status.ContentDigest == report.ContentDigest && apiequality.Semantic.DeepEqual(res.Status.Preview, preview) {
+		return false
+	}
+
+	res.Status.ContentDigest = report.ContentDigest
+	res.Status.Preview = preview
+
+	return true
+}
+
+// currentRenderTask returns the RenderTask among tasks that most recently
+// rendered and pushed a chart for the owner of res, or nil if there is none.
+func currentRenderTask(res *solarv1alpha1.RenderTask, tasks []solarv1alpha1.RenderTask) *solarv1alpha1.RenderTask {
+	var current *solarv1alpha1.RenderTask
+	for i := range tasks {
+		rt := &tasks[i]
+		if rt.Namespace != res.Namespace || rt.Name == res.Name || isDryRun(rt) ||
+			rt.Spec.OwnerKind != res.Spec.OwnerKind || rt.Spec.OwnerName != res.Spec.OwnerName ||
+			rt.Spec.OwnerNamespace != res.Spec.OwnerNamespace || rt.Status.ContentDigest == "" ||
+			!apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobSucceeded) {
+			continue
+		}
+
+		if current == nil || renderedAt(current).Before(renderedAt(rt)) {
+			current = rt
+		}
+	}
+
+	return current
+}
+
+// renderedAt returns when the chart of rt was rendered, falling back to the
+// creation of rt if unknown.
+func renderedAt(rt *solarv1alpha1.RenderTask) *metav1.Time {
+	if rt.Status.RenderedAt != nil {
+		return rt.Status.RenderedAt
+	}
+
+	return &rt.CreationTimestamp
+}
+
+// renderPreview summarizes the report of a dry run compared to the current
+// RenderTask of the same owner, which may be nil.
+func renderPreview(report *solarv1alpha1.RenderReport, current *solarv1alpha1.RenderTask) *solarv1alpha1.RenderPreview {
+	preview := &solarv1alpha1.RenderPreview{
+		Files:   report.Files,
+		Size:    report.Size,
+		Changed: true,
+	}
+	if current != nil {
+		preview.CurrentRenderTask = current.Name
+		preview.CurrentContentDigest = current.Status.ContentDigest
+		preview.Changed = current.Status.ContentDigest != report.ContentDigest
+	}
+
+	return preview
+}
+
+// isDryRun reports whether rt renders its chart without pushing it.
+func isDryRun(rt *solarv1alpha1.RenderTask) bool {
+	return rt.Annotations[annotationDryRun] == "true"
+}
+
+// failedJobLogs returns the last log lines of the renderer container of the
+// job's most recently failed pod. Errors are logged and yield no logs, since
+// they must not keep the failure from being recorded.
+func (r *RenderTaskReconciler) failedJobLogs(ctx context.Context, job *batchv1.Job) string {
+	if r.PodLogs == nil {
+		return ""
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	pods, err := r.PodLogs.Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{batchv1.JobNameLabel: job.Name}.String(),
+	})
+	if err != nil {
+		log.Error(err, "failed to list renderer pods", "job", job.Name)
+		return ""
+	}
+
+	pod := failedPod(pods.Items)
+	if pod == nil {
+		return ""
+	}
+
+	raw, err := r.PodLogs.Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: rendererContainerName,
+		TailLines: new(int64(failureLogTailLines)),
+	}).DoRaw(ctx)
+	if err != nil {
+		log.Error(err, "failed to get renderer logs", "pod", pod.Name)
+		return ""
+	}
+
+	return tailLog(string(raw), maxFailureLogBytes)
+}
+
 func (r *RenderTaskReconciler) deleteRenderJob(ctx context.Context, res *solarv1alpha1.RenderTask, jobNS string) error {
 	job := &batchv1.Job{}
 	if err := r.Get(ctx, r.renderJobKey(res, jobNS), job); err != nil {
@@ -267,11 +967,9 @@ func (r *RenderTaskReconciler) createRenderJob(ctx context.Context, res *solarv1
 
 	jobKey := r.renderJobKey(res, jobNS)
 	jobName := jobKey.Name
-	backoffLimit := int32(3)
-	ttlSecondsAfterFinished := int32(3600)
-	if res.Spec.FailedJobTTL != nil {
-		ttlSecondsAfterFinished = *res.Spec.FailedJobTTL
-	}
+	backoffLimit := r.jobBackoffLimit(res)
+	ttlSecondsAfterFinished := r.jobTTL(res)
+	activeDeadlineSeconds := r.jobActiveDeadlineSeconds(res)
 
 	volumes := []corev1.Volume{
 		{
@@ -316,6 +1014,29 @@ func (r *RenderTaskReconciler) createRenderJob(ctx context.Context, res *solarv1
 		},
 	}
 
+	if res.Spec.SensitiveValuesSecretRef != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "sensitive-values",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: res.Spec.SensitiveValuesSecretRef.Name,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  sensitiveValuesKey,
+							Path: sensitiveValuesKey,
+						},
+					},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "sensitive-values",
+			MountPath: "/etc/renderer/values.json",
+			SubPath:   sensitiveValuesKey,
+			ReadOnly:  true,
+		})
+	}
+
 	if r.RendererCAConfigMap != "" {
 		volumes = append(volumes, corev1.Volume{
 			Name: "ca-bundle",
@@ -344,13 +1065,79 @@ func (r *RenderTaskReconciler) createRenderJob(ctx context.Context, res *solarv1
 		})
 	}
 
+	// Let the renderer continue the trace of this reconcile.
+	traceEnv := observability.TraceContextEnv(ctx)
+	for _, name := range slices.Sorted(maps.Keys(traceEnv)) {
+		envVars = append(envVars, corev1.EnvVar{Name: name, Value: traceEnv[name]})
+	}
+
 	pushURL := r.reference(res.Spec.BaseURL, res.Spec.Repository, res.Spec.Tag)
 
-	args := slices.Clone(r.RendererArgs)
+	image, command, rendererArgs := r.rendererContainer(res.Spec.Type)
+	args := slices.Clone(rendererArgs)
 	args = append(args, "/etc/renderer/config.json", fmt.Sprintf("--url=%s", pushURL))
+	if res.Spec.SensitiveValuesSecretRef != nil {
+		args = append(args, "--values-file=/etc/renderer/values.json")
+	}
 	if res.Spec.PlainHTTP {
 		args = append(args, "--plain-http=true")
 	}
+	if isDryRun(res) {
+		args = append(args, "--skip-push")
+	}
+	if r.ReportDigest || r.signsCharts() || isDryRun(res) {
+		args = append(args, "--result-file="+corev1.TerminationMessagePathDefault)
+	}
+
+	if !isDryRun(res) {
+		switch {
+		case r.SigningKeySecret != "":
+			volumes = append(volumes, corev1.Volume{
+				Name: "signing-key",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: r.SigningKeySecret,
+						Items: []corev1.KeyToPath{
+							{
+								Key:  signingKeySecretKey,
+								Path: signingKeySecretKey,
+							},
+						},
+					},
+				},
+			})
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      "signing-key",
+				MountPath: "/etc/renderer/signing",
+				ReadOnly:  true,
+			})
+			args = append(args, "--sign-key=/etc/renderer/signing/"+signingKeySecretKey)
+
+		case r.KeylessSigning:
+			volumes = append(volumes, corev1.Volume{
+				Name: "sigstore-token",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{
+								ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+									Audience:          signingTokenAudience,
+									ExpirationSeconds: new(signingTokenExpirationSeconds),
+									Path:              "token",
+								},
+							},
+						},
+					},
+				},
+			})
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      "sigstore-token",
+				MountPath: "/var/run/sigstore",
+				ReadOnly:  true,
+			})
+			args = append(args, "--sign-keyless", "--identity-token=/var/run/sigstore/token")
+		}
+	}
 
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -363,14 +1150,15 @@ func (r *RenderTaskReconciler) createRenderJob(ctx context.Context, res *solarv1
 		Spec: batchv1.JobSpec{
 			BackoffLimit:            &backoffLimit,
 			TTLSecondsAfterFinished: &ttlSecondsAfterFinished,
+			ActiveDeadlineSeconds:   activeDeadlineSeconds,
 			Template: corev1.PodTemplateSpec{
 				Spec: corev1.PodSpec{
 					RestartPolicy: corev1.RestartPolicyNever,
 					Containers: []corev1.Container{
 						{
-							Name:         "renderer",
-							Image:        r.RendererImage,
-							Command:      []string{r.RendererCommand},
+							Name:         rendererContainerName,
+							Image:        image,
+							Command:      []string{command},
 							Args:         args,
 							Env:          envVars,
 							VolumeMounts: volumeMounts,
@@ -437,6 +1225,21 @@ func (r *RenderTaskReconciler) createRenderJob(ctx context.Context, res *solarv1
 				Name:  "DOCKER_CONFIG",
 				Value: "/etc/renderer/dockerconfig.json",
 			})
+
+		case corev1.SecretTypeOpaque:
+			if _, ok := pushSecret.Data[pushSecretTokenKey]; ok {
+				job.Spec.Template.Spec.Containers[0].Env = append(job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+					Name: "REGISTRY_TOKEN",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: pushSecret.Name,
+							},
+							Key: pushSecretTokenKey,
+						},
+					},
+				})
+			}
 		default:
 		}
 	}
@@ -460,12 +1263,8 @@ func (r *RenderTaskReconciler) createRenderJob(ctx context.Context, res *solarv1
 		return errLogAndWrap(log, err, "job creation failed")
 	}
 
-	res.Status.JobRef = &corev1.ObjectReference{
-		APIVersion: batchv1.SchemeGroupVersion.String(),
-		Kind:       "Job",
-		Namespace:  job.Namespace,
-		Name:       job.Name,
-	}
+	res.Status.Attempts++
+	res.Status.JobRef = jobRef(job)
 
 	if err := r.Status().Update(ctx, res); err != nil {
 		return errLogAndWrap(log, err, "failed to update status")
@@ -508,12 +1307,7 @@ func (r *RenderTaskReconciler) createConfigSecret(ctx context.Context, res *sola
 		return nil, errLogAndWrap(log, err, "secret creation failed")
 	}
 
-	res.Status.ConfigSecretRef = &corev1.ObjectReference{
-		APIVersion: corev1.SchemeGroupVersion.String(),
-		Kind:       "Secret",
-		Namespace:  secret.Namespace,
-		Name:       secret.Name,
-	}
+	res.Status.ConfigSecretRef = configSecretRef(secret)
 
 	if err := r.Status().Update(ctx, res); err != nil {
 		return nil, errLogAndWrap(log, err, "failed to update status")
@@ -522,6 +1316,49 @@ func (r *RenderTaskReconciler) createConfigSecret(ctx context.Context, res *sola
 	return secret, nil
 }
 
+// isStale reports whether res lags behind the RenderTask stored in the API
+// server. The job and config secret are deleted right after the success of a
+// render job is recorded, and the deletion may be observed before the
+// informer cache holds that status. Recreating them from such a stale
+// RenderTask would render the chart again, so the reconcile is skipped; the
+// status update triggers another one.
+func (r *RenderTaskReconciler) isStale(ctx context.Context, res *solarv1alpha1.RenderTask) (bool, error) {
+	if r.APIReader == nil {
+		return false, nil
+	}
+
+	live := &solarv1alpha1.RenderTask{}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(res), live); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if live.ResourceVersion == res.ResourceVersion {
+		return false, nil
+	}
+
+	ctrl.LoggerFrom(ctx).V(1).Info("RenderTask in cache is outdated, waiting for the cache to catch up",
+		"cachedResourceVersion", res.ResourceVersion, "resourceVersion", live.ResourceVersion)
+
+	return true, nil
+}
+
+func jobRef(job *batchv1.Job) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: batchv1.SchemeGroupVersion.String(),
+		Kind:       "Job",
+		Namespace:  job.Namespace,
+		Name:       job.Name,
+	}
+}
+
+func configSecretRef(secret *corev1.Secret) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: corev1.SchemeGroupVersion.String(),
+		Kind:       "Secret",
+		Namespace:  secret.Namespace,
+		Name:       secret.Name,
+	}
+}
+
 func (r *RenderTaskReconciler) configSecretKey(res *solarv1alpha1.RenderTask, jobNS string) client.ObjectKey {
 	return client.ObjectKey{
 		Name:      truncateName(fmt.Sprintf("render-%s", res.Name), maxK8sLabelValueLen),
@@ -547,12 +1384,71 @@ func (r *RenderTaskReconciler) reference(baseURL, repo, tag string) string {
 	return fmt.Sprintf("%s/%s:%s", base, repo, tag)
 }
 
-func ttlSeconds(ttl *int32) int32 {
-	if ttl != nil {
-		return *ttl
+// jobBackoffLimit returns the backoff limit of the render job of res.
+func (r *RenderTaskReconciler) jobBackoffLimit(res *solarv1alpha1.RenderTask) int32 {
+	switch {
+	case res.Spec.BackoffLimit != nil:
+		return *res.Spec.BackoffLimit
+	case r.JobBackoffLimit != nil:
+		return *r.JobBackoffLimit
+	}
+
+	return defaultJobBackoffLimit
+}
+
+// jobTTL returns the time in seconds to keep the finished render job of res.
+func (r *RenderTaskReconciler) jobTTL(res *solarv1alpha1.RenderTask) int32 {
+	switch {
+	case res.Spec.FailedJobTTL != nil:
+		return *res.Spec.FailedJobTTL
+	case r.JobTTL != nil:
+		return *r.JobTTL
+	}
+
+	return defaultJobTTL
+}
+
+// jobActiveDeadlineSeconds returns the active deadline of the render job of
+// res, or nil if its run time is not limited.
+func (r *RenderTaskReconciler) jobActiveDeadlineSeconds(res *solarv1alpha1.RenderTask) *int64 {
+	if res.Spec.ActiveDeadlineSeconds != nil {
+		return res.Spec.ActiveDeadlineSeconds
+	}
+	if r.JobActiveDeadlineSeconds > 0 {
+		return &r.JobActiveDeadlineSeconds
+	}
+
+	return nil
+}
+
+// renderRetriesLeft reports whether the RetryPolicy of res allows replacing
+// its failed render job by another one.
+func renderRetriesLeft(res *solarv1alpha1.RenderTask) bool {
+	if res.Spec.RetryPolicy == nil {
+		return false
+	}
+
+	// RenderTasks whose job was created before attempts were counted ran once.
+	return max(res.Status.Attempts, 1) <= res.Spec.RetryPolicy.MaxRetries
+}
+
+// renderRetryBackoff returns the time to wait after the failure of the
+// current attempt of res before starting the next one. The backoff doubles
+// with every attempt, up to maxRenderRetryBackoff.
+func renderRetryBackoff(res *solarv1alpha1.RenderTask) time.Duration {
+	backoff := defaultRenderRetryBackoff
+	if policy := res.Spec.RetryPolicy; policy != nil && policy.Backoff != nil {
+		backoff = policy.Backoff.Duration
+	}
+
+	for range max(res.Status.Attempts, 1) - 1 {
+		if backoff >= maxRenderRetryBackoff {
+			break
+		}
+		backoff *= 2
 	}
 
-	return 3600
+	return min(backoff, maxRenderRetryBackoff)
 }
 
 func shouldCleanupSecrets(res *solarv1alpha1.RenderTask, ttl time.Duration) bool {
@@ -594,5 +1490,5 @@ func (r *RenderTaskReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&solarv1alpha1.RenderTask{}).
 		Owns(&batchv1.Job{}).
 		Owns(&corev1.Secret{}).
-		Complete(r)
+		Complete(observability.WrapReconciler("RenderTask", r))
 }
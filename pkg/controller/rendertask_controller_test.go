@@ -424,6 +424,71 @@ var _ = Describe("RenderTaskController", Ordered, func() {
 			}, eventuallyTimeout).Should(Succeed())
 		})
 
+		It("should replace a failed job until the retry policy is exhausted", func() {
+			task := validRenderTask("test-task-retry", ns)
+			task.Spec.RetryPolicy = &solarv1alpha1.RenderTaskRetryPolicy{
+				MaxRetries: 1,
+				Backoff:    &metav1.Duration{Duration: time.Second},
+			}
+			Expect(k8sClient.Create(ctx, task)).To(Succeed())
+
+			jobKey := client.ObjectKey{Name: "render-test-task-retry", Namespace: ns.Name}
+			taskKey := client.ObjectKey{Name: "test-task-retry", Namespace: ns.Name}
+
+			failJob := func(job *batchv1.Job) {
+				now := metav1.Now()
+				job.Status.Failed = 1
+				job.Status.StartTime = &now
+				job.Status.Conditions = []batchv1.JobCondition{
+					{Type: batchv1.JobFailureTarget, Status: corev1.ConditionTrue, LastProbeTime: now, LastTransitionTime: now},
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastProbeTime: now, LastTransitionTime: now},
+				}
+				Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+			}
+
+			// Fail the first attempt
+			job := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, jobKey, job)
+			}, eventuallyTimeout).Should(Succeed())
+			firstUID := job.UID
+			failJob(job)
+
+			updatedTask := &solarv1alpha1.RenderTask{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, taskKey, updatedTask)).To(Succeed())
+				cond := apimeta.FindStatusCondition(updatedTask.Status.Conditions, ConditionTypeRetrying)
+				g.Expect(cond).NotTo(BeNil())
+				g.Expect(cond.Reason).To(Equal("JobFailed"))
+			}, eventuallyTimeout).Should(Succeed())
+			Expect(apimeta.IsStatusConditionTrue(updatedTask.Status.Conditions, ConditionTypeJobFailed)).To(BeFalse())
+
+			// The failed job is replaced after the backoff
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, jobKey, job)).To(Succeed())
+				g.Expect(job.UID).NotTo(Equal(firstUID))
+				g.Expect(job.DeletionTimestamp.IsZero()).To(BeTrue())
+			}, eventuallyTimeout).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, taskKey, updatedTask)).To(Succeed())
+				g.Expect(updatedTask.Status.Attempts).To(Equal(int32(2)))
+				g.Expect(apimeta.IsStatusConditionFalse(updatedTask.Status.Conditions, ConditionTypeRetrying)).To(BeTrue())
+			}, eventuallyTimeout).Should(Succeed())
+
+			// The second failure exhausts the retry policy
+			failJob(job)
+
+			Eventually(func() bool {
+				if err := k8sClient.Get(ctx, taskKey, updatedTask); err != nil {
+					return false
+				}
+
+				return apimeta.IsStatusConditionTrue(updatedTask.Status.Conditions, ConditionTypeJobFailed)
+			}, eventuallyTimeout).Should(BeTrue())
+			Expect(updatedTask.Status.Attempts).To(Equal(int32(2)))
+		})
+
 		It("should cleanup secrets after FailedJobTTL on job failure", func() {
 			// Create a RenderTask with short TTL for testing
 			task := validRenderTask("test-task-failed-ttl", ns)
@@ -676,5 +741,45 @@ var _ = Describe("RenderTaskController", Ordered, func() {
 				Value: "/etc/renderer/dockerconfig.json",
 			}))
 		})
+
+		It("should pass the token to job when an Opaque secret with a token is configured", func() {
+			// replace dummy secret with a token
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rendertask-secret",
+					Namespace: ns.Name,
+				},
+			}
+			Expect(k8sClient.Delete(ctx, secret.DeepCopy())).To(Succeed())
+
+			secret.Type = corev1.SecretTypeOpaque
+			secret.StringData = map[string]string{
+				"token": "foo",
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+			// Create a RenderTask
+			task := validRenderTask("test-task-token", ns)
+			Expect(k8sClient.Create(ctx, task)).To(Succeed())
+
+			// Wait for job to be created
+			job := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{Name: "render-test-task-token", Namespace: ns.Name}, job)
+			}).Should(Succeed())
+
+			Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name: "REGISTRY_TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "rendertask-secret",
+						},
+						Key: "token",
+					},
+				},
+			}))
+		})
 	})
 })
@@ -0,0 +1,109 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func hashedTask(name, hash string, age time.Duration) solarv1alpha1.RenderTask {
+	rt := runningTask(name)
+	rt.CreationTimestamp = metav1.NewTime(time.Date(2026, time.June, 10, 12, 0, 0, 0, time.UTC).Add(-age))
+	rt.Status.ConfigHash = hash
+
+	return rt
+}
+
+func TestRenderTaskConfigHash(t *testing.T) {
+	t.Parallel()
+
+	a := queuedTask("a", 0, time.Minute)
+	a.Spec.BaseURL = "registry.example.com"
+	a.Spec.Repository = "charts/demo"
+	a.Spec.Tag = "v1.0.0"
+	a.Spec.ReleaseConfig.Values.Raw = []byte(`{"key":"value"}`)
+
+	b := *a.DeepCopy()
+	b.Name = "b"
+	b.Spec.Priority = 10
+	b.Spec.OwnerName = "other-target"
+
+	hashA, err := renderTaskConfigHash(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashB, err := renderTaskConfigHash(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashA != hashB {
+		t.Errorf("hashes differ for the same config: %q != %q", hashA, hashB)
+	}
+
+	b.Spec.ReleaseConfig.Values.Raw = []byte(`{"key":"other"}`)
+	if hashB, _ = renderTaskConfigHash(&b); hashA == hashB {
+		t.Error("hashes must differ for different renderer configs")
+	}
+
+	b = *a.DeepCopy()
+	b.Spec.Tag = "v1.0.1"
+	if hashB, _ = renderTaskConfigHash(&b); hashA == hashB {
+		t.Error("hashes must differ for different push destinations")
+	}
+}
+
+func TestFindPrimaryRenderTask(t *testing.T) {
+	t.Parallel()
+
+	res := queuedTask("res", 0, time.Minute)
+	res.Status.ConfigHash = "sha256:aaa"
+
+	failed := hashedTask("failed", "sha256:aaa", 3*time.Hour)
+	failed.Status.Conditions = []metav1.Condition{{Type: ConditionTypeJobFailed, Status: metav1.ConditionTrue}}
+	optedOut := hashedTask("opted-out", "sha256:aaa", 3*time.Hour)
+	optedOut.Annotations = map[string]string{annotationDisableDedupe: "true"}
+	dryRun := hashedTask("dry-run", "sha256:aaa", 3*time.Hour)
+	dryRun.Annotations = map[string]string{annotationDryRun: "true"}
+	duplicate := hashedTask("duplicate", "sha256:aaa", 3*time.Hour)
+	duplicate.Status.JobRef = nil
+	duplicate.Status.PrimaryRef = &corev1.LocalObjectReference{Name: "old"}
+	queued := queuedTask("queued", 0, 3*time.Hour)
+	queued.Status.ConfigHash = "sha256:aaa"
+	succeeded := hashedTask("succeeded", "sha256:aaa", time.Hour)
+	succeeded.Status.JobRef = nil
+	succeeded.Status.Conditions = []metav1.Condition{{Type: ConditionTypeJobSucceeded, Status: metav1.ConditionTrue}}
+
+	tasks := []solarv1alpha1.RenderTask{
+		failed, optedOut, dryRun, duplicate, queued, succeeded,
+		hashedTask("other-hash", "sha256:bbb", 3*time.Hour),
+		hashedTask("young", "sha256:aaa", 30*time.Minute),
+		hashedTask("old", "sha256:aaa", 2*time.Hour),
+	}
+
+	if got := findPrimaryRenderTask(&res, tasks); got == nil || got.Name != "old" {
+		t.Errorf("got %v, want the oldest task rendering the same config", got)
+	}
+
+	res.Status.PrimaryRef = &corev1.LocalObjectReference{Name: "failed"}
+	if got := findPrimaryRenderTask(&res, tasks); got == nil || got.Name != "failed" {
+		t.Errorf("got %v, want the referenced primary regardless of its state", got)
+	}
+
+	res.Status.PrimaryRef = &corev1.LocalObjectReference{Name: "gone"}
+	if got := findPrimaryRenderTask(&res, tasks); got != nil {
+		t.Errorf("got %v, want nil for a deleted primary", got)
+	}
+
+	res.Status.PrimaryRef = nil
+	res.Status.ConfigHash = "sha256:ccc"
+	if got := findPrimaryRenderTask(&res, tasks); got != nil {
+		t.Errorf("got %v, want nil without an identical task", got)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func TestCreateRenderJob_DryRun(t *testing.T) {
+	t.Parallel()
+
+	resultFile := "--result-file=" + corev1.TerminationMessagePathDefault
+
+	for _, dryRun := range []bool{false, true} {
+		task := newPullSecretsTestTask("dryrun")
+		if dryRun {
+			task.Annotations = map[string]string{annotationDryRun: "true"}
+		}
+		r, c := newPullSecretsTestReconciler(nil, task)
+
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: task.Name, Namespace: task.Namespace},
+		}); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+
+		args := getRenderedJob(t, c, task.Name).Spec.Template.Spec.Containers[0].Args
+		if got := slices.Contains(args, "--skip-push"); got != dryRun {
+			t.Errorf("dry run %v: --skip-push in args = %v (%v)", dryRun, got, args)
+		}
+		if got := slices.Contains(args, resultFile); got != dryRun {
+			t.Errorf("dry run %v: %s in args = %v (%v)", dryRun, resultFile, got, args)
+		}
+	}
+}
+
+func TestCurrentRenderTask(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	rendered := func(name string, age time.Duration, digest string) solarv1alpha1.RenderTask {
+		rt := *newPullSecretsTestTask(name)
+		rt.Status.ContentDigest = digest
+		rt.Status.RenderedAt = &metav1.Time{Time: now.Add(-age)}
+		rt.Status.Conditions = []metav1.Condition{{Type: ConditionTypeJobSucceeded, Status: metav1.ConditionTrue}}
+
+		return rt
+	}
+
+	res := *newPullSecretsTestTask("preview")
+	res.Annotations = map[string]string{annotationDryRun: "true"}
+
+	otherOwner := rendered("other-owner", 0, "sha256:ccc")
+	otherOwner.Spec.OwnerName = "other"
+	dryRun := rendered("other-dry-run", 0, "sha256:ccc")
+	dryRun.Annotations = map[string]string{annotationDryRun: "true"}
+	failed := rendered("failed", 0, "")
+	failed.Status.Conditions = []metav1.Condition{{Type: ConditionTypeJobFailed, Status: metav1.ConditionTrue}}
+
+	tasks := []solarv1alpha1.RenderTask{
+		res, otherOwner, dryRun, failed,
+		rendered("old", 2*time.Hour, "sha256:aaa"),
+		rendered("new", time.Hour, "sha256:bbb"),
+	}
+
+	if got := currentRenderTask(&res, tasks); got == nil || got.Name != "new" {
+		t.Errorf("got %v, want the task that rendered most recently for the same owner", got)
+	}
+
+	if got := currentRenderTask(&res, tasks[:4]); got != nil {
+		t.Errorf("got %v, want nil without a rendered chart of the owner", got)
+	}
+}
+
+func TestRenderPreview(t *testing.T) {
+	t.Parallel()
+
+	report := &solarv1alpha1.RenderReport{ContentDigest: "sha256:aaa", Files: 3, Size: 1024}
+	current := newPullSecretsTestTask("current")
+	current.Status.ContentDigest = "sha256:aaa"
+
+	tests := []struct {
+		name    string
+		current *solarv1alpha1.RenderTask
+		digest  string
+		want    solarv1alpha1.RenderPreview
+	}{
+		{
+			name: "no current chart",
+			want: solarv1alpha1.RenderPreview{Files: 3, Size: 1024, Changed: true},
+		},
+		{
+			name:    "unchanged",
+			current: current,
+			digest:  "sha256:aaa",
+			want:    solarv1alpha1.RenderPreview{Files: 3, Size: 1024, CurrentRenderTask: "current", CurrentContentDigest: "sha256:aaa"},
+		},
+		{
+			name:    "changed",
+			current: current,
+			digest:  "sha256:bbb",
+			want:    solarv1alpha1.RenderPreview{Files: 3, Size: 1024, CurrentRenderTask: "current", CurrentContentDigest: "sha256:bbb", Changed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var current *solarv1alpha1.RenderTask
+			if tt.current != nil {
+				current = tt.current.DeepCopy()
+				current.Status.ContentDigest = tt.digest
+			}
+
+			if got := renderPreview(report, current); *got != tt.want {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func TestCreateRenderJob_JobSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		reconciler   func(r *RenderTaskReconciler)
+		taskTTL      *int32
+		taskBackoff  *int32
+		taskDeadline *int64
+		wantTTL      int32
+		wantBackoff  int32
+		wantDeadline *int64
+	}{
+		{
+			name:        "defaults",
+			wantTTL:     3600,
+			wantBackoff: 3,
+		},
+		{
+			name: "reconciler defaults",
+			reconciler: func(r *RenderTaskReconciler) {
+				r.JobTTL = new(int32(60))
+				r.JobBackoffLimit = new(int32(0))
+				r.JobActiveDeadlineSeconds = 900
+			},
+			wantTTL:      60,
+			wantBackoff:  0,
+			wantDeadline: new(int64(900)),
+		},
+		{
+			name: "task overrides",
+			reconciler: func(r *RenderTaskReconciler) {
+				r.JobTTL = new(int32(60))
+				r.JobBackoffLimit = new(int32(0))
+				r.JobActiveDeadlineSeconds = 900
+			},
+			taskTTL:      new(int32(120)),
+			taskBackoff:  new(int32(5)),
+			taskDeadline: new(int64(7200)),
+			wantTTL:      120,
+			wantBackoff:  5,
+			wantDeadline: new(int64(7200)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := newPullSecretsTestTask("jobsettings")
+			task.Spec.FailedJobTTL = tt.taskTTL
+			task.Spec.BackoffLimit = tt.taskBackoff
+			task.Spec.ActiveDeadlineSeconds = tt.taskDeadline
+			r, c := newPullSecretsTestReconciler(nil, task)
+			if tt.reconciler != nil {
+				tt.reconciler(r)
+			}
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: task.Name, Namespace: task.Namespace},
+			}); err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+
+			spec := getRenderedJob(t, c, task.Name).Spec
+			if got := *spec.TTLSecondsAfterFinished; got != tt.wantTTL {
+				t.Errorf("TTLSecondsAfterFinished = %d, want %d", got, tt.wantTTL)
+			}
+			if got := *spec.BackoffLimit; got != tt.wantBackoff {
+				t.Errorf("BackoffLimit = %d, want %d", got, tt.wantBackoff)
+			}
+			switch got := spec.ActiveDeadlineSeconds; {
+			case tt.wantDeadline == nil && got != nil:
+				t.Errorf("ActiveDeadlineSeconds = %d, want unset", *got)
+			case tt.wantDeadline != nil && (got == nil || *got != *tt.wantDeadline):
+				t.Errorf("ActiveDeadlineSeconds = %v, want %d", got, *tt.wantDeadline)
+			}
+		})
+	}
+}
+
+func TestCreateRenderJob_SensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	task := newPullSecretsTestTask("sensitive")
+	task.Spec.SensitiveValuesSecretRef = &corev1.LocalObjectReference{Name: "sensitive-values"}
+	r, c := newPullSecretsTestReconciler(nil, task)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: task.Name, Namespace: task.Namespace},
+	}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	spec := getRenderedJob(t, c, task.Name).Spec.Template.Spec
+	i := slices.IndexFunc(spec.Volumes, func(v corev1.Volume) bool { return v.Name == "sensitive-values" })
+	if i < 0 || spec.Volumes[i].Secret == nil || spec.Volumes[i].Secret.SecretName != "sensitive-values" {
+		t.Fatalf("Volumes = %+v, want the sensitive values Secret", spec.Volumes)
+	}
+	if args := spec.Containers[0].Args; !slices.Contains(args, "--values-file=/etc/renderer/values.json") {
+		t.Errorf("Args = %v, want --values-file", args)
+	}
+}
+
+func TestCreateRenderJob_RendererTypes(t *testing.T) {
+	t.Parallel()
+
+	rendererTypes := map[solarv1alpha1.RendererConfigType]RendererContainer{
+		solarv1alpha1.RendererConfigTypeRelease: {
+			Image: "registry.example.com/release-renderer:v1",
+			Args:  []string{"--plain-http"},
+		},
+		solarv1alpha1.RendererConfigTypeBootstrap: {
+			Command: "/bootstrap-renderer",
+		},
+	}
+
+	tests := []struct {
+		configType  solarv1alpha1.RendererConfigType
+		wantImage   string
+		wantCommand string
+		wantArgs    []string
+	}{
+		{
+			configType:  solarv1alpha1.RendererConfigTypeRelease,
+			wantImage:   "registry.example.com/release-renderer:v1",
+			wantCommand: "/solar-renderer",
+			wantArgs:    []string{"--plain-http"},
+		},
+		{
+			configType:  solarv1alpha1.RendererConfigTypeBootstrap,
+			wantImage:   "ghcr.io/opendefensecloud/solar-renderer:test",
+			wantCommand: "/bootstrap-renderer",
+			wantArgs:    []string{"--global"},
+		},
+		{
+			configType:  solarv1alpha1.RendererConfigTypeProfile,
+			wantImage:   "ghcr.io/opendefensecloud/solar-renderer:test",
+			wantCommand: "/solar-renderer",
+			wantArgs:    []string{"--global"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.configType), func(t *testing.T) {
+			t.Parallel()
+
+			task := newPullSecretsTestTask("renderer-" + string(tt.configType))
+			task.Spec.Type = tt.configType
+			r, c := newPullSecretsTestReconciler(nil, task)
+			r.RendererArgs = []string{"--global"}
+			r.RendererTypes = rendererTypes
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: task.Name, Namespace: task.Namespace},
+			}); err != nil {
+				t.Fatalf("Reconcile: %v", err)
+			}
+
+			container := getRenderedJob(t, c, task.Name).Spec.Template.Spec.Containers[0]
+			if container.Image != tt.wantImage {
+				t.Errorf("Image = %q, want %q", container.Image, tt.wantImage)
+			}
+			if !slices.Equal(container.Command, []string{tt.wantCommand}) {
+				t.Errorf("Command = %v, want [%s]", container.Command, tt.wantCommand)
+			}
+			if !slices.Equal(container.Args[:len(tt.wantArgs)], tt.wantArgs) || slices.Contains(container.Args[len(tt.wantArgs):], "--global") {
+				t.Errorf("Args = %v, want them to start with %v", container.Args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// These tests run as plain Go tests (not Ginkgo specs) so the queue ordering
+// can be verified without the envtest BeforeSuite.
+
+func queuedTask(name string, priority int32, age time.Duration) solarv1alpha1.RenderTask {
+	return solarv1alpha1.RenderTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Date(2026, time.June, 10, 12, 0, 0, 0, time.UTC).Add(-age)),
+		},
+		Spec: solarv1alpha1.RenderTaskSpec{Priority: priority},
+	}
+}
+
+func runningTask(name string) solarv1alpha1.RenderTask {
+	rt := queuedTask(name, 0, time.Hour)
+	rt.Status.JobRef = &corev1.ObjectReference{Name: "render-" + name}
+
+	return rt
+}
+
+func finishedTask(name, condType string) solarv1alpha1.RenderTask {
+	rt := runningTask(name)
+	rt.Status.Conditions = []metav1.Condition{{Type: condType, Status: metav1.ConditionTrue}}
+
+	return rt
+}
+
+func TestRenderQueueAdmits(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		res   solarv1alpha1.RenderTask
+		tasks []solarv1alpha1.RenderTask
+		max   int
+		want  bool
+	}{
+		{
+			name: "free slot admits the only queued task",
+			res:  queuedTask("a", 0, time.Minute),
+			max:  1,
+			want: true,
+		},
+		{
+			name:  "all slots occupied by running jobs",
+			res:   queuedTask("a", 100, time.Minute),
+			tasks: []solarv1alpha1.RenderTask{runningTask("r1"), runningTask("r2")},
+			max:   2,
+			want:  false,
+		},
+		{
+			name: "finished jobs do not occupy a slot",
+			res:  queuedTask("a", 0, time.Minute),
+			tasks: []solarv1alpha1.RenderTask{
+				finishedTask("done", ConditionTypeJobSucceeded),
+				finishedTask("failed", ConditionTypeJobFailed),
+			},
+			max:  1,
+			want: true,
+		},
+		{
+			name:  "higher priority task takes the last slot",
+			res:   queuedTask("low", 0, time.Hour),
+			tasks: []solarv1alpha1.RenderTask{runningTask("r1"), queuedTask("high", 10, time.Minute)},
+			max:   2,
+			want:  false,
+		},
+		{
+			name:  "task ahead of lower priority tasks is admitted",
+			res:   queuedTask("high", 10, time.Minute),
+			tasks: []solarv1alpha1.RenderTask{queuedTask("low", 0, time.Hour)},
+			max:   1,
+			want:  true,
+		},
+		{
+			name:  "equal priority admits the oldest task first",
+			res:   queuedTask("young", 5, time.Minute),
+			tasks: []solarv1alpha1.RenderTask{queuedTask("old", 5, time.Hour)},
+			max:   1,
+			want:  false,
+		},
+		{
+			name: "deduplicated tasks neither run nor queue",
+			res:  queuedTask("a", 0, time.Minute),
+			tasks: []solarv1alpha1.RenderTask{func() solarv1alpha1.RenderTask {
+				rt := queuedTask("dup", 10, time.Hour)
+				rt.Status.PrimaryRef = &corev1.LocalObjectReference{Name: "other"}
+
+				return rt
+			}()},
+			max:  1,
+			want: true,
+		},
+		{
+			name:  "task listed by the cache is not counted twice",
+			res:   queuedTask("a", 0, time.Minute),
+			tasks: []solarv1alpha1.RenderTask{queuedTask("a", 0, time.Minute)},
+			max:   1,
+			want:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := tc.res
+			if got := renderQueueAdmits(&res, tc.tasks, tc.max); got != tc.want {
+				t.Errorf("renderQueueAdmits() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func reconcileRenderTask(t *testing.T, r *RenderTaskReconciler, task *solarv1alpha1.RenderTask) {
+	t.Helper()
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: task.Name, Namespace: task.Namespace},
+	}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+}
+
+func TestReconcileRenderTask_BackfillsLostReferences(t *testing.T) {
+	t.Parallel()
+
+	// The job and secret exist, but the status updates recording them failed.
+	task := newPullSecretsTestTask("lostrefs")
+	objectMeta := metav1.ObjectMeta{Name: "render-lostrefs", Namespace: task.Namespace}
+	r, c := newPullSecretsTestReconciler(nil, task,
+		&corev1.Secret{ObjectMeta: objectMeta},
+		&batchv1.Job{ObjectMeta: objectMeta, Status: batchv1.JobStatus{Active: 1}},
+	)
+
+	reconcileRenderTask(t, r, task)
+
+	got := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(task), got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if ref := got.Status.JobRef; ref == nil || ref.Kind != "Job" || ref.Name != "render-lostrefs" {
+		t.Errorf("JobRef = %+v, want a reference to Job render-lostrefs", ref)
+	}
+	if ref := got.Status.ConfigSecretRef; ref == nil || ref.Kind != "Secret" || ref.Name != "render-lostrefs" {
+		t.Errorf("ConfigSecretRef = %+v, want a reference to Secret render-lostrefs", ref)
+	}
+	if got.Status.Attempts != 1 {
+		t.Errorf("Attempts = %d, want 1", got.Status.Attempts)
+	}
+}
+
+func TestReconcileRenderTask_StaleCacheDoesNotRecreateResources(t *testing.T) {
+	t.Parallel()
+
+	// The cache still holds the RenderTask before the success of its job was
+	// recorded, while the job and secret are already cleaned up.
+	cached := newPullSecretsTestTask("stale")
+	cached.ResourceVersion = "10"
+	cached.Status.JobRef = &corev1.ObjectReference{Kind: "Job", Namespace: cached.Namespace, Name: "render-stale"}
+	cached.Status.ConfigSecretRef = &corev1.ObjectReference{Kind: "Secret", Namespace: cached.Namespace, Name: "render-stale"}
+	cached.Status.Attempts = 1
+	hash, err := renderTaskConfigHash(cached)
+	if err != nil {
+		t.Fatalf("renderTaskConfigHash: %v", err)
+	}
+	cached.Status.ConfigHash = hash
+
+	live := cached.DeepCopy()
+	live.ResourceVersion = "11"
+	live.Status.Conditions = []metav1.Condition{{
+		Type:               ConditionTypeJobSucceeded,
+		Status:             metav1.ConditionTrue,
+		Reason:             "JobSucceeded",
+		LastTransitionTime: metav1.Now(),
+	}}
+
+	r, c := newPullSecretsTestReconciler(nil, cached)
+	r.APIReader = fake.NewClientBuilder().WithScheme(r.Scheme).WithObjects(live).Build()
+
+	reconcileRenderTask(t, r, cached)
+
+	objectKey := client.ObjectKey{Name: "render-stale", Namespace: cached.Namespace}
+	if err := c.Get(context.Background(), objectKey, &corev1.Secret{}); !apierrors.IsNotFound(err) {
+		t.Errorf("config secret was recreated (err: %v)", err)
+	}
+	if err := c.Get(context.Background(), objectKey, &batchv1.Job{}); !apierrors.IsNotFound(err) {
+		t.Errorf("job was recreated (err: %v)", err)
+	}
+}
+
+func TestReconcileRenderTask_RecreatesMissingResourcesOfCurrentTask(t *testing.T) {
+	t.Parallel()
+
+	task := newPullSecretsTestTask("deleted")
+	task.Status.JobRef = &corev1.ObjectReference{Kind: "Job", Namespace: task.Namespace, Name: "render-deleted"}
+	task.Status.ConfigSecretRef = &corev1.ObjectReference{Kind: "Secret", Namespace: task.Namespace, Name: "render-deleted"}
+	task.Status.Attempts = 1
+
+	r, c := newPullSecretsTestReconciler(nil, task)
+	r.APIReader = c
+
+	reconcileRenderTask(t, r, task)
+
+	getRenderedJob(t, c, task.Name)
+}
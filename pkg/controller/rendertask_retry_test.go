@@ -0,0 +1,80 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// These tests run as plain Go tests (not Ginkgo specs) so the retry policy can
+// be verified without the envtest BeforeSuite.
+
+func retryingTask(policy *solarv1alpha1.RenderTaskRetryPolicy, attempts int32) *solarv1alpha1.RenderTask {
+	return &solarv1alpha1.RenderTask{
+		Spec:   solarv1alpha1.RenderTaskSpec{RetryPolicy: policy},
+		Status: solarv1alpha1.RenderTaskStatus{Attempts: attempts},
+	}
+}
+
+func TestRenderRetriesLeft(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		policy   *solarv1alpha1.RenderTaskRetryPolicy
+		attempts int32
+		want     bool
+	}{
+		{name: "no retry policy", attempts: 1, want: false},
+		{name: "zero retries", policy: &solarv1alpha1.RenderTaskRetryPolicy{}, attempts: 1, want: false},
+		{name: "first attempt failed", policy: &solarv1alpha1.RenderTaskRetryPolicy{MaxRetries: 2}, attempts: 1, want: true},
+		{name: "last retry pending", policy: &solarv1alpha1.RenderTaskRetryPolicy{MaxRetries: 2}, attempts: 2, want: true},
+		{name: "retries exhausted", policy: &solarv1alpha1.RenderTaskRetryPolicy{MaxRetries: 2}, attempts: 3, want: false},
+		{name: "uncounted attempt", policy: &solarv1alpha1.RenderTaskRetryPolicy{MaxRetries: 1}, attempts: 0, want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := renderRetriesLeft(retryingTask(tc.policy, tc.attempts)); got != tc.want {
+				t.Errorf("renderRetriesLeft() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRenderRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	custom := &solarv1alpha1.RenderTaskRetryPolicy{MaxRetries: 10, Backoff: &metav1.Duration{Duration: 30 * time.Second}}
+
+	cases := []struct {
+		name     string
+		policy   *solarv1alpha1.RenderTaskRetryPolicy
+		attempts int32
+		want     time.Duration
+	}{
+		{name: "default backoff", policy: &solarv1alpha1.RenderTaskRetryPolicy{MaxRetries: 3}, attempts: 1, want: defaultRenderRetryBackoff},
+		{name: "first retry", policy: custom, attempts: 1, want: 30 * time.Second},
+		{name: "doubles per attempt", policy: custom, attempts: 3, want: 2 * time.Minute},
+		{name: "capped", policy: custom, attempts: 10, want: maxRenderRetryBackoff},
+		{name: "initial backoff above cap", policy: &solarv1alpha1.RenderTaskRetryPolicy{Backoff: &metav1.Duration{Duration: time.Hour}}, attempts: 1, want: maxRenderRetryBackoff},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := renderRetryBackoff(retryingTask(tc.policy, tc.attempts)); got != tc.want {
+				t.Errorf("renderRetryBackoff() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// These tests cover the wiring of chart signing into the Job PodSpec rendered
+// by RenderTaskReconciler, using the fake client like the pull secret tests.
+
+func reconcileSigningTestTask(t *testing.T, configure func(*RenderTaskReconciler), annotations map[string]string) *corev1.PodSpec {
+	t.Helper()
+
+	task := newPullSecretsTestTask("signed")
+	task.Annotations = annotations
+	r, c := newPullSecretsTestReconciler(nil, task)
+	configure(r)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: task.Name, Namespace: task.Namespace},
+	}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	return &getRenderedJob(t, c, task.Name).Spec.Template.Spec
+}
+
+func hasVolume(spec *corev1.PodSpec, name string) bool {
+	return slices.ContainsFunc(spec.Volumes, func(v corev1.Volume) bool { return v.Name == name })
+}
+
+func TestCreateRenderJob_SigningKeySecret(t *testing.T) {
+	t.Parallel()
+	spec := reconcileSigningTestTask(t, func(r *RenderTaskReconciler) { r.SigningKeySecret = "cosign" }, nil)
+
+	i := slices.IndexFunc(spec.Volumes, func(v corev1.Volume) bool { return v.Name == "signing-key" })
+	if i < 0 || spec.Volumes[i].Secret == nil || spec.Volumes[i].Secret.SecretName != "cosign" {
+		t.Fatalf("Volumes = %+v, want the signing key Secret", spec.Volumes)
+	}
+	args := spec.Containers[0].Args
+	if !slices.Contains(args, "--sign-key=/etc/renderer/signing/cosign.key") {
+		t.Errorf("Args = %v, want --sign-key", args)
+	}
+	if !slices.Contains(args, "--result-file="+corev1.TerminationMessagePathDefault) {
+		t.Errorf("Args = %v, want --result-file to report the signature", args)
+	}
+}
+
+func TestCreateRenderJob_KeylessSigning(t *testing.T) {
+	t.Parallel()
+	spec := reconcileSigningTestTask(t, func(r *RenderTaskReconciler) { r.KeylessSigning = true }, nil)
+
+	i := slices.IndexFunc(spec.Volumes, func(v corev1.Volume) bool { return v.Name == "sigstore-token" })
+	if i < 0 || spec.Volumes[i].Projected == nil {
+		t.Fatalf("Volumes = %+v, want a projected service account token", spec.Volumes)
+	}
+	if token := spec.Volumes[i].Projected.Sources[0].ServiceAccountToken; token == nil || token.Audience != "sigstore" {
+		t.Errorf("token projection = %+v, want the audience sigstore", token)
+	}
+	args := spec.Containers[0].Args
+	if !slices.Contains(args, "--sign-keyless") || !slices.Contains(args, "--identity-token=/var/run/sigstore/token") {
+		t.Errorf("Args = %v, want keyless signing", args)
+	}
+}
+
+func TestCreateRenderJob_DryRunIsNotSigned(t *testing.T) {
+	t.Parallel()
+	spec := reconcileSigningTestTask(t, func(r *RenderTaskReconciler) { r.SigningKeySecret = "cosign" },
+		map[string]string{annotationDryRun: "true"})
+
+	if hasVolume(spec, "signing-key") || slices.Contains(spec.Containers[0].Args, "--sign-key=/etc/renderer/signing/cosign.key") {
+		t.Errorf("PodSpec = %+v, want a dry run not to sign", spec)
+	}
+}
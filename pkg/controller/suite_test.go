@@ -49,6 +49,7 @@ var (
 	renderTaskReconciler       *RenderTaskReconciler
 	profileReconciler          *ProfileReconciler
 	renderArtifactReconciler   *RenderArtifactReconciler
+	componentReconciler        *ComponentReconciler
 	componentVersionReconciler *ComponentVersionReconciler
 	releaseBindingReconciler   *ReleaseBindingReconciler
 	registryBindingReconciler  *RegistryBindingReconciler
@@ -139,6 +140,7 @@ var _ = BeforeSuite(func() {
 
 	renderTaskReconciler = &RenderTaskReconciler{
 		Client:              mgr.GetClient(),
+		APIReader:           mgr.GetAPIReader(),
 		Scheme:              mgr.GetScheme(),
 		Recorder:            fakeRecorder,
 		RendererImage:       "image:tag",
@@ -165,6 +167,12 @@ var _ = BeforeSuite(func() {
 	}
 	Expect(renderArtifactReconciler.SetupWithManager(mgr)).To(Succeed())
 
+	componentReconciler = &ComponentReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}
+	Expect(componentReconciler.SetupWithManager(mgr)).To(Succeed())
+
 	componentVersionReconciler = &ComponentVersionReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -213,6 +221,7 @@ var _ = BeforeEach(func() {
 	renderTaskReconciler.WatchNamespace = nsName
 	profileReconciler.WatchNamespace = nsName
 	renderArtifactReconciler.WatchNamespace = nsName
+	componentReconciler.WatchNamespace = nsName
 	componentVersionReconciler.WatchNamespace = nsName
 	releaseBindingReconciler.WatchNamespace = nsName
 	registryBindingReconciler.WatchNamespace = nsName
@@ -227,6 +236,7 @@ var _ = AfterEach(func() {
 	renderTaskReconciler.WatchNamespace = "cleanup-disabled"
 	profileReconciler.WatchNamespace = "cleanup-disabled"
 	renderArtifactReconciler.WatchNamespace = "cleanup-disabled"
+	componentReconciler.WatchNamespace = "cleanup-disabled"
 	componentVersionReconciler.WatchNamespace = "cleanup-disabled"
 	releaseBindingReconciler.WatchNamespace = "cleanup-disabled"
 	registryBindingReconciler.WatchNamespace = "cleanup-disabled"
@@ -389,6 +399,7 @@ var _ = AfterEach(func() {
 	renderTaskReconciler.WatchNamespace = ""
 	profileReconciler.WatchNamespace = ""
 	renderArtifactReconciler.WatchNamespace = ""
+	componentReconciler.WatchNamespace = ""
 	componentVersionReconciler.WatchNamespace = ""
 	releaseBindingReconciler.WatchNamespace = ""
 	registryBindingReconciler.WatchNamespace = ""
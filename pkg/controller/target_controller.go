@@ -5,8 +5,10 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 	"strings"
@@ -25,22 +27,39 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 const (
 	targetFinalizer = "solar.opendefense.cloud/target-finalizer"
 
+	// ReleaseDefaultsConfigMap is the name of the ConfigMap whose
+	// ReleaseDefaultsKey holds default values for all Releases in its
+	// namespace. Values of the Releases themselves take precedence.
+	ReleaseDefaultsConfigMap = "solar-release-defaults"
+	// ReleaseDefaultsKey is the key of the release defaults ConfigMap holding
+	// the default values as a YAML object.
+	ReleaseDefaultsKey = "values.yaml"
+
 	ConditionTypeRegistryResolved = "RegistryResolved"
 	ConditionTypeReleasesResolved = "ReleasesResolved"
 	ConditionTypeReleasesRendered = "ReleasesRendered"
 	ConditionTypeBootstrapReady   = "BootstrapReady"
 )
 
-var ErrReleaseNotRenderedYet = errors.New("release is not rendered yet")
+var (
+	ErrReleaseNotRenderedYet = errors.New("release is not rendered yet")
+	// ErrValuesFromUnavailable is returned when a ConfigMap or Secret key
+	// referenced by a Release's ValuesFrom is missing or holds invalid values,
+	// or the release defaults ConfigMap holds invalid values.
+	ErrValuesFromUnavailable = errors.New("values reference unavailable")
+)
 
 type releaseInfo struct {
 	// bindingKey is "<namespace>/<name>" of the originating ReleaseBinding, used as a
@@ -51,13 +70,65 @@ type releaseInfo struct {
 	// Spec.UniqueName when set, otherwise the parent Component name from the CV.
 	// It is guaranteed unique across all surviving releases and used as the
 	// bootstrap map key to avoid collisions between same-named cross-namespace releases.
-	uniqueName          string
-	release             *solarv1alpha1.Release
-	cv                  *solarv1alpha1.ComponentVersion
-	rtName              string
-	chartURL            string
+	uniqueName string
+	release    *solarv1alpha1.Release
+	cv         *solarv1alpha1.ComponentVersion
+	rtName     string
+	// heldReason is set when the Release must not be rendered right now,
+	// either because it is suspended or outside its maintenance windows.
+	heldReason string
+	chartURL   string
+	// chartDigest is the digest of the chart at chartURL, by which the
+	// bootstrap chart pins it. Empty if the render job did not report it.
+	chartDigest         string
 	artifactName        string
 	artifactBindingName string
+	// overrides are the values of the originating ReleaseBinding, merged on
+	// top of the Release's values.
+	overrides runtime.RawExtension
+	// valuesFrom are the values resolved from the Release's ValuesFrom, which
+	// the Release's values are merged on top of.
+	valuesFrom runtime.RawExtension
+	// secretKeyPaths are the key paths of the values in valuesFrom that were
+	// read from Secrets. They are treated as sensitive.
+	secretKeyPaths [][]string
+}
+
+// releaseReadiness collects the readiness of the releases bound to a Target
+// by release name.
+type releaseReadiness map[string]solarv1alpha1.TargetReleaseStatus
+
+func (rr releaseReadiness) ready(name, chartURL, chartDigest string) {
+	rr[name] = solarv1alpha1.TargetReleaseStatus{Name: name, Ready: true, Reason: "Rendered", ChartURL: chartURL, ChartDigest: chartDigest}
+}
+
+func (rr releaseReadiness) notReady(name, reason, message string) solarv1alpha1.TargetReleaseStatus {
+	rr[name] = solarv1alpha1.TargetReleaseStatus{Name: name, Reason: reason, Message: message}
+
+	return rr[name]
+}
+
+// statuses returns the readiness of all releases sorted by name.
+func (rr releaseReadiness) statuses() []solarv1alpha1.TargetReleaseStatus {
+	var statuses []solarv1alpha1.TargetReleaseStatus
+	for _, name := range slices.Sorted(maps.Keys(rr)) {
+		statuses = append(statuses, rr[name])
+	}
+
+	return statuses
+}
+
+// missing lists the releases that are not ready with their reason, e.g.
+// "app (Pending), db (ReleaseFailed)".
+func (rr releaseReadiness) missing() string {
+	var missing []string
+	for _, s := range rr.statuses() {
+		if !s.Ready {
+			missing = append(missing, fmt.Sprintf("%s (%s)", s.Name, s.Reason))
+		}
+	}
+
+	return strings.Join(missing, ", ")
 }
 
 type TargetReconciler struct {
@@ -84,12 +155,15 @@ type TargetReconciler struct {
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=releasebindings,verbs=get;list;watch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=registrybindings,verbs=get;list;watch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=releases,verbs=get;list;watch
+//+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=releases/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=componentversions,verbs=get;list;watch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=referencegrants,verbs=get;list;watch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=rendertasks,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=renderartifacts,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups=solar.opendefense.cloud,resources=renderbindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=create;update
 
 // Reconcile collects ReleaseBindings, resolves the render registry, creates per-release
 // RenderTasks (with dedup), and creates a per-target bootstrap RenderTask.
@@ -182,10 +256,19 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Resolve render registry — supports cross-namespace via ReferenceGrant
-	registryNamespace := target.Namespace
-	if target.Spec.RenderRegistryNamespace != "" {
-		registryNamespace = target.Spec.RenderRegistryNamespace
+	registryKey, reason, message, err := r.renderRegistryKey(ctx, target)
+	if err != nil {
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to resolve render Registry")
+	}
+	if reason != "" {
+		// Creating or updating a RegistryBinding triggers a new reconcile.
+		if condErr := r.setCondition(ctx, target, ConditionTypeRegistryResolved, metav1.ConditionFalse, reason, message); condErr != nil {
+			return ctrl.Result{}, condErr
+		}
+
+		return ctrl.Result{}, nil
 	}
+	registryNamespace := registryKey.Namespace
 
 	// If the registry lives in a different namespace, verify a ReferenceGrant permits it
 	// before attempting to fetch the object.
@@ -196,7 +279,7 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 		if !granted {
 			if condErr := r.setCondition(ctx, target, ConditionTypeRegistryResolved, metav1.ConditionFalse, "NotGranted",
-				"No ReferenceGrant allows access to Registry "+target.Spec.RenderRegistryRef.Name+" in namespace "+registryNamespace); condErr != nil {
+				"No ReferenceGrant allows access to Registry "+registryKey.Name+" in namespace "+registryNamespace); condErr != nil {
 				return ctrl.Result{}, condErr
 			}
 
@@ -206,13 +289,10 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	registry := &solarv1alpha1.Registry{}
-	if err := r.Get(ctx, client.ObjectKey{
-		Name:      target.Spec.RenderRegistryRef.Name,
-		Namespace: registryNamespace,
-	}, registry); err != nil {
+	if err := r.Get(ctx, registryKey, registry); err != nil {
 		if apierrors.IsNotFound(err) {
 			if condErr := r.setCondition(ctx, target, ConditionTypeRegistryResolved, metav1.ConditionFalse, "NotFound",
-				"Registry not found: "+target.Spec.RenderRegistryRef.Name); condErr != nil {
+				"Registry not found: "+registryKey.Name); condErr != nil {
 				return ctrl.Result{}, condErr
 			}
 
@@ -225,7 +305,8 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	// Protect Registry from deletion while this Target references it, regardless of
 	// whether SolarSecretRef is configured — the Target still references this Registry.
-	if !slices.Contains(registry.Finalizers, registryRefFinalizer) {
+	// A Registry bound with role deploy is protected by its RegistryBinding instead.
+	if target.Spec.RenderRegistryRef.Name != "" && !slices.Contains(registry.Finalizers, registryRefFinalizer) {
 		latest := registry.DeepCopy()
 		latest.Finalizers = append(latest.Finalizers, registryRefFinalizer)
 		if err := r.Patch(ctx, latest, client.MergeFromWithOptions(registry, client.MergeFromWithOptimisticLock{})); err != nil {
@@ -292,6 +373,10 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			return ctrl.Result{}, condErr
 		}
 
+		if err := r.setReleaseStatuses(ctx, target, nil); err != nil {
+			return ctrl.Result{}, errLogAndWrap(log, err, "failed to clear release statuses")
+		}
+
 		// Clean up any stale RenderTasks and RenderBindings left from prior reconciles.
 		if err := r.deleteStaleRenderTasks(ctx, target, map[string]struct{}{}); err != nil {
 			return ctrl.Result{}, errLogAndWrap(log, err, "failed to clean up stale RenderTasks after all bindings removed")
@@ -306,6 +391,11 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// For each bound release, ensure a per-release RenderTask exists
 	var releases []releaseInfo
 
+	// readiness reports each release in status.releases. Releases that cannot
+	// be rendered until they are changed are collected in failed.
+	readiness := releaseReadiness{}
+	var failed []solarv1alpha1.TargetReleaseStatus
+
 	pendingDeps := false
 
 	for _, binding := range bindingList.Items {
@@ -316,6 +406,7 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}, rel); err != nil {
 			if apierrors.IsNotFound(err) {
 				log.V(1).Info("Release not found", "release", binding.Spec.ReleaseRef.Name)
+				readiness.notReady(binding.Spec.ReleaseRef.Name, "ReleaseNotFound", "Release not found")
 				pendingDeps = true
 
 				continue
@@ -344,6 +435,8 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			}
 			if !granted {
 				log.V(1).Info("ComponentVersion access not granted", "cv", rel.Spec.ComponentVersionRef.Name, "namespace", cvNamespace)
+				readiness.notReady(rel.Name, "ComponentVersionNotGranted",
+					fmt.Sprintf("No ReferenceGrant in namespace %s grants access to ComponentVersion %s", cvNamespace, rel.Spec.ComponentVersionRef.Name))
 				pendingDeps = true
 
 				continue
@@ -356,6 +449,8 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}, cv); err != nil {
 			if apierrors.IsNotFound(err) {
 				log.V(1).Info("ComponentVersion not found", "cv", rel.Spec.ComponentVersionRef.Name)
+				readiness.notReady(rel.Name, "ComponentVersionNotFound",
+					fmt.Sprintf("ComponentVersion %s not found", rel.Spec.ComponentVersionRef.Name))
 				pendingDeps = true
 
 				continue
@@ -364,13 +459,60 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			return ctrl.Result{}, errLogAndWrap(log, err, "failed to get ComponentVersion")
 		}
 
-		rtName := releaseRenderTaskName(rel.Namespace, rel.Name, target.Name, rel.GetGeneration())
+		var heldReason string
+		if rel.Spec.Suspend {
+			heldReason = "Suspended"
+		} else if open, _, err := releaseWindow(rel.Spec.Schedule, time.Now()); err != nil {
+			failed = append(failed, readiness.notReady(rel.Name, "InvalidSchedule",
+				fmt.Sprintf("Release %s: %s", rel.Name, err)))
+
+			continue
+		} else if !open {
+			heldReason = "OutsideMaintenanceWindow"
+		}
+
+		// Releases rolled back to a prior revision, suspended or outside
+		// their maintenance windows reuse a rendered chart and need no
+		// RenderTask.
+		var rtName string
+		var valuesFrom runtime.RawExtension
+		var secretKeyPaths [][]string
+		if rel.Spec.RollbackTo == nil && heldReason == "" {
+			rtName = releaseRenderTaskName(rel.Namespace, rel.Name, target.Name, rel.GetGeneration())
+
+			var err error
+			valuesFrom, secretKeyPaths, err = r.resolveValuesFrom(ctx, rel)
+			if errors.Is(err, ErrValuesFromUnavailable) {
+				failed = append(failed, readiness.notReady(rel.Name, "ValuesFromUnavailable",
+					fmt.Sprintf("Release %s: %s", rel.Name, err)))
+
+				continue
+			} else if err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to resolve Release valuesFrom")
+			}
+		}
+
+		values, err := mergeValuesFrom(valuesFrom, rel.Spec.Values)
+		if err == nil {
+			_, err = mergeReleaseValues(values, binding.Spec.Values)
+		}
+		if err != nil {
+			failed = append(failed, readiness.notReady(rel.Name, "InvalidValues",
+				fmt.Sprintf("ReleaseBinding %s: %s", binding.Name, err)))
+
+			continue
+		}
+
 		releases = append(releases, releaseInfo{
-			bindingKey: binding.Namespace + "/" + binding.Name,
-			name:       rel.Name,
-			release:    rel,
-			cv:         cv,
-			rtName:     rtName,
+			bindingKey:     binding.Namespace + "/" + binding.Name,
+			name:           rel.Name,
+			release:        rel,
+			cv:             cv,
+			overrides:      binding.Spec.Values,
+			valuesFrom:     valuesFrom,
+			secretKeyPaths: secretKeyPaths,
+			rtName:         rtName,
+			heldReason:     heldReason,
 		})
 	}
 
@@ -381,7 +523,10 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, condErr
 	}
 
-	if len(releases) == 0 && !pendingDeps {
+	if len(releases) == 0 && !pendingDeps && len(failed) == 0 {
+		if err := r.setReleaseStatuses(ctx, target, nil); err != nil {
+			return ctrl.Result{}, errLogAndWrap(log, err, "failed to clear release statuses")
+		}
 		if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "AllReleaseBindingsFiltered",
 			"All ReleaseBindings were filtered out by the release resolver (uniqueName conflicts or anti-affinity rules)"); condErr != nil {
 			return ctrl.Result{}, condErr
@@ -394,13 +539,74 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// The renderer job handles dedup by skipping if the chart already exists in the registry.
 	allRendered := true
 
+	var held []int
 	for i, ri := range releases {
+		if ri.release.Spec.RollbackTo != nil {
+			rev, err := r.rollbackRevision(ctx, ri.release, target)
+			if err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to resolve rollback revision")
+			}
+			if rev == nil {
+				failed = append(failed, readiness.notReady(ri.name, "RollbackUnavailable",
+					fmt.Sprintf("Release %s has no rendered chart for revision %d", ri.name, *ri.release.Spec.RollbackTo)))
+
+				continue
+			}
+
+			bName := renderBindingName(rev.ArtifactName, target.Name)
+			if err := r.ensureRenderBinding(ctx, target, rev.ArtifactName, bName); err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to ensure RenderBinding for rolled back release")
+			}
+			releases[i].chartURL = rev.ChartURL
+			releases[i].chartDigest = rev.ChartDigest
+			releases[i].artifactName = rev.ArtifactName
+			releases[i].artifactBindingName = bName
+			readiness.ready(ri.name, rev.ChartURL, rev.ChartDigest)
+
+			continue
+		}
+
+		if ri.heldReason != "" {
+			rev, err := r.renderedRevision(ctx, latestReleaseRevision(ri.release.Status.History, target.Namespace, target.Name), target)
+			if err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to resolve last rendered revision")
+			}
+			if rev == nil {
+				// Never rendered for this Target: leave it out until resumed
+				// or until its next maintenance window opens.
+				log.V(1).Info("Skipping held release without a rendered chart", "release", ri.name, "reason", ri.heldReason)
+				if ri.heldReason == "Suspended" {
+					readiness.notReady(ri.name, ri.heldReason, "Release is suspended and was never rendered for this Target")
+				} else {
+					readiness.notReady(ri.name, ri.heldReason, "Release is outside its maintenance windows and was never rendered for this Target")
+				}
+				held = append(held, i)
+
+				continue
+			}
+
+			bName := renderBindingName(rev.ArtifactName, target.Name)
+			if err := r.ensureRenderBinding(ctx, target, rev.ArtifactName, bName); err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to ensure RenderBinding for held release")
+			}
+			releases[i].chartURL = rev.ChartURL
+			releases[i].chartDigest = rev.ChartDigest
+			releases[i].artifactName = rev.ArtifactName
+			releases[i].artifactBindingName = bName
+			readiness.ready(ri.name, rev.ChartURL, rev.ChartDigest)
+
+			continue
+		}
+
 		rt := &solarv1alpha1.RenderTask{}
 		err := r.Get(ctx, client.ObjectKey{Name: ri.rtName, Namespace: target.Namespace}, rt)
 
+		var sensitive runtime.RawExtension
 		switch {
 		case apierrors.IsNotFound(err):
-			spec, specErr := r.computeReleaseRenderTaskSpec(ri.release, ri.cv, registry, target, pullSecretsByHost)
+			var spec solarv1alpha1.RenderTaskSpec
+			var specErr error
+			spec, sensitive, specErr = r.computeReleaseRenderTaskSpec(ri.release, ri.valuesFrom, ri.secretKeyPaths, ri.overrides, ri.cv, registry, target, pullSecretsByHost)
 			if specErr != nil {
 				if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "MissingRegistryBinding",
 					specErr.Error()); condErr != nil {
@@ -430,7 +636,9 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		default:
 			// RenderTask exists — check for spec drift (e.g. pull secrets
 			// changed after a RegistryBinding was created/updated).
-			desiredSpec, specErr := r.computeReleaseRenderTaskSpec(ri.release, ri.cv, registry, target, pullSecretsByHost)
+			var desiredSpec solarv1alpha1.RenderTaskSpec
+			var specErr error
+			desiredSpec, sensitive, specErr = r.computeReleaseRenderTaskSpec(ri.release, ri.valuesFrom, ri.secretKeyPaths, ri.overrides, ri.cv, registry, target, pullSecretsByHost)
 			if specErr != nil {
 				if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "MissingRegistryBinding",
 					specErr.Error()); condErr != nil {
@@ -463,18 +671,23 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			}
 		}
 
-		// Check if release RenderTask is complete
-		if apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobFailed) {
-			if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "ReleaseFailed",
-				fmt.Sprintf("Release %s rendering failed", ri.name)); condErr != nil {
-				return ctrl.Result{}, condErr
+		if rt.Spec.SensitiveValuesSecretRef != nil {
+			if err := r.ensureSensitiveValuesSecret(ctx, rt, sensitive); err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to ensure sensitive values Secret for release RenderTask")
 			}
+		}
 
-			return ctrl.Result{}, nil
+		// Check if release RenderTask is complete
+		if cond := apimeta.FindStatusCondition(rt.Status.Conditions, ConditionTypeJobFailed); cond != nil && cond.Status == metav1.ConditionTrue {
+			failed = append(failed, readiness.notReady(ri.name, "ReleaseFailed",
+				fmt.Sprintf("Release %s rendering failed: %s", ri.name, cond.Message)))
+
+			continue
 		}
 
 		if apimeta.IsStatusConditionTrue(rt.Status.Conditions, ConditionTypeJobSucceeded) && rt.Status.ChartURL != "" {
 			releases[i].chartURL = rt.Status.ChartURL
+			releases[i].chartDigest = rt.Status.ChartDigest
 
 			// Ensure a RenderArtifact object exists for the pushed OCI artifact, and
 			// create a RenderBinding linking this Target to it.
@@ -487,14 +700,46 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			if err := r.ensureRenderArtifact(ctx, aName, rt, registry.Spec.Flavor, registryNamespace); err != nil {
 				return ctrl.Result{}, errLogAndWrap(log, err, "failed to ensure RenderArtifact for release")
 			}
+			if err := r.recordReleaseRevision(ctx, ri.release, target, rt, aName); err != nil {
+				return ctrl.Result{}, errLogAndWrap(log, err, "failed to record Release revision")
+			}
 			releases[i].artifactName = aName
 			releases[i].artifactBindingName = bName
+			readiness.ready(ri.name, rt.Status.ChartURL, rt.Status.ChartDigest)
 		} else {
+			readiness.notReady(ri.name, "Pending", fmt.Sprintf("Waiting for RenderTask %s to complete", ri.rtName))
 			allRendered = false
 		}
 	}
 
-	if pendingDeps {
+	for _, i := range slices.Backward(held) {
+		releases = slices.Delete(releases, i, i+1)
+	}
+
+	if err := r.setReleaseStatuses(ctx, target, readiness.statuses()); err != nil {
+		return ctrl.Result{}, errLogAndWrap(log, err, "failed to update release statuses")
+	}
+
+	// Only rendered releases are bundled into the bootstrap chart. Unless the
+	// Target allows partial rendering, this is only reached once all are.
+	var rendered []releaseInfo
+	for _, ri := range releases {
+		if ri.chartURL != "" {
+			rendered = append(rendered, ri)
+		}
+	}
+	partial := target.Spec.AllowPartialRender && len(rendered) > 0
+
+	if len(failed) > 0 && !partial {
+		if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, failed[0].Reason,
+			failed[0].Message); condErr != nil {
+			return ctrl.Result{}, condErr
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if pendingDeps && !partial {
 		if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "MissingDependencies",
 			"One or more bound Releases or ComponentVersions not found"); condErr != nil {
 			return ctrl.Result{}, condErr
@@ -504,7 +749,7 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			apimeta.FindStatusCondition(target.Status.Conditions, ConditionTypeReleasesRendered), time.Now())}, nil
 	}
 
-	if !allRendered {
+	if !allRendered && !partial {
 		if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "Pending",
 			"Waiting for release RenderTasks to complete"); condErr != nil {
 			return ctrl.Result{}, condErr
@@ -514,7 +759,12 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			apimeta.FindStatusCondition(target.Status.Conditions, ConditionTypeReleasesRendered), time.Now())}, nil
 	}
 
-	if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionTrue, "AllRendered",
+	if len(failed) > 0 || pendingDeps || !allRendered {
+		if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionFalse, "PartiallyRendered",
+			fmt.Sprintf("Rendered %d of %d releases, missing: %s", len(rendered), len(readiness), readiness.missing())); condErr != nil {
+			return ctrl.Result{}, condErr
+		}
+	} else if condErr := r.setCondition(ctx, target, ConditionTypeReleasesRendered, metav1.ConditionTrue, "AllRendered",
 		"All releases rendered successfully"); condErr != nil {
 		return ctrl.Result{}, condErr
 	}
@@ -537,7 +787,7 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	default:
 		// RenderTask exists — check if the desired bootstrap input changed
 		// (release set, resolved refs/tags, or userdata)
-		desiredInput, inputErr := buildBootstrapInput(target, releases, registry.Spec.TargetPullSecretName, registry.Spec.PlainHTTP)
+		desiredInput, inputErr := buildBootstrapInput(target, rendered, registry.Spec.TargetPullSecretName, registry.Spec.PlainHTTP)
 		if inputErr != nil {
 			return ctrl.Result{}, errLogAndWrap(log, inputErr, "failed to build desired bootstrap input for comparison")
 		}
@@ -550,7 +800,7 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	if needsNewBootstrap {
-		spec, specErr := r.computeBootstrapRenderTaskSpec(target, releases, registry, bootstrapVersion)
+		spec, specErr := r.computeBootstrapRenderTaskSpec(target, rendered, registry, bootstrapVersion)
 		if specErr != nil {
 			return ctrl.Result{}, errLogAndWrap(log, specErr, "failed to compute bootstrap RenderTask spec")
 		}
@@ -588,9 +838,9 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Update target status from bootstrap RenderTask
-	if apimeta.IsStatusConditionTrue(bootstrapRT.Status.Conditions, ConditionTypeJobFailed) {
+	if cond := apimeta.FindStatusCondition(bootstrapRT.Status.Conditions, ConditionTypeJobFailed); cond != nil && cond.Status == metav1.ConditionTrue {
 		if condErr := r.setCondition(ctx, target, ConditionTypeBootstrapReady, metav1.ConditionFalse, "Failed",
-			"Bootstrap rendering failed"); condErr != nil {
+			"Bootstrap rendering failed: "+cond.Message); condErr != nil {
 			return ctrl.Result{}, condErr
 		}
 
@@ -622,20 +872,27 @@ func (r *TargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		if err := r.deleteStaleRenderTasks(ctx, target, currentRTNames); err != nil {
 			// Stale cleanup is best-effort: a failure here does not affect the desired state
 			// that was just reconciled. The next reconcile will retry the cleanup.
-			log.Error(err, "failed to clean up stale RenderTasks")
+			_ = observability.RecordError(ctx, fmt.Errorf("failed to clean up stale RenderTasks: %w", err))
 		}
 
-		// Clean up stale RenderBindings owned by this target.
+		// Clean up stale RenderBindings owned by this target. The charts of
+		// revisions still listed in a Release's history are kept so the
+		// Release can be rolled back to them.
 		currentBindingNames := map[string]struct{}{bootstrapBindingName: {}}
 		for _, ri := range releases {
 			if ri.artifactBindingName != "" {
 				currentBindingNames[ri.artifactBindingName] = struct{}{}
 			}
+			for _, h := range ri.release.Status.History {
+				if h.TargetRef.Namespace == target.Namespace && h.TargetRef.Name == target.Name {
+					currentBindingNames[renderBindingName(h.ArtifactName, target.Name)] = struct{}{}
+				}
+			}
 		}
 		if err := r.deleteStaleRenderBindings(ctx, target, currentBindingNames); err != nil {
 			// Stale cleanup is best-effort: a failure here does not affect the desired state
 			// that was just reconciled. The next reconcile will retry the cleanup.
-			log.Error(err, "failed to clean up stale RenderBindings")
+			_ = observability.RecordError(ctx, fmt.Errorf("failed to clean up stale RenderBindings: %w", err))
 		}
 
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
@@ -662,6 +919,20 @@ func (r *TargetReconciler) setCondition(ctx context.Context, target *solarv1alph
 	return nil
 }
 
+// setReleaseStatuses updates status.releases of target if it changed.
+func (r *TargetReconciler) setReleaseStatuses(ctx context.Context, target *solarv1alpha1.Target, statuses []solarv1alpha1.TargetReleaseStatus) error {
+	if apiequality.Semantic.DeepEqual(target.Status.Releases, statuses) {
+		return nil
+	}
+
+	target.Status.Releases = statuses
+	if err := r.Status().Update(ctx, target); err != nil {
+		return fmt.Errorf("failed to update Target release statuses: %w", err)
+	}
+
+	return nil
+}
+
 func (r *TargetReconciler) setResolvedCondition(ctx context.Context, target *solarv1alpha1.Target, skipped []string) error {
 	if len(skipped) == 0 {
 		return r.setCondition(ctx, target, ConditionTypeReleasesResolved, metav1.ConditionTrue, "NoConflicts", "")
@@ -780,7 +1051,29 @@ func resolveReleaseConflicts(releases []releaseInfo) ([]releaseInfo, []string) {
 
 // deleteStaleRenderTasks removes RenderTasks owned by this target that are no
 // longer needed. Any owned RenderTask whose name is not in currentRTNames is
-// deleted. This covers both old bootstrap versions and old release generations.
+// deleted, except dry runs. This covers both old bootstrap versions and old
+// release generations.
+// ensureSensitiveValuesSecret creates or updates the Secret referenced by the
+// SensitiveValuesSecretRef of a release RenderTask. The Secret is owned by the
+// RenderTask, so it is deleted along with it.
+func (r *TargetReconciler) ensureSensitiveValuesSecret(ctx context.Context, rt *solarv1alpha1.RenderTask, values runtime.RawExtension) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rt.Spec.SensitiveValuesSecretRef.Name,
+			Namespace: rt.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{sensitiveValuesKey: values.Raw}
+
+		return controllerutil.SetControllerReference(rt, secret, r.Scheme)
+	})
+
+	return err
+}
+
 func (r *TargetReconciler) deleteStaleRenderTasks(ctx context.Context, target *solarv1alpha1.Target, currentRTNames map[string]struct{}) error {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -798,7 +1091,8 @@ func (r *TargetReconciler) deleteStaleRenderTasks(ctx context.Context, target *s
 			continue
 		}
 
-		if _, current := currentRTNames[rt.Name]; current {
+		// Dry runs are created by users to preview a render and left to them.
+		if _, current := currentRTNames[rt.Name]; current || isDryRun(rt) {
 			continue
 		}
 
@@ -884,6 +1178,102 @@ func (r *TargetReconciler) deleteOwnedRenderBindings(ctx context.Context, target
 	return nil
 }
 
+// recordReleaseRevision adds the chart rendered for the Release's current
+// generation on the given Target to the Release's Status.History, pruning the
+// Target's entries to the Release's history limit. It is a no-op if the chart
+// is recorded already.
+func (r *TargetReconciler) recordReleaseRevision(ctx context.Context, rel *solarv1alpha1.Release, target *solarv1alpha1.Target, rt *solarv1alpha1.RenderTask, artifactName string) error {
+	chartURL := rt.Status.ChartURL
+	if isReleaseRevisionRecorded(rel, target, chartURL, artifactName) {
+		return nil
+	}
+
+	renderedAt := metav1.Now()
+	if rt.Status.RenderedAt != nil {
+		renderedAt = *rt.Status.RenderedAt
+	}
+
+	cvNamespace := rel.Namespace
+	if rel.Spec.ComponentVersionNamespace != "" {
+		cvNamespace = rel.Spec.ComponentVersionNamespace
+	}
+
+	revision := solarv1alpha1.ReleaseRevision{
+		Revision: rel.Generation,
+		TargetRef: corev1.ObjectReference{
+			APIVersion: solarv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Target",
+			Namespace:  target.Namespace,
+			Name:       target.Name,
+		},
+		ComponentVersionRef: &corev1.ObjectReference{
+			APIVersion: solarv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ComponentVersion",
+			Namespace:  cvNamespace,
+			Name:       rel.Spec.ComponentVersionRef.Name,
+		},
+		ChartURL:     chartURL,
+		ArtifactName: artifactName,
+		ValuesHash:   releaseValuesHash(rt.Spec.RendererConfig.ReleaseConfig.Values),
+		ChartDigest:  rt.Status.ChartDigest,
+		ChartSize:    rt.Status.ChartSize,
+		SignatureRef: rt.Status.SignatureRef,
+		RenderedAt:   renderedAt,
+	}
+
+	// Several Targets may record revisions of the same Release concurrently;
+	// the optimistic lock keeps them from overwriting each other's entries.
+	// The revision was rendered for the generation read before, so it is not
+	// recorded if the Release changed in the meantime.
+	generation := rel.Generation
+
+	return patchOnConflict(ctx, r.Client, rel, true, func(latest *solarv1alpha1.Release) bool {
+		if latest.Generation != generation || isReleaseRevisionRecorded(latest, target, chartURL, artifactName) {
+			return false
+		}
+		latest.Status.History = recordReleaseRevision(latest.Status.History, revision, releaseHistoryLimit(latest))
+
+		return true
+	})
+}
+
+// isReleaseRevisionRecorded reports whether the chart at chartURL is recorded
+// as the revision of the current generation of rel on target.
+func isReleaseRevisionRecorded(rel *solarv1alpha1.Release, target *solarv1alpha1.Target, chartURL, artifactName string) bool {
+	h := findReleaseRevision(rel.Status.History, rel.Generation, target.Namespace, target.Name)
+
+	return h != nil && h.ChartURL == chartURL && h.ArtifactName == artifactName
+}
+
+// rollbackRevision returns the history entry the Release is rolled back to on
+// the given Target, or nil if that revision was never rendered for the Target
+// or its RenderArtifact is gone.
+func (r *TargetReconciler) rollbackRevision(ctx context.Context, rel *solarv1alpha1.Release, target *solarv1alpha1.Target) (*solarv1alpha1.ReleaseRevision, error) {
+	return r.renderedRevision(ctx, findReleaseRevision(rel.Status.History, *rel.Spec.RollbackTo, target.Namespace, target.Name), target)
+}
+
+// renderedRevision returns rev if its RenderArtifact still exists in the
+// Target's namespace, or nil otherwise.
+func (r *TargetReconciler) renderedRevision(ctx context.Context, rev *solarv1alpha1.ReleaseRevision, target *solarv1alpha1.Target) (*solarv1alpha1.ReleaseRevision, error) {
+	if rev == nil {
+		return nil, nil
+	}
+
+	artifact := &solarv1alpha1.RenderArtifact{}
+	if err := r.Get(ctx, client.ObjectKey{Name: rev.ArtifactName, Namespace: target.Namespace}, artifact); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	if !artifact.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+
+	return rev, nil
+}
+
 // ensureRenderArtifact creates a RenderArtifact for the given RenderTask's OCI coordinates
 // if one does not already exist. Idempotent: if it already exists (possibly created by
 // another Target reconciling the same shared artifact), this is a no-op.
@@ -959,7 +1349,93 @@ func (r *TargetReconciler) ensureRenderBinding(ctx context.Context, target *sola
 	return nil
 }
 
-func (r *TargetReconciler) computeReleaseRenderTaskSpec(rel *solarv1alpha1.Release, cv *solarv1alpha1.ComponentVersion, registry *solarv1alpha1.Registry, target *solarv1alpha1.Target, pullSecretsByHost map[string]string) (solarv1alpha1.RenderTaskSpec, error) {
+// resolveValuesFrom reads the ConfigMap and Secret keys referenced by the
+// Release's ValuesFrom and merges them in order on top of the defaults of the
+// Release's namespace, see ReleaseDefaultsConfigMap. Missing objects and keys
+// of optional selectors are skipped, as is a missing defaults ConfigMap. It
+// also returns the key paths of the values read from Secrets.
+func (r *TargetReconciler) resolveValuesFrom(ctx context.Context, rel *solarv1alpha1.Release) (runtime.RawExtension, [][]string, error) {
+	var (
+		merged         map[string]any
+		secretKeyPaths [][]string
+	)
+
+	defaults := &corev1.ConfigMap{}
+	if err := r.Get(ctx, client.ObjectKey{Name: ReleaseDefaultsConfigMap, Namespace: rel.Namespace}, defaults); err != nil && !apierrors.IsNotFound(err) {
+		return runtime.RawExtension{}, nil, err
+	} else if data, found := defaults.Data[ReleaseDefaultsKey]; err == nil && found {
+		if err := yaml.Unmarshal([]byte(data), &merged); err != nil {
+			return runtime.RawExtension{}, nil, fmt.Errorf("%w: key %q of ConfigMap %s must hold a YAML object: %w",
+				ErrValuesFromUnavailable, ReleaseDefaultsKey, ReleaseDefaultsConfigMap, err)
+		}
+	}
+
+	for _, ref := range rel.Spec.ValuesFrom {
+		var (
+			kind, name, key string
+			optional        *bool
+			data            string
+			found           bool
+		)
+
+		switch {
+		case ref.ConfigMapKeyRef != nil:
+			kind, name, key, optional = "ConfigMap", ref.ConfigMapKeyRef.Name, ref.ConfigMapKeyRef.Key, ref.ConfigMapKeyRef.Optional
+			cm := &corev1.ConfigMap{}
+			if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: rel.Namespace}, cm); err != nil && !apierrors.IsNotFound(err) {
+				return runtime.RawExtension{}, nil, err
+			} else if err == nil {
+				data, found = cm.Data[key]
+			}
+		case ref.SecretKeyRef != nil:
+			kind, name, key, optional = "Secret", ref.SecretKeyRef.Name, ref.SecretKeyRef.Key, ref.SecretKeyRef.Optional
+			secret := &corev1.Secret{}
+			if err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: rel.Namespace}, secret); err != nil && !apierrors.IsNotFound(err) {
+				return runtime.RawExtension{}, nil, err
+			} else if err == nil {
+				var raw []byte
+				raw, found = secret.Data[key]
+				data = string(raw)
+			}
+		default:
+			continue
+		}
+
+		if !found {
+			if optional != nil && *optional {
+				continue
+			}
+
+			return runtime.RawExtension{}, nil, fmt.Errorf("%w: key %q of %s %s not found", ErrValuesFromUnavailable, key, kind, name)
+		}
+
+		var values map[string]any
+		if err := yaml.Unmarshal([]byte(data), &values); err != nil {
+			return runtime.RawExtension{}, nil, fmt.Errorf("%w: key %q of %s %s must hold a YAML object: %w", ErrValuesFromUnavailable, key, kind, name, err)
+		}
+		if ref.SecretKeyRef != nil {
+			secretKeyPaths = append(secretKeyPaths, valueKeyPaths(values, nil)...)
+		}
+		merged = mergeValueMaps(merged, values)
+	}
+
+	if merged == nil {
+		return runtime.RawExtension{}, nil, nil
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return runtime.RawExtension{}, nil, err
+	}
+
+	return runtime.RawExtension{Raw: data}, secretKeyPaths, nil
+}
+
+// computeReleaseRenderTaskSpec returns the spec of the release RenderTask of
+// rel for target, and the values at the Release's SensitivePaths and at
+// secretKeyPaths, which are left out of the spec and stored in the Secret it
+// references instead.
+func (r *TargetReconciler) computeReleaseRenderTaskSpec(rel *solarv1alpha1.Release, valuesFrom runtime.RawExtension, secretKeyPaths [][]string, overrides runtime.RawExtension, cv *solarv1alpha1.ComponentVersion, registry *solarv1alpha1.Registry, target *solarv1alpha1.Target, pullSecretsByHost map[string]string) (solarv1alpha1.RenderTaskSpec, runtime.RawExtension, error) {
 	chartName := fmt.Sprintf("release-%s", rel.Name)
 	repo := fmt.Sprintf("%s/%s/%s", target.Namespace, rel.Namespace, chartName)
 
@@ -970,7 +1446,7 @@ func (r *TargetReconciler) computeReleaseRenderTaskSpec(rel *solarv1alpha1.Relea
 
 	resolvedResources, err := resolveResources(cv.Spec.Resources, pullSecretsByHost, r.RegistryBindingStrict)
 	if err != nil {
-		return solarv1alpha1.RenderTaskSpec{}, fmt.Errorf("release %s: %w", rel.Name, err)
+		return solarv1alpha1.RenderTaskSpec{}, runtime.RawExtension{}, fmt.Errorf("release %s: %w", rel.Name, err)
 	}
 
 	// Include a hash of pull-secret names in the tag so that charts whose
@@ -979,6 +1455,37 @@ func (r *TargetReconciler) computeReleaseRenderTaskSpec(rel *solarv1alpha1.Relea
 	// recreation (e.g. RegistryBinding created after the first render).
 	tag := fmt.Sprintf("v0.0.%d-%s", rel.GetGeneration(), pullSecretsTag(resolvedResources))
 
+	// Likewise for value overrides of the ReleaseBinding and values from
+	// referenced ConfigMaps and Secrets, which change the chart without
+	// bumping the Release's generation.
+	values, err := mergeValuesFrom(valuesFrom, rel.Spec.Values)
+	if err != nil {
+		return solarv1alpha1.RenderTaskSpec{}, runtime.RawExtension{}, fmt.Errorf("release %s: %w", rel.Name, err)
+	}
+	values, err = mergeReleaseValues(values, overrides)
+	if err != nil {
+		return solarv1alpha1.RenderTaskSpec{}, runtime.RawExtension{}, fmt.Errorf("release %s: %w", rel.Name, err)
+	}
+	if t := valuesTag(overrides); t != "" {
+		tag += "-" + t
+	}
+	if t := valuesTag(valuesFrom); t != "" {
+		tag += "-" + t
+	}
+
+	// The tag covers the sensitive values as well, so changing them renders
+	// a new chart although they are not part of the spec.
+	values, sensitive, err := splitSensitiveValues(values, rel.Spec.SensitivePaths, secretKeyPaths)
+	if err != nil {
+		return solarv1alpha1.RenderTaskSpec{}, runtime.RawExtension{}, fmt.Errorf("release %s: %w", rel.Name, err)
+	}
+	var sensitiveRef *corev1.LocalObjectReference
+	if len(sensitive.Raw) > 0 {
+		sensitiveRef = &corev1.LocalObjectReference{
+			Name: sensitiveValuesSecretName(releaseRenderTaskName(rel.Namespace, rel.Name, target.Name, rel.GetGeneration())),
+		}
+	}
+
 	return solarv1alpha1.RenderTaskSpec{
 		RendererConfig: solarv1alpha1.RendererConfig{
 			Type: solarv1alpha1.RendererConfigTypeRelease,
@@ -994,20 +1501,24 @@ func (r *TargetReconciler) computeReleaseRenderTaskSpec(rel *solarv1alpha1.Relea
 					Resources:  resolvedResources,
 					Entrypoint: cv.Spec.Entrypoint,
 				},
-				Values:          rel.Spec.Values,
+				Values:          values,
 				TargetNamespace: targetNamespace,
 			},
 		},
-		Repository:     repo,
-		Tag:            tag,
-		BaseURL:        registry.Spec.Hostname,
-		PlainHTTP:      registry.Spec.PlainHTTP,
-		PushSecretRef:  registry.Spec.SolarSecretRef,
-		FailedJobTTL:   rel.Spec.FailedJobTTL,
-		OwnerName:      target.Name,
-		OwnerNamespace: target.Namespace,
-		OwnerKind:      "Target",
-	}, nil
+		Repository:               repo,
+		Tag:                      tag,
+		BaseURL:                  registry.Spec.Hostname,
+		PlainHTTP:                registry.Spec.PlainHTTP,
+		PushSecretRef:            registry.Spec.SolarSecretRef,
+		SensitiveValuesSecretRef: sensitiveRef,
+		FailedJobTTL:             rel.Spec.FailedJobTTL,
+		BackoffLimit:             rel.Spec.BackoffLimit,
+		ActiveDeadlineSeconds:    rel.Spec.ActiveDeadlineSeconds,
+		Priority:                 rel.Spec.Priority,
+		OwnerName:                target.Name,
+		OwnerNamespace:           target.Namespace,
+		OwnerKind:                "Target",
+	}, sensitive, nil
 }
 
 // buildBootstrapInput constructs the desired BootstrapInput from the current
@@ -1029,6 +1540,7 @@ func buildBootstrapInput(target *solarv1alpha1.Target, releases []releaseInfo, r
 		resolvedReleases[ri.uniqueName] = solarv1alpha1.ResolvedResourceAccess{
 			Repository:     ref.Context().String(),
 			Tag:            ref.Identifier(),
+			Digest:         ri.chartDigest,
 			PullSecretName: renderRegistryPullSecret,
 			Insecure:       insecure,
 		}
@@ -1046,9 +1558,16 @@ func (r *TargetReconciler) computeBootstrapRenderTaskSpec(target *solarv1alpha1.
 		return solarv1alpha1.RenderTaskSpec{}, err
 	}
 
+	// The bootstrap chart is the last step of a rollout, so it is queued with the
+	// highest priority of the releases it bundles.
+	var priority int32
+
 	releaseNames := make([]string, 0, len(releases))
-	for _, ri := range releases {
+	for i, ri := range releases {
 		releaseNames = append(releaseNames, ri.name)
+		if i == 0 || ri.release.Spec.Priority > priority {
+			priority = ri.release.Spec.Priority
+		}
 	}
 
 	sort.Strings(releaseNames)
@@ -1070,14 +1589,18 @@ func (r *TargetReconciler) computeBootstrapRenderTaskSpec(target *solarv1alpha1.
 				Input: input,
 			},
 		},
-		Repository:     repo,
-		Tag:            tag,
-		BaseURL:        registry.Spec.Hostname,
-		PlainHTTP:      registry.Spec.PlainHTTP,
-		PushSecretRef:  registry.Spec.SolarSecretRef,
-		OwnerName:      target.Name,
-		OwnerNamespace: target.Namespace,
-		OwnerKind:      "Target",
+		Repository:            repo,
+		Tag:                   tag,
+		BaseURL:               registry.Spec.Hostname,
+		PlainHTTP:             registry.Spec.PlainHTTP,
+		PushSecretRef:         registry.Spec.SolarSecretRef,
+		FailedJobTTL:          target.Spec.FailedJobTTL,
+		BackoffLimit:          target.Spec.BackoffLimit,
+		ActiveDeadlineSeconds: target.Spec.ActiveDeadlineSeconds,
+		Priority:              priority,
+		OwnerName:             target.Name,
+		OwnerNamespace:        target.Namespace,
+		OwnerKind:             "Target",
 	}, nil
 }
 
@@ -1110,7 +1633,15 @@ func (r *TargetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&solarv1alpha1.Release{},
 			handler.EnqueueRequestsFromMapFunc(r.mapReleaseToTargets),
 		).
-		Complete(r)
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.mapValuesSourceToTargets("ConfigMap")),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapValuesSourceToTargets("Secret")),
+		).
+		Complete(observability.WrapReconciler("Target", r))
 }
 
 // registryGranted checks whether a ReferenceGrant in registryNamespace permits
@@ -1165,6 +1696,26 @@ func (r *TargetReconciler) mapRegistryToTargets(ctx context.Context, obj client.
 		}
 	}
 
+	// Same-namespace targets pushing to the registry through a RegistryBinding
+	// with role deploy.
+	rbList := &solarv1alpha1.RegistryBindingList{}
+	if err := r.List(ctx, rbList,
+		client.InNamespace(reg.Namespace),
+		client.MatchingFields{indexRegistryBindingByRegistryName: reg.Name},
+	); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "failed to list RegistryBindings for Registry", "registry", reg.Name)
+	}
+	for _, rb := range rbList.Items {
+		if rb.Spec.Role == solarv1alpha1.RegistryBindingRoleDeploy && rb.Spec.TargetNamespace == "" {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      rb.Spec.TargetRef.Name,
+					Namespace: rb.Namespace,
+				},
+			})
+		}
+	}
+
 	// Cross-namespace targets: find namespaces that have been granted access to
 	// registries in reg.Namespace, then check their targets.
 	grantList := &solarv1alpha1.ReferenceGrantList{}
@@ -1203,6 +1754,50 @@ func (r *TargetReconciler) mapRegistryToTargets(ctx context.Context, obj client.
 	return requests
 }
 
+// renderRegistryKey returns the key of the Registry rendered charts of target
+// are pushed to: its RenderRegistryRef or, if that is empty, the Registry of
+// the RegistryBinding with role deploy for target. If there is no such
+// Registry, or more than one, it returns an empty key and the reason and
+// message for the RegistryResolved condition.
+func (r *TargetReconciler) renderRegistryKey(ctx context.Context, target *solarv1alpha1.Target) (client.ObjectKey, string, string, error) {
+	if target.Spec.RenderRegistryRef.Name != "" {
+		namespace := target.Namespace
+		if target.Spec.RenderRegistryNamespace != "" {
+			namespace = target.Spec.RenderRegistryNamespace
+		}
+
+		return client.ObjectKey{Name: target.Spec.RenderRegistryRef.Name, Namespace: namespace}, "", "", nil
+	}
+
+	rbList := &solarv1alpha1.RegistryBindingList{}
+	if err := r.List(ctx, rbList,
+		client.InNamespace(target.Namespace),
+		client.MatchingFields{indexRegistryBindingTargetName: target.Name},
+	); err != nil {
+		return client.ObjectKey{}, "", "", err
+	}
+
+	var deploy []string
+	for _, rb := range rbList.Items {
+		if rb.Spec.Role == solarv1alpha1.RegistryBindingRoleDeploy && rb.Spec.TargetNamespace == "" {
+			deploy = append(deploy, rb.Name)
+		}
+	}
+	slices.Sort(deploy)
+
+	switch len(deploy) {
+	case 0:
+		return client.ObjectKey{}, "NoRenderRegistry",
+			"Target has no renderRegistryRef and no RegistryBinding with role deploy", nil
+	case 1:
+		i := slices.IndexFunc(rbList.Items, func(rb solarv1alpha1.RegistryBinding) bool { return rb.Name == deploy[0] })
+		return client.ObjectKey{Name: rbList.Items[i].Spec.RegistryRef.Name, Namespace: target.Namespace}, "", "", nil
+	default:
+		return client.ObjectKey{}, "AmbiguousRenderRegistry",
+			"Multiple RegistryBindings with role deploy for this Target: " + strings.Join(deploy, ", "), nil
+	}
+}
+
 // buildPullSecretsLookup lists RegistryBindings for the given target, resolves
 // each bound Registry, and returns a map from registry hostname to
 // targetPullSecretName. Registries without a targetPullSecretName are included
@@ -1224,6 +1819,11 @@ func (r *TargetReconciler) buildPullSecretsLookup(ctx context.Context, target *s
 	lookup := make(map[string]hostEntry, len(rbList.Items))
 
 	for _, rb := range rbList.Items {
+		// Charts are only pulled from source registries.
+		if rb.Spec.Role == solarv1alpha1.RegistryBindingRoleDeploy {
+			continue
+		}
+
 		reg := &solarv1alpha1.Registry{}
 		if err := r.Get(ctx, client.ObjectKey{
 			Name:      rb.Spec.RegistryRef.Name,
@@ -1510,3 +2110,29 @@ func (r *TargetReconciler) mapReleaseBindingToTarget(_ context.Context, obj clie
 func (r *TargetReconciler) removeRegistryRefFinalizer(ctx context.Context, deletingTarget *solarv1alpha1.Target, registry *solarv1alpha1.Registry) error {
 	return removeRegistryRefFinalizer(ctx, r.Client, deletingTarget, nil, registry)
 }
+
+// mapValuesSourceToTargets returns a map function enqueueing the Targets of
+// all Releases whose ValuesFrom references the given ConfigMap or Secret, or
+// of all Releases in its namespace for the release defaults ConfigMap.
+func (r *TargetReconciler) mapValuesSourceToTargets(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		opts := []client.ListOption{client.InNamespace(obj.GetNamespace())}
+		if kind != "ConfigMap" || obj.GetName() != ReleaseDefaultsConfigMap {
+			opts = append(opts, client.MatchingFields{indexReleaseValuesFrom: kind + "/" + obj.GetName()})
+		}
+
+		releaseList := &solarv1alpha1.ReleaseList{}
+		if err := r.List(ctx, releaseList, opts...); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "failed to list Releases for values source", "kind", kind, "name", obj.GetName())
+
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range releaseList.Items {
+			requests = append(requests, r.mapReleaseToTargets(ctx, &releaseList.Items[i])...)
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// newPartialTestObjects returns a Target bound to the releases "app" and
+// "db", whose release RenderTasks succeeded and failed respectively.
+func newPartialTestObjects(t *testing.T, allowPartialRender bool) (*TargetReconciler, client.Client, *solarv1alpha1.Target) {
+	t.Helper()
+
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	const ns = "default"
+	target := &solarv1alpha1.Target{
+		ObjectMeta: metav1.ObjectMeta{Name: "partial", Namespace: ns, Finalizers: []string{targetFinalizer}},
+		Spec: solarv1alpha1.TargetSpec{
+			RenderRegistryRef:  corev1.LocalObjectReference{Name: "render"},
+			Userdata:           runtime.RawExtension{Raw: []byte(`{}`)},
+			AllowPartialRender: allowPartialRender,
+		},
+	}
+	registry := &solarv1alpha1.Registry{
+		ObjectMeta: metav1.ObjectMeta{Name: "render", Namespace: ns, Finalizers: []string{registryRefFinalizer}},
+		Spec: solarv1alpha1.RegistrySpec{
+			Hostname:       "registry.example.com",
+			SolarSecretRef: &corev1.LocalObjectReference{Name: "push"},
+		},
+	}
+	objs := []client.Object{target, registry}
+
+	r := &TargetReconciler{Scheme: sch, Recorder: events.NewFakeRecorder(64)}
+	for _, name := range []string{"app", "db"} {
+		cv := &solarv1alpha1.ComponentVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-v1", Namespace: ns},
+			Spec: solarv1alpha1.ComponentVersionSpec{
+				ComponentRef: corev1.LocalObjectReference{Name: name},
+			},
+		}
+		rel := &solarv1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Generation: 1},
+			Spec: solarv1alpha1.ReleaseSpec{
+				ComponentVersionRef: corev1.LocalObjectReference{Name: cv.Name},
+			},
+		}
+		spec, _, err := r.computeReleaseRenderTaskSpec(rel, runtime.RawExtension{}, nil, runtime.RawExtension{}, cv, registry, target, map[string]string{})
+		if err != nil {
+			t.Fatalf("computeReleaseRenderTaskSpec: %v", err)
+		}
+		rt := &solarv1alpha1.RenderTask{
+			ObjectMeta: metav1.ObjectMeta{Name: releaseRenderTaskName(ns, name, target.Name, 1), Namespace: ns},
+			Spec:       spec,
+		}
+		if name == "app" {
+			rt.Status.ChartURL = renderChartURL(spec.BaseURL, spec.Repository, spec.Tag)
+			rt.Status.Conditions = []metav1.Condition{{
+				Type: ConditionTypeJobSucceeded, Status: metav1.ConditionTrue, Reason: "JobSucceeded", LastTransitionTime: metav1.Now(),
+			}}
+		} else {
+			rt.Status.Conditions = []metav1.Condition{{
+				Type: ConditionTypeJobFailed, Status: metav1.ConditionTrue, Reason: "JobFailed", Message: "image not found", LastTransitionTime: metav1.Now(),
+			}}
+		}
+
+		objs = append(objs, cv, rel, rt, &solarv1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: solarv1alpha1.ReleaseBindingSpec{
+				TargetRef:  corev1.LocalObjectReference{Name: target.Name},
+				ReleaseRef: corev1.LocalObjectReference{Name: name},
+			},
+		})
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(objs...).
+		WithStatusSubresource(&solarv1alpha1.Target{}, &solarv1alpha1.Release{}, &solarv1alpha1.RenderTask{}).
+		WithIndex(&solarv1alpha1.RegistryBinding{}, indexRegistryBindingTargetName, func(obj client.Object) []string {
+			return []string{obj.(*solarv1alpha1.RegistryBinding).Spec.TargetRef.Name}
+		}).
+		Build()
+	r.Client = c
+	r.APIReader = c
+
+	return r, c, target
+}
+
+func reconcilePartialTarget(t *testing.T, r *TargetReconciler, c client.Client, target *solarv1alpha1.Target) *solarv1alpha1.Target {
+	t.Helper()
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(target)}); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	got := &solarv1alpha1.Target{}
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(target), got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	return got
+}
+
+func assertReleaseStatuses(t *testing.T, got *solarv1alpha1.Target) {
+	t.Helper()
+
+	if n := len(got.Status.Releases); n != 2 {
+		t.Fatalf("got %d release statuses, want 2: %+v", n, got.Status.Releases)
+	}
+	if app := got.Status.Releases[0]; app.Name != "app" || !app.Ready || app.ChartURL == "" {
+		t.Errorf("release app = %+v, want ready with a chart URL", app)
+	}
+	if db := got.Status.Releases[1]; db.Name != "db" || db.Ready || db.Reason != "ReleaseFailed" {
+		t.Errorf("release db = %+v, want not ready with reason ReleaseFailed", db)
+	}
+}
+
+func TestTargetReconcile_FailedReleaseBlocksBootstrap(t *testing.T) {
+	t.Parallel()
+
+	r, c, target := newPartialTestObjects(t, false)
+	got := reconcilePartialTarget(t, r, c, target)
+
+	assertReleaseStatuses(t, got)
+	if cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionTypeReleasesRendered); cond == nil || cond.Reason != "ReleaseFailed" {
+		t.Errorf("ReleasesRendered condition = %+v, want reason ReleaseFailed", cond)
+	}
+
+	rt := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: targetRenderTaskName(target.Name, 0), Namespace: target.Namespace}, rt); err == nil {
+		t.Error("bootstrap RenderTask was created although a release failed")
+	}
+}
+
+func TestTargetReconcile_AllowPartialRender(t *testing.T) {
+	t.Parallel()
+
+	r, c, target := newPartialTestObjects(t, true)
+	got := reconcilePartialTarget(t, r, c, target)
+
+	assertReleaseStatuses(t, got)
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, ConditionTypeReleasesRendered)
+	if cond == nil || cond.Reason != "PartiallyRendered" || cond.Message != "Rendered 1 of 2 releases, missing: db (ReleaseFailed)" {
+		t.Errorf("ReleasesRendered condition = %+v, want reason PartiallyRendered listing db", cond)
+	}
+
+	rt := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: targetRenderTaskName(target.Name, 0), Namespace: target.Namespace}, rt); err != nil {
+		t.Fatalf("bootstrap RenderTask: %v", err)
+	}
+	releases := rt.Spec.RendererConfig.BootstrapConfig.Input.Releases
+	if _, ok := releases["app"]; !ok || len(releases) != 1 {
+		t.Errorf("bootstrap releases = %v, want only app", releases)
+	}
+}
+
+func TestTargetReconcile_PinsReleaseChartDigest(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	r, c, target := newPartialTestObjects(t, true)
+	rt := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: releaseRenderTaskName(target.Namespace, "app", target.Name, 1), Namespace: target.Namespace}, rt); err != nil {
+		t.Fatalf("release RenderTask: %v", err)
+	}
+	rt.Status.ChartDigest = digest
+	if err := c.Status().Update(context.Background(), rt); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	got := reconcilePartialTarget(t, r, c, target)
+	if app := got.Status.Releases[0]; app.ChartDigest != digest {
+		t.Errorf("release app ChartDigest = %q, want %q", app.ChartDigest, digest)
+	}
+
+	bootstrap := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: targetRenderTaskName(target.Name, 0), Namespace: target.Namespace}, bootstrap); err != nil {
+		t.Fatalf("bootstrap RenderTask: %v", err)
+	}
+	if app := bootstrap.Spec.RendererConfig.BootstrapConfig.Input.Releases["app"]; app.Digest != digest {
+		t.Errorf("bootstrap release app = %+v, want digest %q", app, digest)
+	}
+}
+
+func TestReleaseReadiness(t *testing.T) {
+	t.Parallel()
+
+	rr := releaseReadiness{}
+	rr.notReady("web", "Pending", "Waiting for RenderTask render-rel-web to complete")
+	rr.ready("app", "oci://registry.example.com/app:v0.0.1", "sha256:abc")
+	rr.notReady("db", "ReleaseNotFound", "Release not found")
+
+	statuses := rr.statuses()
+	if len(statuses) != 3 || statuses[0].Name != "app" || statuses[1].Name != "db" || statuses[2].Name != "web" {
+		t.Errorf("statuses = %+v, want app, db and web in order", statuses)
+	}
+	if statuses[0].ChartDigest != "sha256:abc" {
+		t.Errorf("app ChartDigest = %q, want sha256:abc", statuses[0].ChartDigest)
+	}
+	if got, want := rr.missing(), "db (ReleaseNotFound), web (Pending)"; got != want {
+		t.Errorf("missing() = %q, want %q", got, want)
+	}
+	if statuses := (releaseReadiness{}).statuses(); statuses != nil {
+		t.Errorf("statuses of no releases = %+v, want nil", statuses)
+	}
+}
+
+func TestTargetReconcile_ReleaseOutsideMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	r, c, target := newPartialTestObjects(t, false)
+
+	// db is only rendered during a window on the 29th of February and was
+	// never rendered before, so it is left out of the bootstrap chart.
+	rel := &solarv1alpha1.Release{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: "db", Namespace: target.Namespace}, rel); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	rel.Spec.Schedule = &solarv1alpha1.ReleaseSchedule{
+		Windows: []solarv1alpha1.MaintenanceWindow{
+			{Start: "0 0 29 2 *", Duration: metav1.Duration{Duration: time.Minute}},
+		},
+	}
+	if err := c.Update(context.Background(), rel); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	got := reconcilePartialTarget(t, r, c, target)
+
+	if n := len(got.Status.Releases); n != 2 {
+		t.Fatalf("got %d release statuses, want 2: %+v", n, got.Status.Releases)
+	}
+	if db := got.Status.Releases[1]; db.Name != "db" || db.Ready || db.Reason != "OutsideMaintenanceWindow" {
+		t.Errorf("release db = %+v, want not ready with reason OutsideMaintenanceWindow", db)
+	}
+
+	rt := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: targetRenderTaskName(target.Name, 0), Namespace: target.Namespace}, rt); err != nil {
+		t.Fatalf("bootstrap RenderTask: %v", err)
+	}
+	releases := rt.Spec.RendererConfig.BootstrapConfig.Input.Releases
+	if _, ok := releases["app"]; !ok || len(releases) != 1 {
+		t.Errorf("bootstrap releases = %v, want only app", releases)
+	}
+}
+
+func TestTargetReconcile_SensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	r, c, target := newPartialTestObjects(t, false)
+
+	rel := &solarv1alpha1.Release{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: target.Namespace}, rel); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	rel.Spec.Values = runtime.RawExtension{Raw: []byte(`{"replicas":2,"database":{"password":"s3cr3t"}}`)}
+	rel.Spec.SensitivePaths = []string{"database.password"}
+	if err := c.Update(context.Background(), rel); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	reconcilePartialTarget(t, r, c, target)
+
+	rt := &solarv1alpha1.RenderTask{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: releaseRenderTaskName(target.Namespace, "app", target.Name, 1), Namespace: target.Namespace}, rt); err != nil {
+		t.Fatalf("release RenderTask: %v", err)
+	}
+	if values := string(rt.Spec.ReleaseConfig.Values.Raw); values != `{"database":{},"replicas":2}` {
+		t.Errorf("RenderTask values = %s, want them without the password", values)
+	}
+	ref := rt.Spec.SensitiveValuesSecretRef
+	if ref == nil {
+		t.Fatal("RenderTask does not reference a sensitive values Secret")
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: ref.Name, Namespace: target.Namespace}, secret); err != nil {
+		t.Fatalf("sensitive values Secret: %v", err)
+	}
+	if data := string(secret.Data[sensitiveValuesKey]); data != `{"database":{"password":"s3cr3t"}}` {
+		t.Errorf("sensitive values = %s, want the password", data)
+	}
+	if owner := metav1.GetControllerOf(secret); owner == nil || owner.Kind != "RenderTask" || owner.Name != rt.Name {
+		t.Errorf("Secret owner = %+v, want the RenderTask %s", owner, rt.Name)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func newRegistryBinding(name, target, registry string, role solarv1alpha1.RegistryBindingRole) *solarv1alpha1.RegistryBinding {
+	return &solarv1alpha1.RegistryBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: solarv1alpha1.RegistryBindingSpec{
+			TargetRef:   corev1.LocalObjectReference{Name: target},
+			RegistryRef: corev1.LocalObjectReference{Name: registry},
+			Role:        role,
+		},
+	}
+}
+
+func TestRenderRegistryKey(t *testing.T) {
+	t.Parallel()
+
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	objs := []client.Object{
+		newRegistryBinding("pull", "bound", "ghcr", solarv1alpha1.RegistryBindingRoleSource),
+		newRegistryBinding("pull-default", "bound", "quay", ""),
+		newRegistryBinding("push", "bound", "harbor", solarv1alpha1.RegistryBindingRoleDeploy),
+		newRegistryBinding("push-a", "ambiguous", "harbor", solarv1alpha1.RegistryBindingRoleDeploy),
+		newRegistryBinding("push-b", "ambiguous", "zot", solarv1alpha1.RegistryBindingRoleDeploy),
+		newRegistryBinding("pull-only", "unbound", "ghcr", solarv1alpha1.RegistryBindingRoleSource),
+	}
+	r := &TargetReconciler{
+		Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).
+			WithIndex(&solarv1alpha1.RegistryBinding{}, indexRegistryBindingTargetName, func(obj client.Object) []string {
+				return []string{obj.(*solarv1alpha1.RegistryBinding).Spec.TargetRef.Name}
+			}).Build(),
+		Scheme: sch,
+	}
+
+	target := func(name string, spec solarv1alpha1.TargetSpec) *solarv1alpha1.Target {
+		return &solarv1alpha1.Target{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}, Spec: spec}
+	}
+
+	tests := []struct {
+		name       string
+		target     *solarv1alpha1.Target
+		wantKey    client.ObjectKey
+		wantReason string
+	}{
+		{
+			name: "explicit ref wins over bindings",
+			target: target("bound", solarv1alpha1.TargetSpec{
+				RenderRegistryRef: corev1.LocalObjectReference{Name: "explicit"},
+			}),
+			wantKey: client.ObjectKey{Name: "explicit", Namespace: "ns"},
+		},
+		{
+			name: "explicit ref in another namespace",
+			target: target("bound", solarv1alpha1.TargetSpec{
+				RenderRegistryRef:       corev1.LocalObjectReference{Name: "shared"},
+				RenderRegistryNamespace: "registries",
+			}),
+			wantKey: client.ObjectKey{Name: "shared", Namespace: "registries"},
+		},
+		{
+			name:    "deploy binding",
+			target:  target("bound", solarv1alpha1.TargetSpec{}),
+			wantKey: client.ObjectKey{Name: "harbor", Namespace: "ns"},
+		},
+		{
+			name:       "only source bindings",
+			target:     target("unbound", solarv1alpha1.TargetSpec{}),
+			wantReason: "NoRenderRegistry",
+		},
+		{
+			name:       "multiple deploy bindings",
+			target:     target("ambiguous", solarv1alpha1.TargetSpec{}),
+			wantReason: "AmbiguousRenderRegistry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, reason, _, err := r.renderRegistryKey(context.Background(), tt.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key != tt.wantKey {
+				t.Errorf("key = %v, want %v", key, tt.wantKey)
+			}
+			if reason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestBuildPullSecretsLookupSkipsDeployBindings(t *testing.T) {
+	t.Parallel()
+
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	objs := []client.Object{
+		&solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "ghcr", Namespace: "ns"},
+			Spec:       solarv1alpha1.RegistrySpec{Hostname: "ghcr.io", TargetPullSecretName: "ghcr-pull"},
+		},
+		&solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "harbor", Namespace: "ns"},
+			Spec:       solarv1alpha1.RegistrySpec{Hostname: "harbor.example.com", TargetPullSecretName: "harbor-pull"},
+		},
+		newRegistryBinding("pull", "prod", "ghcr", ""),
+		newRegistryBinding("push", "prod", "harbor", solarv1alpha1.RegistryBindingRoleDeploy),
+	}
+	r := &TargetReconciler{
+		Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).
+			WithIndex(&solarv1alpha1.RegistryBinding{}, indexRegistryBindingTargetName, func(obj client.Object) []string {
+				return []string{obj.(*solarv1alpha1.RegistryBinding).Spec.TargetRef.Name}
+			}).Build(),
+		Scheme: sch,
+	}
+
+	got, err := r.buildPullSecretsLookup(context.Background(), &solarv1alpha1.Target{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "ns"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got["ghcr.io"] != "ghcr-pull" {
+		t.Errorf("got %v, want only ghcr.io", got)
+	}
+}
@@ -207,6 +207,123 @@ var _ = Describe("TargetController", Ordered, func() {
 		})
 	})
 
+	Context("ReleaseBinding value overrides", Label("target"), func() {
+		It("should merge the ReleaseBinding values into the release RenderTask", func() {
+			registry := newRegistry("test-registry")
+			_ = k8sClient.Create(ctx, registry)
+
+			cv := newComponentVersion("my-cv")
+			Expect(k8sClient.Create(ctx, cv)).To(Succeed())
+
+			rel := newRelease("override-release")
+			rel.Spec.Values = runtime.RawExtension{Raw: []byte(`{"key":"value","replicas":1}`)}
+			Expect(k8sClient.Create(ctx, rel)).To(Succeed())
+
+			target := newTarget("test-overrides")
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+
+			binding := newReleaseBinding("binding-overrides", "test-overrides", "override-release")
+			binding.Spec.Values = runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+			Expect(k8sClient.Create(ctx, binding)).To(Succeed())
+
+			rtName := releaseRenderTaskName(ns.Name, "override-release", "test-overrides", 1)
+			rt := &solarv1alpha1.RenderTask{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: rtName, Namespace: ns.Name}, rt)).To(Succeed())
+				g.Expect(string(rt.Spec.RendererConfig.ReleaseConfig.Values.Raw)).To(MatchJSON(`{"key":"value","replicas":3}`))
+			}, eventuallyTimeout).Should(Succeed())
+			firstTag := rt.Spec.Tag
+
+			// Changing the overrides re-renders under a new tag.
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(binding), binding)).To(Succeed())
+			binding.Spec.Values = runtime.RawExtension{Raw: []byte(`{"replicas":5}`)}
+			Expect(k8sClient.Update(ctx, binding)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: rtName, Namespace: ns.Name}, rt)).To(Succeed())
+				g.Expect(string(rt.Spec.RendererConfig.ReleaseConfig.Values.Raw)).To(MatchJSON(`{"key":"value","replicas":5}`))
+				g.Expect(rt.Spec.Tag).NotTo(Equal(firstTag))
+			}, eventuallyTimeout).Should(Succeed())
+		})
+	})
+
+	Context("Release valuesFrom", Label("target"), func() {
+		It("should merge referenced ConfigMap values and re-render when they change", func() {
+			registry := newRegistry("test-registry")
+			_ = k8sClient.Create(ctx, registry)
+
+			cv := newComponentVersion("my-cv")
+			_ = k8sClient.Create(ctx, cv)
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "env-values", Namespace: ns.Name},
+				Data:       map[string]string{"values.yaml": "env: dev\nreplicas: 2\n"},
+			}
+			Expect(k8sClient.Create(ctx, cm)).To(Succeed())
+
+			rel := newRelease("values-from-release")
+			rel.Spec.Values = runtime.RawExtension{Raw: []byte(`{"replicas":1}`)}
+			rel.Spec.ValuesFrom = []solarv1alpha1.ValuesReference{{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "env-values"},
+					Key:                  "values.yaml",
+				},
+			}}
+			Expect(k8sClient.Create(ctx, rel)).To(Succeed())
+
+			target := newTarget("test-values-from")
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+			Expect(k8sClient.Create(ctx, newReleaseBinding("binding-values-from", "test-values-from", "values-from-release"))).To(Succeed())
+
+			rtName := releaseRenderTaskName(ns.Name, "values-from-release", "test-values-from", 1)
+			rt := &solarv1alpha1.RenderTask{}
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: rtName, Namespace: ns.Name}, rt)).To(Succeed())
+				g.Expect(string(rt.Spec.RendererConfig.ReleaseConfig.Values.Raw)).To(MatchJSON(`{"env":"dev","replicas":1}`))
+			}, eventuallyTimeout).Should(Succeed())
+			firstTag := rt.Spec.Tag
+
+			// Changing the ConfigMap re-renders under a new tag.
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+			cm.Data["values.yaml"] = "env: prod\n"
+			Expect(k8sClient.Update(ctx, cm)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: rtName, Namespace: ns.Name}, rt)).To(Succeed())
+				g.Expect(string(rt.Spec.RendererConfig.ReleaseConfig.Values.Raw)).To(MatchJSON(`{"env":"prod","replicas":1}`))
+				g.Expect(rt.Spec.Tag).NotTo(Equal(firstTag))
+			}, eventuallyTimeout).Should(Succeed())
+		})
+
+		It("should report a missing values reference on the Target", func() {
+			registry := newRegistry("test-registry")
+			_ = k8sClient.Create(ctx, registry)
+
+			cv := newComponentVersion("my-cv")
+			_ = k8sClient.Create(ctx, cv)
+
+			rel := newRelease("missing-values-release")
+			rel.Spec.ValuesFrom = []solarv1alpha1.ValuesReference{{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "missing-values"},
+					Key:                  "values.yaml",
+				},
+			}}
+			Expect(k8sClient.Create(ctx, rel)).To(Succeed())
+
+			target := newTarget("test-missing-values")
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+			Expect(k8sClient.Create(ctx, newReleaseBinding("binding-missing-values", "test-missing-values", "missing-values-release"))).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(target), target)).To(Succeed())
+				cond := apimeta.FindStatusCondition(target.Status.Conditions, ConditionTypeReleasesRendered)
+				g.Expect(cond).NotTo(BeNil())
+				g.Expect(cond.Reason).To(Equal("ValuesFromUnavailable"))
+			}, eventuallyTimeout).Should(Succeed())
+		})
+	})
+
 	Context("RegistryBinding pull secret resolution", Label("target"), func() {
 		It("should populate PullSecretName in the release RenderTask when a RegistryBinding exists", func() {
 			// Create a source registry with a targetPullSecretName
@@ -1061,6 +1178,192 @@ var _ = Describe("TargetController", Ordered, func() {
 			}, eventuallyTimeout).Should(Succeed(), "RenderArtifact should carry the Registry's Flavor")
 		})
 
+		It("should record rendered revisions and roll back to a prior one", func() {
+			registry := newRegistry("test-registry")
+			_ = k8sClient.Create(ctx, registry)
+
+			cv := newComponentVersion("my-cv")
+			Expect(k8sClient.Create(ctx, cv)).To(Succeed())
+
+			rel := newRelease("rel-rollback")
+			Expect(k8sClient.Create(ctx, rel)).To(Succeed())
+
+			target := newTarget("test-rollback")
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, newReleaseBinding("rb-rollback", "test-rollback", "rel-rollback"))).To(Succeed())
+
+			// renderRevision marks the RenderTask of the given generation as succeeded
+			// and returns it.
+			renderRevision := func(generation int64) *solarv1alpha1.RenderTask {
+				rtName := releaseRenderTaskName(ns.Name, "rel-rollback", "test-rollback", generation)
+				rt := &solarv1alpha1.RenderTask{}
+				Eventually(func() error {
+					return k8sClient.Get(ctx, client.ObjectKey{Name: rtName, Namespace: ns.Name}, rt)
+				}, eventuallyTimeout).Should(Succeed())
+
+				chartURL := "oci://" + rt.Spec.BaseURL + "/" + rt.Spec.Repository + ":" + rt.Spec.Tag
+				markRenderTaskSucceeded(rtName, chartURL)
+
+				return rt
+			}
+
+			firstRT := renderRevision(1)
+			firstChartURL := "oci://" + firstRT.Spec.BaseURL + "/" + firstRT.Spec.Repository + ":" + firstRT.Spec.Tag
+			firstArtName := renderArtifactName(ns.Name, firstRT.Spec.BaseURL, firstRT.Spec.Repository, firstRT.Spec.Tag)
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rel), rel)).To(Succeed())
+				g.Expect(rel.Status.History).To(ConsistOf(And(
+					HaveField("Revision", int64(1)),
+					HaveField("TargetRef.Name", "test-rollback"),
+					HaveField("ComponentVersionRef.Name", "my-cv"),
+					HaveField("ComponentVersionRef.Namespace", ns.Name),
+					HaveField("ChartURL", firstChartURL),
+					HaveField("ArtifactName", firstArtName),
+					HaveField("ValuesHash", HavePrefix("sha256:")),
+				)))
+			}, eventuallyTimeout).Should(Succeed())
+
+			rel.Spec.Values = runtime.RawExtension{Raw: []byte(`{"key":"changed"}`)}
+			Expect(k8sClient.Update(ctx, rel)).To(Succeed())
+
+			renderRevision(2)
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rel), rel)).To(Succeed())
+				g.Expect(rel.Status.History).To(HaveLen(2))
+				g.Expect(rel.Status.History[0].Revision).To(Equal(int64(2)))
+			}, eventuallyTimeout).Should(Succeed())
+
+			rel.Spec.RollbackTo = new(int64(1))
+			Expect(k8sClient.Update(ctx, rel)).To(Succeed())
+
+			// The rollback reuses the chart of revision 1 instead of rendering again.
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(target), target)).To(Succeed())
+				bootstrapRT := &solarv1alpha1.RenderTask{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{
+					Name:      targetRenderTaskName(target.Name, target.Status.BootstrapVersion),
+					Namespace: ns.Name,
+				}, bootstrapRT)).To(Succeed())
+				g.Expect(bootstrapRT.Spec.RendererConfig.BootstrapConfig.Input.Releases).To(
+					HaveKeyWithValue("my-unique-component", HaveField("Tag", firstRT.Spec.Tag)))
+			}, eventuallyTimeout).Should(Succeed())
+
+			Consistently(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{
+					Name:      releaseRenderTaskName(ns.Name, "rel-rollback", "test-rollback", 3),
+					Namespace: ns.Name,
+				}, &solarv1alpha1.RenderTask{})
+			}, consistentlyDuration).ShouldNot(Succeed(), "no RenderTask should be created for a rollback")
+
+			Expect(k8sClient.Get(ctx, client.ObjectKey{
+				Name:      renderBindingName(firstArtName, "test-rollback"),
+				Namespace: ns.Name,
+			}, &solarv1alpha1.RenderBinding{})).To(Succeed(), "the rolled back chart must be kept alive")
+		})
+
+		It("should report an unavailable rollback revision", func() {
+			registry := newRegistry("test-registry")
+			_ = k8sClient.Create(ctx, registry)
+
+			cv := newComponentVersion("my-cv")
+			Expect(k8sClient.Create(ctx, cv)).To(Succeed())
+
+			rel := newRelease("rel-rollback-missing")
+			rel.Spec.RollbackTo = new(int64(1))
+			Expect(k8sClient.Create(ctx, rel)).To(Succeed())
+
+			target := newTarget("test-rollback-missing")
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, newReleaseBinding("rb-rollback-missing", "test-rollback-missing", "rel-rollback-missing"))).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(target), target)).To(Succeed())
+				cond := apimeta.FindStatusCondition(target.Status.Conditions, ConditionTypeReleasesRendered)
+				g.Expect(cond).NotTo(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal("RollbackUnavailable"))
+			}, eventuallyTimeout).Should(Succeed())
+		})
+
+		It("should keep the last rendered chart of a suspended release and re-render on resume", func() {
+			registry := newRegistry("test-registry")
+			_ = k8sClient.Create(ctx, registry)
+
+			cv := newComponentVersion("my-cv")
+			_ = k8sClient.Create(ctx, cv)
+
+			rel := newRelease("rel-suspend")
+			Expect(k8sClient.Create(ctx, rel)).To(Succeed())
+
+			target := newTarget("test-suspend")
+			Expect(k8sClient.Create(ctx, target)).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, newReleaseBinding("rb-suspend", "test-suspend", "rel-suspend"))).To(Succeed())
+
+			firstRTName := releaseRenderTaskName(ns.Name, "rel-suspend", "test-suspend", 1)
+			firstRT := &solarv1alpha1.RenderTask{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{Name: firstRTName, Namespace: ns.Name}, firstRT)
+			}, eventuallyTimeout).Should(Succeed())
+			markRenderTaskSucceeded(firstRTName, "oci://"+firstRT.Spec.BaseURL+"/"+firstRT.Spec.Repository+":"+firstRT.Spec.Tag)
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rel), rel)).To(Succeed())
+				g.Expect(rel.Status.History).To(HaveLen(1))
+			}, eventuallyTimeout).Should(Succeed())
+
+			// Changes made while suspended are not rendered.
+			rel.Spec.Suspend = true
+			rel.Spec.Values = runtime.RawExtension{Raw: []byte(`{"key":"changed"}`)}
+			Expect(k8sClient.Update(ctx, rel)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rel), rel)).To(Succeed())
+				cond := apimeta.FindStatusCondition(rel.Status.Conditions, ConditionTypeSuspended)
+				g.Expect(cond).NotTo(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			}, eventuallyTimeout).Should(Succeed())
+
+			Consistently(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{
+					Name:      releaseRenderTaskName(ns.Name, "rel-suspend", "test-suspend", 2),
+					Namespace: ns.Name,
+				}, &solarv1alpha1.RenderTask{})
+			}, consistentlyDuration).ShouldNot(Succeed(), "no RenderTask should be created while suspended")
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(target), target)).To(Succeed())
+				bootstrapRT := &solarv1alpha1.RenderTask{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{
+					Name:      targetRenderTaskName(target.Name, target.Status.BootstrapVersion),
+					Namespace: ns.Name,
+				}, bootstrapRT)).To(Succeed())
+				g.Expect(bootstrapRT.Spec.RendererConfig.BootstrapConfig.Input.Releases).To(
+					HaveKeyWithValue("my-unique-component", HaveField("Tag", firstRT.Spec.Tag)))
+			}, eventuallyTimeout).Should(Succeed())
+
+			rel.Spec.Suspend = false
+			Expect(k8sClient.Update(ctx, rel)).To(Succeed())
+
+			// Resuming renders the current spec.
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{
+					Name:      releaseRenderTaskName(ns.Name, "rel-suspend", "test-suspend", 3),
+					Namespace: ns.Name,
+				}, &solarv1alpha1.RenderTask{})
+			}, eventuallyTimeout).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rel), rel)).To(Succeed())
+				cond := apimeta.FindStatusCondition(rel.Status.Conditions, ConditionTypeSuspended)
+				g.Expect(cond).NotTo(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal("Resumed"))
+			}, eventuallyTimeout).Should(Succeed())
+		})
+
 		It("should delete owned RenderBindings when the Target is deleted", func() {
 			registry := newRegistry("test-registry")
 			_ = k8sClient.Create(ctx, registry)
@@ -1814,4 +2117,17 @@ var _ = Describe("buildBootstrapInput", func() {
 		Expect(input.Releases).To(HaveLen(1))
 		Expect(input.Releases["uniq-release"].Insecure).To(BeFalse())
 	})
+
+	It("pins releases by the digest of their chart if known", func() {
+		releases := []releaseInfo{{
+			name:        "my-release",
+			uniqueName:  "uniq-release",
+			chartURL:    "oci://registry.example.com/ns/my-release:v1.0.0",
+			chartDigest: "sha256:abc",
+		}}
+		input, err := buildBootstrapInput(target, releases, "", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input.Releases["uniq-release"].Tag).To(Equal("v1.0.0"))
+		Expect(input.Releases["uniq-release"].Digest).To(Equal("sha256:abc"))
+	})
 })
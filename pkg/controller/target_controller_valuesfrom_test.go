@@ -0,0 +1,196 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+func configMapValues(name, key string) solarv1alpha1.ValuesReference {
+	return solarv1alpha1.ValuesReference{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}}
+}
+
+func secretValues(name, key string) solarv1alpha1.ValuesReference {
+	return solarv1alpha1.ValuesReference{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}}
+}
+
+func TestResolveValuesFrom(t *testing.T) {
+	t.Parallel()
+
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = solarv1alpha1.AddToScheme(sch)
+
+	objs := []client.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "env", Namespace: "ns"},
+			Data: map[string]string{
+				"values.yaml": "replicas: 2\ningress:\n  host: dev.example.com\n  tls: true\n",
+				"invalid":     "- not\n- an object\n",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "ns"},
+			Data:       map[string][]byte{"values.yaml": []byte("ingress:\n  host: prod.example.com\npassword: s3cr3t\n")},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ReleaseDefaultsConfigMap, Namespace: "defaulted"},
+			Data:       map[string]string{ReleaseDefaultsKey: "replicas: 1\nimage:\n  registry: mirror.example.com\n"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "env", Namespace: "defaulted"},
+			Data:       map[string]string{"values.yaml": "replicas: 3\n"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ReleaseDefaultsConfigMap, Namespace: "broken"},
+			Data:       map[string]string{ReleaseDefaultsKey: "- not\n- an object\n"},
+		},
+	}
+	r := &TargetReconciler{Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).Build(), Scheme: sch}
+
+	release := func(refs ...solarv1alpha1.ValuesReference) *solarv1alpha1.Release {
+		return &solarv1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "rel", Namespace: "ns"},
+			Spec:       solarv1alpha1.ReleaseSpec{ValuesFrom: refs},
+		}
+	}
+
+	t.Run("merges the referenced keys in order", func(t *testing.T) {
+		t.Parallel()
+
+		got, secretKeyPaths, err := r.resolveValuesFrom(context.Background(), release(configMapValues("env", "values.yaml"), secretValues("creds", "values.yaml")))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `{"ingress":{"host":"prod.example.com","tls":true},"password":"s3cr3t","replicas":2}`
+		if string(got.Raw) != want {
+			t.Errorf("got %s, want %s", got.Raw, want)
+		}
+
+		// Only the keys read from the Secret are sensitive.
+		slices.SortFunc(secretKeyPaths, slices.Compare)
+		wantPaths := [][]string{{"ingress", "host"}, {"password"}}
+		if !slices.EqualFunc(secretKeyPaths, wantPaths, slices.Equal) {
+			t.Errorf("got secret key paths %v, want %v", secretKeyPaths, wantPaths)
+		}
+	})
+
+	t.Run("returns no values without references", func(t *testing.T) {
+		t.Parallel()
+
+		got, _, err := r.resolveValuesFrom(context.Background(), release())
+		if err != nil || len(got.Raw) != 0 {
+			t.Errorf("got %s, %v; want no values", got.Raw, err)
+		}
+	})
+
+	t.Run("merges the referenced keys on top of the namespace defaults", func(t *testing.T) {
+		t.Parallel()
+
+		rel := release(configMapValues("env", "values.yaml"))
+		rel.Namespace = "defaulted"
+		got, _, err := r.resolveValuesFrom(context.Background(), rel)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `{"image":{"registry":"mirror.example.com"},"replicas":3}`
+		if string(got.Raw) != want {
+			t.Errorf("got %s, want %s", got.Raw, want)
+		}
+	})
+
+	t.Run("reports invalid namespace defaults as unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		rel := release()
+		rel.Namespace = "broken"
+		if _, _, err := r.resolveValuesFrom(context.Background(), rel); !errors.Is(err, ErrValuesFromUnavailable) {
+			t.Errorf("got %v, want ErrValuesFromUnavailable", err)
+		}
+	})
+
+	t.Run("skips missing optional references", func(t *testing.T) {
+		t.Parallel()
+
+		missing := configMapValues("missing", "values.yaml")
+		missing.ConfigMapKeyRef.Optional = new(true)
+		missingKey := secretValues("creds", "other.yaml")
+		missingKey.SecretKeyRef.Optional = new(true)
+
+		got, _, err := r.resolveValuesFrom(context.Background(), release(missing, missingKey))
+		if err != nil || len(got.Raw) != 0 {
+			t.Errorf("got %s, %v; want no values", got.Raw, err)
+		}
+	})
+
+	for name, ref := range map[string]solarv1alpha1.ValuesReference{
+		"missing object":  configMapValues("missing", "values.yaml"),
+		"missing key":     secretValues("creds", "other.yaml"),
+		"non-object YAML": configMapValues("env", "invalid"),
+	} {
+		t.Run("reports "+name+" as unavailable", func(t *testing.T) {
+			t.Parallel()
+
+			if _, _, err := r.resolveValuesFrom(context.Background(), release(ref)); !errors.Is(err, ErrValuesFromUnavailable) {
+				t.Errorf("got %v, want ErrValuesFromUnavailable", err)
+			}
+		})
+	}
+}
+
+func TestMergeValuesFrom(t *testing.T) {
+	t.Parallel()
+
+	valuesFrom := runtime.RawExtension{Raw: []byte(`{"replicas":2,"env":"dev"}`)}
+
+	got, err := mergeValuesFrom(valuesFrom, runtime.RawExtension{Raw: []byte(`{"env":"prod"}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"env":"prod","replicas":2}`; string(got.Raw) != want {
+		t.Errorf("got %s, want %s", got.Raw, want)
+	}
+
+	values := runtime.RawExtension{Raw: []byte(`{"env":"prod"}`)}
+	if got, err := mergeValuesFrom(runtime.RawExtension{}, values); err != nil || string(got.Raw) != string(values.Raw) {
+		t.Errorf("got %s, %v; want the release values unchanged", got.Raw, err)
+	}
+
+	if _, err := mergeValuesFrom(valuesFrom, runtime.RawExtension{Raw: []byte(`[1]`)}); err == nil {
+		t.Error("expected an error for release values that are not an object")
+	}
+}
+
+func TestValuesFromKeys(t *testing.T) {
+	t.Parallel()
+
+	rel := &solarv1alpha1.Release{Spec: solarv1alpha1.ReleaseSpec{ValuesFrom: []solarv1alpha1.ValuesReference{
+		configMapValues("env", "values.yaml"),
+		secretValues("creds", "values.yaml"),
+		{},
+	}}}
+
+	got := valuesFromKeys(rel)
+	if len(got) != 2 || got[0] != "ConfigMap/env" || got[1] != "Secret/creds" {
+		t.Errorf("got %v, want [ConfigMap/env Secret/creds]", got)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"go.opendefense.cloud/solar/pkg/cron"
+)
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	// A Wednesday.
+	from := time.Date(2026, time.March, 4, 10, 17, 30, 0, time.UTC)
+
+	for _, tc := range []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, time.March, 4, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, time.March, 4, 10, 30, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, time.March, 4, 10, 25, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, time.March, 5, 2, 0, 0, 0, time.UTC)},
+		{"30 1-3,22 * * *", time.Date(2026, time.March, 4, 22, 30, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2026, time.March, 5, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, time.March, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 6 *", time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either one matching is enough.
+		{"0 0 15 * 5", time.Date(2026, time.March, 6, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, time.March, 4, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, time.March, 8, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	} {
+		t.Run(tc.spec, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := cron.Parse(tc.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := s.Next(from); !got.Equal(tc.want) {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNextKeepsLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("CET", 3600)
+	s, err := cron.Parse("0 2 * * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := s.Next(time.Date(2026, time.March, 4, 10, 0, 0, 0, loc))
+	if want := time.Date(2026, time.March, 5, 2, 0, 0, 0, loc); !got.Equal(want) || got.Location() != loc {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@reboot",
+	} {
+		if _, err := cron.Parse(spec); err == nil {
+			t.Errorf("%q: expected an error", spec)
+		}
+	}
+}
@@ -5,15 +5,19 @@ package apiwriter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
 
 	"github.com/cenkalti/backoff/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"ocm.software/ocm/api/datacontext"
 	"ocm.software/ocm/api/oci"
 	"ocm.software/ocm/api/ocm"
@@ -30,6 +34,7 @@ import (
 const (
 	componentLabel = "solar.opendefense.cloud/component"
 	digestLabel    = "solar.opendefense.cloud/digest"
+	channelLabel   = "solar.opendefense.cloud/channel"
 )
 
 var _ discovery.Processor[discovery.WriteAPIResourceEvent, any] = &APIWriter{}
@@ -40,6 +45,9 @@ type APIWriter struct {
 	client    v1alpha1.SolarV1alpha1Interface
 	namespace string
 	provider  *discovery.RegistryProvider
+	digests   *discovery.DigestCache
+	observer  func(discovery.ComponentVersionEvent)
+	versions  *versionSet
 }
 
 func NewAPIWriter(
@@ -55,8 +63,10 @@ func NewAPIWriter(
 		client:    client,
 		namespace: namespace,
 		provider:  provider,
+		versions:  newVersionSet(),
 	}
 	p.Runner = discovery.NewRunner(p, in, nil, err)
+	p.versions.register(otel.GetMeterProvider())
 
 	for _, opt := range opts {
 		opt(p.Runner)
@@ -65,6 +75,41 @@ func NewAPIWriter(
 	return p
 }
 
+// SetWriteObserver passes every component version event to f once the
+// APIWriter wrote it to the API, e.g. to publish it to external systems. f
+// is called by the APIWriter's workers and must not block.
+func (rs *APIWriter) SetWriteObserver(f func(discovery.ComponentVersionEvent)) {
+	rs.observer = f
+}
+
+// SetMeterProvider sets the MeterProvider the number of written component
+// versions is reported on. Defaults to the global MeterProvider.
+func (rs *APIWriter) SetMeterProvider(mp metric.MeterProvider) {
+	rs.versions.register(mp)
+}
+
+// Start starts the APIWriter. Versions recorded in the digest cache were
+// written before and are skipped by scans, so they count as written.
+func (rs *APIWriter) Start(ctx context.Context) error {
+	if rs.digests != nil {
+		rs.digests.Range(rs.versions.add)
+	}
+
+	return rs.Runner.Start(ctx)
+}
+
+// Stop stops the APIWriter and reporting the number of written versions.
+func (rs *APIWriter) Stop() {
+	rs.Runner.Stop()
+	rs.versions.unregister()
+}
+
+// SetDigestCache makes the APIWriter record the digest of every component
+// version it wrote, so later scans can skip it while it is unchanged.
+func (rs *APIWriter) SetDigestCache(c *discovery.DigestCache) {
+	rs.digests = c
+}
+
 func (rs *APIWriter) Process(ctx context.Context, ev discovery.WriteAPIResourceEvent) ([]any, error) {
 	var op backoff.Operation[struct{}]
 
@@ -77,24 +122,53 @@ func (rs *APIWriter) Process(ctx context.Context, ev discovery.WriteAPIResourceE
 		spec := ev.ComponentSpec
 		op = func() (struct{}, error) { return struct{}{}, rs.ensureComponentVersion(ctx, ref, spec, ev) }
 	case discovery.EventDeleted:
-		op = func() (struct{}, error) { return struct{}{}, rs.deleteComponentVersion(ctx, ev) }
+		op = func() (struct{}, error) { return struct{}{}, rs.markUnavailable(ctx, ev) }
 	default:
 		return nil, fmt.Errorf("SHOULD NOT HAPPEN: Invalid event type: %s", ev.Source.Source.Type)
 	}
 
 	// Retry selected operation if a backoff is configured
+	var err error
 	if opts := rs.RetryOptions(); opts != nil {
-		_, err := backoff.Retry(ctx, op, opts...)
+		_, err = backoff.Retry(ctx, op, opts...)
+	} else {
+		_, err = op()
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	_, err := op()
+	if src := ev.Source.Source; src.Type != discovery.EventDeleted {
+		rs.versions.add(src.Registry, src.Repository, src.Version)
+	}
+	rs.recordDigest(ev.Source.Source)
+	if rs.observer != nil {
+		rs.observer(ev.Source)
+	}
 
-	return nil, err
+	return nil, nil
+}
+
+// recordDigest updates the digest cache after the given event was written.
+// Only versions written successfully are recorded, so failed ones are
+// processed again by the next scan.
+func (rs *APIWriter) recordDigest(ev discovery.RepositoryEvent) {
+	if rs.digests == nil {
+		return
+	}
+
+	switch {
+	case ev.Digest == "":
+		return
+	case ev.Type == discovery.EventDeleted:
+		rs.digests.DeleteDigest(ev.Registry, ev.Repository, ev.Digest)
+	default:
+		rs.digests.Set(ev.Registry, ev.Repository, ev.Version, ev.Digest)
+	}
 }
 
 func (rs *APIWriter) ensureComponentVersion(ctx context.Context, ref oci.RefSpec, spec compdesc.ComponentSpec, ev discovery.WriteAPIResourceEvent) error {
-	if err := rs.ensureComponent(ctx, ref, spec); err != nil {
+	if err := rs.ensureComponent(ctx, ref, spec, ev.Source.Source.Repository); err != nil {
 		return err
 	}
 
@@ -167,13 +241,17 @@ func (rs *APIWriter) ensureComponentVersion(ctx context.Context, ref oci.RefSpec
 	// can look up the corresponding ComponentVersion.
 	digest := discovery.SanitizeDigestLabel(ev.Source.Source.Digest)
 
+	cvLabels := rs.mappedLabels(ev, spec)
+	cvLabels[componentLabel] = comp
+	cvLabels[digestLabel] = digest
+	if ev.Source.Channel != "" {
+		cvLabels[channelLabel] = ev.Source.Channel
+	}
+
 	cv := &solarv1alpha1.ComponentVersion{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: discovery.ComponentVersionName(spec.Name, ref.Version()),
-			Labels: map[string]string{
-				componentLabel: comp,
-				digestLabel:    digest,
-			},
+			Name:   discovery.ComponentVersionName(spec.Name, ref.Version()),
+			Labels: cvLabels,
 		},
 		Spec: solarv1alpha1.ComponentVersionSpec{
 			ComponentRef: v1.LocalObjectReference{
@@ -184,85 +262,166 @@ func (rs *APIWriter) ensureComponentVersion(ctx context.Context, ref oci.RefSpec
 			Entrypoint: entrypoint,
 		},
 	}
+	if c := ev.Catalog; c.Readme != "" || len(c.Icon) > 0 {
+		cv.Spec.Catalog = &solarv1alpha1.CatalogDetails{
+			Readme:        c.Readme,
+			Icon:          c.Icon,
+			IconMediaType: c.IconMediaType,
+		}
+	}
+	discovery.SetComponentAnnotations(cv, spec.Name, ref.Version())
 
-	_, err := rs.client.ComponentVersions(rs.namespace).Create(ctx, cv, metav1.CreateOptions{})
+	written, err := rs.client.ComponentVersions(rs.namespace).Create(ctx, cv, metav1.CreateOptions{})
 	if err != nil && errors.IsAlreadyExists(err) {
 		existing, getErr := rs.client.ComponentVersions(rs.namespace).Get(ctx, cv.Name, metav1.GetOptions{})
 		if getErr != nil {
 			return fmt.Errorf("failed to get existing component version for update: %w", getErr)
 		}
+		if err := discovery.CheckComponentName(existing, spec.Name); err != nil {
+			return backoff.Permanent(err)
+		}
 		cv.ResourceVersion = existing.ResourceVersion
-		_, err = rs.client.ComponentVersions(rs.namespace).Update(ctx, cv, metav1.UpdateOptions{})
+		written, err = rs.client.ComponentVersions(rs.namespace).Update(ctx, cv, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return err
 	}
 
-	return err
+	// A version that was removed from the registry and pushed again is
+	// available again.
+	if written.Status.Phase == solarv1alpha1.ComponentVersionPhaseUnavailable {
+		written.Status.Phase = solarv1alpha1.ComponentVersionPhaseAvailable
+		written.Status.UnavailableSince = nil
+		if _, err := rs.client.ComponentVersions(rs.namespace).UpdateStatus(ctx, written, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to mark component version %s available: %w", written.Name, err)
+		}
+		rs.Logger().Info("component version is available again", "name", written.Name)
+	}
+
+	return nil
 }
 
-func (rs *APIWriter) deleteComponentVersion(ctx context.Context, ev discovery.WriteAPIResourceEvent) error {
-	digest := discovery.SanitizeDigestLabel(ev.Source.Source.Digest)
-	if digest == "" {
-		return fmt.Errorf("cannot delete component version: no digest available")
+// mappedLabels returns the labels the LabelMappings of the event's registry
+// derive from the manifest annotations and OCM component labels of the
+// component version. Values that are not valid label values are skipped.
+func (rs *APIWriter) mappedLabels(ev discovery.WriteAPIResourceEvent, spec compdesc.ComponentSpec) map[string]string {
+	out := map[string]string{}
+	registry := rs.provider.Get(ev.Source.Source.Registry)
+	if registry == nil {
+		return out
 	}
 
-	// Look up the ComponentVersion by its digest label since delete events
-	// from Zot typically only carry a digest, not a version tag.
-	matchLabels := map[string]string{
-		digestLabel: digest,
+	for _, m := range registry.Spec.LabelMappings {
+		var value string
+		var ok bool
+		if m.Annotation != "" {
+			value, ok = ev.Source.Annotations[m.Annotation]
+		} else {
+			value, ok = componentLabelValue(spec, m.ComponentLabel)
+		}
+		if !ok {
+			continue
+		}
+
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			rs.Logger().V(1).Info("skipping invalid label value", "label", m.Label, "value", value, "reason", strings.Join(errs, "; "))
+			continue
+		}
+		out[m.Label] = value
 	}
-	cvList, err := rs.client.ComponentVersions(rs.namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: labels.Set(matchLabels).String(),
-	})
-	if err != nil {
-		return fmt.Errorf("failed to list component versions by digest: %w", err)
+
+	return out
+}
+
+// componentLabelValue returns the value of the named OCM component label.
+// String values are unquoted, other values are returned as JSON.
+func componentLabelValue(spec compdesc.ComponentSpec, name string) (string, bool) {
+	for _, l := range spec.Labels {
+		if l.Name != name {
+			continue
+		}
+
+		var s string
+		if err := json.Unmarshal(l.Value, &s); err == nil {
+			return s, true
+		}
+
+		return string(l.Value), true
 	}
 
-	if len(cvList.Items) == 0 {
-		rs.Logger().V(1).Info("no component version found for digest, nothing to delete", "digest", digest)
-		return nil
+	return "", false
+}
+
+// markUnavailable sets the phase of the ComponentVersion removed from the
+// registry to Unavailable. The ComponentVersion controller deletes it, and
+// its Component once no other version is left, after the retention period.
+func (rs *APIWriter) markUnavailable(ctx context.Context, ev discovery.WriteAPIResourceEvent) error {
+	cvs, err := rs.removedComponentVersions(ctx, ev)
+	if err != nil {
+		return err
 	}
 
-	for _, cv := range cvList.Items {
-		if err := client.IgnoreNotFound(rs.client.ComponentVersions(rs.namespace).Delete(ctx, cv.Name, metav1.DeleteOptions{})); err != nil {
-			return fmt.Errorf("failed to delete component version %s: %w", cv.Name, err)
+	now := metav1.Now()
+	for i := range cvs {
+		cv := &cvs[i]
+		if cv.Status.Phase == solarv1alpha1.ComponentVersionPhaseUnavailable || !cv.DeletionTimestamp.IsZero() {
+			rs.versions.remove(ev.Source.Source.Registry, ev.Source.Source.Repository, cv.Spec.Tag)
+			continue
 		}
-		rs.Logger().Info("deleted component version", "name", cv.Name, "digest", digest)
 
-		// Clean up parent component if no other versions reference it.
-		parent := cv.Labels[componentLabel]
-		if parent != "" {
-			parentMatch := map[string]string{
-				componentLabel: parent,
-			}
-			remaining, err := rs.client.ComponentVersions(rs.namespace).List(ctx, metav1.ListOptions{
-				LabelSelector: labels.Set(parentMatch).String(),
-			})
-			if err != nil {
-				return err
-			}
-			// The CV we just deleted still appears here in Terminating state (it carries
-			// a finalizer), so exclude it and any other terminating CVs; otherwise the
-			// parent Component delete is skipped and the Component is orphaned forever.
-			// FIXME: replace this inferred cleanup with a Component reconciler that
-			// owns deletion serialized per-Component (re-creation-safe follow-up).
-			active := 0
-			for _, r := range remaining.Items {
-				if r.Name == cv.Name || !r.DeletionTimestamp.IsZero() {
-					continue
-				}
-				active++
-			}
-			if active == 0 {
-				if err := client.IgnoreNotFound(rs.client.Components(rs.namespace).Delete(ctx, parent, metav1.DeleteOptions{})); err != nil {
-					return err
-				}
-			}
+		cv.Status.Phase = solarv1alpha1.ComponentVersionPhaseUnavailable
+		cv.Status.UnavailableSince = &now
+		if _, err := rs.client.ComponentVersions(rs.namespace).UpdateStatus(ctx, cv, metav1.UpdateOptions{}); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to mark component version %s unavailable: %w", cv.Name, err)
 		}
+		rs.versions.remove(ev.Source.Source.Registry, ev.Source.Source.Repository, cv.Spec.Tag)
+		rs.Logger().Info("marked component version unavailable", "name", cv.Name, "digest", ev.Source.Source.Digest)
 	}
 
 	return nil
 }
 
-func (rs *APIWriter) ensureComponent(ctx context.Context, ref oci.RefSpec, spec compdesc.ComponentSpec) error {
+// removedComponentVersions returns the ComponentVersions of a removed
+// version. Delete events from Zot typically only carry a digest, not a
+// version tag, so they are looked up by their digest label if the event has
+// a digest and by name otherwise.
+func (rs *APIWriter) removedComponentVersions(ctx context.Context, ev discovery.WriteAPIResourceEvent) ([]solarv1alpha1.ComponentVersion, error) {
+	if digest := discovery.SanitizeDigestLabel(ev.Source.Source.Digest); digest != "" {
+		cvList, err := rs.client.ComponentVersions(rs.namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.Set{digestLabel: digest}.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list component versions by digest: %w", err)
+		}
+		if len(cvList.Items) == 0 {
+			rs.Logger().V(1).Info("no component version found for digest, nothing to mark unavailable", "digest", digest)
+		}
+
+		return cvList.Items, nil
+	}
+
+	if ev.Source.Component == "" || ev.Source.Source.Version == "" {
+		return nil, fmt.Errorf("cannot mark component version unavailable: neither digest nor version available")
+	}
+
+	name := discovery.ComponentVersionName(ev.Source.Component, ev.Source.Source.Version)
+	cv, err := rs.client.ComponentVersions(rs.namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		rs.Logger().V(1).Info("no component version found, nothing to mark unavailable", "name", name)
+
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component version %s: %w", name, err)
+	}
+	if err := discovery.CheckComponentName(cv, ev.Source.Component); err != nil {
+		return nil, backoff.Permanent(err)
+	}
+
+	return []solarv1alpha1.ComponentVersion{*cv}, nil
+}
+
+func (rs *APIWriter) ensureComponent(ctx context.Context, ref oci.RefSpec, spec compdesc.ComponentSpec, descriptorRepo string) error {
 	c := &solarv1alpha1.Component{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: discovery.SanitizeWithHash(spec.Name),
@@ -273,13 +432,21 @@ func (rs *APIWriter) ensureComponent(ctx context.Context, ref oci.RefSpec, spec
 			Repository: ref.Repository,
 		},
 	}
+	discovery.SetComponentAnnotations(c, spec.Name, "")
+	c.Annotations[discovery.AnnotationDescriptorRepository] = descriptorRepo
+
 	_, err := rs.client.Components(rs.namespace).Create(ctx, c, metav1.CreateOptions{})
 	if err != nil && errors.IsAlreadyExists(err) {
 		existing, getErr := rs.client.Components(rs.namespace).Get(ctx, c.Name, metav1.GetOptions{})
 		if getErr != nil {
 			return fmt.Errorf("failed to get existing component for update: %w", getErr)
 		}
+		if err := discovery.CheckComponentName(existing, spec.Name); err != nil {
+			return backoff.Permanent(err)
+		}
 		c.ResourceVersion = existing.ResourceVersion
+		// TagDiscovery is configured by users, not discovered, so keep it.
+		c.Spec.TagDiscovery = existing.Spec.TagDiscovery
 		_, err = rs.client.Components(rs.namespace).Update(ctx, c, metav1.UpdateOptions{})
 	}
 
@@ -15,7 +15,7 @@ import (
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/registry"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"ocm.software/ocm/api/ocm/compdesc"
@@ -28,6 +28,7 @@ import (
 	"go.opendefense.cloud/solar/client-go/clientset/versioned/fake"
 	solarv1alpha1client "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
 	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
 	"go.opendefense.cloud/solar/test"
 	testregistry "go.opendefense.cloud/solar/test/registry"
 
@@ -40,6 +41,13 @@ func TestQualifier(t *testing.T) {
 	RunSpecs(t, "APIWriter Suite")
 }
 
+var (
+	// componentName and componentVersionName are the resource names of the
+	// component version createEvent announces.
+	componentName        = discovery.SanitizeWithHash("opendefense.cloud/ocm-demo")
+	componentVersionName = discovery.ComponentVersionName("opendefense.cloud/ocm-demo", "v26.4.2")
+)
+
 // createEvent builds a WriteAPIResourceEvent for the given event type.
 func createEvent(eventType discovery.EventType) discovery.WriteAPIResourceEvent {
 	ev := discovery.WriteAPIResourceEvent{
@@ -197,13 +205,13 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				mcv, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				mcv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				cv = mcv
 
 				return err
 			}).ShouldNot(HaveOccurred())
 
-			Expect(cv.Spec.ComponentRef.Name).To(Equal("opendefense-cloud-ocm-demo"))
+			Expect(cv.Spec.ComponentRef.Name).To(Equal(componentName))
 
 			Expect(cv.Spec.Resources).NotTo(BeNil())
 			Expect(cv.Spec.Resources["mychart"].Repository).To(Equal("zot.local/mychart"))
@@ -244,7 +252,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				mcv, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				mcv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				cv = mcv
 
 				return err
@@ -266,7 +274,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				mcv, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				mcv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				cv = mcv
 
 				return err
@@ -276,6 +284,37 @@ var _ = Describe("APIWriter", Ordered, func() {
 			Expect(cv.Spec.Resources["mychart"].Helm.ValuesTemplate).To(BeNil())
 		})
 
+		It("should store the discovered catalog details", func() {
+			Expect(writer.Start(ctx)).To(Succeed())
+
+			ev := createEvent(discovery.EventCreated)
+			ev.Catalog = discovery.CatalogDiscovery{
+				Readme:        "# OCM Demo\n",
+				Icon:          []byte("<svg></svg>"),
+				IconMediaType: "image/svg+xml",
+			}
+			inputChan <- ev
+
+			cv := &solarv1alpha1.ComponentVersion{}
+			Eventually(func() error {
+				select {
+				case errEvent := <-errChan:
+					Expect(errEvent.Error).NotTo(HaveOccurred())
+				default:
+				}
+				mcv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+				cv = mcv
+
+				return err
+			}).ShouldNot(HaveOccurred())
+
+			Expect(cv.Spec.Catalog).To(Equal(&solarv1alpha1.CatalogDetails{
+				Readme:        "# OCM Demo\n",
+				Icon:          []byte("<svg></svg>"),
+				IconMediaType: "image/svg+xml",
+			}))
+		})
+
 		It("should create a Component when an event is received and no component for componentversion exists", func() {
 			Expect(writer.Start(ctx)).To(Succeed())
 			inputChan <- createEvent(discovery.EventCreated)
@@ -287,7 +326,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				mc, err := solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+				mc, err := solarClient.Components("default").Get(ctx, componentName, metav1.GetOptions{})
 				c = mc
 
 				return err
@@ -296,6 +335,41 @@ var _ = Describe("APIWriter", Ordered, func() {
 			Expect(c.Spec.Scheme).To(Equal("http"))
 			Expect(c.Spec.Repository).To(Equal("opendefense.cloud/ocm-demo"))
 			Expect(c.Spec.Registry).To(Equal(strings.TrimPrefix(testRegistry.GetURL(), "http://")))
+			Expect(discovery.ComponentNameOf(c)).To(Equal("opendefense.cloud/ocm-demo"))
+			Expect(discovery.DescriptorRepositoryOf(c)).To(Equal("test/component-descriptors/opendefense.cloud/ocm-demo"))
+		})
+
+		It("should record the OCM component name and version on the ComponentVersion", func() {
+			Expect(writer.Start(ctx)).To(Succeed())
+			inputChan <- createEvent(discovery.EventCreated)
+
+			cv := &solarv1alpha1.ComponentVersion{}
+			Eventually(func() error {
+				var err error
+				cv, err = solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+
+				return err
+			}).ShouldNot(HaveOccurred())
+
+			Expect(cv.Annotations).To(HaveKeyWithValue(discovery.AnnotationComponentName, "opendefense.cloud/ocm-demo"))
+			Expect(cv.Annotations).To(HaveKeyWithValue(discovery.AnnotationComponentVersion, "v26.4.2"))
+		})
+
+		It("should label the ComponentVersion with its channel", func() {
+			Expect(writer.Start(ctx)).To(Succeed())
+			ev := createEvent(discovery.EventCreated)
+			ev.Source.Channel = "stable"
+			inputChan <- ev
+
+			cv := &solarv1alpha1.ComponentVersion{}
+			Eventually(func() error {
+				var err error
+				cv, err = solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+
+				return err
+			}).ShouldNot(HaveOccurred())
+
+			Expect(cv.Labels).To(HaveKeyWithValue(channelLabel, "stable"))
 		})
 	})
 
@@ -310,7 +384,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				_, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				_, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 
 				return err
 			}).ShouldNot(HaveOccurred())
@@ -340,7 +414,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				cv, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				if err != nil {
 					return false
 				}
@@ -357,10 +431,10 @@ var _ = Describe("APIWriter", Ordered, func() {
 
 			preExisting := &solarv1alpha1.ComponentVersion{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "opendefense-cloud-ocm-demo-v26-4-2",
+					Name:      componentVersionName,
 					Namespace: "default",
 					Labels: map[string]string{
-						componentLabel: "opendefense-cloud-ocm-demo",
+						componentLabel: componentName,
 						digestLabel:    discovery.SanitizeDigestLabel("sha256:abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890"),
 					},
 				},
@@ -371,7 +445,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 			inputChan <- createEvent(discovery.EventCreated)
 
 			Eventually(func() bool {
-				cv, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				if err != nil {
 					return false
 				}
@@ -389,7 +463,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 			// the incoming event triggers Create → AlreadyExists → Get → Update.
 			preExisting := &solarv1alpha1.Component{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "opendefense-cloud-ocm-demo",
+					Name:      componentName,
 					Namespace: "default",
 				},
 			}
@@ -399,7 +473,7 @@ var _ = Describe("APIWriter", Ordered, func() {
 			inputChan <- createEvent(discovery.EventCreated)
 
 			Eventually(func() bool {
-				c, err := solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+				c, err := solarClient.Components("default").Get(ctx, componentName, metav1.GetOptions{})
 				if err != nil {
 					return false
 				}
@@ -409,10 +483,36 @@ var _ = Describe("APIWriter", Ordered, func() {
 
 			Consistently(func() int { return len(errChan) }, "500ms").Should(BeZero(), "no errors should be emitted")
 		})
+
+		It("should not overwrite a Component discovered from a different OCM component with the same name", func() {
+			Expect(writer.Start(ctx)).To(Succeed())
+
+			// Another OCM component holds the name of "opendefense.cloud/ocm-demo".
+			preExisting := &solarv1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        componentName,
+					Namespace:   "default",
+					Annotations: map[string]string{discovery.AnnotationComponentName: "opendefense-cloud/ocm.demo"},
+				},
+				Spec: solarv1alpha1.ComponentSpec{Repository: "opendefense-cloud/ocm.demo"},
+			}
+			_, err := solarClient.Components("default").Create(ctx, preExisting, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			inputChan <- createEvent(discovery.EventCreated)
+
+			var errEvent discovery.ErrorEvent
+			Eventually(errChan).Should(Receive(&errEvent))
+			Expect(errEvent.Error).To(MatchError(discovery.ErrNameCollision))
+
+			c, err := solarClient.Components("default").Get(ctx, componentName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Spec.Repository).To(Equal("opendefense-cloud/ocm.demo"))
+		})
 	})
 
 	Describe("Deletion", func() {
-		It("should delete ComponentVersion and Component when a delete event is received", func() {
+		It("should mark the ComponentVersion unavailable and keep the Component when a delete event is received", func() {
 			Expect(writer.Start(ctx)).To(Succeed())
 			inputChan <- createEvent(discovery.EventCreated)
 			Eventually(func() error {
@@ -421,43 +521,93 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				_, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				_, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				if err != nil {
 					return err
 				}
-				_, err = solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+				_, err = solarClient.Components("default").Get(ctx, componentName, metav1.GetOptions{})
 
 				return err
 			}).ShouldNot(HaveOccurred())
 
 			inputChan <- createEvent(discovery.EventDeleted)
-			var err error = nil
-			Eventually(func() error {
+			Eventually(func() solarv1alpha1.ComponentVersionPhase {
 				select {
 				case errEvent := <-errChan:
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				_, err = solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
 
-				return err
-			}).Should(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("not found"))
+				return cv.Status.Phase
+			}).Should(Equal(solarv1alpha1.ComponentVersionPhaseUnavailable))
 
-			Eventually(func() error {
-				select {
-				case errEvent := <-errChan:
-					Expect(errEvent.Error).NotTo(HaveOccurred())
-				default:
-				}
-				_, err = solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+			cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cv.Status.UnavailableSince).NotTo(BeNil())
 
-				return err
-			}).Should(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("not found"))
+			// The Component is deleted by the controller manager together with
+			// its last version once the retention period expired.
+			_, err = solarClient.Components("default").Get(ctx, componentName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should record the digest of written versions and forget deleted ones", func() {
+			cache := discovery.NewDigestCache(nil)
+			writer.SetDigestCache(cache)
+			Expect(writer.Start(ctx)).To(Succeed())
+
+			created := createEvent(discovery.EventCreated)
+			src := created.Source.Source
+			inputChan <- created
+			Eventually(func() bool {
+				digest, ok := cache.Get(src.Registry, src.Repository, src.Version)
+				return ok && digest == src.Digest
+			}).Should(BeTrue())
+
+			inputChan <- createEvent(discovery.EventDeleted)
+			Eventually(func() bool {
+				_, ok := cache.Get(src.Registry, src.Repository, src.Version)
+				return ok
+			}).Should(BeFalse())
+		})
+
+		It("should pass written and deleted versions to the write observer", func() {
+			written := make(chan discovery.ComponentVersionEvent, 2)
+			writer.SetWriteObserver(func(ev discovery.ComponentVersionEvent) { written <- ev })
+			Expect(writer.Start(ctx)).To(Succeed())
+
+			created := createEvent(discovery.EventCreated)
+			inputChan <- created
+			Eventually(written).Should(Receive(Equal(created.Source)))
+			_, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			deleted := createEvent(discovery.EventDeleted)
+			inputChan <- deleted
+			Eventually(written).Should(Receive(Equal(deleted.Source)))
+		})
+
+		It("should report the number of written versions by registry", func() {
+			meters := observabilitytest.NewMeterProvider()
+			writer.SetMeterProvider(meters)
+			Expect(writer.Start(ctx)).To(Succeed())
+			versions := func() float64 {
+				Expect(meters.Collect(ctx)).To(Succeed())
+				n, _ := meters.Last("solar.discovery.versions", attribute.String("registry", "test-registry"))
+
+				return n
+			}
+
+			inputChan <- createEvent(discovery.EventCreated)
+			Eventually(versions).Should(BeEquivalentTo(1))
+
+			inputChan <- createEvent(discovery.EventDeleted)
+			Eventually(versions).Should(BeEquivalentTo(0))
 		})
 
-		It("should delete ComponentVersion but keep Component when a delete event is received", func() {
+		It("should only mark the removed ComponentVersion unavailable", func() {
 			Expect(writer.Start(ctx)).To(Succeed())
 
 			// Setup 2 componentversions referencing the same component
@@ -474,84 +624,102 @@ var _ = Describe("APIWriter", Ordered, func() {
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				_, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				_, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
 				if err != nil {
 					return err
 				}
-				_, err = solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-5-0", metav1.GetOptions{})
-				if err != nil {
-					return err
-				}
-				_, err = solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+				_, err = solarClient.ComponentVersions("default").Get(ctx, discovery.ComponentVersionName("opendefense.cloud/ocm-demo", "v26.5.0"), metav1.GetOptions{})
 
 				return err
 			}).ShouldNot(HaveOccurred())
 
 			// Remove one componentversion
 			inputChan <- createEvent(discovery.EventDeleted)
-			Eventually(func() bool {
+			Eventually(func() solarv1alpha1.ComponentVersionPhase {
 				select {
 				case errEvent := <-errChan:
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				_, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{})
+				cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
 
-				return apierrors.IsNotFound(err)
-			}).To(BeTrue())
+				return cv.Status.Phase
+			}).Should(Equal(solarv1alpha1.ComponentVersionPhaseUnavailable))
 
-			// Verify component is still there
-			_, err := solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+			// Verify the other componentversion is untouched
+			cv, err := solarClient.ComponentVersions("default").Get(ctx, discovery.ComponentVersionName("opendefense.cloud/ocm-demo", "v26.5.0"), metav1.GetOptions{})
 			Expect(err).NotTo(HaveOccurred())
+			Expect(cv.Status.Phase).To(BeEmpty())
 		})
 
-		It("should delete the parent Component when the only sibling ComponentVersion is already terminating", func() {
+		It("should make an unavailable ComponentVersion available again when it is pushed again", func() {
 			Expect(writer.Start(ctx)).To(Succeed())
 
-			// Create the CV + Component via a normal create event. Wait for both so
-			// the later NotFound assertion can't pass merely because the Component
-			// was never created.
 			inputChan <- createEvent(discovery.EventCreated)
-			Eventually(func() error {
-				if _, err := solarClient.ComponentVersions("default").Get(ctx, "opendefense-cloud-ocm-demo-v26-4-2", metav1.GetOptions{}); err != nil {
-					return err
+			inputChan <- createEvent(discovery.EventDeleted)
+			Eventually(func() solarv1alpha1.ComponentVersionPhase {
+				cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+				if err != nil {
+					return ""
 				}
-				_, err := solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
 
-				return err
-			}).ShouldNot(HaveOccurred())
-
-			// Seed a sibling CV for the same Component that is already terminating
-			// (deletionTimestamp + finalizer set). The real apiserver leaves such a
-			// CV listable until its finalizer clears, so it must NOT count as an
-			// active reference keeping the parent Component alive — otherwise the
-			// Component is orphaned forever (the e2e webhook GC failure).
-			now := metav1.Now()
-			terminating := &solarv1alpha1.ComponentVersion{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:              "opendefense-cloud-ocm-demo-v26-5-0",
-					Namespace:         "default",
-					DeletionTimestamp: &now,
-					Finalizers:        []string{"solar.opendefense.cloud/componentversion-finalizer"},
-					Labels:            map[string]string{componentLabel: "opendefense-cloud-ocm-demo"},
-				},
-			}
-			_, err := solarClient.ComponentVersions("default").Create(ctx, terminating, metav1.CreateOptions{})
-			Expect(err).NotTo(HaveOccurred())
+				return cv.Status.Phase
+			}).Should(Equal(solarv1alpha1.ComponentVersionPhaseUnavailable))
 
-			// Delete the active CV; its only sibling is terminating, so the parent
-			// Component must be garbage-collected.
-			inputChan <- createEvent(discovery.EventDeleted)
-			Eventually(func() bool {
+			inputChan <- createEvent(discovery.EventCreated)
+			Eventually(func() solarv1alpha1.ComponentVersionPhase {
 				select {
 				case errEvent := <-errChan:
 					Expect(errEvent.Error).NotTo(HaveOccurred())
 				default:
 				}
-				_, err := solarClient.Components("default").Get(ctx, "opendefense-cloud-ocm-demo", metav1.GetOptions{})
+				cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
 
-				return apierrors.IsNotFound(err)
-			}).Should(BeTrue(), "parent Component should be deleted when its only sibling CV is terminating")
+				return cv.Status.Phase
+			}).Should(Equal(solarv1alpha1.ComponentVersionPhaseAvailable))
+
+			cv, err := solarClient.ComponentVersions("default").Get(ctx, componentVersionName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cv.Status.UnavailableSince).To(BeNil())
 		})
 	})
 })
+
+var _ = Describe("APIWriter.mappedLabels", func() {
+	It("should copy mapped annotations and component labels", func() {
+		provider := discovery.NewRegistryProvider()
+		Expect(provider.Register(&solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-registry"},
+			Spec: solarv1alpha1.RegistrySpec{
+				Hostname: "registry.example.com",
+				LabelMappings: []solarv1alpha1.LabelMapping{
+					{Annotation: "org.opencontainers.image.vendor", Label: "catalog.example.com/vendor"},
+					{Annotation: "org.opencontainers.image.description", Label: "description"},
+					{Annotation: "org.opencontainers.image.licenses", Label: "license"},
+					{ComponentLabel: "team", Label: "team"},
+					{ComponentLabel: "tier", Label: "tier"},
+				},
+			},
+		}, nil)).To(Succeed())
+		writer := NewAPIWriter(nil, "default", provider, nil, nil)
+
+		ev := createEvent(discovery.EventCreated)
+		ev.Source.Annotations = map[string]string{
+			"org.opencontainers.image.vendor":      "Example",
+			"org.opencontainers.image.description": "not a valid label value",
+		}
+		spec := compdesc.ComponentSpec{}
+		spec.Labels = compmetav1.Labels{
+			{Name: "team", Value: []byte(`"platform"`)},
+			{Name: "tier", Value: []byte(`2`)},
+		}
+
+		Expect(writer.mappedLabels(ev, spec)).To(Equal(map[string]string{
+			"catalog.example.com/vendor": "Example",
+			"team":                       "platform",
+			"tier":                       "2",
+		}))
+	})
+})
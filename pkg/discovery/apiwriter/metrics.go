@@ -0,0 +1,106 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiwriter
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "go.opendefense.cloud/solar/pkg/discovery/apiwriter"
+
+// versionSet tracks the component versions the APIWriter wrote and has not
+// marked unavailable since, by registry, and reports their number as the
+// solar.discovery.versions gauge.
+type versionSet struct {
+	mu           sync.Mutex
+	versions     map[string]map[string]struct{}
+	registration metric.Registration
+}
+
+func newVersionSet() *versionSet {
+	return &versionSet{versions: make(map[string]map[string]struct{})}
+}
+
+// add records the version of the repository as written.
+func (s *versionSet) add(registry, repository, version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.versions[registry] == nil {
+		s.versions[registry] = make(map[string]struct{})
+	}
+	s.versions[registry][repository+":"+version] = struct{}{}
+}
+
+// remove forgets the version of the repository.
+func (s *versionSet) remove(registry, repository, version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.versions[registry], repository+":"+version)
+}
+
+// countByRegistry returns the number of versions of each registry.
+func (s *versionSet) countByRegistry() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[string]int64, len(s.versions))
+	for registry, versions := range s.versions {
+		counts[registry] = int64(len(versions))
+	}
+
+	return counts
+}
+
+// register reports the gauge on mp, replacing an earlier registration.
+func (s *versionSet) register(mp metric.MeterProvider) {
+	s.unregister()
+
+	meter := mp.Meter(meterName)
+	versions, err := meter.Int64ObservableGauge("solar.discovery.versions",
+		metric.WithDescription("Number of component versions discovered and written to the API, by registry."),
+		metric.WithUnit("{version}"))
+	if err != nil {
+		otel.Handle(err)
+
+		return
+	}
+
+	registration, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for registry, n := range s.countByRegistry() {
+			o.ObserveInt64(versions, n, metric.WithAttributes(attribute.String("registry", registry)))
+		}
+
+		return nil
+	}, versions)
+	if err != nil {
+		otel.Handle(err)
+
+		return
+	}
+
+	s.mu.Lock()
+	s.registration = registration
+	s.mu.Unlock()
+}
+
+// unregister stops reporting the gauge.
+func (s *versionSet) unregister() {
+	s.mu.Lock()
+	registration := s.registration
+	s.registration = nil
+	s.mu.Unlock()
+
+	if registration != nil {
+		if err := registration.Unregister(); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
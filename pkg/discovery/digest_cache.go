@@ -0,0 +1,310 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// DefaultDigestCacheFlushInterval is how often a started DigestCache persists
+// its changes.
+const DefaultDigestCacheFlushInterval = time.Minute
+
+// DigestStore persists the entries of a DigestCache.
+type DigestStore interface {
+	Load(ctx context.Context) (map[string]string, error)
+	Save(ctx context.Context, entries map[string]string) error
+}
+
+// DigestCache remembers the manifest digest of every component version that
+// was written to the API. Scans use it to skip versions whose digest has not
+// changed since, so only new and re-pushed versions are processed again.
+type DigestCache struct {
+	store         DigestStore
+	logger        logr.Logger
+	flushInterval time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]string
+	dirty   bool
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	stopped  bool
+	stopMu   sync.Mutex
+}
+
+// DigestCacheOption describes the available options
+// for creating the DigestCache.
+type DigestCacheOption func(c *DigestCache)
+
+// NewDigestCache creates a new DigestCache persisted to the given store. A nil
+// store keeps the cache in memory only.
+func NewDigestCache(store DigestStore, opts ...DigestCacheOption) *DigestCache {
+	c := &DigestCache{
+		store:         store,
+		logger:        logr.Discard(),
+		flushInterval: DefaultDigestCacheFlushInterval,
+		entries:       make(map[string]string),
+		stopChan:      make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	return c
+}
+
+func WithDigestCacheLogger(l logr.Logger) DigestCacheOption {
+	return func(c *DigestCache) {
+		c.logger = l
+	}
+}
+
+// WithFlushInterval sets how often changes are persisted to the store.
+func WithFlushInterval(d time.Duration) DigestCacheOption {
+	return func(c *DigestCache) {
+		c.flushInterval = d
+	}
+}
+
+func digestCacheKey(registry, repository, version string) string {
+	return registry + "/" + repository + ":" + version
+}
+
+// Get returns the digest recorded for the given version of the repository.
+func (c *DigestCache) Get(registry, repository, version string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	digest, ok := c.entries[digestCacheKey(registry, repository, version)]
+
+	return digest, ok
+}
+
+// Set records the digest of the given version of the repository.
+func (c *DigestCache) Set(registry, repository, version, digest string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := digestCacheKey(registry, repository, version)
+	if c.entries[key] == digest {
+		return
+	}
+	c.entries[key] = digest
+	c.dirty = true
+}
+
+// Range calls f with every version recorded in the cache.
+func (c *DigestCache) Range(f func(registry, repository, version string)) {
+	c.mu.RLock()
+	keys := slices.Collect(maps.Keys(c.entries))
+	c.mu.RUnlock()
+
+	for _, key := range keys {
+		registry, rest, _ := strings.Cut(key, "/")
+		i := strings.LastIndex(rest, ":")
+		if i < 0 {
+			continue
+		}
+		f(registry, rest[:i], rest[i+1:])
+	}
+}
+
+// DeleteDigest forgets all versions of the repository with the given digest.
+// Delete events usually carry only the digest of the removed manifest.
+func (c *DigestCache) DeleteDigest(registry, repository, digest string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := digestCacheKey(registry, repository, "")
+	for key, d := range c.entries {
+		if d == digest && strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+			c.dirty = true
+		}
+	}
+}
+
+// Load replaces the entries of the cache with the persisted ones.
+func (c *DigestCache) Load(ctx context.Context) error {
+	if c.store == nil {
+		return nil
+	}
+
+	entries, err := c.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load digest cache: %w", err)
+	}
+	if entries == nil {
+		entries = make(map[string]string)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = entries
+	c.dirty = false
+
+	return nil
+}
+
+// Flush persists the entries of the cache if they changed since the last flush.
+func (c *DigestCache) Flush(ctx context.Context) error {
+	if c.store == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return nil
+	}
+	entries := maps.Clone(c.entries)
+	c.dirty = false
+	c.mu.Unlock()
+
+	if err := c.store.Save(ctx, entries); err != nil {
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+
+		return fmt.Errorf("failed to save digest cache: %w", err)
+	}
+
+	return nil
+}
+
+// Start loads the persisted entries and begins flushing changes periodically
+// in a separate goroutine. The cache is flushed a last time by Stop().
+func (c *DigestCache) Start(ctx context.Context) error {
+	if err := c.Load(ctx); err != nil {
+		return err
+	}
+
+	c.logger.Info("starting digest cache", "entries", len(c.entries), "interval", c.flushInterval)
+
+	c.wg.Add(1)
+	go c.flushLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the periodic flush and persists any remaining changes.
+func (c *DigestCache) Stop(ctx context.Context) error {
+	c.stopMu.Lock()
+	defer c.stopMu.Unlock()
+
+	if c.stopped {
+		return nil
+	}
+
+	c.stopped = true
+	close(c.stopChan)
+	c.wg.Wait()
+
+	return c.Flush(ctx)
+}
+
+func (c *DigestCache) flushLoop(ctx context.Context) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				c.logger.Error(err, "failed to flush digest cache")
+			}
+		}
+	}
+}
+
+// digestConfigMapKey is the key of the ConfigMap data holding the entries.
+const digestConfigMapKey = "digests.json"
+
+// ConfigMapDigestStore persists the entries of a DigestCache as JSON in a
+// ConfigMap, which is created on the first save.
+type ConfigMapDigestStore struct {
+	client    corev1client.ConfigMapsGetter
+	namespace string
+	name      string
+}
+
+var _ DigestStore = &ConfigMapDigestStore{}
+
+// NewConfigMapDigestStore creates a DigestStore backed by the named ConfigMap.
+func NewConfigMapDigestStore(client corev1client.ConfigMapsGetter, namespace, name string) *ConfigMapDigestStore {
+	return &ConfigMapDigestStore{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (s *ConfigMapDigestStore) Load(ctx context.Context) (map[string]string, error) {
+	cm, err := s.client.ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]string{}
+	if data, ok := cm.Data[digestConfigMapKey]; ok {
+		if err := json.Unmarshal([]byte(data), &entries); err != nil {
+			return nil, fmt.Errorf("failed to decode configmap %s/%s: %w", s.namespace, s.name, err)
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *ConfigMapDigestStore) Save(ctx context.Context, entries map[string]string) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	cm, err := s.client.ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = s.client.ConfigMaps(s.namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace},
+			Data:       map[string]string{digestConfigMapKey: string(data)},
+		}, metav1.CreateOptions{})
+
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[digestConfigMapKey] = string(data)
+	_, err = s.client.ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+
+	return err
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DigestCache", func() {
+	var (
+		ctx   context.Context
+		core  *k8sfake.Clientset
+		store *ConfigMapDigestStore
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		core = k8sfake.NewClientset()
+		store = NewConfigMapDigestStore(core.CoreV1(), "default", "digests")
+	})
+
+	It("should record, update and forget digests", func() {
+		c := NewDigestCache(nil)
+
+		_, ok := c.Get("reg", "ns/component-descriptors/example.com/comp", "v1.0.0")
+		Expect(ok).To(BeFalse())
+
+		c.Set("reg", "ns/component-descriptors/example.com/comp", "v1.0.0", "sha256:aaa")
+		c.Set("reg", "ns/component-descriptors/example.com/comp", "v1.1.0", "sha256:bbb")
+		c.Set("other", "ns/component-descriptors/example.com/comp", "v1.0.0", "sha256:aaa")
+
+		digest, ok := c.Get("reg", "ns/component-descriptors/example.com/comp", "v1.0.0")
+		Expect(ok).To(BeTrue())
+		Expect(digest).To(Equal("sha256:aaa"))
+
+		c.DeleteDigest("reg", "ns/component-descriptors/example.com/comp", "sha256:aaa")
+
+		_, ok = c.Get("reg", "ns/component-descriptors/example.com/comp", "v1.0.0")
+		Expect(ok).To(BeFalse())
+		_, ok = c.Get("reg", "ns/component-descriptors/example.com/comp", "v1.1.0")
+		Expect(ok).To(BeTrue())
+		_, ok = c.Get("other", "ns/component-descriptors/example.com/comp", "v1.0.0")
+		Expect(ok).To(BeTrue(), "entries of other registries must be kept")
+	})
+
+	It("should range over the recorded versions", func() {
+		c := NewDigestCache(nil)
+		c.Set("reg", "ns/component-descriptors/example.com/comp", "v1.0.0", "sha256:aaa")
+		c.Set("other", "ns/component-descriptors/example.com/comp", "v1.1.0", "sha256:bbb")
+
+		var versions []string
+		c.Range(func(registry, repository, version string) {
+			versions = append(versions, registry+" "+repository+" "+version)
+		})
+		Expect(versions).To(ConsistOf(
+			"reg ns/component-descriptors/example.com/comp v1.0.0",
+			"other ns/component-descriptors/example.com/comp v1.1.0",
+		))
+	})
+
+	It("should persist its entries in a ConfigMap", func() {
+		c := NewDigestCache(store)
+		Expect(c.Load(ctx)).To(Succeed())
+
+		c.Set("reg", "ns/component-descriptors/example.com/comp", "v1.0.0", "sha256:aaa")
+		Expect(c.Flush(ctx)).To(Succeed())
+
+		cm, err := core.CoreV1().ConfigMaps("default").Get(ctx, "digests", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data).To(HaveKey(digestConfigMapKey))
+
+		c.Set("reg", "ns/component-descriptors/example.com/comp", "v1.1.0", "sha256:bbb")
+		Expect(c.Flush(ctx)).To(Succeed())
+
+		restored := NewDigestCache(store)
+		Expect(restored.Load(ctx)).To(Succeed())
+		digest, ok := restored.Get("reg", "ns/component-descriptors/example.com/comp", "v1.1.0")
+		Expect(ok).To(BeTrue())
+		Expect(digest).To(Equal("sha256:bbb"))
+	})
+
+	It("should only save when entries changed", func() {
+		c := NewDigestCache(store)
+		Expect(c.Flush(ctx)).To(Succeed())
+
+		_, err := core.CoreV1().ConfigMaps("default").Get(ctx, "digests", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred(), "nothing to save yet")
+
+		c.Set("reg", "repo", "v1", "sha256:aaa")
+		Expect(c.Flush(ctx)).To(Succeed())
+		core.ClearActions()
+
+		c.Set("reg", "repo", "v1", "sha256:aaa")
+		Expect(c.Flush(ctx)).To(Succeed())
+		Expect(core.Actions()).To(BeEmpty())
+	})
+
+	It("should fail to load a corrupt ConfigMap", func() {
+		_, err := core.CoreV1().ConfigMaps("default").Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "digests", Namespace: "default"},
+			Data:       map[string]string{digestConfigMapKey: "not json"},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(NewDigestCache(store).Load(ctx)).To(MatchError(ContainSubstring("failed to load digest cache")))
+	})
+
+	It("should flush the remaining changes when stopped", func() {
+		c := NewDigestCache(store)
+		Expect(c.Start(ctx)).To(Succeed())
+
+		c.Set("reg", "repo", "v1", "sha256:aaa")
+		Expect(c.Stop(ctx)).To(Succeed())
+
+		entries, err := store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveKeyWithValue("reg/repo:v1", "sha256:aaa"))
+	})
+})
@@ -0,0 +1,131 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+)
+
+// ErrorSeverity classifies an ErrorEvent by how the pipeline handles it.
+type ErrorSeverity string
+
+const (
+	// SeverityTransient errors are expected to go away on their own, such as
+	// rate limiting or timeouts. The failed event is retried.
+	SeverityTransient ErrorSeverity = "transient"
+	// SeverityPermanent errors fail again when retried, such as missing
+	// permissions or malformed components. The failed event is dropped.
+	SeverityPermanent ErrorSeverity = "permanent"
+	// SeverityFatal errors stop the discovery pipeline.
+	SeverityFatal ErrorSeverity = "fatal"
+)
+
+// ClassifyError returns the severity of an error returned by a Processor and,
+// if the error tells, how long to wait before retrying it.
+func ClassifyError(err error) (ErrorSeverity, time.Duration) {
+	var retryAfter *backoff.RetryAfterError
+	if errors.As(err, &retryAfter) {
+		return SeverityTransient, retryAfter.Duration
+	}
+
+	var permanent *backoff.PermanentError
+	if errors.As(err, &permanent) || errors.Is(err, context.Canceled) {
+		return SeverityPermanent, 0
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) {
+		return SeverityTransient, 0
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return SeverityTransient, 0
+	}
+
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+		return SeverityTransient, time.Duration(seconds) * time.Second
+	}
+	if apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) ||
+		apierrors.IsConflict(err) {
+		return SeverityTransient, 0
+	}
+
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		if transportErr.Temporary() ||
+			transportErr.StatusCode == http.StatusTooManyRequests ||
+			transportErr.StatusCode >= http.StatusInternalServerError {
+			return SeverityTransient, 0
+		}
+
+		return SeverityPermanent, 0
+	}
+
+	// OCM often wraps errors without keeping them, so check the message for
+	// common rate limit and connection indicators.
+	msg := strings.ToLower(err.Error())
+	if strings.Contains(msg, "429") ||
+		strings.Contains(msg, "too many requests") ||
+		strings.Contains(msg, "connection refused") {
+		return SeverityTransient, 0
+	}
+
+	return SeverityPermanent, 0
+}
+
+// ErrorRecorder reports ErrorEvents as Warning events on the Registry they
+// occurred in, so they show up next to the Registry in the cluster.
+type ErrorRecorder struct {
+	registries *RegistryProvider
+	recorder   events.EventRecorder
+	log        logr.Logger
+}
+
+// NewErrorRecorder returns an ErrorRecorder looking up the Registries of
+// ErrorEvents in registries.
+func NewErrorRecorder(registries *RegistryProvider, recorder events.EventRecorder, log logr.Logger) *ErrorRecorder {
+	return &ErrorRecorder{
+		registries: registries,
+		recorder:   recorder,
+		log:        log,
+	}
+}
+
+// Record logs ev and emits an event on its Registry. Events without a known
+// Registry are only logged.
+func (r *ErrorRecorder) Record(ev ErrorEvent) {
+	r.log.Error(ev.Error, "discovery error", "source", ev.Source, "severity", ev.Severity,
+		"registry", ev.Registry, "repository", ev.Repository)
+
+	reg := r.registries.Get(ev.Registry)
+	if reg == nil {
+		return
+	}
+
+	reason := "DiscoveryFailed"
+	if ev.Severity == SeverityTransient {
+		reason = "DiscoveryRetriesExhausted"
+	}
+	r.recorder.Eventf(reg, nil, corev1.EventTypeWarning, reason, "Discover",
+		"Failed to discover repository %q (%s error in %s): %s", ev.Repository, ev.Severity, ev.Source, ev.Error)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClassifyError", func() {
+	resource := schema.GroupResource{Group: "solar.opendefense.cloud", Resource: "componentversions"}
+
+	DescribeTable("classifies errors",
+		func(err error, severity ErrorSeverity, retryAfter time.Duration) {
+			gotSeverity, gotRetryAfter := ClassifyError(err)
+			Expect(gotSeverity).To(Equal(severity))
+			Expect(gotRetryAfter).To(Equal(retryAfter))
+		},
+		Entry("retry after", fmt.Errorf("lookup: %w", backoff.RetryAfter(3)), SeverityTransient, 3*time.Second),
+		Entry("backoff.Permanent", backoff.Permanent(errors.New("boom")), SeverityPermanent, time.Duration(0)),
+		Entry("canceled context", context.Canceled, SeverityPermanent, time.Duration(0)),
+		Entry("deadline exceeded", fmt.Errorf("get: %w", context.DeadlineExceeded), SeverityTransient, time.Duration(0)),
+		Entry("connection refused", fmt.Errorf("dial: %w", syscall.ECONNREFUSED), SeverityTransient, time.Duration(0)),
+		Entry("API server throttling", apierrors.NewTooManyRequests("slow down", 5), SeverityTransient, 5*time.Second),
+		Entry("API conflict", apierrors.NewConflict(resource, "cv", errors.New("modified")), SeverityTransient, time.Duration(0)),
+		Entry("API not found", apierrors.NewNotFound(resource, "cv"), SeverityPermanent, time.Duration(0)),
+		Entry("registry rate limit", &transport.Error{StatusCode: http.StatusTooManyRequests}, SeverityTransient, time.Duration(0)),
+		Entry("registry outage", &transport.Error{StatusCode: http.StatusBadGateway}, SeverityTransient, time.Duration(0)),
+		Entry("registry unauthorized", &transport.Error{StatusCode: http.StatusUnauthorized}, SeverityPermanent, time.Duration(0)),
+		Entry("wrapped rate limit message", errors.New("received status 429 from registry"), SeverityTransient, time.Duration(0)),
+		Entry("other errors", errors.New("component descriptor not found"), SeverityPermanent, time.Duration(0)),
+	)
+})
+
+var _ = Describe("ErrorRecorder", func() {
+	var (
+		recorder *events.FakeRecorder
+		errRec   *ErrorRecorder
+	)
+
+	BeforeEach(func() {
+		registries := NewRegistryProvider()
+		Expect(registries.Register(&solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "reg", Namespace: "default"}}, nil)).To(Succeed())
+		recorder = events.NewFakeRecorder(1)
+		errRec = NewErrorRecorder(registries, recorder, logr.Discard())
+	})
+
+	It("emits a Warning event on the Registry of the error", func() {
+		errRec.Record(ErrorEvent{
+			Source:     "*handler.Handler",
+			Registry:   "reg",
+			Repository: "org/component-descriptors/comp",
+			Severity:   SeverityPermanent,
+			Error:      errors.New("invalid component descriptor"),
+		})
+
+		Expect(recorder.Events).To(Receive(And(
+			HavePrefix("Warning DiscoveryFailed"),
+			ContainSubstring("org/component-descriptors/comp"),
+			ContainSubstring("invalid component descriptor"),
+		)))
+	})
+
+	It("only logs errors of unknown registries", func() {
+		errRec.Record(ErrorEvent{Registry: "unknown", Severity: SeverityPermanent, Error: errors.New("boom")})
+
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -28,6 +28,9 @@ type RepositoryEvent struct {
 	Repository string
 	// Version is an optional field that contains the version of the component discovered.
 	Version string
+	// VersionConstraint optionally restricts the versions looked up when Version
+	// is empty to semantic versions matching the constraint.
+	VersionConstraint string
 	// Digest is the OCI manifest
 	Digest string
 	// Type is the type of event.
@@ -36,6 +39,14 @@ type RepositoryEvent struct {
 	Timestamp time.Time
 }
 
+func (e RepositoryEvent) location() (registry, repository string) {
+	return e.Registry, e.Repository
+}
+
+func (e RepositoryEvent) eventType() EventType {
+	return e.Type
+}
+
 type ComponentVersionEvent struct {
 	// Source is the event from which the component was discovered.
 	Source RepositoryEvent
@@ -43,10 +54,25 @@ type ComponentVersionEvent struct {
 	Namespace string
 	// Component is the name of the OCM component.
 	Component string
+	// Annotations are the OCI manifest annotations of the component
+	// descriptor. They are only looked up if the registry maps annotations
+	// to labels.
+	Annotations map[string]string
+	// Channel is the release channel the registry's VersionPolicy assigns
+	// the version to, if any.
+	Channel string
 	// Timestamp is the timestamp when the event was created.
 	Timestamp time.Time
 }
 
+func (e ComponentVersionEvent) location() (registry, repository string) {
+	return e.Source.location()
+}
+
+func (e ComponentVersionEvent) eventType() EventType {
+	return e.Source.eventType()
+}
+
 type HelmDiscovery struct {
 	ResourceName   string
 	Name           string
@@ -59,20 +85,61 @@ type HelmDiscovery struct {
 	ValuesTemplate *string
 }
 
+// CatalogDiscovery is the documentation and icon discovered for the
+// solution catalog. Either may be empty.
+type CatalogDiscovery struct {
+	Readme        string
+	Icon          []byte
+	IconMediaType string
+}
+
 type WriteAPIResourceEvent struct {
 	// Source is the event from which the resource was discovered.
 	Source ComponentVersionEvent
 	// HelmDiscovery is the discovered Helm chart information. It is only set if the event is of type EventCreated or EventUpdated and the discovered resource is a Helm chart.
 	HelmDiscovery HelmDiscovery
+	// Catalog is the discovered documentation and icon of the component version.
+	Catalog CatalogDiscovery
 	// ComponentSpec is the ComponentSpec of the ComponentVersion.
 	ComponentSpec compdesc.ComponentSpec
 	// Timestamp is the timestamp when the event was created.
 	Timestamp time.Time
 }
 
-// ErrorEvent represents an event sent by the RegistryScanner or Webhook Server containing information about errors.
+func (e WriteAPIResourceEvent) location() (registry, repository string) {
+	return e.Source.location()
+}
+
+func (e WriteAPIResourceEvent) eventType() EventType {
+	return e.Source.eventType()
+}
+
+// locator is implemented by events that originate from a repository.
+type locator interface {
+	location() (registry, repository string)
+}
+
+// typer is implemented by events that carry the EventType of the change they
+// originate from.
+type typer interface {
+	eventType() EventType
+}
+
+// ErrorEvent represents an error that occurred in the discovery pipeline.
 type ErrorEvent struct {
-	// Error is when an error occurred.
+	// Source is the pipeline component the error occurred in, e.g. the type
+	// of the Processor.
+	Source string
+	// Registry is the name of the registry of the failed event, if known.
+	Registry string
+	// Repository is the repository of the failed event, if known.
+	Repository string
+	// Severity classifies the error. See ClassifyError.
+	Severity ErrorSeverity
+	// RetryAfter is how long to wait before retrying a transient error, if
+	// the error tells.
+	RetryAfter time.Duration
+	// Error is the error that occurred.
 	Error error
 	// Timestamp is the timestamp when the event was created.
 	Timestamp time.Time
@@ -0,0 +1,116 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"ocm.software/ocm/api/ocm"
+	"ocm.software/ocm/api/ocm/extensions/download"
+
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+const (
+	// maxReadmeBytes is the size limit of a docs resource.
+	maxReadmeBytes = 64 << 10
+	// maxIconBytes is the size limit of an icon resource.
+	maxIconBytes = 32 << 10
+)
+
+// catalogDetails reads the first docs and icon resource of comp for the
+// solution catalog. Both are optional, so resources that cannot be read,
+// exceed their size limit or have an unexpected format are logged and left
+// out instead of failing the discovery of the component version.
+func (rs *Handler) catalogDetails(octx ocm.Context, comp ocm.ComponentVersionAccess) discovery.CatalogDiscovery {
+	var details discovery.CatalogDiscovery
+
+	for _, res := range comp.GetResources() {
+		name := res.Meta().Name
+
+		switch OCMResourceType(res.Meta().Type) {
+		case DocsResource:
+			if details.Readme != "" {
+				continue
+			}
+			data, err := readResource(octx, res, maxReadmeBytes)
+			if err != nil {
+				rs.Logger().Info("skipping docs resource", "resource", name, "reason", err.Error())
+				continue
+			}
+			if !utf8.Valid(data) {
+				rs.Logger().Info("skipping docs resource", "resource", name, "reason", "not valid UTF-8")
+				continue
+			}
+			details.Readme = string(data)
+		case IconResource:
+			if len(details.Icon) > 0 {
+				continue
+			}
+			data, err := readResource(octx, res, maxIconBytes)
+			if err != nil {
+				rs.Logger().Info("skipping icon resource", "resource", name, "reason", err.Error())
+				continue
+			}
+			mediaType, ok := iconMediaType(data)
+			if !ok {
+				rs.Logger().Info("skipping icon resource", "resource", name, "reason", "not a supported image format")
+				continue
+			}
+			details.Icon = data
+			details.IconMediaType = mediaType
+		}
+	}
+
+	return details
+}
+
+// readResource downloads res and returns its content. It fails if the
+// content is larger than limit bytes.
+func readResource(octx ocm.Context, res ocm.ResourceAccess, limit int64) ([]byte, error) {
+	mfs := memoryfs.New()
+
+	effPath, err := download.DownloadResource(octx, res, res.Meta().Name, download.WithFileSystem(mfs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download resource: %w", err)
+	}
+
+	f, err := mfs.Open(effPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("resource exceeds the size limit of %d bytes", limit)
+	}
+
+	return data, nil
+}
+
+// iconMediaType returns the media type of an icon. Only images the catalog
+// UI can show are accepted.
+func iconMediaType(data []byte) (string, bool) {
+	mediaType := http.DetectContentType(data)
+	switch mediaType {
+	case "image/png", "image/jpeg", "image/gif", "image/webp":
+		return mediaType, true
+	}
+
+	// SVG is XML and not sniffed as an image.
+	if bytes.Contains(data, []byte("<svg")) {
+		return "image/svg+xml", true
+	}
+
+	return "", false
+}
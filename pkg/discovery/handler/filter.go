@@ -51,8 +51,12 @@ func (rs *Filter) Process(ctx context.Context, ev discovery.ComponentVersionEven
 	}
 
 	// We have to check if the component version already exists in the cluster to avoid creating duplicate component versions.
-	_, err := rs.solarClient.ComponentVersions(rs.namespace).Get(ctx, discovery.ComponentVersionName(ev.Component, ev.Source.Version), metav1.GetOptions{})
+	cv, err := rs.solarClient.ComponentVersions(rs.namespace).Get(ctx, discovery.ComponentVersionName(ev.Component, ev.Source.Version), metav1.GetOptions{})
 	switch {
+	case err == nil && discovery.CheckComponentName(cv, ev.Component) != nil:
+		// The name belongs to a different component, pass the event on so the
+		// collision is reported when writing it.
+		return []discovery.ComponentVersionEvent{ev}, nil
 	case err == nil:
 		// Component version already exists, skip creating it again
 		rs.Logger().V(2).Info("component version already exists, skipping", "component", ev.Component, "version", ev.Source.Version)
@@ -36,7 +36,14 @@ var _ = Describe("Filter", Ordered, func() {
 		outputChan = make(chan discovery.ComponentVersionEvent, 100)
 		errChan = make(chan discovery.ErrorEvent, 100)
 		solarClient = fake.NewClientset(&v1alpha1.ComponentVersion{
-			ObjectMeta: metav1.ObjectMeta{Name: discovery.SanitizeWithHash("opendefense-cloud-ocm-demo-v26-4-1"), Namespace: "default"},
+			ObjectMeta: metav1.ObjectMeta{Name: discovery.ComponentVersionName("opendefense.cloud/ocm-demo", "v26.4.1"), Namespace: "default"},
+		}, &v1alpha1.ComponentVersion{
+			// Another component holds the name of "opendefense.cloud/ocm-demo" v1.0.0.
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        discovery.ComponentVersionName("opendefense.cloud/ocm-demo", "v1.0.0"),
+				Namespace:   "default",
+				Annotations: map[string]string{discovery.AnnotationComponentName: "opendefense-cloud/ocm.demo"},
+			},
 		}).SolarV1alpha1()
 
 		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
@@ -92,6 +99,22 @@ var _ = Describe("Filter", Ordered, func() {
 			Consistently(errChan).ShouldNot(Receive())
 		})
 
+		It("should forward create events when the component version name belongs to another component", func() {
+			inputChan <- discovery.ComponentVersionEvent{
+				Source: discovery.RepositoryEvent{
+					Registry:   "default",
+					Repository: "test/component-descriptors/opendefense.cloud/ocm-demo",
+					Version:    "v1.0.0",
+					Type:       discovery.EventCreated,
+				},
+				Namespace: "test",
+				Component: "opendefense.cloud/ocm-demo",
+			}
+
+			Eventually(outputChan).Should(Receive())
+			Consistently(errChan).ShouldNot(Receive())
+		})
+
 		It("should pass through update events without filtering", func() {
 			// Send update event for a component version that already exists — should still pass through
 			inputChan <- discovery.ComponentVersionEvent{
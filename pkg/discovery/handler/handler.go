@@ -6,11 +6,12 @@ package handler
 import (
 	"context"
 	"fmt"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
 	"github.com/go-logr/logr"
+	ocmerrors "github.com/mandelsoft/goutils/errors"
 	"ocm.software/ocm/api/ocm"
 	"ocm.software/ocm/api/ocm/extensions/repositories/ocireg"
 
@@ -39,7 +40,15 @@ func RegisterComponentHandler(t HandlerType, fn InitHandlerFunc) {
 type Handler struct {
 	*discovery.Runner[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent]
 	provider *discovery.RegistryProvider
-	handler  map[HandlerType]ComponentHandler
+	// handlerMu guards handler, which the workers of all registries share.
+	handlerMu sync.Mutex
+	handler   map[HandlerType]ComponentHandler
+	workers   int
+	// notFoundMu guards notFound, which counts the consecutive lookups of a
+	// component version the registry answered with not found.
+	notFoundMu        sync.Mutex
+	notFound          map[string]int
+	notFoundThreshold int
 }
 
 func NewHandlerOptions(opts ...discovery.RunnerOption[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent]) []discovery.RunnerOption[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent] {
@@ -56,8 +65,20 @@ func NewHandler(
 	p := &Handler{
 		provider: provider,
 		handler:  make(map[HandlerType]ComponentHandler),
+		notFound: make(map[string]int),
 	}
 	p.Runner = discovery.NewRunner(p, in, out, err)
+	// Lookups hit the upstream registries, so process each registry
+	// independently to keep a slow registry from delaying the others.
+	discovery.WithPartitions[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent](
+		func(ev discovery.ComponentVersionEvent) string { return ev.Source.Registry },
+		provider.PartitionLimitsFunc(&p.workers),
+	)(p.Runner)
+	// Workers of a registry pick up the events of a repository in order, so
+	// a deletion is never overtaken by an earlier version of the repository.
+	discovery.WithSharding[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent](
+		func(ev discovery.ComponentVersionEvent) string { return ev.Source.Repository },
+	)(p.Runner)
 	for _, opt := range opts {
 		opt(p.Runner)
 	}
@@ -65,13 +86,55 @@ func NewHandler(
 	return p
 }
 
+// SetWorkers sets the number of workers handling the events of a registry in
+// parallel, unless the registry sets its own MaxConcurrency. Events of the
+// same repository are always processed in order.
+func (rs *Handler) SetWorkers(n int) {
+	rs.workers = n
+}
+
+// SetNotFoundThreshold makes the handler treat a component version as deleted
+// once n consecutive lookups of it were answered with not found. A single
+// not found may stem from a registry still replicating a push or serving a
+// stale cache, so zero, the default, never infers deletions and only
+// explicit delete events mark versions unavailable.
+func (rs *Handler) SetNotFoundThreshold(n int) {
+	rs.notFoundThreshold = n
+}
+
+// countNotFound records a not found lookup of the version of ev and returns
+// the number of consecutive ones.
+func (rs *Handler) countNotFound(ev discovery.ComponentVersionEvent) int {
+	rs.notFoundMu.Lock()
+	defer rs.notFoundMu.Unlock()
+
+	key := notFoundKey(ev)
+	rs.notFound[key]++
+	count := rs.notFound[key]
+	if rs.notFoundThreshold > 0 && count >= rs.notFoundThreshold {
+		delete(rs.notFound, key)
+	}
+
+	return count
+}
+
+// resetNotFound forgets earlier not found lookups of the version of ev.
+func (rs *Handler) resetNotFound(ev discovery.ComponentVersionEvent) {
+	rs.notFoundMu.Lock()
+	defer rs.notFoundMu.Unlock()
+
+	delete(rs.notFound, notFoundKey(ev))
+}
+
+func notFoundKey(ev discovery.ComponentVersionEvent) string {
+	return ev.Source.Registry + "/" + ev.Source.Repository + ":" + ev.Source.Version
+}
+
 // isRetryable determines if we should wait and try again
 func isRetryable(err error) bool {
-	msg := strings.ToLower(err.Error())
-	// OCM often wraps errors, so we check the string for common rate-limit indicators
-	return strings.Contains(msg, "429") ||
-		strings.Contains(msg, "too many requests") ||
-		strings.Contains(msg, "connection refused")
+	severity, _ := discovery.ClassifyError(err)
+
+	return severity == discovery.SeverityTransient
 }
 
 func (rs *Handler) Process(ctx context.Context, ev discovery.ComponentVersionEvent) ([]discovery.WriteAPIResourceEvent, error) {
@@ -101,8 +164,9 @@ func (rs *Handler) Process(ctx context.Context, ev discovery.ComponentVersionEve
 	var octx ocm.Context
 	var err error
 	creds := rs.provider.GetCredentials(ev.Source.Registry)
-	if creds != nil {
-		octx, err = discovery.FromContextWithCreds(ctx, registry.Spec.Hostname, creds)
+	registryTLS := rs.provider.GetTLS(ev.Source.Registry)
+	if creds != nil || registryTLS != nil {
+		octx, err = discovery.FromContextWithCreds(ctx, registry.Spec.Hostname, creds, registryTLS)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create OCM context with creds: %w", err)
 		}
@@ -140,11 +204,33 @@ func (rs *Handler) Process(ctx context.Context, ev discovery.ComponentVersionEve
 		}
 		compVersion, err = backoff.Retry(ctx, operation, opts...)
 	}
+	if ocmerrors.IsErrNotFound(err) {
+		if rs.notFoundThreshold <= 0 {
+			return nil, fmt.Errorf("component version %s not found in registry: %w", version, err)
+		}
+		count := rs.countNotFound(ev)
+		if count < rs.notFoundThreshold {
+			return nil, fmt.Errorf("component version %s not found in registry (%d of %d lookups before it is handled as deleted): %w", version, count, rs.notFoundThreshold, err)
+		}
+
+		// The version is gone from the registry, e.g. because it was deleted
+		// after it was announced, so handle it like a deletion.
+		rs.Logger().Info("component version repeatedly not found in registry, handling it as deleted", "version", version, "lookups", count)
+		ev.Source.Type = discovery.EventDeleted
+
+		return []discovery.WriteAPIResourceEvent{{
+			Source:    ev,
+			Timestamp: time.Now().UTC(),
+		}}, nil
+	}
 	if err != nil {
 		rs.Logger().Error(err, "failed to lookup component", "version", version)
 		return nil, fmt.Errorf("failed to lookup component version %s: %w", version, err)
 	}
 	defer func() { _ = compVersion.Close() }()
+	if rs.notFoundThreshold > 0 {
+		rs.resetNotFound(ev)
+	}
 
 	// Count the number of Helm chart resources in the component version and determine the handler type based on that.
 	for _, res := range compVersion.GetDescriptor().ComponentSpec.Resources {
@@ -180,12 +266,16 @@ func (rs *Handler) Process(ctx context.Context, ev discovery.ComponentVersionEve
 		rs.Logger().Error(err, "failed to process component with handler", "handler", handlerType)
 		return nil, fmt.Errorf("failed to process component with handler %q: %w", handlerType, err)
 	}
+	resEvent.Catalog = rs.catalogDetails(octx, compVersion)
 
 	return []discovery.WriteAPIResourceEvent{*resEvent}, nil
 }
 
 // getHandlerForType returns the handler for the given type, initializing it if necessary.
 func (rs *Handler) getHandlerForType(t HandlerType) (ComponentHandler, error) {
+	rs.handlerMu.Lock()
+	defer rs.handlerMu.Unlock()
+
 	if h, ok := rs.handler[t]; ok {
 
 		return h, nil
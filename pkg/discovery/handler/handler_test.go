@@ -143,6 +143,30 @@ var _ = Describe("Handler", Ordered, func() {
 			Expect(*ev.HelmDiscovery.ValuesTemplate).NotTo(ContainSubstring("repository: /\n"))
 		})
 
+		It("should report a version missing from the registry without handling it as deleted", func() {
+			inputChan <- removedVersionEvent(testRegistry.Name)
+
+			var errEv discovery.ErrorEvent
+			Eventually(errChan).Should(Receive(&errEv))
+			Expect(errEv.Error).To(MatchError(ContainSubstring("not found")))
+			Consistently(outputChan).ShouldNot(Receive())
+		})
+
+		It("should handle a version repeatedly missing from the registry as deleted", func() {
+			handler.SetNotFoundThreshold(2)
+
+			inputChan <- removedVersionEvent(testRegistry.Name)
+			Eventually(errChan).Should(Receive())
+			Consistently(outputChan).ShouldNot(Receive())
+
+			inputChan <- removedVersionEvent(testRegistry.Name)
+			var ev discovery.WriteAPIResourceEvent
+			Eventually(outputChan).Should(Receive(&ev))
+			Consistently(errChan).ShouldNot(Receive())
+			Expect(ev.Source.Source.Type).To(Equal(discovery.EventDeleted))
+			Expect(ev.Source.Source.Version).To(Equal("v0.0.0-removed"))
+		})
+
 		It("should support basic auth", func() {
 			regWAuth := registry.New().WithAuth("", "")
 			testServerWAuth := httptest.NewServer(regWAuth.HandleFunc())
@@ -215,6 +239,21 @@ var _ = Describe("Handler", Ordered, func() {
 	})
 })
 
+// removedVersionEvent announces a version of the ocm-demo component that is
+// not in the registry.
+func removedVersionEvent(registry string) discovery.ComponentVersionEvent {
+	return discovery.ComponentVersionEvent{
+		Source: discovery.RepositoryEvent{
+			Registry:   registry,
+			Repository: "test/component-descriptors/opendefense.cloud/ocm-demo",
+			Version:    "v0.0.0-removed",
+			Type:       discovery.EventCreated,
+		},
+		Namespace: "test",
+		Component: "opendefense.cloud/ocm-demo",
+	}
+}
+
 var _ = Describe("isRetryable", func() {
 	It("should treat HTTP 429 responses as retryable", func() {
 		Expect(isRetryable(fmt.Errorf("received status 429 from registry"))).To(BeTrue())
@@ -251,3 +290,94 @@ var _ = Describe("RegisterComponentHandler", func() {
 		}).To(PanicWith(fmt.Sprintf("handler %q already registered", handlerType)))
 	})
 })
+
+var _ = DescribeTable("iconMediaType",
+	func(data []byte, mediaType string, ok bool) {
+		got, gotOK := iconMediaType(data)
+		Expect(gotOK).To(Equal(ok))
+		Expect(got).To(Equal(mediaType))
+	},
+	Entry("png", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), "image/png", true),
+	Entry("jpeg", []byte("\xff\xd8\xff\xe0\x00\x10JFIF"), "image/jpeg", true),
+	Entry("svg", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`), "image/svg+xml", true),
+	Entry("html", []byte("<html><body>icon</body></html>"), "", false),
+	Entry("text", []byte("not an icon"), "", false),
+)
+
+// blockingProcessor handles events immediately, except those of the blocked
+// registry, or only of its blocked repository if set, which wait until
+// release is closed.
+type blockingProcessor struct {
+	blocked           string
+	blockedRepository string
+	release           chan struct{}
+}
+
+func (p *blockingProcessor) Process(ctx context.Context, ev discovery.ComponentVersionEvent) ([]discovery.WriteAPIResourceEvent, error) {
+	if ev.Source.Registry == p.blocked && (p.blockedRepository == "" || ev.Source.Repository == p.blockedRepository) {
+		select {
+		case <-p.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return []discovery.WriteAPIResourceEvent{{Source: ev}}, nil
+}
+
+var _ = Describe("Handler partitions", func() {
+	It("should not delay the events of a registry behind a slow registry", func() {
+		in := make(chan discovery.ComponentVersionEvent, 10)
+		out := make(chan discovery.WriteAPIResourceEvent, 10)
+		h := NewHandler(discovery.NewRegistryProvider(), in, out, nil)
+		proc := &blockingProcessor{blocked: "slow", release: make(chan struct{})}
+		h.Runner.Processor = proc
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(h.Start(ctx)).To(Succeed())
+		defer h.Stop()
+
+		in <- discovery.ComponentVersionEvent{Source: discovery.RepositoryEvent{Registry: "slow", Repository: "a", Version: "v1"}}
+		in <- discovery.ComponentVersionEvent{Source: discovery.RepositoryEvent{Registry: "fast", Repository: "b", Version: "v1"}}
+
+		var ev discovery.WriteAPIResourceEvent
+		Eventually(out).Should(Receive(&ev))
+		Expect(ev.Source.Source.Registry).To(Equal("fast"))
+		Consistently(out, 200*time.Millisecond).ShouldNot(Receive())
+
+		close(proc.release)
+		Eventually(out).Should(Receive(&ev))
+		Expect(ev.Source.Source.Registry).To(Equal("slow"))
+	})
+
+	It("should not delay the events of a repository behind another repository of the registry", func() {
+		in := make(chan discovery.ComponentVersionEvent, 10)
+		out := make(chan discovery.WriteAPIResourceEvent, 10)
+		h := NewHandler(discovery.NewRegistryProvider(), in, out, nil)
+		h.SetWorkers(2)
+		proc := &blockingProcessor{blocked: "reg", blockedRepository: "a", release: make(chan struct{})}
+		h.Runner.Processor = proc
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(h.Start(ctx)).To(Succeed())
+		defer h.Stop()
+
+		// "a" and "b" are assigned to different workers of the registry.
+		in <- discovery.ComponentVersionEvent{Source: discovery.RepositoryEvent{Registry: "reg", Repository: "a", Version: "v1"}}
+		in <- discovery.ComponentVersionEvent{Source: discovery.RepositoryEvent{Registry: "reg", Repository: "a", Version: "v2"}}
+		in <- discovery.ComponentVersionEvent{Source: discovery.RepositoryEvent{Registry: "reg", Repository: "b", Version: "v1"}}
+
+		var ev discovery.WriteAPIResourceEvent
+		Eventually(out).Should(Receive(&ev))
+		Expect(ev.Source.Source.Repository).To(Equal("b"))
+		Consistently(out, 200*time.Millisecond).ShouldNot(Receive())
+
+		close(proc.release)
+		Eventually(out).Should(Receive(&ev))
+		Expect(ev.Source.Source.Version).To(Equal("v1"))
+		Eventually(out).Should(Receive(&ev))
+		Expect(ev.Source.Source.Version).To(Equal("v2"))
+	})
+})
@@ -22,6 +22,10 @@ const (
 	HelmResource OCMResourceType = "helmChart"
 	BlobResource OCMResourceType = "blob"
 	OCIResource  OCMResourceType = "ociImage"
+	// DocsResource is a markdown document shown in the solution catalog.
+	DocsResource OCMResourceType = "docs"
+	// IconResource is an image shown in the solution catalog.
+	IconResource OCMResourceType = "icon"
 )
 
 const (
@@ -7,21 +7,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"hash/fnv"
 	"net"
-	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	"ocm.software/ocm/api/credentials"
 	"ocm.software/ocm/api/oci/extensions/repositories/ocireg"
 	"ocm.software/ocm/api/ocm"
-)
 
-var (
-	ErrNotComponentDescriptor  = errors.New("repository is not a component descriptor")
-	regexNonAlphaNumericString = regexp.MustCompile("[^a-z0-9]+")
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 )
 
+var ErrNotComponentDescriptor = errors.New("repository is not a component descriptor")
+
 // SplitRepository splits the repository into its (optional) base and component descriptor part.
 func SplitRepository(repo string) (string, string, error) {
 	const prefix = "component-descriptors/"
@@ -53,51 +52,73 @@ func SplitRepository(repo string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
-// SanitizeName cleans a string to be a valid K8s resource name.
-// It ensures:
-// 1. Max 63 characters
-// 2. Lowercase alphanumeric or '-'
-// 3. Starts and ends with alphanumeric
-func SanitizeName(input string) string {
-	name := strings.ToLower(input)
-
-	name = regexNonAlphaNumericString.ReplaceAllString(name, "-")
+// FilterVersions returns the versions that are valid semantic versions matching
+// the given constraint, in their original order. An empty constraint matches
+// every version, including those that are not semantic versions.
+func FilterVersions(versions []string, constraint string) ([]string, error) {
+	if constraint == "" {
+		return versions, nil
+	}
 
-	name = strings.Trim(name, "-")
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid semver constraint %q: %w", constraint, err)
+	}
 
-	if len(name) > 63 {
-		name = name[:63]
-		name = strings.TrimRight(name, "-")
+	matching := make([]string, 0, len(versions))
+	for _, version := range versions {
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			continue
+		}
+		if c.Check(v) {
+			matching = append(matching, version)
+		}
 	}
 
-	return name
+	return matching, nil
 }
 
-// SanitizeWithHash sanitizes the input string and appends a hash if the sanitized name exceeds 63 characters.
-func SanitizeWithHash(input string) string {
-	clean := SanitizeName(input)
-
-	// If the name was short enough, just return it
-	if len(clean) < 57 {
-		return clean
+// MatchChannel returns the name of the first of the channels the version
+// belongs to by its first prerelease identifier, or an empty string if it
+// belongs to none of them or is not a semantic version.
+func MatchChannel(channels []solarv1alpha1.VersionChannel, version string) string {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return ""
 	}
 
-	// Otherwise, use the first 57 chars + a hash of the FULL original input
-	h := fnv.New32a()
-	h.Write([]byte(input))
-	hashParams := fmt.Sprintf("%x", h.Sum32())
+	prerelease, _, _ := strings.Cut(v.Prerelease(), ".")
+	for _, c := range channels {
+		if prerelease == "" && len(c.Prerelease) == 0 ||
+			prerelease != "" && (slices.Contains(c.Prerelease, "*") || slices.Contains(c.Prerelease, prerelease)) {
+			return c.Name
+		}
+	}
 
-	return fmt.Sprintf("%s-%s", clean[:57], hashParams)
+	return ""
 }
 
-// ComponentVersionName generates a name for a ComponentVersion
-func ComponentVersionName(comp string, version string) string {
-	return SanitizeName(fmt.Sprintf("%s-%s", comp, version))
+// RepositoryIncluded reports whether the repository passes the filter. A nil
+// filter includes every repository.
+func RepositoryIncluded(filter *solarv1alpha1.RepositoryFilter, repo string) bool {
+	if filter == nil {
+		return true
+	}
+
+	repo = strings.TrimPrefix(repo, "/")
+	hasPrefix := func(prefix string) bool { return strings.HasPrefix(repo, prefix) }
+	if slices.ContainsFunc(filter.ExcludePrefixes, hasPrefix) {
+		return false
+	}
+
+	return len(filter.IncludePrefixes) == 0 || slices.ContainsFunc(filter.IncludePrefixes, hasPrefix)
 }
 
 // FromContextWithCreds creates an OCM context with the given registry credentials
-// registered for the specified hostname. The hostname must be in "host:port" format.
-func FromContextWithCreds(ctx context.Context, hostname string, creds *RegistryCredentials) (ocm.Context, error) {
+// and TLS settings registered for the specified hostname. Either of them may be
+// nil. The hostname must be in "host:port" format.
+func FromContextWithCreds(ctx context.Context, hostname string, creds *RegistryCredentials, tls *RegistryTLS) (ocm.Context, error) {
 	octx := ocm.FromContext(ctx)
 	host, port, err := net.SplitHostPort(hostname)
 	if err != nil {
@@ -108,11 +129,21 @@ func FromContextWithCreds(ctx context.Context, hostname string, creds *RegistryC
 		"hostname":            host,
 		"port":                port,
 	}
-	ociCreds := credentials.NewCredentials(map[string]string{
-		credentials.ATTR_USERNAME: creds.Username,
-		credentials.ATTR_PASSWORD: creds.Password,
-	})
-	octx.CredentialsContext().SetCredentialsForConsumer(id, ociCreds)
+	attrs := map[string]string{}
+	if creds != nil {
+		attrs[credentials.ATTR_USERNAME] = creds.Username
+		attrs[credentials.ATTR_PASSWORD] = creds.Password
+	}
+	if tls != nil {
+		if len(tls.CA) > 0 {
+			attrs[credentials.ATTR_CERTIFICATE_AUTHORITY] = string(tls.CA)
+		}
+		if len(tls.Cert) > 0 {
+			attrs[credentials.ATTR_CERTIFICATE] = string(tls.Cert)
+			attrs[credentials.ATTR_PRIVATE_KEY] = string(tls.Key)
+		}
+	}
+	octx.CredentialsContext().SetCredentialsForConsumer(id, credentials.NewCredentials(attrs))
 
 	return octx, nil
 }
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"strings"
 
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -49,87 +51,104 @@ var _ = Describe("SplitRepository", func() {
 	})
 })
 
-var _ = Describe("SanitizeDigestLabel", func() {
-	It("should strip the algorithm prefix", func() {
-		Expect(SanitizeDigestLabel("sha256:abcdef1234567890")).To(Equal("abcdef1234567890"))
+var _ = Describe("FilterVersions", func() {
+	versions := []string{"v1.0.0", "1.2.0", "1.3.0-rc.1", "2.0.0", "latest"}
+
+	It("should return all versions without a constraint", func() {
+		Expect(FilterVersions(versions, "")).To(Equal(versions))
 	})
 
-	It("should strip sha512 prefix", func() {
-		Expect(SanitizeDigestLabel("sha512:abcdef1234567890")).To(Equal("abcdef1234567890"))
+	It("should only return semantic versions matching the constraint", func() {
+		Expect(FilterVersions(versions, ">= 1.0, < 2")).To(Equal([]string{"v1.0.0", "1.2.0"}))
 	})
 
-	It("should return empty string for empty input", func() {
-		Expect(SanitizeDigestLabel("")).To(BeEmpty())
+	It("should include prereleases if the constraint does", func() {
+		Expect(FilterVersions(versions, ">= 1.3.0-0, < 2")).To(Equal([]string{"1.3.0-rc.1"}))
 	})
 
-	It("should truncate to 63 characters", func() {
-		long := "sha256:" + strings.Repeat("a", 100)
-		result := SanitizeDigestLabel(long)
-		Expect(result).To(HaveLen(63))
+	It("should return an error for an invalid constraint", func() {
+		_, err := FilterVersions(versions, "not a constraint")
+		Expect(err).To(MatchError(ContainSubstring("invalid semver constraint")))
 	})
+})
 
-	It("should return hex as-is when no prefix and under 63 chars", func() {
-		Expect(SanitizeDigestLabel("abcdef1234567890")).To(Equal("abcdef1234567890"))
+var _ = Describe("MatchChannel", func() {
+	channels := []solarv1alpha1.VersionChannel{
+		{Name: "stable"},
+		{Name: "candidate", Prerelease: []string{"rc"}},
+		{Name: "edge", Prerelease: []string{"*"}},
+	}
+
+	It("should assign versions without a prerelease to the channel without identifiers", func() {
+		Expect(MatchChannel(channels, "v1.2.0")).To(Equal("stable"))
+		Expect(MatchChannel(channels, "1.2.0+build.5")).To(Equal("stable"))
 	})
 
-	It("should handle a realistic sha256 digest", func() {
-		// sha256 hex is 64 chars, truncated to 63
-		digest := "sha256:40bac3123555936fd4aa8260a853669283fa8d64be8f665ba9d60fd9f7d7df3b"
-		result := SanitizeDigestLabel(digest)
-		Expect(result).To(Equal("40bac3123555936fd4aa8260a853669283fa8d64be8f665ba9d60fd9f7d7df3"))
-		Expect(len(result)).To(BeNumerically("<=", 63))
+	It("should assign prereleases to the first channel listing their identifier", func() {
+		Expect(MatchChannel(channels, "1.2.0-rc.1")).To(Equal("candidate"))
+		Expect(MatchChannel(channels, "1.2.0-beta.2")).To(Equal("edge"))
 	})
-})
 
-var _ = Describe("SanitizeName", func() {
-	It("should lowercase and replace non-alphanumeric runs with a single dash", func() {
-		Expect(SanitizeName("My Cool_Component!!v1")).To(Equal("my-cool-component-v1"))
+	It("should return no channel if none matches", func() {
+		Expect(MatchChannel(channels[1:], "1.2.0")).To(BeEmpty())
+		Expect(MatchChannel(channels[:1], "1.2.0-rc.1")).To(BeEmpty())
+		Expect(MatchChannel(channels, "latest")).To(BeEmpty())
+		Expect(MatchChannel(nil, "1.2.0")).To(BeEmpty())
 	})
+})
+
+var _ = Describe("RepositoryIncluded", func() {
+	filter := &solarv1alpha1.RepositoryFilter{
+		IncludePrefixes: []string{"component-descriptors/", "team/component-descriptors/"},
+		ExcludePrefixes: []string{"component-descriptors/example.com/internal"},
+	}
 
-	It("should trim leading and trailing dashes", func() {
-		Expect(SanitizeName("--hello--")).To(Equal("hello"))
+	It("should include every repository without a filter", func() {
+		Expect(RepositoryIncluded(nil, "images/nginx")).To(BeTrue())
+		Expect(RepositoryIncluded(&solarv1alpha1.RepositoryFilter{}, "images/nginx")).To(BeTrue())
 	})
 
-	It("should truncate to 63 characters and trim a trailing dash left by truncation", func() {
-		input := strings.Repeat("a", 62) + "-" + strings.Repeat("b", 10)
-		result := SanitizeName(input)
-		Expect(len(result)).To(BeNumerically("<=", 63))
-		Expect(result).NotTo(HaveSuffix("-"))
+	It("should include repositories matching an include prefix", func() {
+		Expect(RepositoryIncluded(filter, "component-descriptors/example.com/app")).To(BeTrue())
+		Expect(RepositoryIncluded(filter, "/team/component-descriptors/example.com/app")).To(BeTrue())
+		Expect(RepositoryIncluded(filter, "images/nginx")).To(BeFalse())
 	})
 
-	It("should return empty string for input with no alphanumeric characters", func() {
-		Expect(SanitizeName("!!!")).To(BeEmpty())
+	It("should exclude repositories matching an exclude prefix", func() {
+		Expect(RepositoryIncluded(filter, "component-descriptors/example.com/internal-tools")).To(BeFalse())
+		Expect(RepositoryIncluded(&solarv1alpha1.RepositoryFilter{ExcludePrefixes: []string{"images/"}}, "images/nginx")).To(BeFalse())
 	})
 })
 
-var _ = Describe("SanitizeWithHash", func() {
-	It("should return the sanitized name unchanged when short enough", func() {
-		Expect(SanitizeWithHash("my-component")).To(Equal("my-component"))
+var _ = Describe("SanitizeDigestLabel", func() {
+	It("should strip the algorithm prefix", func() {
+		Expect(SanitizeDigestLabel("sha256:abcdef1234567890")).To(Equal("abcdef1234567890"))
 	})
 
-	It("should append a hash suffix when the sanitized name is too long", func() {
-		input := strings.Repeat("a", 100)
-		result := SanitizeWithHash(input)
-		Expect(len(result)).To(BeNumerically(">", 57))
-		Expect(result).To(HavePrefix(strings.Repeat("a", 57) + "-"))
+	It("should strip sha512 prefix", func() {
+		Expect(SanitizeDigestLabel("sha512:abcdef1234567890")).To(Equal("abcdef1234567890"))
 	})
 
-	It("should be deterministic for the same input", func() {
-		input := strings.Repeat("x", 100)
-		Expect(SanitizeWithHash(input)).To(Equal(SanitizeWithHash(input)))
+	It("should return empty string for empty input", func() {
+		Expect(SanitizeDigestLabel("")).To(BeEmpty())
 	})
 
-	It("should differ for different inputs that share the same truncated prefix", func() {
-		base := strings.Repeat("a", 57)
-		first := SanitizeWithHash(base + "-one")
-		second := SanitizeWithHash(base + "-two")
-		Expect(first).NotTo(Equal(second))
+	It("should truncate to 63 characters", func() {
+		long := "sha256:" + strings.Repeat("a", 100)
+		result := SanitizeDigestLabel(long)
+		Expect(result).To(HaveLen(63))
 	})
-})
 
-var _ = Describe("ComponentVersionName", func() {
-	It("should combine component and version into a sanitized name", func() {
-		Expect(ComponentVersionName("My.Component", "1.0.0")).To(Equal("my-component-1-0-0"))
+	It("should return hex as-is when no prefix and under 63 chars", func() {
+		Expect(SanitizeDigestLabel("abcdef1234567890")).To(Equal("abcdef1234567890"))
+	})
+
+	It("should handle a realistic sha256 digest", func() {
+		// sha256 hex is 64 chars, truncated to 63
+		digest := "sha256:40bac3123555936fd4aa8260a853669283fa8d64be8f665ba9d60fd9f7d7df3b"
+		result := SanitizeDigestLabel(digest)
+		Expect(result).To(Equal("40bac3123555936fd4aa8260a853669283fa8d64be8f665ba9d60fd9f7d7df3"))
+		Expect(len(result)).To(BeNumerically("<=", 63))
 	})
 })
 
@@ -138,6 +157,16 @@ var _ = Describe("FromContextWithCreds", func() {
 		octx, err := FromContextWithCreds(context.Background(), "registry.example.com:5000", &RegistryCredentials{
 			Username: "user",
 			Password: "pass",
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(octx).NotTo(BeNil())
+	})
+
+	It("should accept TLS settings without credentials", func() {
+		octx, err := FromContextWithCreds(context.Background(), "registry.example.com:5000", nil, &RegistryTLS{
+			CA:   []byte("ca"),
+			Cert: []byte("cert"),
+			Key:  []byte("key"),
 		})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(octx).NotTo(BeNil())
@@ -147,7 +176,7 @@ var _ = Describe("FromContextWithCreds", func() {
 		_, err := FromContextWithCreds(context.Background(), "registry.example.com", &RegistryCredentials{
 			Username: "user",
 			Password: "pass",
-		})
+		}, nil)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("failed to split host and port"))
 	})
@@ -0,0 +1,97 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// ResultSuccess and ResultError are the values of the result attribute of
+	// processed events.
+	ResultSuccess = "success"
+	ResultError   = "error"
+
+	// DropReasonCoalesced, DropReasonFailed, DropReasonCanceled and
+	// DropReasonShutdown are the values of the reason attribute of dropped
+	// events.
+	DropReasonCoalesced = "coalesced"
+	DropReasonFailed    = "failed"
+	DropReasonCanceled  = "canceled"
+	DropReasonShutdown  = "shutdown"
+)
+
+// runnerMetrics are the instruments a Runner records its events on.
+type runnerMetrics struct {
+	errors    metric.Int64Counter
+	processed metric.Int64Counter
+	dropped   metric.Int64Counter
+	duration  metric.Float64Histogram
+	queued    metric.Int64UpDownCounter
+}
+
+// newRunnerMetrics creates the Runner instruments. Instrument creation only
+// fails on invalid names or options, in which case the meter still returns a
+// usable no-op instrument.
+func newRunnerMetrics(mp metric.MeterProvider) runnerMetrics {
+	meter := mp.Meter(meterName)
+
+	var m runnerMetrics
+	var err error
+
+	m.errors, err = meter.Int64Counter("solar.discovery.errors",
+		metric.WithDescription("Number of events that failed to be processed, by pipeline component and error severity."),
+		metric.WithUnit("{error}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	m.processed, err = meter.Int64Counter("solar.discovery.events.processed",
+		metric.WithDescription("Number of events processed, by pipeline component, registry, event type and result."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	m.dropped, err = meter.Int64Counter("solar.discovery.events.dropped",
+		metric.WithDescription("Number of events dropped without being processed successfully, by pipeline component, registry, event type and reason."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	m.duration, err = meter.Float64Histogram("solar.discovery.process.duration",
+		metric.WithDescription("Duration of processing a single event, by pipeline component, registry and event type."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	m.queued, err = meter.Int64UpDownCounter("solar.discovery.queue.depth",
+		metric.WithDescription("Number of events queued in a pipeline component, waiting on their partition or for a retry."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return m
+}
+
+// eventAttributes returns the attributes identifying the pipeline component
+// and, if the event tells, the registry and type of the event.
+func eventAttributes(source string, ev any) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("source", source)}
+
+	if l, ok := ev.(locator); ok {
+		registry, _ := l.location()
+		attrs = append(attrs, attribute.String("registry", registry))
+	}
+	if t, ok := ev.(typer); ok {
+		attrs = append(attrs, attribute.String("type", string(t.eventType())))
+	}
+
+	return attrs
+}
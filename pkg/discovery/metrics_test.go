@@ -0,0 +1,110 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Runner metrics", func() {
+	var (
+		meters *observabilitytest.MeterProvider
+		input  chan RepositoryEvent
+		output chan testOutput
+		errCh  chan ErrorEvent
+		ev     = RepositoryEvent{Registry: "reg", Repository: "org/component-descriptors/comp", Type: EventUpdated}
+		source = attribute.String("source", "*discovery.flakyProcessor")
+	)
+
+	BeforeEach(func() {
+		meters = observabilitytest.NewMeterProvider()
+		input = make(chan RepositoryEvent, 1)
+		output = make(chan testOutput, 1)
+		errCh = make(chan ErrorEvent, 1)
+	})
+
+	start := func(proc *flakyProcessor, retries int) {
+		r := NewRunner[RepositoryEvent, testOutput](proc, input, output, errCh)
+		WithMeterProvider[RepositoryEvent, testOutput](meters)(r)
+		WithBackoff[RepositoryEvent, testOutput](time.Millisecond, 10*time.Millisecond, time.Second)(r)
+		WithRetries[RepositoryEvent, testOutput](retries)(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		DeferCleanup(r.Stop)
+	}
+
+	It("records processed events and their duration by registry and event type", func() {
+		start(&flakyProcessor{}, 0)
+
+		input <- ev
+
+		Eventually(output).Should(Receive())
+		attrs := []attribute.KeyValue{source, attribute.String("registry", "reg"), attribute.String("type", "updated")}
+		Expect(meters.Sum("solar.discovery.events.processed", append(attrs, attribute.String("result", ResultSuccess))...)).To(Equal(float64(1)))
+		Expect(meters.Count("solar.discovery.process.duration", attrs...)).To(Equal(1))
+		Expect(meters.Sum("solar.discovery.events.dropped")).To(BeZero())
+	})
+
+	It("counts failed attempts and drops events that are not retried", func() {
+		start(&flakyProcessor{err: errors.New("invalid component descriptor"), failures: 10}, 3)
+
+		input <- ev
+
+		Eventually(errCh).Should(Receive())
+		Expect(meters.Sum("solar.discovery.events.processed", source, attribute.String("result", ResultError))).To(Equal(float64(1)))
+		Expect(meters.Sum("solar.discovery.events.dropped", source,
+			attribute.String("registry", "reg"), attribute.String("reason", DropReasonFailed))).To(Equal(float64(1)))
+		Expect(meters.Sum("solar.discovery.errors", source, attribute.String("severity", string(SeverityPermanent)))).To(Equal(float64(1)))
+	})
+
+	It("counts events waiting for a retry in the queue depth", func() {
+		start(&flakyProcessor{err: backoff.RetryAfter(0), failures: 2}, 3)
+
+		input <- ev
+
+		Eventually(output).Should(Receive())
+		Expect(meters.Sum("solar.discovery.events.processed", source, attribute.String("result", ResultError))).To(Equal(float64(2)))
+		Expect(meters.Sum("solar.discovery.events.processed", source, attribute.String("result", ResultSuccess))).To(Equal(float64(1)))
+		Eventually(func() int { return meters.Count("solar.discovery.queue.depth", source) }).Should(Equal(4))
+		Expect(meters.Sum("solar.discovery.queue.depth", source)).To(BeZero())
+		Expect(meters.Sum("solar.discovery.events.dropped")).To(BeZero())
+	})
+
+	It("counts coalesced events as dropped and queued events in the queue depth", func() {
+		in := make(chan testEvent, 10)
+		out := make(chan testOutput, 10)
+		proc := &blockingProcessor{release: make(chan struct{})}
+		queued := attribute.String("source", "*discovery.blockingProcessor")
+
+		r := NewRunner[testEvent, testOutput](proc, in, out, nil)
+		WithMeterProvider[testEvent, testOutput](meters)(r)
+		WithPartitions[testEvent, testOutput](func(testEvent) string { return "p" }, func(string) PartitionLimits { return PartitionLimits{} })(r)
+		WithCoalescing[testEvent, testOutput](func(ev testEvent) (string, string) { return "t", "merge" })(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		DeferCleanup(r.Stop)
+
+		in <- testEvent{N: -1}
+		Eventually(proc.active.Load).Should(Equal(int32(1)))
+		in <- testEvent{N: 2}
+		in <- testEvent{N: 3}
+
+		Eventually(func() float64 {
+			return meters.Sum("solar.discovery.events.dropped", attribute.String("reason", DropReasonCoalesced))
+		}).Should(Equal(float64(1)))
+		Expect(meters.Sum("solar.discovery.queue.depth", queued)).To(Equal(float64(1)))
+
+		close(proc.release)
+		Eventually(out).Should(HaveLen(2))
+		Expect(meters.Sum("solar.discovery.queue.depth", queued)).To(BeZero())
+	})
+})
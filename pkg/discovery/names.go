@@ -0,0 +1,144 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OCM component names such as "ocm.software/toi/demo/helmdemo" are not valid
+// Kubernetes resource names. Discovery maps them with SanitizeWithHash and
+// ComponentVersionName and records the original name and version in the
+// annotations below, so resources can be mapped back to their OCM component.
+const (
+	// AnnotationComponentName holds the OCM name of the component a Component
+	// or ComponentVersion was discovered from.
+	AnnotationComponentName = "solar.opendefense.cloud/ocm-component-name"
+	// AnnotationComponentVersion holds the OCM version a ComponentVersion was
+	// discovered from.
+	AnnotationComponentVersion = "solar.opendefense.cloud/ocm-component-version"
+	// AnnotationDescriptorRepository holds the OCI repository a Component's
+	// component descriptors were discovered in, which tag discovery lists.
+	AnnotationDescriptorRepository = "solar.opendefense.cloud/ocm-descriptor-repository"
+)
+
+// maxNameLength is the maximum length of a DNS-1123 label, which also limits
+// label values.
+const maxNameLength = 63
+
+var (
+	// ErrNameCollision is returned when two different OCM components map to
+	// the same Kubernetes resource name.
+	ErrNameCollision           = errors.New("resource name is already used by another ocm component")
+	regexNonAlphaNumericString = regexp.MustCompile("[^a-z0-9]+")
+)
+
+// SanitizeName cleans a string to be a valid K8s resource name.
+// It ensures:
+// 1. Max 63 characters
+// 2. Lowercase alphanumeric or '-'
+// 3. Starts and ends with alphanumeric
+func SanitizeName(input string) string {
+	name := sanitize(input)
+
+	if len(name) > maxNameLength {
+		name = name[:maxNameLength]
+		name = strings.TrimRight(name, "-")
+	}
+
+	return name
+}
+
+// SanitizeWithHash sanitizes the input string like SanitizeName. If that
+// changes the input, e.g. by lowercasing it or replacing characters, it
+// appends a hash of the original input, so OCM names that only differ in
+// those characters, like "acme.io/foo-bar" and "acme.io/foo.bar", stay
+// distinct. Names longer than 63 characters are shortened to fit the hash.
+func SanitizeWithHash(input string) string {
+	clean := sanitize(input)
+	if clean == input && len(clean) <= maxNameLength {
+		return clean
+	}
+
+	return withHash(clean, input)
+}
+
+// ComponentVersionName generates a name for a ComponentVersion. The hash
+// covers component and version separately, so "comp-1" at version "0" and
+// "comp" at version "1-0" do not share a name.
+func ComponentVersionName(comp string, version string) string {
+	return withHash(sanitize(comp+"-"+version), comp+":"+version)
+}
+
+// withHash appends an 8 character hash of key to name, shortening name so the
+// result has at most 63 characters.
+func withHash(name, key string) string {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	hash := fmt.Sprintf("%08x", h.Sum32())
+
+	if len(name) > maxNameLength-len(hash)-1 {
+		name = strings.TrimRight(name[:maxNameLength-len(hash)-1], "-")
+	}
+	if name == "" {
+		return hash
+	}
+
+	return name + "-" + hash
+}
+
+// SetComponentAnnotations records the OCM component name and, if not empty,
+// the version on obj.
+func SetComponentAnnotations(obj metav1.Object, comp, version string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationComponentName] = comp
+	if version != "" {
+		annotations[AnnotationComponentVersion] = version
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// ComponentNameOf returns the OCM component name recorded on obj. The second
+// return value is false for resources not created by discovery.
+func ComponentNameOf(obj metav1.Object) (string, bool) {
+	name, ok := obj.GetAnnotations()[AnnotationComponentName]
+	return name, ok
+}
+
+// DescriptorRepositoryOf returns the OCI repository of the component
+// descriptors recorded on obj. The second return value is false for
+// Components not created by discovery.
+func DescriptorRepositoryOf(obj metav1.Object) (string, bool) {
+	repo, ok := obj.GetAnnotations()[AnnotationDescriptorRepository]
+	return repo, ok
+}
+
+// CheckComponentName returns ErrNameCollision if obj was discovered from an
+// OCM component other than comp. Resources without the annotation, e.g. those
+// created before it was introduced or by users, are assumed to match.
+func CheckComponentName(obj metav1.Object, comp string) error {
+	if existing, ok := ComponentNameOf(obj); ok && existing != comp {
+		return fmt.Errorf("%w: component %q maps to %q which belongs to %q", ErrNameCollision, comp, obj.GetName(), existing)
+	}
+
+	return nil
+}
+
+// sanitize lowercases input, replaces runs of characters that are not allowed
+// in resource names with a single '-' and trims leading and trailing dashes.
+func sanitize(input string) string {
+	name := strings.ToLower(input)
+	name = regexNonAlphaNumericString.ReplaceAllString(name, "-")
+
+	return strings.Trim(name, "-")
+}
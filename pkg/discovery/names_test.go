@@ -0,0 +1,137 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"errors"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SanitizeName", func() {
+	It("should lowercase and replace non-alphanumeric runs with a single dash", func() {
+		Expect(SanitizeName("My Cool_Component!!v1")).To(Equal("my-cool-component-v1"))
+	})
+
+	It("should trim leading and trailing dashes", func() {
+		Expect(SanitizeName("--hello--")).To(Equal("hello"))
+	})
+
+	It("should truncate to 63 characters and trim a trailing dash left by truncation", func() {
+		input := strings.Repeat("a", 62) + "-" + strings.Repeat("b", 10)
+		result := SanitizeName(input)
+		Expect(len(result)).To(BeNumerically("<=", 63))
+		Expect(result).NotTo(HaveSuffix("-"))
+	})
+
+	It("should return empty string for input with no alphanumeric characters", func() {
+		Expect(SanitizeName("!!!")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("SanitizeWithHash", func() {
+	It("should return names that need no sanitizing unchanged", func() {
+		Expect(SanitizeWithHash("my-component")).To(Equal("my-component"))
+	})
+
+	It("should keep sanitized names of up to 63 characters unchanged", func() {
+		input := strings.Repeat("a", 63)
+		Expect(SanitizeWithHash(input)).To(Equal(input))
+	})
+
+	It("should append a hash suffix when sanitizing changed the name", func() {
+		Expect(SanitizeWithHash("ocm.software/toi/demo/helmdemo")).To(MatchRegexp("^ocm-software-toi-demo-helmdemo-[0-9a-f]{8}$"))
+	})
+
+	DescribeTable("should keep names distinct that only differ in sanitized characters",
+		func(first, second string) {
+			Expect(SanitizeWithHash(first)).NotTo(Equal(SanitizeWithHash(second)))
+		},
+		Entry("separators", "acme.io/foo-bar", "acme.io/foo.bar"),
+		Entry("case", "acme.io/foo-bar", "Acme.io/foo-bar"),
+		Entry("sanitized and already valid", "acme-io-foo-bar", "acme.io/foo-bar"),
+	)
+
+	It("should append a hash suffix when the sanitized name is too long", func() {
+		input := strings.Repeat("a", 100)
+		result := SanitizeWithHash(input)
+		Expect(result).To(HaveLen(63))
+		Expect(result).To(MatchRegexp("^a{54}-[0-9a-f]{8}$"))
+	})
+
+	It("should not leave a double dash before the hash suffix", func() {
+		result := SanitizeWithHash(strings.Repeat("a", 53) + "/" + strings.Repeat("b", 20))
+		Expect(len(result)).To(BeNumerically("<=", 63))
+		Expect(result).NotTo(ContainSubstring("--"))
+	})
+
+	It("should return only the hash for input without alphanumeric characters", func() {
+		Expect(SanitizeWithHash("!!!")).To(MatchRegexp("^[0-9a-f]{8}$"))
+	})
+
+	It("should be deterministic for the same input", func() {
+		input := strings.Repeat("x", 100)
+		Expect(SanitizeWithHash(input)).To(Equal(SanitizeWithHash(input)))
+	})
+
+	It("should differ for different inputs that share the same truncated prefix", func() {
+		base := strings.Repeat("a", 57)
+		first := SanitizeWithHash(base + "-one")
+		second := SanitizeWithHash(base + "-two")
+		Expect(first).NotTo(Equal(second))
+	})
+})
+
+var _ = Describe("ComponentVersionName", func() {
+	It("should combine component and version into a sanitized name with a hash suffix", func() {
+		Expect(ComponentVersionName("My.Component", "1.0.0")).To(MatchRegexp("^my-component-1-0-0-[0-9a-f]{8}$"))
+	})
+
+	It("should keep components and versions distinct whose joined names are equal", func() {
+		Expect(ComponentVersionName("comp-1", "0")).NotTo(Equal(ComponentVersionName("comp", "1-0")))
+	})
+
+	It("should keep long names within 63 characters and distinct per version", func() {
+		comp := "ocm.software/" + strings.Repeat("long-path/", 6) + "helmdemo"
+		first := ComponentVersionName(comp, "1.0.0-rc.1")
+		second := ComponentVersionName(comp, "1.0.0-rc.2")
+		Expect(len(first)).To(BeNumerically("<=", 63))
+		Expect(len(second)).To(BeNumerically("<=", 63))
+		Expect(first).NotTo(Equal(second))
+	})
+})
+
+var _ = Describe("component annotations", func() {
+	It("should record and read back the OCM component name and version", func() {
+		obj := &metav1.ObjectMeta{Annotations: map[string]string{"keep": "me"}}
+		SetComponentAnnotations(obj, "ocm.software/toi/demo/helmdemo", "0.12.0")
+
+		Expect(obj.Annotations).To(HaveKeyWithValue("keep", "me"))
+		Expect(obj.Annotations).To(HaveKeyWithValue(AnnotationComponentVersion, "0.12.0"))
+		name, ok := ComponentNameOf(obj)
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("ocm.software/toi/demo/helmdemo"))
+	})
+
+	It("should not record an empty version", func() {
+		obj := &metav1.ObjectMeta{}
+		SetComponentAnnotations(obj, "ocm.software/toi/demo/helmdemo", "")
+		Expect(obj.Annotations).NotTo(HaveKey(AnnotationComponentVersion))
+	})
+
+	It("should report a collision only for a different recorded component", func() {
+		obj := &metav1.ObjectMeta{Name: "ocm-software-demo"}
+		Expect(CheckComponentName(obj, "ocm.software/demo")).To(Succeed())
+
+		SetComponentAnnotations(obj, "ocm.software/demo", "")
+		Expect(CheckComponentName(obj, "ocm.software/demo")).To(Succeed())
+
+		err := CheckComponentName(obj, "ocm-software/demo")
+		Expect(errors.Is(err, ErrNameCollision)).To(BeTrue())
+	})
+})
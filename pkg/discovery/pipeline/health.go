@@ -0,0 +1,144 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+// healthCheck is a single component check of the health endpoints. It returns
+// a short status detail, or an error if the component is unhealthy.
+type healthCheck struct {
+	name  string
+	check func() (string, error)
+}
+
+// HealthHandler returns a handler serving the probe endpoints of the worker:
+//
+//   - /healthz fails if a registry scanner is stuck, so the worker is
+//     restarted by its liveness probe.
+//   - /readyz fails until the pipeline has been started, while the webhook
+//     server is not serving and while the qualifier backlog is full.
+//   - /scans returns the summary of the last scan of every scanned registry
+//     as JSON, see discovery.ScanSummary.
+//
+// The probe endpoints list the status of every checked component.
+func (p *Pipeline) HealthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", healthHandler("healthz", p.livenessChecks))
+	mux.Handle("/readyz", healthHandler("readyz", p.readinessChecks))
+	mux.HandleFunc("/scans", p.serveScans)
+
+	return mux
+}
+
+// serveScans responds with the summaries of the last scans of the registry
+// scanners, ordered by registry. Registries not scanned yet are left out.
+func (p *Pipeline) serveScans(w http.ResponseWriter, _ *http.Request) {
+	scans := make([]discovery.ScanSummary, 0, len(p.regScanners))
+	for _, s := range p.regScanners {
+		if scan, ok := s.LastScan(); ok {
+			scans = append(scans, scan)
+		}
+	}
+	slices.SortFunc(scans, func(a, b discovery.ScanSummary) int {
+		return strings.Compare(a.Registry, b.Registry)
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	_ = json.NewEncoder(w).Encode(scans)
+}
+
+func (p *Pipeline) livenessChecks() []healthCheck {
+	checks := make([]healthCheck, 0, len(p.regScanners))
+	for _, s := range p.regScanners {
+		checks = append(checks, healthCheck{
+			name: "scanner/" + s.Registry().Name,
+			check: func() (string, error) {
+				return "", s.Healthy()
+			},
+		})
+	}
+
+	return checks
+}
+
+func (p *Pipeline) readinessChecks() []healthCheck {
+	checks := []healthCheck{{
+		name: "pipeline",
+		check: func() (string, error) {
+			if !p.started.Load() {
+				return "", errors.New("not started")
+			}
+
+			return "", nil
+		},
+	}}
+
+	if p.webhookServer != nil {
+		checks = append(checks, healthCheck{
+			name: "webhook",
+			check: func() (string, error) {
+				if !p.webhookServer.Serving() {
+					return "", errors.New("server is not serving")
+				}
+
+				return fmt.Sprintf("%d paths registered", p.webhookRouter.Paths()), nil
+			},
+		})
+	}
+
+	checks = append(checks, healthCheck{
+		name: "qualifier",
+		check: func() (string, error) {
+			backlog := fmt.Sprintf("backlog %d/%d", len(p.repoEvents), cap(p.repoEvents))
+			if len(p.repoEvents) == cap(p.repoEvents) {
+				return "", fmt.Errorf("%s is full", backlog)
+			}
+
+			return backlog, nil
+		},
+	})
+
+	return append(checks, p.livenessChecks()...)
+}
+
+// healthHandler runs all checks on each request and responds with one line
+// per check, in the format of the Kubernetes API server health endpoints.
+func healthHandler(name string, checks func() []healthCheck) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		var out strings.Builder
+		failed := false
+		for _, c := range checks() {
+			detail, err := c.check()
+			switch {
+			case err != nil:
+				failed = true
+				fmt.Fprintf(&out, "[-]%s failed: %v\n", c.name, err)
+			case detail != "":
+				fmt.Fprintf(&out, "[+]%s ok: %s\n", c.name, detail)
+			default:
+				fmt.Fprintf(&out, "[+]%s ok\n", c.name)
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if failed {
+			fmt.Fprintf(&out, "%s check failed\n", name)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			fmt.Fprintf(&out, "%s check passed\n", name)
+		}
+		_, _ = w.Write([]byte(out.String()))
+	})
+}
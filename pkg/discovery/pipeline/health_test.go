@@ -0,0 +1,128 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/discovery/scanner"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type blockingScanner struct{}
+
+func (blockingScanner) Scan(ctx context.Context, _ chan<- discovery.RepositoryEvent) {
+	<-ctx.Done()
+}
+
+var _ = Describe("Health endpoints", func() {
+	var (
+		p          *Pipeline
+		repoEvents chan discovery.RepositoryEvent
+	)
+
+	probe := func(path string) (int, string) {
+		rec := httptest.NewRecorder()
+		p.HealthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		return rec.Code, rec.Body.String()
+	}
+
+	BeforeEach(func() {
+		repoEvents = make(chan discovery.RepositoryEvent, 2)
+		s := scanner.NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "ghcr"},
+				Spec: solarv1alpha1.RegistrySpec{
+					Hostname:     "ghcr.io",
+					ScanInterval: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			nil, repoEvents, make(chan discovery.ErrorEvent, 1),
+		)
+		s.Scanner = blockingScanner{}
+		p = &Pipeline{regScanners: []*scanner.RegistryScanner{s}, repoEvents: repoEvents}
+	})
+
+	It("should report not ready before the pipeline is started", func() {
+		code, body := probe("/readyz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(body).To(ContainSubstring("[-]pipeline failed: not started\n"))
+		Expect(body).To(HaveSuffix("readyz check failed\n"))
+	})
+
+	It("should report the status of each component", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		Expect(p.regScanners[0].Start(ctx)).To(Succeed())
+		DeferCleanup(func() {
+			// The blocking scan only returns once the context is canceled.
+			cancel()
+			p.regScanners[0].Stop()
+		})
+		p.started.Store(true)
+		repoEvents <- discovery.RepositoryEvent{}
+
+		code, body := probe("/readyz")
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("[+]pipeline ok\n[+]qualifier ok: backlog 1/2\n[+]scanner/ghcr ok\nreadyz check passed\n"))
+
+		code, body = probe("/healthz")
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("[+]scanner/ghcr ok\nhealthz check passed\n"))
+	})
+
+	It("should report not ready while the qualifier backlog is full", func() {
+		p.started.Store(true)
+		repoEvents <- discovery.RepositoryEvent{}
+		repoEvents <- discovery.RepositoryEvent{}
+
+		code, body := probe("/readyz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(body).To(ContainSubstring("[-]qualifier failed: backlog 2/2 is full\n"))
+	})
+
+	It("should serve the summaries of the last scans", func() {
+		_, body := probe("/scans")
+		Expect(body).To(Equal("[]\n"))
+
+		// Nothing listens on port 1, so the scan fails right away.
+		s := scanner.NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "closed"},
+				Spec:       solarv1alpha1.RegistrySpec{Hostname: "127.0.0.1:1", PlainHTTP: true},
+			},
+			nil, repoEvents, nil,
+		)
+		var observed []discovery.ScanSummary
+		WithScanObserver(func(s discovery.ScanSummary) { observed = append(observed, s) })(&Pipeline{regScanners: []*scanner.RegistryScanner{s}})
+		p.regScanners = append(p.regScanners, s)
+		s.Scan(context.Background(), repoEvents)
+
+		Expect(observed).To(HaveLen(1))
+		Expect(observed[0].Error).To(ContainSubstring("connection refused"))
+
+		_, body = probe("/scans")
+		var scans []discovery.ScanSummary
+		Expect(json.Unmarshal([]byte(body), &scans)).To(Succeed())
+		Expect(scans).To(HaveLen(1))
+		Expect(scans[0].Registry).To(Equal("closed"))
+		Expect(scans[0].Error).To(Equal(observed[0].Error))
+	})
+
+	It("should fail the liveness check while a scanner is not running", func() {
+		code, body := probe("/healthz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(body).To(Equal("[-]scanner/ghcr failed: scan loop is not running\nhealthz check failed\n"))
+	})
+})
@@ -7,28 +7,50 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
 
 	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/cron"
 	"go.opendefense.cloud/solar/pkg/discovery"
 	"go.opendefense.cloud/solar/pkg/discovery/apiwriter"
 	"go.opendefense.cloud/solar/pkg/discovery/handler"
+	"go.opendefense.cloud/solar/pkg/discovery/publisher"
 	"go.opendefense.cloud/solar/pkg/discovery/qualifier"
 	"go.opendefense.cloud/solar/pkg/discovery/scanner"
 	"go.opendefense.cloud/solar/pkg/discovery/webhook"
+	"go.opendefense.cloud/solar/pkg/ociregistry"
 )
 
+// eventRetries is the number of times an event failing with a transient error
+// is retried by a stage of the pipeline.
+const eventRetries = 5
+
+// drainPollInterval is how often Drain checks whether the stages of the
+// pipeline processed all queued events.
+const drainPollInterval = 100 * time.Millisecond
+
 type Pipeline struct {
 	regScanners   []*scanner.RegistryScanner
+	compPoller    *scanner.ComponentPoller
 	webhookServer *webhook.WebhookServer
+	webhookRouter *webhook.WebhookRouter
+	repoEvents    chan discovery.RepositoryEvent
 	qualifier     *qualifier.Qualifier
 	filter        *handler.Filter
 	handler       *handler.Handler
 	writer        *apiwriter.APIWriter
+	dispatcher    *publisher.Dispatcher
+	digests       *discovery.DigestCache
 	errChan       chan<- discovery.ErrorEvent
 	log           logr.Logger
+	started       atomic.Bool
+
+	stopSourcesOnce sync.Once
+	stopSourcesErr  error
 }
 
 // Option overrides pipeline components after construction (e.g. WithFilterProcessor).
@@ -49,19 +71,40 @@ func NewPipeline(namespace string, registries *discovery.RegistryProvider, webho
 			if httpRouter == nil {
 				httpRouter = webhook.NewWebhookRouter(repoEvents)
 				httpRouter.WithLogger(log)
+				httpRouter.WithWebhookSecrets(registries.GetWebhookSecret)
 			}
 			if err := httpRouter.RegisterPath(registry); err != nil {
 				return nil, fmt.Errorf("failed to register handler: %w", err)
 			}
 		}
 
-		if registry.Spec.ScanInterval != nil && registry.Spec.ScanInterval.Duration > 0 {
-			creds := registries.GetCredentials(registry.Name)
-			s := scanner.NewRegistryScanner(registry, creds, repoEvents, errChan,
-				scanner.WithScanInterval(registry.Spec.ScanInterval.Duration),
+		if discovery.IsScanned(registry) {
+			scannerOpts := []scanner.Option{
 				scanner.WithLogger(log),
-			)
-			regScanners = append(regScanners, s)
+				scanner.WithTLS(registries.GetTLS(registry.Name)),
+				scanner.WithOffset(registries.ScanOffset(registry.Name)),
+			}
+			if registry.Spec.ScanInterval != nil {
+				scannerOpts = append(scannerOpts, scanner.WithScanInterval(registry.Spec.ScanInterval.Duration))
+			}
+			if registry.Spec.ScanSchedule != "" {
+				schedule, err := cron.Parse(registry.Spec.ScanSchedule)
+				if err != nil {
+					return nil, fmt.Errorf("invalid scan schedule of registry %q: %w", registry.Name, err)
+				}
+				scannerOpts = append(scannerOpts, scanner.WithSchedule(schedule))
+			}
+			if registry.Spec.ScanJitter != nil {
+				scannerOpts = append(scannerOpts, scanner.WithJitter(registry.Spec.ScanJitter.Duration))
+			}
+
+			// Look up the credentials on every scan, they change when the
+			// pull secret of the registry is updated.
+			name := registry.Name
+			scannerOpts = append(scannerOpts, scanner.WithCredentialsFunc(func() *discovery.RegistryCredentials {
+				return registries.GetCredentials(name)
+			}))
+			regScanners = append(regScanners, scanner.NewRegistryScanner(registry, nil, repoEvents, errChan, scannerOpts...))
 		}
 	}
 
@@ -73,17 +116,25 @@ func NewPipeline(namespace string, registries *discovery.RegistryProvider, webho
 	p := &Pipeline{
 		regScanners:   regScanners,
 		webhookServer: webhookServer,
+		webhookRouter: httpRouter,
+		repoEvents:    repoEvents,
 		errChan:       errChan,
 		log:           log,
 	}
 
-	p.qualifier = qualifier.NewQualifier(registries, namespace, repoEvents, filterInput, errChan, discovery.WithLogger[discovery.RepositoryEvent, discovery.ComponentVersionEvent](log))
+	if solarClient != nil {
+		p.compPoller = scanner.NewComponentPoller(solarClient, namespace, registries, repoEvents,
+			scanner.WithComponentPollerLogger(log),
+		)
+	}
+
+	p.qualifier = qualifier.NewQualifier(registries, namespace, repoEvents, filterInput, errChan, discovery.WithLogger[discovery.RepositoryEvent, discovery.ComponentVersionEvent](log), discovery.WithRetries[discovery.RepositoryEvent, discovery.ComponentVersionEvent](eventRetries))
 
-	p.filter = handler.NewFilter(solarClient, namespace, filterInput, handlerInput, errChan, discovery.WithLogger[discovery.ComponentVersionEvent, discovery.ComponentVersionEvent](log))
+	p.filter = handler.NewFilter(solarClient, namespace, filterInput, handlerInput, errChan, discovery.WithLogger[discovery.ComponentVersionEvent, discovery.ComponentVersionEvent](log), discovery.WithRetries[discovery.ComponentVersionEvent, discovery.ComponentVersionEvent](eventRetries))
 
-	p.handler = handler.NewHandler(registries, handlerInput, writerInput, errChan, discovery.WithLogger[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent](log), discovery.WithRateLimiter[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent](time.Second, 1))
+	p.handler = handler.NewHandler(registries, handlerInput, writerInput, errChan, discovery.WithLogger[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent](log), discovery.WithRetries[discovery.ComponentVersionEvent, discovery.WriteAPIResourceEvent](eventRetries))
 
-	p.writer = apiwriter.NewAPIWriter(solarClient, namespace, registries, writerInput, errChan, discovery.WithLogger[discovery.WriteAPIResourceEvent, any](log))
+	p.writer = apiwriter.NewAPIWriter(solarClient, namespace, registries, writerInput, errChan, discovery.WithLogger[discovery.WriteAPIResourceEvent, any](log), discovery.WithRetries[discovery.WriteAPIResourceEvent, any](eventRetries))
 
 	for _, opt := range opts {
 		opt(p)
@@ -102,6 +153,12 @@ func (p *Pipeline) Start(ctx context.Context) (err error) {
 		}
 	}()
 
+	// Load the digest cache before anything is scanned.
+	if p.digests != nil {
+		if err = p.digests.Start(ctx); err != nil {
+			return err
+		}
+	}
 	if p.webhookServer != nil {
 		if err = p.webhookServer.Start(ctx); err != nil {
 			return err
@@ -113,6 +170,11 @@ func (p *Pipeline) Start(ctx context.Context) (err error) {
 			return err
 		}
 	}
+	if p.compPoller != nil {
+		if err = p.compPoller.Start(ctx); err != nil {
+			return err
+		}
+	}
 	if err = p.qualifier.Start(ctx); err != nil {
 		return err
 	}
@@ -122,29 +184,219 @@ func (p *Pipeline) Start(ctx context.Context) (err error) {
 	if err = p.handler.Start(ctx); err != nil {
 		return err
 	}
+	if p.dispatcher != nil {
+		if err = p.dispatcher.Start(ctx); err != nil {
+			return err
+		}
+	}
 	if err = p.writer.Start(ctx); err != nil {
 		return err
 	}
+	p.started.Store(true)
 
 	return nil
 }
 
+// Stop stops the pipeline. Events still queued are dropped; call Drain first
+// to process them.
 func (p *Pipeline) Stop(ctx context.Context) error {
-	var err error
-	if p.webhookServer != nil {
-		err = p.webhookServer.Stop(ctx)
-	}
-	for _, scanner := range p.regScanners {
-		scanner.Stop()
-	}
+	p.started.Store(false)
+
+	err := p.stopSources(ctx)
 	p.qualifier.Stop()
 	p.filter.Stop()
 	p.handler.Stop()
 	p.writer.Stop()
+	if p.dispatcher != nil {
+		p.dispatcher.Stop()
+	}
+	p.discard(ctx)
+	if p.digests != nil {
+		err = errors.Join(err, p.digests.Stop(ctx))
+	}
 
 	return err
 }
 
+// Drain stops accepting new events and waits until the stages of the pipeline
+// processed the queued events, or until ctx is done. The webhook server, the
+// registry scanners and the component poller are stopped first, so webhook
+// requests are rejected and no scan is started while draining. Drain does not
+// stop the stages; call Stop afterwards.
+func (p *Pipeline) Drain(ctx context.Context) error {
+	p.started.Store(false)
+
+	err := p.stopSources(ctx)
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pending := p.pending()
+		if pending == 0 {
+			p.log.Info("drained discovery pipeline")
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, fmt.Errorf("%d events still pending: %w", pending, ctx.Err()))
+		case <-ticker.C:
+		}
+	}
+}
+
+// stopSources stops the components queueing new events. It is safe to call
+// more than once.
+func (p *Pipeline) stopSources(ctx context.Context) error {
+	p.stopSourcesOnce.Do(func() {
+		if p.webhookServer != nil {
+			p.stopSourcesErr = p.webhookServer.Stop(ctx)
+		}
+		for _, scanner := range p.regScanners {
+			scanner.Stop()
+		}
+		if p.compPoller != nil {
+			p.compPoller.Stop()
+		}
+	})
+
+	return p.stopSourcesErr
+}
+
+// pending returns the number of events queued for or being processed by the
+// stages of the pipeline.
+func (p *Pipeline) pending() int {
+	pending := p.qualifier.Pending() + p.filter.Pending() + p.handler.Pending() + p.writer.Pending()
+	if p.dispatcher != nil {
+		pending += p.dispatcher.Pending()
+	}
+
+	return pending
+}
+
+// discard drops the events left in the stopped stages and logs how many
+// events of each stage were not processed.
+func (p *Pipeline) discard(ctx context.Context) {
+	type stage struct {
+		name  string
+		stage interface{ Discard(context.Context) int }
+	}
+	stages := []stage{
+		{"qualifier", p.qualifier},
+		{"filter", p.filter},
+		{"handler", p.handler},
+		{"writer", p.writer},
+	}
+	if p.dispatcher != nil {
+		stages = append(stages, stage{"publisher", p.dispatcher})
+	}
+
+	total := 0
+	keysAndValues := make([]any, 0, 2*len(stages)+2)
+	for _, s := range stages {
+		n := s.stage.Discard(ctx)
+		total += n
+		keysAndValues = append(keysAndValues, s.name, n)
+	}
+	if total > 0 {
+		p.log.Info("dropped pending events on shutdown", append(keysAndValues, "total", total)...)
+	}
+}
+
+// WithDigestCache enables incremental scans: the qualifier skips component
+// versions whose digest is recorded in the cache, and the API writer records
+// the digest of every version it wrote.
+func WithDigestCache(c *discovery.DigestCache) Option {
+	return func(p *Pipeline) {
+		p.digests = c
+		p.qualifier.SetDigestCache(c)
+		p.writer.SetDigestCache(c)
+	}
+}
+
+// WithResponseCache makes the registry scanners and the qualifier answer
+// repeated manifest and blob requests from the given cache.
+func WithResponseCache(c *ociregistry.ResponseCache) Option {
+	return func(p *Pipeline) {
+		for _, s := range p.regScanners {
+			s.SetResponseCache(c)
+		}
+		p.qualifier.SetResponseCache(c)
+	}
+}
+
+// WithScanObserver passes the summary of every scan of a registry scanner to
+// f, e.g. to record it as an event on the scanned Registry.
+func WithScanObserver(f func(discovery.ScanSummary)) Option {
+	return func(p *Pipeline) {
+		for _, s := range p.regScanners {
+			s.SetScanObserver(f)
+		}
+	}
+}
+
+// WithRetrier makes the registry scanners and the qualifier retry registry
+// requests failing with transient errors.
+func WithRetrier(r *ociregistry.Retrier) Option {
+	return func(p *Pipeline) {
+		for _, s := range p.regScanners {
+			s.SetRetrier(r)
+		}
+		p.qualifier.SetRetrier(r)
+	}
+}
+
+// WithRegistryWorkers sets the number of workers the qualifier and the
+// handler process the repositories of a registry with, unless the registry
+// sets its own discoveryLimits.maxConcurrency.
+func WithRegistryWorkers(n int) Option {
+	return func(p *Pipeline) {
+		p.qualifier.SetWorkers(n)
+		p.handler.SetWorkers(n)
+	}
+}
+
+// WithNotFoundThreshold makes the handler treat a component version as
+// deleted once n consecutive lookups of it were answered with not found. Zero
+// only handles explicit delete events as deletions.
+func WithNotFoundThreshold(n int) Option {
+	return func(p *Pipeline) {
+		p.handler.SetNotFoundThreshold(n)
+	}
+}
+
+// WithPublishers publishes every ComponentVersionEvent the API writer wrote to
+// the given publishers. Events are delivered in the background, so a slow or
+// unreachable publisher does not hold back the pipeline.
+func WithPublishers(publishers []publisher.Publisher, opts ...publisher.DispatcherOption) Option {
+	return func(p *Pipeline) {
+		opts = append([]publisher.DispatcherOption{publisher.WithDispatcherLogger(p.log)}, opts...)
+		p.dispatcher = publisher.NewDispatcher(publishers, p.errChan, opts...)
+		p.writer.SetWriteObserver(p.dispatcher.Enqueue)
+	}
+}
+
+// WithWebhookTLS serves the webhook server over HTTPS with the certificate and
+// key in the given files, reloading them when they change.
+func WithWebhookTLS(certFile, keyFile string) Option {
+	return func(p *Pipeline) {
+		if p.webhookServer != nil {
+			p.webhookServer.SetTLS(certFile, keyFile)
+		}
+	}
+}
+
+// WithWebhookLimits rejects webhook requests exceeding the given limits.
+func WithWebhookLimits(limits webhook.RequestLimits) Option {
+	return func(p *Pipeline) {
+		if p.webhookRouter != nil {
+			p.webhookRouter.WithRequestLimits(limits)
+		}
+	}
+}
+
 func WithScanner(s scanner.Scanner) Option {
 	return func(p *Pipeline) {
 		if len(p.regScanners) > 0 {
@@ -276,4 +276,51 @@ var _ = Describe("Pipeline", Ordered, func() {
 		})
 
 	})
+
+	Describe("Drain", func() {
+		It("waits until the queued events were processed", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			qualifier := NewFakeQualifierProcessor()
+			filter := NewFakeFilterProcessor()
+			handler := &BlockingHandlerProcessor{release: make(chan struct{})}
+			writer := NewFakeAPIWriterProcessor()
+
+			p, err := NewPipeline("default", discovery.NewRegistryProvider(), "127.0.0.1:0", make(chan discovery.ErrorEvent, 1), log, nil,
+				WithQualifierProcessor(qualifier),
+				WithFilterProcessor(filter),
+				WithHandlerProcessor(handler),
+				WithWriterProcessor(writer),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Start(ctx)).To(Succeed())
+			defer func() { _ = p.Stop(ctx) }()
+
+			p.repoEvents <- discovery.RepositoryEvent{Registry: "default", Repository: "test/component-descriptors/opendefense.cloud/ocm-demo", Version: "1.1.1", Type: discovery.EventCreated}
+			Eventually(qualifier.in).Should(Receive())
+			Eventually(qualifier.out).Should(Receive())
+			Eventually(filter.inOut).Should(Receive())
+
+			drainCtx, cancelDrain := context.WithTimeout(ctx, 200*time.Millisecond)
+			defer cancelDrain()
+			Expect(p.Drain(drainCtx)).To(MatchError(ContainSubstring("1 events still pending")))
+
+			close(handler.release)
+			Expect(p.Drain(ctx)).To(Succeed())
+			Expect(writer.in).To(Receive())
+			Expect(p.Stop(ctx)).To(Succeed())
+		})
+	})
 })
+
+// BlockingHandlerProcessor passes events on once release is closed.
+type BlockingHandlerProcessor struct {
+	release chan struct{}
+}
+
+func (h *BlockingHandlerProcessor) Process(ctx context.Context, ev discovery.ComponentVersionEvent) ([]discovery.WriteAPIResourceEvent, error) {
+	<-h.release
+
+	return []discovery.WriteAPIResourceEvent{{Source: ev}}, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+// EventTypePrefix prefixes the CloudEvent type of published events; the event
+// type of the discovered version (created, updated or deleted) is appended.
+const EventTypePrefix = "cloud.opendefense.solar.discovery.componentversion."
+
+// ComponentVersionData is the data of a published CloudEvent.
+type ComponentVersionData struct {
+	Registry   string    `json:"registry"`
+	Repository string    `json:"repository"`
+	Namespace  string    `json:"namespace"`
+	Component  string    `json:"component"`
+	Version    string    `json:"version"`
+	Digest     string    `json:"digest,omitempty"`
+	Type       string    `json:"type"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// CloudEventsPublisher sends events as CloudEvents over HTTP, the ingress
+// protocol of most event brokers (e.g. Knative brokers).
+type CloudEventsPublisher struct {
+	client cloudevents.Client
+	target string
+	source string
+}
+
+// NewCloudEventsPublisher returns a publisher sending events to the given URL.
+// source identifies the discovery worker in the CloudEvent source attribute. A
+// nil transport uses http.DefaultTransport.
+func NewCloudEventsPublisher(target, source string, transport http.RoundTripper) (*CloudEventsPublisher, error) {
+	opts := []cloudevents.HTTPOption{cloudevents.WithTarget(target)}
+	if transport != nil {
+		opts = append(opts, cloudevents.WithRoundTripper(transport))
+	}
+
+	client, err := cloudevents.NewClientHTTP(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CloudEvents client for %s: %w", target, err)
+	}
+
+	return &CloudEventsPublisher{client: client, target: target, source: source}, nil
+}
+
+func (p *CloudEventsPublisher) Publish(ctx context.Context, ev discovery.ComponentVersionEvent) error {
+	out, err := newCloudEvent(p.source, ev)
+	if err != nil {
+		return err
+	}
+
+	ctx = cloudevents.ContextWithRetriesExponentialBackoff(ctx, 100*time.Millisecond, 3)
+	if result := p.client.Send(ctx, out); !cloudevents.IsACK(result) {
+		return fmt.Errorf("failed to send event to %s: %w", p.target, result)
+	}
+
+	return nil
+}
+
+// newCloudEvent returns the CloudEvent published for ev. source identifies the
+// discovery worker in the CloudEvent source attribute.
+func newCloudEvent(source string, ev discovery.ComponentVersionEvent) (cloudevents.Event, error) {
+	out := cloudevents.NewEvent()
+	out.SetID(uuid.NewString())
+	out.SetSource(source)
+	out.SetType(EventTypePrefix + string(ev.Source.Type))
+	out.SetSubject(ev.Component + ":" + ev.Source.Version)
+	out.SetTime(ev.Timestamp)
+	if err := out.SetData(cloudevents.ApplicationJSON, ComponentVersionData{
+		Registry:   ev.Source.Registry,
+		Repository: ev.Source.Repository,
+		Namespace:  ev.Namespace,
+		Component:  ev.Component,
+		Version:    ev.Source.Version,
+		Digest:     ev.Source.Digest,
+		Type:       string(ev.Source.Type),
+		Timestamp:  ev.Timestamp,
+	}); err != nil {
+		return out, fmt.Errorf("failed to encode event data: %w", err)
+	}
+
+	return out, nil
+}
+
+// encodeCloudEvent returns the CloudEvent published for ev in the structured
+// JSON format, for transports without CloudEvents bindings of their own.
+func encodeCloudEvent(source string, ev discovery.ComponentVersionEvent) ([]byte, error) {
+	out, err := newCloudEvent(source, ev)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Config configures the publishers discovered component versions are
+// published to. It is read from the file given to the discovery worker with
+// --publisher-config.
+type Config struct {
+	// QueueSize is the number of events buffered per publisher before
+	// further events are dropped. Defaults to DefaultQueueSize.
+	QueueSize int `json:"queueSize,omitempty"`
+	// MaxAttempts is the number of times delivering an event to a publisher
+	// is attempted. Defaults to DefaultMaxAttempts.
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// Publishers are the systems events are published to.
+	Publishers []PublisherConfig `json:"publishers"`
+}
+
+// PublisherConfig configures a single publisher. Exactly one of its fields
+// must be set.
+type PublisherConfig struct {
+	CloudEvents    *CloudEventsConfig    `json:"cloudEvents,omitempty"`
+	KafkaRESTProxy *KafkaRESTProxyConfig `json:"kafkaRestProxy,omitempty"`
+}
+
+// CloudEventsConfig configures a CloudEventsPublisher.
+type CloudEventsConfig struct {
+	// URL of the CloudEvents HTTP endpoint.
+	URL string `json:"url"`
+}
+
+// KafkaRESTProxyConfig configures a KafkaRESTProxyPublisher. The password is
+// read from the environment variable named by PasswordEnv, so the file can be
+// kept in a ConfigMap.
+type KafkaRESTProxyConfig struct {
+	// URL of the Kafka REST Proxy v2 bridge in front of the Kafka cluster.
+	URL string `json:"url"`
+	// Topic events are produced to.
+	Topic string `json:"topic"`
+	// Username authenticates with the bridge together with the password read
+	// from PasswordEnv.
+	Username    string `json:"username,omitempty"`
+	PasswordEnv string `json:"passwordEnv,omitempty"`
+}
+
+// LoadConfig reads and validates the Config in the YAML file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read publisher config: %w", err)
+	}
+
+	var c Config
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse publisher config %s: %w", path, err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid publisher config %s: %w", path, err)
+	}
+
+	return &c, nil
+}
+
+// Validate checks that every publisher sets exactly one type and its required
+// fields.
+func (c *Config) Validate() error {
+	var errs []error
+	for i, pc := range c.Publishers {
+		set := 0
+		if pc.CloudEvents != nil {
+			set++
+			if pc.CloudEvents.URL == "" {
+				errs = append(errs, fmt.Errorf("publishers[%d].cloudEvents.url is required", i))
+			}
+		}
+		if pc.KafkaRESTProxy != nil {
+			set++
+			if pc.KafkaRESTProxy.URL == "" || pc.KafkaRESTProxy.Topic == "" {
+				errs = append(errs, fmt.Errorf("publishers[%d].kafkaRestProxy.url and topic are required", i))
+			}
+		}
+		if set != 1 {
+			errs = append(errs, fmt.Errorf("publishers[%d] must set exactly one of cloudEvents and kafkaRestProxy", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// NewPublishers creates the configured publishers. source identifies the
+// discovery worker in the CloudEvent source attribute.
+func (c *Config) NewPublishers(source string) ([]Publisher, error) {
+	publishers := make([]Publisher, 0, len(c.Publishers))
+	for i, pc := range c.Publishers {
+		var (
+			p   Publisher
+			err error
+		)
+		switch {
+		case pc.CloudEvents != nil:
+			p, err = NewCloudEventsPublisher(pc.CloudEvents.URL, source, nil)
+		case pc.KafkaRESTProxy != nil:
+			opts := KafkaRESTProxyOptions{URL: pc.KafkaRESTProxy.URL, Topic: pc.KafkaRESTProxy.Topic, Username: pc.KafkaRESTProxy.Username}
+			if opts.Password, err = lookupEnv(pc.KafkaRESTProxy.PasswordEnv); err == nil {
+				p, err = NewKafkaRESTProxyPublisher(opts, source)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("publishers[%d]: %w", i, err)
+		}
+		publishers = append(publishers, p)
+	}
+
+	return publishers, nil
+}
+
+// DispatcherOptions returns the options of the Dispatcher delivering events
+// to the configured publishers.
+func (c *Config) DispatcherOptions() []DispatcherOption {
+	return []DispatcherOption{WithQueueSize(c.QueueSize), WithMaxAttempts(c.MaxAttempts)}
+}
+
+// lookupEnv returns the value of the named environment variable, or an empty
+// string if name is empty.
+func lookupEnv(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	writeConfig := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "publishers.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+
+		return path
+	}
+
+	It("should create the configured publishers", func() {
+		c, err := LoadConfig(writeConfig(`
+queueSize: 10
+publishers:
+  - cloudEvents:
+      url: http://broker.example.com
+  - kafkaRestProxy:
+      url: http://bridge:8080
+      topic: solar-discovery
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.QueueSize).To(Equal(10))
+
+		publishers, err := c.NewPublishers("/solar-discovery/default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(publishers).To(HaveLen(2))
+		Expect(publishers[0]).To(BeAssignableToTypeOf(&CloudEventsPublisher{}))
+		Expect(publishers[1]).To(BeAssignableToTypeOf(&KafkaRESTProxyPublisher{}))
+	})
+
+	It("should reject publishers setting no or several types", func() {
+		_, err := LoadConfig(writeConfig(`
+publishers:
+  - {}
+  - cloudEvents:
+      url: http://broker.example.com
+    kafkaRestProxy:
+      url: http://bridge:8080
+      topic: solar-discovery
+`))
+		Expect(err).To(MatchError(ContainSubstring("publishers[0] must set exactly one")))
+		Expect(err).To(MatchError(ContainSubstring("publishers[1] must set exactly one")))
+	})
+
+	It("should reject unknown fields", func() {
+		_, err := LoadConfig(writeConfig(`
+publishers:
+  - kafkaRestProxy:
+      url: http://bridge:8080
+      topic: solar-discovery
+      password: secret
+`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail if a referenced environment variable is not set", func() {
+		c, err := LoadConfig(writeConfig(`
+publishers:
+  - kafkaRestProxy:
+      url: http://bridge:8080
+      topic: solar-discovery
+      username: solar
+      passwordEnv: SOLAR_TEST_UNSET_PASSWORD
+`))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.NewPublishers("/solar-discovery/default")
+		Expect(err).To(MatchError(ContainSubstring("SOLAR_TEST_UNSET_PASSWORD")))
+	})
+})
@@ -0,0 +1,149 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+const (
+	// kafkaContentType is the content type of records with JSON keys and
+	// values in the Kafka REST API v2.
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+	// kafkaAccept is the content type of responses of the Kafka REST API v2.
+	kafkaAccept = "application/vnd.kafka.v2+json"
+	// maxKafkaErrorBody is the number of bytes of an error response included
+	// in the returned error.
+	maxKafkaErrorBody = 1024
+)
+
+// KafkaRESTProxyOptions configures a KafkaRESTProxyPublisher.
+type KafkaRESTProxyOptions struct {
+	// URL of the HTTP bridge of the Kafka cluster, e.g.
+	// http://my-bridge-bridge-service:8080.
+	URL string
+	// Topic events are produced to.
+	Topic string
+	// Username and Password authenticate with the bridge using basic
+	// authentication, unless Username is empty.
+	Username string
+	Password string
+	// Transport sends the requests. Nil uses http.DefaultTransport.
+	Transport http.RoundTripper
+}
+
+// KafkaRESTProxyPublisher produces events as CloudEvents in the structured
+// JSON format to a Kafka topic through an HTTP bridge implementing the Kafka
+// REST Proxy API v2, such as the Strimzi Kafka Bridge or the Confluent REST
+// Proxy. It does not speak the Kafka protocol, so it cannot publish to a
+// cluster without such a bridge. Records are keyed by registry and
+// repository, so the events of a repository land in the same partition and
+// keep their order.
+type KafkaRESTProxyPublisher struct {
+	client   *http.Client
+	endpoint string
+	opts     KafkaRESTProxyOptions
+	source   string
+}
+
+// kafkaRecord is a record of a produce request of the Kafka REST API v2.
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// kafkaProduceResponse is the response to a produce request of the Kafka
+// REST API v2. The Strimzi Kafka Bridge reports errors of a record in
+// message, the Confluent REST Proxy in error.
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+		Message   string `json:"message"`
+	} `json:"offsets"`
+}
+
+// NewKafkaRESTProxyPublisher returns a publisher producing events through
+// the bridge of opts. source identifies the discovery worker in the
+// CloudEvent source attribute.
+func NewKafkaRESTProxyPublisher(opts KafkaRESTProxyOptions, source string) (*KafkaRESTProxyPublisher, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kafka bridge URL %q: %w", opts.URL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid Kafka bridge URL %q: must be an http or https URL", opts.URL)
+	}
+	if opts.Topic == "" {
+		return nil, fmt.Errorf("missing Kafka topic")
+	}
+
+	transport := opts.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &KafkaRESTProxyPublisher{
+		client:   &http.Client{Transport: transport},
+		endpoint: u.JoinPath("topics", opts.Topic).String(),
+		opts:     opts,
+		source:   source,
+	}, nil
+}
+
+func (p *KafkaRESTProxyPublisher) Publish(ctx context.Context, ev discovery.ComponentVersionEvent) error {
+	data, err := encodeCloudEvent(p.source, ev)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(struct {
+		Records []kafkaRecord `json:"records"`
+	}{Records: []kafkaRecord{{Key: ev.Source.Registry + "/" + ev.Source.Repository, Value: data}}})
+	if err != nil {
+		return fmt.Errorf("failed to encode records: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", kafkaAccept)
+	if p.opts.Username != "" {
+		req.SetBasicAuth(p.opts.Username, p.opts.Password)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to produce to Kafka topic %s: %w", p.opts.Topic, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxKafkaErrorBody))
+		return fmt.Errorf("failed to produce to Kafka topic %s: bridge answered %s: %s", p.opts.Topic, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	var out kafkaProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return fmt.Errorf("failed to decode response of Kafka bridge: %w", err)
+	}
+	for _, offset := range out.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("failed to produce to Kafka topic %s: error %d: %s%s", p.opts.Topic, *offset.ErrorCode, offset.Message, offset.Error)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KafkaRESTProxyPublisher", func() {
+	It("should produce the event as a record keyed by its repository", func() {
+		var (
+			path, contentType, user string
+			records                 struct {
+				Records []struct {
+					Key   string          `json:"key"`
+					Value json.RawMessage `json:"value"`
+				} `json:"records"`
+			}
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+			user, _, _ = r.BasicAuth()
+			if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", kafkaAccept)
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":42,"error_code":null,"error":null}]}`))
+		}))
+		DeferCleanup(srv.Close)
+
+		pub, err := NewKafkaRESTProxyPublisher(KafkaRESTProxyOptions{URL: srv.URL, Topic: "solar-discovery", Username: "solar", Password: "secret"}, "/solar-discovery/default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub.Publish(context.Background(), testEvent)).To(Succeed())
+
+		Expect(path).To(Equal("/topics/solar-discovery"))
+		Expect(contentType).To(Equal(kafkaContentType))
+		Expect(user).To(Equal("solar"))
+		Expect(records.Records).To(HaveLen(1))
+		Expect(records.Records[0].Key).To(Equal("default/test/component-descriptors/opendefense.cloud/ocm-demo"))
+		ev := cloudevents.NewEvent()
+		Expect(json.Unmarshal(records.Records[0].Value, &ev)).To(Succeed())
+		Expect(ev.Type()).To(Equal("cloud.opendefense.solar.discovery.componentversion.created"))
+		Expect(ev.Source()).To(Equal("/solar-discovery/default"))
+	})
+
+	It("should return an error if the bridge rejects the request", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, `{"error_code":404,"message":"topic not found"}`, http.StatusNotFound)
+		}))
+		DeferCleanup(srv.Close)
+
+		pub, err := NewKafkaRESTProxyPublisher(KafkaRESTProxyOptions{URL: srv.URL, Topic: "missing"}, "/solar-discovery/default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub.Publish(context.Background(), testEvent)).To(MatchError(ContainSubstring("topic not found")))
+	})
+
+	It("should return an error if a record was not produced", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"error_code":500,"message":"leader not available"}]}`))
+		}))
+		DeferCleanup(srv.Close)
+
+		pub, err := NewKafkaRESTProxyPublisher(KafkaRESTProxyOptions{URL: srv.URL, Topic: "solar-discovery"}, "/solar-discovery/default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub.Publish(context.Background(), testEvent)).To(MatchError(ContainSubstring("leader not available")))
+	})
+})
@@ -0,0 +1,236 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+const (
+	// DefaultQueueSize is the number of events buffered per publisher before
+	// further events are dropped.
+	DefaultQueueSize = 1000
+	// DefaultMaxAttempts is the number of times delivering an event to a
+	// publisher is attempted before it is given up.
+	DefaultMaxAttempts = 5
+)
+
+const (
+	// publishTimeout bounds a single attempt to publish an event.
+	publishTimeout = 10 * time.Second
+	// retryInterval and maxRetryInterval bound the delay between two attempts
+	// to publish an event, which doubles with every attempt.
+	retryInterval    = time.Second
+	maxRetryInterval = time.Minute
+)
+
+// Publisher delivers ComponentVersionEvents to a system outside of the
+// discovery worker, e.g. a message broker an indexing service subscribes to.
+type Publisher interface {
+	Publish(ctx context.Context, ev discovery.ComponentVersionEvent) error
+}
+
+// DispatcherOption describes the available options for creating the
+// Dispatcher.
+type DispatcherOption func(d *Dispatcher)
+
+// WithQueueSize sets the number of events buffered per publisher. Values
+// below 1 are replaced with DefaultQueueSize.
+func WithQueueSize(n int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if n > 0 {
+			d.queueSize = n
+		}
+	}
+}
+
+// WithMaxAttempts sets the number of times delivering an event to a publisher
+// is attempted. Values below 1 are replaced with DefaultMaxAttempts.
+func WithMaxAttempts(n int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if n > 0 {
+			d.maxAttempts = n
+		}
+	}
+}
+
+// WithDispatcherLogger sets the logger of the Dispatcher.
+func WithDispatcherLogger(log logr.Logger) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.log = log
+	}
+}
+
+// Dispatcher delivers ComponentVersionEvents to publishers in the background.
+// Every publisher has its own bounded queue and worker, so a slow or
+// unreachable publisher neither blocks the caller nor delays the other
+// publishers. Events are dropped when the queue of a publisher is full, and
+// reported to the error channel once all attempts to deliver them failed.
+type Dispatcher struct {
+	sinks       []*sink
+	queueSize   int
+	maxAttempts int
+	errChan     chan<- discovery.ErrorEvent
+	log         logr.Logger
+	stopChan    chan struct{}
+	stopOnce    sync.Once
+	wg          sync.WaitGroup
+}
+
+// sink is the queue of events waiting to be delivered to a publisher.
+type sink struct {
+	publisher Publisher
+	queue     chan discovery.ComponentVersionEvent
+	// busy is 1 while an event taken from queue is being delivered.
+	busy atomic.Int64
+}
+
+// NewDispatcher returns a Dispatcher delivering events to the given
+// publishers. Events that could not be delivered are published to errChan.
+func NewDispatcher(publishers []Publisher, errChan chan<- discovery.ErrorEvent, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		queueSize:   DefaultQueueSize,
+		maxAttempts: DefaultMaxAttempts,
+		errChan:     errChan,
+		log:         logr.Discard(),
+		stopChan:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	for _, p := range publishers {
+		d.sinks = append(d.sinks, &sink{
+			publisher: p,
+			queue:     make(chan discovery.ComponentVersionEvent, d.queueSize),
+		})
+	}
+
+	return d
+}
+
+// Start starts a worker per publisher delivering the queued events.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	for _, s := range d.sinks {
+		d.wg.Add(1)
+		go d.run(ctx, s)
+	}
+
+	return nil
+}
+
+// Stop stops the workers. Events still queued are dropped; wait for Pending
+// to reach zero first to deliver them.
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.stopChan)
+		d.wg.Wait()
+	})
+}
+
+// Enqueue queues ev for delivery to every publisher without blocking. It is
+// dropped for publishers whose queue is full.
+func (d *Dispatcher) Enqueue(ev discovery.ComponentVersionEvent) {
+	for _, s := range d.sinks {
+		select {
+		case s.queue <- ev:
+		default:
+			d.log.Error(nil, "publisher queue full, dropping event", "publisher", fmt.Sprintf("%T", s.publisher), "component", ev.Component, "version", ev.Source.Version)
+		}
+	}
+}
+
+// Pending returns the number of events queued for or being delivered to the
+// publishers.
+func (d *Dispatcher) Pending() int {
+	pending := 0
+	for _, s := range d.sinks {
+		pending += len(s.queue) + int(s.busy.Load())
+	}
+
+	return pending
+}
+
+// Discard drops the events left in the queues of a stopped Dispatcher and
+// returns their number.
+func (d *Dispatcher) Discard(context.Context) int {
+	discarded := 0
+	for _, s := range d.sinks {
+		for len(s.queue) > 0 {
+			<-s.queue
+			discarded++
+		}
+	}
+
+	return discarded
+}
+
+func (d *Dispatcher) run(ctx context.Context, s *sink) {
+	defer d.wg.Done()
+
+	for {
+		select {
+		case <-d.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case ev := <-s.queue:
+			s.busy.Store(1)
+			d.deliver(ctx, s.publisher, ev)
+			s.busy.Store(0)
+		}
+	}
+}
+
+// deliver publishes ev, retrying with an exponentially growing delay until it
+// succeeds, the attempts are used up or the Dispatcher is stopped.
+func (d *Dispatcher) deliver(ctx context.Context, p Publisher, ev discovery.ComponentVersionEvent) {
+	log := d.log.WithValues("publisher", fmt.Sprintf("%T", p), "component", ev.Component, "version", ev.Source.Version)
+	delay := retryInterval
+
+	for attempt := 1; ; attempt++ {
+		pubCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+		err := p.Publish(pubCtx, ev)
+		cancel()
+		if err == nil {
+			return
+		}
+
+		if attempt >= d.maxAttempts {
+			log.Error(err, "failed to publish component version event", "attempts", attempt)
+			severity, _ := discovery.ClassifyError(err)
+			discovery.Publish(&d.log, d.errChan, discovery.ErrorEvent{
+				Source:     fmt.Sprintf("%T", p),
+				Registry:   ev.Source.Registry,
+				Repository: ev.Source.Repository,
+				Severity:   severity,
+				Error:      fmt.Errorf("failed to publish %s:%s: %w", ev.Component, ev.Source.Version, err),
+				Timestamp:  time.Now().UTC(),
+			})
+
+			return
+		}
+
+		log.Info("retrying to publish component version event", "attempt", attempt, "delay", delay, "error", err.Error())
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-d.stopChan:
+			timer.Stop()
+			return
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+		delay = min(2*delay, maxRetryInterval)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"go.opendefense.cloud/solar/pkg/discovery"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var testEvent = discovery.ComponentVersionEvent{
+	Source: discovery.RepositoryEvent{
+		Registry:   "default",
+		Repository: "test/component-descriptors/opendefense.cloud/ocm-demo",
+		Version:    "1.1.1",
+		Digest:     "sha256:abc",
+		Type:       discovery.EventCreated,
+	},
+	Namespace: "opendefense.cloud",
+	Component: "ocm-demo",
+	Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+}
+
+// fakePublisher records the events it publishes. It fails while failures
+// is positive and blocks while block is open.
+type fakePublisher struct {
+	mu       sync.Mutex
+	events   []discovery.ComponentVersionEvent
+	calls    int
+	failures int
+	block    chan struct{}
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, ev discovery.ComponentVersionEvent) error {
+	if p.block != nil {
+		select {
+		case <-p.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls++
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	p.events = append(p.events, ev)
+
+	return nil
+}
+
+func (p *fakePublisher) published() []discovery.ComponentVersionEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.events)
+}
+
+func (p *fakePublisher) attempts() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.calls
+}
+
+var _ = Describe("Dispatcher", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+	})
+
+	It("should publish events to all publishers", func() {
+		first := &fakePublisher{}
+		second := &fakePublisher{}
+		d := NewDispatcher([]Publisher{first, second}, nil)
+		Expect(d.Start(ctx)).To(Succeed())
+		DeferCleanup(d.Stop)
+
+		d.Enqueue(testEvent)
+
+		Eventually(first.published).Should(Equal([]discovery.ComponentVersionEvent{testEvent}))
+		Eventually(second.published).Should(Equal([]discovery.ComponentVersionEvent{testEvent}))
+		Eventually(d.Pending).Should(BeZero())
+	})
+
+	It("should not block while a publisher is unreachable", func() {
+		stuck := &fakePublisher{block: make(chan struct{})}
+		healthy := &fakePublisher{}
+		d := NewDispatcher([]Publisher{stuck, healthy}, nil, WithQueueSize(1))
+		Expect(d.Start(ctx)).To(Succeed())
+		DeferCleanup(d.Stop)
+		DeferCleanup(func() { close(stuck.block) })
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for range 10 {
+				d.Enqueue(testEvent)
+			}
+		}()
+		Eventually(done).Should(BeClosed())
+		Eventually(func() int { return len(healthy.published()) }).Should(BeNumerically(">=", 1))
+		Expect(stuck.published()).To(BeEmpty())
+	})
+
+	It("should retry failed deliveries", func() {
+		flaky := &fakePublisher{failures: 1}
+		d := NewDispatcher([]Publisher{flaky}, nil)
+		Expect(d.Start(ctx)).To(Succeed())
+		DeferCleanup(d.Stop)
+
+		d.Enqueue(testEvent)
+
+		Eventually(flaky.published, 5*time.Second).Should(Equal([]discovery.ComponentVersionEvent{testEvent}))
+		Expect(flaky.attempts()).To(Equal(2))
+	})
+
+	It("should report events that could not be delivered", func() {
+		failing := &fakePublisher{failures: 10}
+		errChan := make(chan discovery.ErrorEvent, 1)
+		d := NewDispatcher([]Publisher{failing}, errChan, WithMaxAttempts(1))
+		Expect(d.Start(ctx)).To(Succeed())
+		DeferCleanup(d.Stop)
+
+		d.Enqueue(testEvent)
+
+		var errEv discovery.ErrorEvent
+		Eventually(errChan).Should(Receive(&errEv))
+		Expect(errEv.Registry).To(Equal("default"))
+		Expect(errEv.Error).To(MatchError(ContainSubstring("broker unavailable")))
+		Expect(failing.attempts()).To(Equal(1))
+	})
+
+	It("should discard queued events once stopped", func() {
+		d := NewDispatcher([]Publisher{&fakePublisher{}}, nil)
+		d.Enqueue(testEvent)
+		d.Enqueue(testEvent)
+		Expect(d.Pending()).To(Equal(2))
+
+		d.Stop()
+		Expect(d.Discard(ctx)).To(Equal(2))
+		Expect(d.Pending()).To(BeZero())
+	})
+})
+
+var _ = Describe("CloudEventsPublisher", func() {
+	It("should send the event as a CloudEvent", func() {
+		received := make(chan *cloudevents.Event, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ev, err := cloudevents.NewEventFromHTTPRequest(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			received <- ev
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		DeferCleanup(srv.Close)
+
+		pub, err := NewCloudEventsPublisher(srv.URL, "/solar-discovery/default", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub.Publish(context.Background(), testEvent)).To(Succeed())
+
+		var ev *cloudevents.Event
+		Eventually(received).Should(Receive(&ev))
+		Expect(ev.Type()).To(Equal("cloud.opendefense.solar.discovery.componentversion.created"))
+		Expect(ev.Source()).To(Equal("/solar-discovery/default"))
+		Expect(ev.Subject()).To(Equal("ocm-demo:1.1.1"))
+		Expect(ev.Time()).To(Equal(testEvent.Timestamp))
+
+		var data ComponentVersionData
+		Expect(json.Unmarshal(ev.Data(), &data)).To(Succeed())
+		Expect(data).To(Equal(ComponentVersionData{
+			Registry:   "default",
+			Repository: "test/component-descriptors/opendefense.cloud/ocm-demo",
+			Namespace:  "opendefense.cloud",
+			Component:  "ocm-demo",
+			Version:    "1.1.1",
+			Digest:     "sha256:abc",
+			Type:       "created",
+			Timestamp:  testEvent.Timestamp,
+		}))
+	})
+
+	It("should return an error if the sink rejects the event", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		DeferCleanup(srv.Close)
+
+		pub, err := NewCloudEventsPublisher(srv.URL, "/solar-discovery/default", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pub.Publish(context.Background(), testEvent)).To(MatchError(ContainSubstring("failed to send event to " + srv.URL)))
+	})
+})
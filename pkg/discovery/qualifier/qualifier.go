@@ -5,19 +5,31 @@ package qualifier
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"ocm.software/ocm/api/ocm"
 	"ocm.software/ocm/api/ocm/extensions/repositories/ocireg"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
 
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/ociregistry"
 )
 
 type Qualifier struct {
 	*discovery.Runner[discovery.RepositoryEvent, discovery.ComponentVersionEvent]
 	provider  *discovery.RegistryProvider
 	namespace string
+	digests   *discovery.DigestCache
+	responses *ociregistry.ResponseCache
+	retrier   *ociregistry.Retrier
+	workers   int
 }
 
 func NewQualifier(
@@ -33,6 +45,20 @@ func NewQualifier(
 		namespace: namespace,
 	}
 	p.Runner = discovery.NewRunner(p, in, out, err)
+	// Lookups hit the upstream registries, so process each registry
+	// independently to keep a slow registry from delaying the others.
+	discovery.WithPartitions[discovery.RepositoryEvent, discovery.ComponentVersionEvent](
+		func(ev discovery.RepositoryEvent) string { return ev.Registry },
+		provider.PartitionLimitsFunc(&p.workers),
+	)(p.Runner)
+	// Workers of a registry pick up the events of a repository in order, so
+	// a deletion is never overtaken by an earlier listing of the repository.
+	discovery.WithSharding[discovery.RepositoryEvent, discovery.ComponentVersionEvent](
+		func(ev discovery.RepositoryEvent) string { return ev.Repository },
+	)(p.Runner)
+	// A registry scan can emit many events for the same repository; a single
+	// version listing answers all of them.
+	discovery.WithCoalescing[discovery.RepositoryEvent, discovery.ComponentVersionEvent](coalesceKey)(p.Runner)
 	for _, opt := range opts {
 		opt(p.Runner)
 	}
@@ -40,6 +66,44 @@ func NewQualifier(
 	return p
 }
 
+// coalesceKey lets queued version listings of a repository absorb further
+// listing requests for it. Events for a single version and deletions are
+// always processed.
+func coalesceKey(ev discovery.RepositoryEvent) (string, string) {
+	target := ev.Registry + "/" + ev.Repository
+	if ev.Type == discovery.EventDeleted || ev.Version != "" {
+		return target, ""
+	}
+
+	return target, "list:" + ev.VersionConstraint
+}
+
+// SetDigestCache enables incremental lookups: versions whose manifest digest
+// is recorded in the cache are skipped, and versions whose digest changed are
+// sent as updates.
+func (rs *Qualifier) SetDigestCache(c *discovery.DigestCache) {
+	rs.digests = c
+}
+
+// SetWorkers sets the number of workers looking up the repositories of a
+// registry in parallel, unless the registry sets its own MaxConcurrency.
+// Events of the same repository are always processed in order.
+func (rs *Qualifier) SetWorkers(n int) {
+	rs.workers = n
+}
+
+// SetResponseCache makes digest lookups answer repeated manifest requests
+// from the given cache.
+func (rs *Qualifier) SetResponseCache(c *ociregistry.ResponseCache) {
+	rs.responses = c
+}
+
+// SetRetrier makes digest lookups retry requests failing with transient
+// errors, such as 429 responses of a registry under load.
+func (rs *Qualifier) SetRetrier(r *ociregistry.Retrier) {
+	rs.retrier = r
+}
+
 func NewQualifierOptions(opts ...discovery.RunnerOption[discovery.RepositoryEvent, discovery.ComponentVersionEvent]) []discovery.RunnerOption[discovery.RepositoryEvent, discovery.ComponentVersionEvent] {
 	return opts
 }
@@ -68,6 +132,26 @@ func (rs *Qualifier) Process(ctx context.Context, ev discovery.RepositoryEvent)
 	// If version is specified, we can skip the lookup and just return the event as-is
 	// Otherwise, lookup the component
 	if ev.Version != "" {
+		registry := rs.provider.Get(ev.Registry)
+		qualified, err := qualifyVersions(registry, []string{ev.Version})
+		if err != nil {
+			return nil, err
+		}
+		if len(qualified) == 0 {
+			rs.Logger().V(2).Info("skipping version not matching the version policy", "repository", ev.Repository, "version", ev.Version)
+			return nil, nil
+		}
+		compVerEvent.Channel = versionChannel(registry, ev.Version)
+
+		if registry != nil && hasAnnotationMappings(registry) {
+			annotations, err := rs.resolveAnnotations(ctx, registry, rs.provider.GetCredentials(ev.Registry), rs.provider.GetTLS(ev.Registry), ev.Repository, []string{ev.Version})
+			if err != nil {
+				// Without annotations the version is still cataloged, just without the mapped labels.
+				rs.Logger().Error(err, "failed to resolve manifest annotations", "registry", ev.Registry, "repository", ev.Repository)
+			}
+			compVerEvent.Annotations = annotations[ev.Version]
+		}
+
 		return []discovery.ComponentVersionEvent{compVerEvent}, nil
 	}
 
@@ -80,8 +164,9 @@ func (rs *Qualifier) Process(ctx context.Context, ev discovery.RepositoryEvent)
 
 	var octx ocm.Context
 	creds := rs.provider.GetCredentials(ev.Registry)
-	if creds != nil {
-		octx, err = discovery.FromContextWithCreds(ctx, registry.Spec.Hostname, creds)
+	registryTLS := rs.provider.GetTLS(ev.Registry)
+	if creds != nil || registryTLS != nil {
+		octx, err = discovery.FromContextWithCreds(ctx, registry.Spec.Hostname, creds, registryTLS)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create OCM context with creds: %w", err)
 		}
@@ -113,12 +198,184 @@ func (rs *Qualifier) Process(ctx context.Context, ev discovery.RepositoryEvent)
 		return nil, fmt.Errorf("failed to list component versions: %w", err)
 	}
 
-	// Create a ComponentVersionEvent for each version of the component and return them as output events. The handler will then process each version separately.
-	componentVersionEvents := make([]discovery.ComponentVersionEvent, 0, len(componentVersions))
-	for _, version := range componentVersions {
-		compVerEvent.Source.Version = version
-		componentVersionEvents = append(componentVersionEvents, compVerEvent)
+	componentVersions, err = discovery.FilterVersions(componentVersions, ev.VersionConstraint)
+	if err != nil {
+		return nil, err
 	}
+	componentVersions, err = qualifyVersions(registry, componentVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	var digests map[string]string
+	if rs.digests != nil {
+		digests, err = rs.resolveDigests(ctx, registry, creds, registryTLS, ev.Repository, componentVersions)
+		if err != nil {
+			// Without digests every version is processed, as without a cache.
+			rs.Logger().Error(err, "failed to resolve manifest digests", "registry", ev.Registry, "repository", ev.Repository)
+		}
+	}
+
+	var annotations map[string]map[string]string
+	if hasAnnotationMappings(registry) {
+		annotations, err = rs.resolveAnnotations(ctx, registry, creds, registryTLS, ev.Repository, componentVersions)
+		if err != nil {
+			// Without annotations the versions are still cataloged, just without the mapped labels.
+			rs.Logger().Error(err, "failed to resolve manifest annotations", "registry", ev.Registry, "repository", ev.Repository)
+		}
+	}
+
+	return rs.versionEvents(compVerEvent, componentVersions, digests, annotations), nil
+}
+
+// versionEvents creates a ComponentVersionEvent for each version of the
+// component. The handler will then process each version separately. With a
+// digest cache, versions whose digest is unchanged are left out and versions
+// whose digest changed are sent as updates.
+func (rs *Qualifier) versionEvents(base discovery.ComponentVersionEvent, versions []string, digests map[string]string, annotations map[string]map[string]string) []discovery.ComponentVersionEvent {
+	registry := rs.provider.Get(base.Source.Registry)
+
+	events := make([]discovery.ComponentVersionEvent, 0, len(versions))
+	for _, version := range versions {
+		ev := base
+		ev.Source.Version = version
+		ev.Source.Digest = digests[version]
+		ev.Annotations = annotations[version]
+		ev.Channel = versionChannel(registry, version)
+
+		if rs.digests != nil && ev.Source.Digest != "" {
+			cached, ok := rs.digests.Get(ev.Source.Registry, ev.Source.Repository, version)
+			switch {
+			case ok && cached == ev.Source.Digest:
+				rs.Logger().V(2).Info("skipping unchanged version", "repository", ev.Source.Repository, "version", version)
+				continue
+			case ok:
+				ev.Source.Type = discovery.EventUpdated
+			}
+		}
+
+		events = append(events, ev)
+	}
+
+	return events
+}
+
+// resolveDigests returns the manifest digests of the component descriptors of
+// the given versions, keyed by version. It only issues a HEAD request per
+// version, which is much cheaper than reading the descriptors.
+func (rs *Qualifier) resolveDigests(ctx context.Context, registry *solarv1alpha1.Registry, creds *discovery.RegistryCredentials, registryTLS *discovery.RegistryTLS, repository string, versions []string) (map[string]string, error) {
+	repo, err := rs.newRepository(registry, creds, registryTLS, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make(map[string]string, len(versions))
+	for _, version := range versions {
+		desc, err := repo.Resolve(ctx, descriptorTag(version))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve version %s: %w", version, err)
+		}
+		digests[version] = desc.Digest.String()
+	}
+
+	return digests, nil
+}
+
+// resolveAnnotations returns the manifest annotations of the component
+// descriptors of the given versions, keyed by version.
+func (rs *Qualifier) resolveAnnotations(ctx context.Context, registry *solarv1alpha1.Registry, creds *discovery.RegistryCredentials, registryTLS *discovery.RegistryTLS, repository string, versions []string) (map[string]map[string]string, error) {
+	repo, err := rs.newRepository(registry, creds, registryTLS, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := make(map[string]map[string]string, len(versions))
+	for _, version := range versions {
+		desc, rc, err := repo.FetchReference(ctx, descriptorTag(version))
+		if err != nil {
+			return annotations, fmt.Errorf("failed to fetch manifest of version %s: %w", version, err)
+		}
+		data, err := content.ReadAll(rc, desc)
+		_ = rc.Close()
+		if err != nil {
+			return annotations, fmt.Errorf("failed to read manifest of version %s: %w", version, err)
+		}
+
+		var manifest ocispec.Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return annotations, fmt.Errorf("failed to decode manifest of version %s: %w", version, err)
+		}
+		annotations[version] = manifest.Annotations
+	}
+
+	return annotations, nil
+}
+
+// newRepository returns a client for the given repository of the registry.
+func (rs *Qualifier) newRepository(registry *solarv1alpha1.Registry, creds *discovery.RegistryCredentials, registryTLS *discovery.RegistryTLS, repository string) (*remote.Repository, error) {
+	repo, err := remote.NewRepository(registry.Spec.Hostname + "/" + repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository client: %w", err)
+	}
+	repo.PlainHTTP = registry.Spec.PlainHTTP
+	if creds != nil || registryTLS != nil || rs.responses != nil || rs.retrier != nil {
+		httpClient, err := registryTLS.HTTPClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create http client: %w", err)
+		}
+		if rs.retrier != nil {
+			httpClient = rs.retrier.Client(httpClient)
+		}
+		if rs.responses != nil {
+			httpClient = rs.responses.Client(httpClient)
+		}
+		authClient := &auth.Client{Client: httpClient}
+		if creds != nil {
+			authClient.Credential = auth.StaticCredential(registry.Spec.Hostname, auth.Credential{
+				Username: creds.Username,
+				Password: creds.Password,
+			})
+		}
+		repo.Client = authClient
+	}
+
+	return repo, nil
+}
+
+// qualifyVersions returns the versions matching the semver constraint of the
+// registry's VersionPolicy.
+func qualifyVersions(registry *solarv1alpha1.Registry, versions []string) ([]string, error) {
+	if registry == nil || registry.Spec.VersionPolicy == nil {
+		return versions, nil
+	}
+
+	return discovery.FilterVersions(versions, registry.Spec.VersionPolicy.SemverConstraint)
+}
+
+// versionChannel returns the channel the registry's VersionPolicy assigns
+// the version to.
+func versionChannel(registry *solarv1alpha1.Registry, version string) string {
+	if registry == nil || registry.Spec.VersionPolicy == nil {
+		return ""
+	}
+
+	return discovery.MatchChannel(registry.Spec.VersionPolicy.Channels, version)
+}
+
+// hasAnnotationMappings reports whether the registry maps manifest
+// annotations to labels, which requires fetching the manifests.
+func hasAnnotationMappings(registry *solarv1alpha1.Registry) bool {
+	for _, m := range registry.Spec.LabelMappings {
+		if m.Annotation != "" {
+			return true
+		}
+	}
+
+	return false
+}
 
-	return componentVersionEvents, nil
+// descriptorTag returns the OCI tag OCM stores the given component version
+// under. Tags cannot contain '+', so OCM replaces build metadata separators.
+func descriptorTag(version string) string {
+	return strings.ReplaceAll(version, "+", ".build-")
 }
@@ -5,6 +5,7 @@ package qualifier
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http/httptest"
 	"net/url"
@@ -12,7 +13,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"oras.land/oras-go/v2"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
@@ -224,3 +228,180 @@ var _ = Describe("Qualifier", Ordered, func() {
 		})
 	})
 })
+
+var _ = Describe("Qualifier.versionEvents", func() {
+	var (
+		q    *Qualifier
+		base discovery.ComponentVersionEvent
+	)
+
+	BeforeEach(func() {
+		q = NewQualifier(discovery.NewRegistryProvider(), "default", nil, nil, nil)
+		base = discovery.ComponentVersionEvent{
+			Source: discovery.RepositoryEvent{
+				Registry:   "reg",
+				Repository: "test/component-descriptors/example.com/comp",
+				Type:       discovery.EventCreated,
+			},
+			Namespace: "test",
+			Component: "example.com/comp",
+		}
+	})
+
+	It("should create an event for every version without a digest cache", func() {
+		events := q.versionEvents(base, []string{"v1.0.0", "v1.1.0"}, map[string]string{"v1.0.0": "sha256:aaa"}, nil)
+
+		Expect(events).To(HaveExactElements(
+			HaveField("Source", SatisfyAll(HaveField("Version", "v1.0.0"), HaveField("Digest", "sha256:aaa"))),
+			HaveField("Source", SatisfyAll(HaveField("Version", "v1.1.0"), HaveField("Digest", ""))),
+		))
+	})
+
+	It("should skip unchanged versions and update changed ones", func() {
+		cache := discovery.NewDigestCache(nil)
+		cache.Set("reg", base.Source.Repository, "v1.0.0", "sha256:aaa")
+		cache.Set("reg", base.Source.Repository, "v1.1.0", "sha256:bbb")
+		q.SetDigestCache(cache)
+
+		events := q.versionEvents(base, []string{"v1.0.0", "v1.1.0", "v1.2.0"}, map[string]string{
+			"v1.0.0": "sha256:aaa",
+			"v1.1.0": "sha256:changed",
+			"v1.2.0": "sha256:ccc",
+		}, nil)
+
+		Expect(events).To(HaveExactElements(
+			HaveField("Source", SatisfyAll(
+				HaveField("Version", "v1.1.0"),
+				HaveField("Digest", "sha256:changed"),
+				HaveField("Type", discovery.EventUpdated),
+			)),
+			HaveField("Source", SatisfyAll(
+				HaveField("Version", "v1.2.0"),
+				HaveField("Digest", "sha256:ccc"),
+				HaveField("Type", discovery.EventCreated),
+			)),
+		))
+	})
+
+	It("should process all versions when their digests are unknown", func() {
+		cache := discovery.NewDigestCache(nil)
+		cache.Set("reg", base.Source.Repository, "v1.0.0", "sha256:aaa")
+		q.SetDigestCache(cache)
+
+		Expect(q.versionEvents(base, []string{"v1.0.0"}, nil, nil)).To(HaveLen(1))
+	})
+
+	It("should assign versions to the channels of the registry's version policy", func() {
+		provider := discovery.NewRegistryProvider()
+		Expect(provider.Register(&solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "reg"},
+			Spec: solarv1alpha1.RegistrySpec{
+				Hostname: "registry.example.com",
+				VersionPolicy: &solarv1alpha1.VersionPolicy{
+					Channels: []solarv1alpha1.VersionChannel{
+						{Name: "stable"},
+						{Name: "edge", Prerelease: []string{"rc"}},
+					},
+				},
+			},
+		}, nil)).To(Succeed())
+		q = NewQualifier(provider, "default", nil, nil, nil)
+
+		Expect(q.versionEvents(base, []string{"v1.0.0", "v1.1.0-rc.1", "v1.1.0-alpha.1"}, nil, nil)).To(HaveExactElements(
+			HaveField("Channel", "stable"),
+			HaveField("Channel", "edge"),
+			HaveField("Channel", ""),
+		))
+	})
+})
+
+var _ = Describe("qualifyVersions", func() {
+	versions := []string{"v1.0.0", "v1.1.0-rc.1", "v2.0.0"}
+
+	It("should keep every version without a version policy", func() {
+		Expect(qualifyVersions(nil, versions)).To(Equal(versions))
+		Expect(qualifyVersions(&solarv1alpha1.Registry{}, versions)).To(Equal(versions))
+	})
+
+	It("should only keep versions matching the semver constraint", func() {
+		registry := &solarv1alpha1.Registry{Spec: solarv1alpha1.RegistrySpec{
+			VersionPolicy: &solarv1alpha1.VersionPolicy{SemverConstraint: ">= 1.0.0-0, < 2"},
+		}}
+		Expect(qualifyVersions(registry, versions)).To(Equal([]string{"v1.0.0", "v1.1.0-rc.1"}))
+	})
+})
+
+var _ = Describe("Qualifier.resolveAnnotations", func() {
+	It("should return the manifest annotations of every version", func() {
+		testServer := httptest.NewServer(registry.New().HandleFunc())
+		DeferCleanup(testServer.Close)
+		testServerUrl, err := url.Parse(testServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		reg := &solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "reg"},
+			Spec: solarv1alpha1.RegistrySpec{
+				Hostname:  testServerUrl.Host,
+				PlainHTTP: true,
+				LabelMappings: []solarv1alpha1.LabelMapping{
+					{Annotation: ocispec.AnnotationVendor, Label: "vendor"},
+				},
+			},
+		}
+		Expect(hasAnnotationMappings(reg)).To(BeTrue())
+
+		const repository = "test/component-descriptors/example.com/comp"
+		q := &Qualifier{}
+		repo, err := q.newRepository(reg, nil, nil, repository)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := context.Background()
+		config, err := oras.PushBytes(ctx, repo, ocispec.MediaTypeImageConfig, []byte("{}"))
+		Expect(err).NotTo(HaveOccurred())
+		manifest, err := json.Marshal(ocispec.Manifest{
+			Versioned:   specs.Versioned{SchemaVersion: 2},
+			MediaType:   ocispec.MediaTypeImageManifest,
+			Config:      config,
+			Layers:      []ocispec.Descriptor{},
+			Annotations: map[string]string{ocispec.AnnotationVendor: "Example Corp"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = oras.TagBytes(ctx, repo, ocispec.MediaTypeImageManifest, manifest, descriptorTag("v1.0.0+1"))
+		Expect(err).NotTo(HaveOccurred())
+
+		annotations, err := q.resolveAnnotations(ctx, reg, nil, nil, repository, []string{"v1.0.0+1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(annotations).To(HaveKeyWithValue("v1.0.0+1", HaveKeyWithValue(ocispec.AnnotationVendor, "Example Corp")))
+	})
+})
+
+var _ = Describe("coalesceKey", func() {
+	It("should only merge version listings of the same repository", func() {
+		listing := discovery.RepositoryEvent{Registry: "reg", Repository: "test/component-descriptors/example.com/comp"}
+		target, mergeKey := coalesceKey(listing)
+		Expect(target).To(Equal("reg/test/component-descriptors/example.com/comp"))
+		Expect(mergeKey).NotTo(BeEmpty())
+
+		constrained := listing
+		constrained.VersionConstraint = ">= 1.0.0"
+		_, constrainedKey := coalesceKey(constrained)
+		Expect(constrainedKey).NotTo(Equal(mergeKey))
+
+		version := listing
+		version.Version = "v1.0.0"
+		_, versionKey := coalesceKey(version)
+		Expect(versionKey).To(BeEmpty())
+
+		deleted := listing
+		deleted.Type = discovery.EventDeleted
+		_, deletedKey := coalesceKey(deleted)
+		Expect(deletedKey).To(BeEmpty())
+	})
+})
+
+var _ = Describe("descriptorTag", func() {
+	It("should replace build metadata separators", func() {
+		Expect(descriptorTag("1.0.0+build.1")).To(Equal("1.0.0.build-build.1"))
+		Expect(descriptorTag("v1.0.0")).To(Equal("v1.0.0"))
+	})
+})
@@ -0,0 +1,154 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// RegistryCheckStatus is the outcome of checking the connectivity of a registry.
+type RegistryCheckStatus string
+
+const (
+	// RegistryCheckOK means the registry was reached and listed its repositories.
+	RegistryCheckOK RegistryCheckStatus = "OK"
+	// RegistryCheckUnreachable means no connection to the registry could be
+	// established, for example because the hostname does not resolve or the
+	// TLS handshake failed.
+	RegistryCheckUnreachable RegistryCheckStatus = "Unreachable"
+	// RegistryCheckUnauthorized means the registry rejected the credentials.
+	RegistryCheckUnauthorized RegistryCheckStatus = "Unauthorized"
+	// RegistryCheckForbidden means the credentials are not allowed to list
+	// the repositories of the registry.
+	RegistryCheckForbidden RegistryCheckStatus = "Forbidden"
+	// RegistryCheckFailed means the registry answered with any other error.
+	RegistryCheckFailed RegistryCheckStatus = "Failed"
+)
+
+// RegistryCheck is the result of checking the connectivity of a registry.
+type RegistryCheck struct {
+	// Registry is the name of the checked Registry.
+	Registry string
+	// Hostname is the hostname the registry was dialed at.
+	Hostname string
+	// Status is the outcome of the check.
+	Status RegistryCheckStatus
+	// Repositories is the number of repositories on the first page of the
+	// registry's catalog.
+	Repositories int
+	// Err is the error the check failed with, nil if Status is RegistryCheckOK.
+	Err error
+}
+
+// errStopListing stops listing the repositories of a registry after the first page.
+var errStopListing = errors.New("stop listing")
+
+// Check dials the registry with the given name using its credentials and TLS
+// settings and lists the first page of its repositories.
+func (p *RegistryProvider) Check(ctx context.Context, name string) RegistryCheck {
+	reg := p.Get(name)
+	if reg == nil {
+		return RegistryCheck{Registry: name, Status: RegistryCheckFailed, Err: fmt.Errorf("registry %q is not registered", name)}
+	}
+
+	check := RegistryCheck{Registry: name, Hostname: reg.Spec.Hostname}
+
+	client, err := newCheckClient(reg, p.GetCredentials(name), p.GetTLS(name))
+	if err != nil {
+		check.Status, check.Err = RegistryCheckFailed, err
+
+		return check
+	}
+
+	err = client.Repositories(ctx, "", func(repos []string) error {
+		check.Repositories = len(repos)
+
+		return errStopListing
+	})
+	if err != nil && !errors.Is(err, errStopListing) {
+		check.Status, check.Err = classifyCheckError(err), err
+
+		return check
+	}
+	check.Status = RegistryCheckOK
+
+	return check
+}
+
+// CheckAll checks every registered registry, see Check. The results are
+// ordered by registry name.
+func (p *RegistryProvider) CheckAll(ctx context.Context) []RegistryCheck {
+	regs := p.GetAll()
+	slices.SortFunc(regs, func(a, b *solarv1alpha1.Registry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	checks := make([]RegistryCheck, 0, len(regs))
+	for _, reg := range regs {
+		checks = append(checks, p.Check(ctx, reg.Name))
+	}
+
+	return checks
+}
+
+// newCheckClient creates a registry client authenticated with creds and tls.
+func newCheckClient(reg *solarv1alpha1.Registry, creds *RegistryCredentials, tls *RegistryTLS) (*remote.Registry, error) {
+	client, err := remote.NewRegistry(reg.Spec.Hostname)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hostname: %w", err)
+	}
+	client.PlainHTTP = reg.Spec.PlainHTTP
+
+	httpClient, err := tls.HTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS settings: %w", err)
+	}
+	authClient := &auth.Client{Client: httpClient}
+	if creds != nil {
+		authClient.Credential = auth.StaticCredential(client.Reference.Registry, auth.Credential{
+			Username: creds.Username,
+			Password: creds.Password,
+		})
+	}
+	client.Client = authClient
+
+	return client, nil
+}
+
+// classifyCheckError maps an error returned while listing the repositories
+// of a registry to a RegistryCheckStatus.
+func classifyCheckError(err error) RegistryCheckStatus {
+	var resp *errcode.ErrorResponse
+	if errors.As(err, &resp) {
+		switch resp.StatusCode {
+		case http.StatusUnauthorized:
+			return RegistryCheckUnauthorized
+		case http.StatusForbidden:
+			return RegistryCheckForbidden
+		default:
+			return RegistryCheckFailed
+		}
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return RegistryCheckUnreachable
+	}
+
+	return RegistryCheckFailed
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"go.opendefense.cloud/solar/test/registry"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RegistryProvider Check", func() {
+	var provider *RegistryProvider
+
+	BeforeEach(func() {
+		provider = NewRegistryProvider()
+	})
+
+	register := func(name string, server *httptest.Server, creds *RegistryCredentials) {
+		reg := newTestRegistry(name, strings.TrimPrefix(server.URL, "http://"))
+		reg.Spec.PlainHTTP = true
+		Expect(provider.Register(reg, creds)).To(Succeed())
+	}
+
+	It("reports a reachable registry", func() {
+		server := httptest.NewServer(registry.New().WithAuth("user", "pass").HandleFunc())
+		DeferCleanup(server.Close)
+		register("ok", server, &RegistryCredentials{Username: "user", Password: "pass"})
+
+		check := provider.Check(context.Background(), "ok")
+		Expect(check.Err).NotTo(HaveOccurred())
+		Expect(check.Status).To(Equal(RegistryCheckOK))
+		Expect(check.Hostname).To(Equal(strings.TrimPrefix(server.URL, "http://")))
+	})
+
+	It("reports rejected credentials", func() {
+		server := httptest.NewServer(registry.New().WithAuth("user", "pass").HandleFunc())
+		DeferCleanup(server.Close)
+		register("wrong-creds", server, &RegistryCredentials{Username: "user", Password: "wrong"})
+
+		check := provider.Check(context.Background(), "wrong-creds")
+		Expect(check.Status).To(Equal(RegistryCheckUnauthorized))
+		Expect(check.Err).To(HaveOccurred())
+	})
+
+	It("reports missing permissions", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"DENIED","message":"catalog access denied"}]}`))
+		}))
+		DeferCleanup(server.Close)
+		register("forbidden", server, nil)
+
+		check := provider.Check(context.Background(), "forbidden")
+		Expect(check.Status).To(Equal(RegistryCheckForbidden))
+		Expect(check.Err).To(MatchError(ContainSubstring("catalog access denied")))
+	})
+
+	It("reports an unreachable registry", func() {
+		server := httptest.NewServer(registry.New().HandleFunc())
+		server.Close()
+		register("gone", server, nil)
+
+		check := provider.Check(context.Background(), "gone")
+		Expect(check.Status).To(Equal(RegistryCheckUnreachable))
+	})
+
+	It("checks all registries ordered by name", func() {
+		server := httptest.NewServer(registry.New().HandleFunc())
+		DeferCleanup(server.Close)
+		register("b", server, nil)
+		register("a", server, nil)
+
+		checks := provider.CheckAll(context.Background())
+		Expect(checks).To(HaveLen(2))
+		Expect(checks[0].Registry).To(Equal("a"))
+		Expect(checks[1].Registry).To(Equal("b"))
+		Expect(checks[0].Status).To(Equal(RegistryCheckOK))
+	})
+})
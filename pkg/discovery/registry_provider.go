@@ -5,11 +5,24 @@ package discovery
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
@@ -20,6 +33,14 @@ const (
 	SecretKeyUsername = "username"
 	// SecretKeyPassword is the key in a SolarSecretRef Secret that holds the registry password.
 	SecretKeyPassword = "password"
+	// SecretKeyWebhookToken is the key in a WebhookAuth Secret that holds the shared webhook secret.
+	SecretKeyWebhookToken = "token"
+	// SecretKeyCA is the key in a TLS CASecretRef Secret that holds the PEM encoded CA bundle.
+	SecretKeyCA = "ca.crt"
+	// SecretKeyTLSCert is the key in a TLS ClientCertSecretRef Secret that holds the client certificate.
+	SecretKeyTLSCert = corev1.TLSCertKey
+	// SecretKeyTLSKey is the key in a TLS ClientCertSecretRef Secret that holds the client key.
+	SecretKeyTLSKey = corev1.TLSPrivateKeyKey
 )
 
 // RegistryProvider manages a collection of OCI registries loaded from the solar.Registry API.
@@ -27,19 +48,48 @@ type RegistryProvider struct {
 	mux        sync.RWMutex
 	registries map[string]*solarv1alpha1.Registry
 	creds      map[string]*RegistryCredentials
+	webhookKey map[string][]byte
+	tls        map[string]*RegistryTLS
+	stagger    time.Duration
+
+	// pullSecrets maps the pull secrets referenced by PullSecretRef to the
+	// names of the registries using them.
+	pullSecrets map[types.NamespacedName][]string
+}
+
+// RegistryProviderOption describes the available options for creating the
+// RegistryProvider.
+type RegistryProviderOption func(p *RegistryProvider)
+
+// WithScanStagger spreads the scans of all scanned registries evenly over a
+// window of d, see RegistryProvider.ScanOffset.
+func WithScanStagger(d time.Duration) RegistryProviderOption {
+	return func(p *RegistryProvider) {
+		p.stagger = d
+	}
 }
 
 // NewRegistryProvider creates and returns a new, empty RegistryProvider instance.
-func NewRegistryProvider() *RegistryProvider {
-	return &RegistryProvider{
-		registries: make(map[string]*solarv1alpha1.Registry),
-		creds:      make(map[string]*RegistryCredentials),
+func NewRegistryProvider(opts ...RegistryProviderOption) *RegistryProvider {
+	p := &RegistryProvider{
+		registries:  make(map[string]*solarv1alpha1.Registry),
+		creds:       make(map[string]*RegistryCredentials),
+		webhookKey:  make(map[string][]byte),
+		tls:         make(map[string]*RegistryTLS),
+		pullSecrets: make(map[types.NamespacedName][]string),
 	}
+	for _, o := range opts {
+		o(p)
+	}
+
+	return p
 }
 
 // LoadFromAPI lists all solar.Registry objects in the given namespace from the
-// Kubernetes API server and, for those with a SolarSecretRef, reads the
-// referenced Secret to resolve credentials. Existing entries are replaced.
+// Kubernetes API server and, for those with a SolarSecretRef or PullSecretRef,
+// reads the referenced Secret to resolve credentials. The shared secrets of
+// registries with WebhookAuth and the certificates of registries with TLS
+// settings are resolved the same way. Existing entries are replaced.
 func (p *RegistryProvider) LoadFromAPI(ctx context.Context, solarClient solarclient.SolarV1alpha1Interface, secretClient corev1client.CoreV1Interface, namespace string) error {
 	list, err := solarClient.Registries(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -48,11 +98,55 @@ func (p *RegistryProvider) LoadFromAPI(ctx context.Context, solarClient solarcli
 
 	registries := make(map[string]*solarv1alpha1.Registry, len(list.Items))
 	creds := make(map[string]*RegistryCredentials)
+	webhookKeys := make(map[string][]byte)
+	registryTLS := make(map[string]*RegistryTLS)
+	pullSecrets := make(map[types.NamespacedName][]string)
 
 	for i := range list.Items {
 		reg := &list.Items[i]
 		registries[reg.Name] = reg
 
+		if auth := reg.Spec.WebhookAuth; auth != nil {
+			secret, err := secretClient.Secrets(namespace).Get(ctx, auth.SecretRef.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to read webhook secret %q for registry %q: %w", auth.SecretRef.Name, reg.Name, err)
+			}
+
+			token, ok := secret.Data[SecretKeyWebhookToken]
+			if !ok || len(token) == 0 {
+				return fmt.Errorf("webhook secret %q for registry %q is missing key %q", auth.SecretRef.Name, reg.Name, SecretKeyWebhookToken)
+			}
+
+			webhookKeys[reg.Name] = token
+		}
+
+		if reg.Spec.TLS != nil {
+			t, err := loadTLS(ctx, secretClient, namespace, reg)
+			if err != nil {
+				return err
+			}
+
+			registryTLS[reg.Name] = t
+		}
+
+		if ref := reg.Spec.PullSecretRef; ref != nil {
+			key := pullSecretKey(ref, namespace)
+			secret, err := secretClient.Secrets(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to read pull secret %q for registry %q: %w", key, reg.Name, err)
+			}
+
+			c, err := credentialsFromPullSecret(secret, reg.Spec.Hostname)
+			if err != nil {
+				return fmt.Errorf("invalid pull secret %q for registry %q: %w", key, reg.Name, err)
+			}
+
+			creds[reg.Name] = c
+			pullSecrets[key] = append(pullSecrets[key], reg.Name)
+
+			continue
+		}
+
 		if reg.Spec.SolarSecretRef == nil {
 			continue
 		}
@@ -83,10 +177,191 @@ func (p *RegistryProvider) LoadFromAPI(ctx context.Context, solarClient solarcli
 
 	p.registries = registries
 	p.creds = creds
+	p.webhookKey = webhookKeys
+	p.tls = registryTLS
+	p.pullSecrets = pullSecrets
 
 	return nil
 }
 
+// WatchPullSecrets watches the Secrets referenced by the PullSecretRef of the
+// registries loaded by LoadFromAPI and updates their credentials whenever a
+// Secret changes. Registries keep their previous credentials if a Secret is
+// deleted or no longer holds credentials for them. It blocks until ctx is
+// done.
+func (p *RegistryProvider) WatchPullSecrets(ctx context.Context, clientset kubernetes.Interface) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	p.mux.RLock()
+	keys := make([]types.NamespacedName, 0, len(p.pullSecrets))
+	for key := range p.pullSecrets {
+		keys = append(keys, key)
+	}
+	p.mux.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		secrets := clientset.CoreV1().Secrets(key.Namespace)
+		selector := fields.OneTermEqualSelector("metadata.name", key.Name).String()
+		lw := &cache.ListWatch{
+			ListWithContextFunc: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector
+				return secrets.List(ctx, opts)
+			},
+			WatchFuncWithContext: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = selector
+				return secrets.Watch(ctx, opts)
+			},
+		}
+		_, informer := cache.NewInformerWithOptions(cache.InformerOptions{
+			ListerWatcher: cache.ToListWatcherWithWatchListSemantics(lw, clientset),
+			ObjectType:    &corev1.Secret{},
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj any) {
+					p.refreshPullSecret(log, key, obj)
+				},
+				UpdateFunc: func(_, obj any) {
+					p.refreshPullSecret(log, key, obj)
+				},
+				DeleteFunc: func(any) {
+					log.Info("pull secret was deleted, keeping the previous credentials", "secret", key.String())
+				},
+			},
+		})
+
+		wg.Go(func() {
+			informer.RunWithContext(ctx)
+		})
+	}
+
+	wg.Wait()
+}
+
+// refreshPullSecret resolves the credentials of all registries using the pull
+// secret key from obj.
+func (p *RegistryProvider) refreshPullSecret(log logr.Logger, key types.NamespacedName, obj any) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok || secret.Name != key.Name || secret.Namespace != key.Namespace {
+		return
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	for _, name := range p.pullSecrets[key] {
+		reg, ok := p.registries[name]
+		if !ok {
+			continue
+		}
+
+		c, err := credentialsFromPullSecret(secret, reg.Spec.Hostname)
+		if err != nil {
+			log.Error(err, "failed to refresh registry credentials, keeping the previous ones", "registry", name, "secret", key.String())
+			continue
+		}
+
+		if old := p.creds[name]; old == nil || *old != *c {
+			log.Info("refreshed registry credentials from pull secret", "registry", name, "secret", key.String())
+		}
+		p.creds[name] = c
+	}
+}
+
+// pullSecretKey returns the namespaced name of the Secret referenced by ref,
+// defaulting to namespace.
+func pullSecretKey(ref *corev1.SecretReference, namespace string) types.NamespacedName {
+	key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	if key.Namespace == "" {
+		key.Namespace = namespace
+	}
+
+	return key
+}
+
+// credentialsFromPullSecret returns the credentials for hostname from a Secret
+// of type kubernetes.io/dockerconfigjson. Entries match the registry host of
+// hostname, with or without scheme, or a repository prefix of it; the longest
+// match wins.
+func credentialsFromPullSecret(secret *corev1.Secret, hostname string) (*RegistryCredentials, error) {
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		return nil, fmt.Errorf("secret is of type %q, expected %q", secret.Type, corev1.SecretTypeDockerConfigJson)
+	}
+
+	var cfg struct {
+		Auths map[string]authn.AuthConfig `json:"auths"`
+	}
+	if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse key %q: %w", corev1.DockerConfigJsonKey, err)
+	}
+
+	var (
+		match string
+		creds *RegistryCredentials
+	)
+	for server, ac := range cfg.Auths {
+		server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+		server = strings.TrimSuffix(strings.TrimSuffix(server, "/"), "/v1")
+		if server != hostname && !strings.HasPrefix(hostname, server+"/") {
+			continue
+		}
+		if len(server) <= len(match) {
+			continue
+		}
+
+		match = server
+		creds = &RegistryCredentials{Username: ac.Username, Password: ac.Password}
+	}
+
+	if creds == nil {
+		return nil, fmt.Errorf("no credentials for %q", hostname)
+	}
+
+	return creds, nil
+}
+
+// loadTLS reads the Secrets referenced by the TLS settings of reg.
+func loadTLS(ctx context.Context, secretClient corev1client.CoreV1Interface, namespace string, reg *solarv1alpha1.Registry) (*RegistryTLS, error) {
+	spec := reg.Spec.TLS
+	t := &RegistryTLS{InsecureSkipVerify: spec.InsecureSkipVerify}
+
+	if spec.CASecretRef != nil {
+		secret, err := secretClient.Secrets(namespace).Get(ctx, spec.CASecretRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA secret %q for registry %q: %w", spec.CASecretRef.Name, reg.Name, err)
+		}
+
+		ca, ok := secret.Data[SecretKeyCA]
+		if !ok || len(ca) == 0 {
+			return nil, fmt.Errorf("CA secret %q for registry %q is missing key %q", spec.CASecretRef.Name, reg.Name, SecretKeyCA)
+		}
+
+		t.CA = ca
+	}
+
+	if spec.ClientCertSecretRef != nil {
+		secret, err := secretClient.Secrets(namespace).Get(ctx, spec.ClientCertSecretRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client certificate secret %q for registry %q: %w", spec.ClientCertSecretRef.Name, reg.Name, err)
+		}
+
+		for _, key := range []string{SecretKeyTLSCert, SecretKeyTLSKey} {
+			if len(secret.Data[key]) == 0 {
+				return nil, fmt.Errorf("client certificate secret %q for registry %q is missing key %q", spec.ClientCertSecretRef.Name, reg.Name, key)
+			}
+		}
+
+		t.Cert = secret.Data[SecretKeyTLSCert]
+		t.Key = secret.Data[SecretKeyTLSKey]
+	}
+
+	// Fail early on malformed PEM data instead of on the first request.
+	if _, err := t.TLSConfig(); err != nil {
+		return nil, fmt.Errorf("invalid TLS settings for registry %q: %w", reg.Name, err)
+	}
+
+	return t, nil
+}
+
 // Register adds or replaces a registry entry directly. Primarily used in tests.
 func (p *RegistryProvider) Register(reg *solarv1alpha1.Registry, creds *RegistryCredentials) error {
 	p.mux.Lock()
@@ -112,6 +387,20 @@ func (p *RegistryProvider) Get(name string) *solarv1alpha1.Registry {
 	return p.registries[name]
 }
 
+// GetByHostname retrieves a registry by its Spec.Hostname. Returns nil if not found.
+func (p *RegistryProvider) GetByHostname(hostname string) *solarv1alpha1.Registry {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+
+	for _, reg := range p.registries {
+		if reg.Spec.Hostname == hostname {
+			return reg
+		}
+	}
+
+	return nil
+}
+
 // GetCredentials returns the resolved credentials for the named registry, or
 // nil if the registry has no SolarSecretRef or was not found.
 func (p *RegistryProvider) GetCredentials(name string) *RegistryCredentials {
@@ -121,6 +410,93 @@ func (p *RegistryProvider) GetCredentials(name string) *RegistryCredentials {
 	return p.creds[name]
 }
 
+// GetWebhookSecret returns the shared webhook secret for the named registry, or
+// nil if the registry has no WebhookAuth or was not found.
+func (p *RegistryProvider) GetWebhookSecret(name string) []byte {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+
+	return p.webhookKey[name]
+}
+
+// GetTLS returns the resolved TLS settings for the named registry, or nil if
+// the registry has no TLS settings or was not found.
+func (p *RegistryProvider) GetTLS(name string) *RegistryTLS {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+
+	return p.tls[name]
+}
+
+// ScanOffset returns how long the scans of the named registry are delayed to
+// spread the scans of all registries over the stagger window. The registries
+// in scan mode are ordered by name and each gets an equal share of the
+// window, so the offsets are stable across restarts. It returns zero without
+// a stagger window or if the registry is not scanned.
+func (p *RegistryProvider) ScanOffset(name string) time.Duration {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+
+	if p.stagger <= 0 {
+		return 0
+	}
+
+	var scanned []string
+	for n, reg := range p.registries {
+		if IsScanned(reg) {
+			scanned = append(scanned, n)
+		}
+	}
+	slices.Sort(scanned)
+
+	i, found := slices.BinarySearch(scanned, name)
+	if !found {
+		return 0
+	}
+
+	return p.stagger * time.Duration(i) / time.Duration(len(scanned))
+}
+
+// PartitionLimits returns the limits the pipeline stages process the events
+// of the named registry with, taken from its DiscoveryLimits. Registries
+// without a MaxConcurrency are processed by the given number of workers.
+func (p *RegistryProvider) PartitionLimits(name string, workers int) PartitionLimits {
+	registry := p.Get(name)
+	if registry == nil || registry.Spec.DiscoveryLimits == nil {
+		return PartitionLimits{Concurrency: workers}
+	}
+
+	limits := registry.Spec.DiscoveryLimits
+	out := PartitionLimits{
+		Burst:       int(limits.Burst),
+		Concurrency: int(limits.MaxConcurrency),
+	}
+	if out.Concurrency < 1 {
+		out.Concurrency = workers
+	}
+	if limits.RequestInterval != nil {
+		out.Interval = limits.RequestInterval.Duration
+	}
+
+	return out
+}
+
+// PartitionLimitsFunc returns the limits function of WithPartitions for
+// pipeline stages processing each registry independently. It reads workers
+// on every call, so a stage may change its number of workers after it
+// passed the function to its Runner.
+func (p *RegistryProvider) PartitionLimitsFunc(workers *int) func(string) PartitionLimits {
+	return func(name string) PartitionLimits {
+		return p.PartitionLimits(name, *workers)
+	}
+}
+
+// IsScanned reports whether the discovery worker periodically scans reg,
+// either every ScanInterval or on its ScanSchedule.
+func IsScanned(reg *solarv1alpha1.Registry) bool {
+	return reg.Spec.ScanSchedule != "" || (reg.Spec.ScanInterval != nil && reg.Spec.ScanInterval.Duration > 0)
+}
+
 // GetAll returns a snapshot of all registered registries.
 func (p *RegistryProvider) GetAll() []*solarv1alpha1.Registry {
 	p.mux.RLock()
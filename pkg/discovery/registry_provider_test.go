@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	solarfake "go.opendefense.cloud/solar/client-go/clientset/versioned/fake"
@@ -92,6 +95,23 @@ var _ = Describe("RegistryProvider", func() {
 		})
 	})
 
+	Describe("GetByHostname", func() {
+		It("returns the registry with the given hostname", func() {
+			Expect(provider.Register(newTestRegistry("first", "one.example.com"), nil)).To(Succeed())
+			Expect(provider.Register(newTestRegistry("second", "two.example.com:5000"), nil)).To(Succeed())
+
+			result := provider.GetByHostname("two.example.com:5000")
+			Expect(result).NotTo(BeNil())
+			Expect(result.Name).To(Equal("second"))
+		})
+
+		It("returns nil if no registry has the hostname", func() {
+			Expect(provider.Register(newTestRegistry("first", "one.example.com"), nil)).To(Succeed())
+
+			Expect(provider.GetByHostname("two.example.com")).To(BeNil())
+		})
+	})
+
 	Describe("GetCredentials", func() {
 		It("returns nil when no credentials were registered", func() {
 			reg := newTestRegistry("no-creds", "example.com")
@@ -119,6 +139,42 @@ var _ = Describe("RegistryProvider", func() {
 		})
 	})
 
+	Describe("ScanOffset", func() {
+		scanned := func(name string) *solarv1alpha1.Registry {
+			reg := newTestRegistry(name, name+".example.com")
+			reg.Spec.ScanInterval = &metav1.Duration{Duration: time.Hour}
+
+			return reg
+		}
+
+		It("spreads the scanned registries evenly over the stagger window", func() {
+			provider = NewRegistryProvider(WithScanStagger(time.Hour))
+			for _, name := range []string{"c", "a", "b", "d"} {
+				Expect(provider.Register(scanned(name), nil)).To(Succeed())
+			}
+			webhookOnly := newTestRegistry("webhook", "webhook.example.com")
+			Expect(provider.Register(webhookOnly, nil)).To(Succeed())
+			scheduled := newTestRegistry("e", "e.example.com")
+			scheduled.Spec.ScanSchedule = "@daily"
+			Expect(provider.Register(scheduled, nil)).To(Succeed())
+
+			Expect(provider.ScanOffset("a")).To(BeZero())
+			Expect(provider.ScanOffset("b")).To(Equal(12 * time.Minute))
+			Expect(provider.ScanOffset("c")).To(Equal(24 * time.Minute))
+			Expect(provider.ScanOffset("d")).To(Equal(36 * time.Minute))
+			Expect(provider.ScanOffset("e")).To(Equal(48 * time.Minute))
+			Expect(provider.ScanOffset("webhook")).To(BeZero())
+			Expect(provider.ScanOffset("unknown")).To(BeZero())
+		})
+
+		It("returns zero without a stagger window", func() {
+			Expect(provider.Register(scanned("a"), nil)).To(Succeed())
+			Expect(provider.Register(scanned("b"), nil)).To(Succeed())
+
+			Expect(provider.ScanOffset("b")).To(BeZero())
+		})
+	})
+
 	Describe("Concurrency", func() {
 		It("supports concurrent Register and Get operations", func() {
 			const count = 50
@@ -228,6 +284,96 @@ var _ = Describe("RegistryProvider", func() {
 			Expect(err.Error()).To(ContainSubstring("bad-secret"))
 		})
 
+		It("loads the shared webhook secret of a registry with webhook auth", func() {
+			reg := newTestRegistry("hook-reg", "registry.example.com")
+			reg.Namespace = ns
+			reg.Spec.WebhookAuth = &solarv1alpha1.WebhookAuth{
+				Type:      solarv1alpha1.WebhookAuthTypeHMAC,
+				SecretRef: corev1.LocalObjectReference{Name: "hook-secret"},
+			}
+			secret := newSecret("hook-secret", map[string][]byte{SecretKeyWebhookToken: []byte("s3cr3t")})
+
+			solarClient := solarfake.NewSimpleClientset(reg)
+			k8sClient := k8sfake.NewSimpleClientset(secret)
+
+			err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.GetWebhookSecret("hook-reg")).To(Equal([]byte("s3cr3t")))
+			Expect(provider.GetCredentials("hook-reg")).To(BeNil())
+		})
+
+		It("returns an error when the webhook token key is missing from the secret", func() {
+			reg := newTestRegistry("hook-reg", "registry.example.com")
+			reg.Namespace = ns
+			reg.Spec.WebhookAuth = &solarv1alpha1.WebhookAuth{
+				Type:      solarv1alpha1.WebhookAuthTypeBearer,
+				SecretRef: corev1.LocalObjectReference{Name: "hook-secret"},
+			}
+			secret := newSecret("hook-secret", map[string][]byte{SecretKeyPassword: []byte("s3cr3t")})
+
+			solarClient := solarfake.NewSimpleClientset(reg)
+			k8sClient := k8sfake.NewSimpleClientset(secret)
+
+			err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(SecretKeyWebhookToken))
+			Expect(err.Error()).To(ContainSubstring("hook-secret"))
+		})
+
+		It("loads the TLS settings of a registry", func() {
+			cert, key := newTestCertificate()
+			reg := newTestRegistry("tls-reg", "registry.example.com")
+			reg.Namespace = ns
+			reg.Spec.TLS = &solarv1alpha1.RegistryTLS{
+				CASecretRef:         &corev1.LocalObjectReference{Name: "tls-ca"},
+				ClientCertSecretRef: &corev1.LocalObjectReference{Name: "tls-client"},
+				InsecureSkipVerify:  true,
+			}
+			ca := newSecret("tls-ca", map[string][]byte{SecretKeyCA: cert})
+			client := newSecret("tls-client", map[string][]byte{SecretKeyTLSCert: cert, SecretKeyTLSKey: key})
+
+			solarClient := solarfake.NewSimpleClientset(reg)
+			k8sClient := k8sfake.NewSimpleClientset(ca, client)
+
+			err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.GetTLS("tls-reg")).To(Equal(&RegistryTLS{CA: cert, Cert: cert, Key: key, InsecureSkipVerify: true}))
+			Expect(provider.GetCredentials("tls-reg")).To(BeNil())
+		})
+
+		It("returns an error when the client key is missing from the secret", func() {
+			cert, _ := newTestCertificate()
+			reg := newTestRegistry("tls-reg", "registry.example.com")
+			reg.Namespace = ns
+			reg.Spec.TLS = &solarv1alpha1.RegistryTLS{
+				ClientCertSecretRef: &corev1.LocalObjectReference{Name: "tls-client"},
+			}
+			secret := newSecret("tls-client", map[string][]byte{SecretKeyTLSCert: cert})
+
+			solarClient := solarfake.NewSimpleClientset(reg)
+			k8sClient := k8sfake.NewSimpleClientset(secret)
+
+			err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(SecretKeyTLSKey))
+			Expect(err.Error()).To(ContainSubstring("tls-client"))
+		})
+
+		It("returns an error when the CA bundle is malformed", func() {
+			reg := newTestRegistry("tls-reg", "registry.example.com")
+			reg.Namespace = ns
+			reg.Spec.TLS = &solarv1alpha1.RegistryTLS{
+				CASecretRef: &corev1.LocalObjectReference{Name: "tls-ca"},
+			}
+			secret := newSecret("tls-ca", map[string][]byte{SecretKeyCA: []byte("garbage")})
+
+			solarClient := solarfake.NewSimpleClientset(reg)
+			k8sClient := k8sfake.NewSimpleClientset(secret)
+
+			err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+			Expect(err).To(MatchError(ContainSubstring("invalid TLS settings for registry \"tls-reg\"")))
+		})
+
 		It("returns an error when the referenced secret does not exist", func() {
 			reg := newRegistryWithSecret("missing-secret-reg", "ghost-secret")
 
@@ -261,5 +407,180 @@ var _ = Describe("RegistryProvider", func() {
 			Expect(provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)).To(Succeed())
 			Expect(provider.GetCredentials("reload-reg").Username).To(Equal("user2"))
 		})
+
+		Describe("pull secrets", func() {
+			newPullSecret := func(namespace, config string) *corev1.Secret {
+				return &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "regcred", Namespace: namespace},
+					Type:       corev1.SecretTypeDockerConfigJson,
+					Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(config)},
+				}
+			}
+
+			newRegistryWithPullSecret := func(name, hostname, namespace string) *solarv1alpha1.Registry {
+				reg := newTestRegistry(name, hostname)
+				reg.Namespace = ns
+				reg.Spec.PullSecretRef = &corev1.SecretReference{Name: "regcred", Namespace: namespace}
+
+				return reg
+			}
+
+			It("resolves the credentials of the matching registry", func() {
+				// "auth" is the base64 encoding of "robot:s3cr3t".
+				secret := newPullSecret("shared", `{"auths":{
+					"https://registry.example.com":{"username":"admin","password":"hunter2"},
+					"ghcr.io":{"username":"other","password":"other"},
+					"ghcr.io/opendefensecloud":{"auth":"cm9ib3Q6czNjcjN0"}
+				}}`)
+				example := newRegistryWithPullSecret("example", "registry.example.com", "shared")
+				ghcr := newRegistryWithPullSecret("ghcr", "ghcr.io/opendefensecloud/solar", "shared")
+
+				solarClient := solarfake.NewSimpleClientset(example, ghcr)
+				k8sClient := k8sfake.NewSimpleClientset(secret)
+
+				Expect(provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)).To(Succeed())
+				Expect(provider.GetCredentials("example")).To(Equal(&RegistryCredentials{Username: "admin", Password: "hunter2"}))
+				Expect(provider.GetCredentials("ghcr")).To(Equal(&RegistryCredentials{Username: "robot", Password: "s3cr3t"}))
+			})
+
+			It("defaults the namespace to the one of the registry", func() {
+				reg := newRegistryWithPullSecret("example", "registry.example.com", "")
+				secret := newPullSecret(ns, `{"auths":{"registry.example.com":{"username":"admin","password":"hunter2"}}}`)
+
+				solarClient := solarfake.NewSimpleClientset(reg)
+				k8sClient := k8sfake.NewSimpleClientset(secret)
+
+				Expect(provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)).To(Succeed())
+				Expect(provider.GetCredentials("example").Username).To(Equal("admin"))
+			})
+
+			It("returns an error without an entry for the registry", func() {
+				reg := newRegistryWithPullSecret("example", "registry.example.com", "shared")
+				secret := newPullSecret("shared", `{"auths":{"registry.example.com.evil":{"username":"admin","password":"hunter2"}}}`)
+
+				solarClient := solarfake.NewSimpleClientset(reg)
+				k8sClient := k8sfake.NewSimpleClientset(secret)
+
+				err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+				Expect(err).To(MatchError(ContainSubstring(`no credentials for "registry.example.com"`)))
+			})
+
+			It("returns an error for a secret of another type", func() {
+				reg := newRegistryWithPullSecret("example", "registry.example.com", "shared")
+				secret := newPullSecret("shared", `{"auths":{}}`)
+				secret.Type = corev1.SecretTypeOpaque
+
+				solarClient := solarfake.NewSimpleClientset(reg)
+				k8sClient := k8sfake.NewSimpleClientset(secret)
+
+				err := provider.LoadFromAPI(context.Background(), solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)
+				Expect(err).To(MatchError(ContainSubstring(`invalid pull secret "shared/regcred"`)))
+			})
+
+			It("refreshes the credentials when the secret changes", func(ctx SpecContext) {
+				reg := newRegistryWithPullSecret("example", "registry.example.com", "shared")
+				secret := newPullSecret("shared", `{"auths":{"registry.example.com":{"username":"user1","password":"pass1"}}}`)
+
+				solarClient := solarfake.NewSimpleClientset(reg)
+				k8sClient := k8sfake.NewSimpleClientset(secret)
+
+				Expect(provider.LoadFromAPI(ctx, solarClient.SolarV1alpha1(), k8sClient.CoreV1(), ns)).To(Succeed())
+
+				// The fake clientset drops changes made before a watch is
+				// established, so wait for it before updating the secret.
+				watching := make(chan struct{})
+				started := sync.OnceFunc(func() { close(watching) })
+				k8sClient.PrependWatchReactor("secrets", func(action k8stesting.Action) (bool, watch.Interface, error) {
+					w, err := k8sClient.Tracker().Watch(action.GetResource(), action.GetNamespace())
+					started()
+
+					return true, w, err
+				})
+
+				watchCtx, cancel := context.WithCancel(ctx)
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					provider.WatchPullSecrets(watchCtx, k8sClient)
+				}()
+				DeferCleanup(func() {
+					cancel()
+					<-done
+				})
+				Eventually(watching).Should(BeClosed())
+
+				// Secrets the registry does not reference are ignored.
+				other := newPullSecret("shared", `{"auths":{"registry.example.com":{"username":"other","password":"other"}}}`)
+				other.Name = "other"
+				_, err := k8sClient.CoreV1().Secrets("shared").Create(ctx, other, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				secret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"registry.example.com":{"username":"user2","password":"pass2"}}}`)
+				_, err = k8sClient.CoreV1().Secrets("shared").Update(ctx, secret, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(func() *RegistryCredentials {
+					return provider.GetCredentials("example")
+				}).Should(Equal(&RegistryCredentials{Username: "user2", Password: "pass2"}))
+
+				// Broken updates keep the previous credentials.
+				secret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{}}`)
+				_, err = k8sClient.CoreV1().Secrets("shared").Update(ctx, secret, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Consistently(func() *RegistryCredentials {
+					return provider.GetCredentials("example")
+				}, 200*time.Millisecond).Should(Equal(&RegistryCredentials{Username: "user2", Password: "pass2"}))
+			})
+		})
+	})
+
+	Describe("PartitionLimitsFunc", func() {
+		It("maps the registry's DiscoveryLimits to partition limits", func() {
+			Expect(provider.Register(&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "slow"},
+				Spec: solarv1alpha1.RegistrySpec{
+					Hostname: "slow.example.com",
+					DiscoveryLimits: &solarv1alpha1.DiscoveryLimits{
+						RequestInterval: &metav1.Duration{Duration: 2 * time.Second},
+						Burst:           3,
+						MaxConcurrency:  4,
+					},
+				},
+			}, nil)).To(Succeed())
+			Expect(provider.Register(newTestRegistry("fast", "fast.example.com"), nil)).To(Succeed())
+
+			var workers int
+			limits := provider.PartitionLimitsFunc(&workers)
+
+			Expect(limits("slow")).To(Equal(PartitionLimits{Interval: 2 * time.Second, Burst: 3, Concurrency: 4}))
+			Expect(limits("fast")).To(Equal(PartitionLimits{}))
+			Expect(limits("unknown")).To(Equal(PartitionLimits{}))
+		})
+
+		It("uses the current workers unless the registry sets MaxConcurrency", func() {
+			Expect(provider.Register(&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "limited"},
+				Spec: solarv1alpha1.RegistrySpec{
+					Hostname:        "limited.example.com",
+					DiscoveryLimits: &solarv1alpha1.DiscoveryLimits{MaxConcurrency: 2},
+				},
+			}, nil)).To(Succeed())
+			Expect(provider.Register(&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "throttled"},
+				Spec: solarv1alpha1.RegistrySpec{
+					Hostname:        "throttled.example.com",
+					DiscoveryLimits: &solarv1alpha1.DiscoveryLimits{Burst: 5},
+				},
+			}, nil)).To(Succeed())
+			Expect(provider.Register(newTestRegistry("plain", "plain.example.com"), nil)).To(Succeed())
+
+			var workers int
+			limits := provider.PartitionLimitsFunc(&workers)
+			workers = 8
+
+			Expect(limits("limited").Concurrency).To(Equal(2))
+			Expect(limits("throttled")).To(Equal(PartitionLimits{Burst: 5, Concurrency: 8}))
+			Expect(limits("plain")).To(Equal(PartitionLimits{Concurrency: 8}))
+		})
 	})
 })
@@ -0,0 +1,91 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newTestCertificate returns a PEM encoded self-signed certificate and its key.
+func newTestCertificate() ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "solar-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+var _ = Describe("RegistryTLS", func() {
+	It("yields no config and the default client for nil settings", func() {
+		var t *RegistryTLS
+
+		cfg, err := t.TLSConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg).To(BeNil())
+
+		client, err := t.HTTPClient()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client).To(BeIdenticalTo(http.DefaultClient))
+	})
+
+	It("trusts the CA bundle and presents the client certificate", func() {
+		cert, key := newTestCertificate()
+		t := &RegistryTLS{CA: cert, Cert: cert, Key: key}
+
+		cfg, err := t.TLSConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.RootCAs).NotTo(BeNil())
+		Expect(cfg.Certificates).To(HaveLen(1))
+		Expect(cfg.InsecureSkipVerify).To(BeFalse())
+
+		client, err := t.HTTPClient()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Transport.(*http.Transport).TLSClientConfig.Certificates).To(HaveLen(1))
+	})
+
+	It("passes through insecureSkipVerify", func() {
+		cfg, err := (&RegistryTLS{InsecureSkipVerify: true}).TLSConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.InsecureSkipVerify).To(BeTrue())
+		Expect(cfg.RootCAs).To(BeNil())
+	})
+
+	It("rejects a CA bundle without certificates", func() {
+		_, err := (&RegistryTLS{CA: []byte("not a certificate")}).TLSConfig()
+		Expect(err).To(MatchError(ContainSubstring("CA bundle")))
+	})
+
+	It("rejects a client certificate without a matching key", func() {
+		cert, _ := newTestCertificate()
+		_, otherKey := newTestCertificate()
+
+		_, err := (&RegistryTLS{Cert: cert, Key: otherKey}).TLSConfig()
+		Expect(err).To(MatchError(ContainSubstring("invalid client certificate")))
+	})
+})
@@ -5,15 +5,30 @@ package discovery
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"golang.org/x/time/rate"
 )
 
+const meterName = "go.opendefense.cloud/solar/pkg/discovery"
+
+const (
+	// defaultRetryInterval and defaultMaxRetryInterval bound the delay of
+	// retries if no backoff is configured.
+	defaultRetryInterval    = time.Second
+	defaultMaxRetryInterval = time.Minute
+)
+
 // Processor defines the interface for processing events.
 type Processor[InputEvent any, OutputEvent any] interface {
 	Process(context.Context, InputEvent) ([]OutputEvent, error)
@@ -38,6 +53,103 @@ func WithRateLimiter[InputEvent any, OutputEvent any](interval time.Duration, bu
 	}
 }
 
+// WithRetries makes the Runner retry events failing with a transient error up
+// to limit times. Retried events are queued again after the delay the error
+// asks for, or else after an exponentially growing delay starting at the
+// initial interval of WithBackoff. Errors that are not retried are published
+// to the error channel.
+func WithRetries[InputEvent any, OutputEvent any](limit int) RunnerOption[InputEvent, OutputEvent] {
+	return func(r *Runner[InputEvent, OutputEvent]) {
+		r.retries = limit
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record processed, dropped
+// and queued events, processing durations and errors. Defaults to the global
+// MeterProvider.
+func WithMeterProvider[InputEvent any, OutputEvent any](mp metric.MeterProvider) RunnerOption[InputEvent, OutputEvent] {
+	return func(r *Runner[InputEvent, OutputEvent]) {
+		r.setMeterProvider(mp)
+	}
+}
+
+// PartitionLimits bounds how events of a single partition are processed.
+type PartitionLimits struct {
+	// Interval is the minimum time between two events of the partition. Zero
+	// disables rate limiting.
+	Interval time.Duration
+	// Burst is the number of events allowed to exceed Interval. Values below 1
+	// are treated as 1.
+	Burst int
+	// Concurrency is the number of events of the partition processed in
+	// parallel. Values below 1 are treated as 1.
+	Concurrency int
+}
+
+// partitionQueueSize is the number of events buffered per partition queue,
+// or per shard queue with sharding, before dispatching blocks.
+const partitionQueueSize = 1000
+
+// partitionConfig groups the partitioning functions stored on a Runner. A nil
+// *partitionConfig means all events are processed sequentially.
+type partitionConfig[InputEvent any] struct {
+	key    func(InputEvent) string
+	limits func(string) PartitionLimits
+}
+
+// WithPartitions makes the Runner process events of different partitions
+// independently, so a slow partition does not delay the others. key returns
+// the partition of an event and limits returns the rate limit and concurrency
+// of a partition; it is called once when the first event of a partition
+// arrives. A rate limiter set with WithRateLimiter still applies to all events.
+func WithPartitions[InputEvent any, OutputEvent any](key func(InputEvent) string, limits func(string) PartitionLimits) RunnerOption[InputEvent, OutputEvent] {
+	return func(r *Runner[InputEvent, OutputEvent]) {
+		r.partitions = &partitionConfig[InputEvent]{key: key, limits: limits}
+	}
+}
+
+// WithCoalescing drops events that would repeat work already queued. key
+// returns the target an event affects and a merge key; an event is dropped
+// when the latest queued event for the same target has the same non-empty
+// merge key and has not been picked up yet. Events with an empty merge key
+// are never dropped and end coalescing for their target, so events of a
+// target keep their order. Coalescing applies to partition queues only and
+// has no effect without WithPartitions.
+func WithCoalescing[InputEvent any, OutputEvent any](key func(InputEvent) (target, mergeKey string)) RunnerOption[InputEvent, OutputEvent] {
+	return func(r *Runner[InputEvent, OutputEvent]) {
+		r.coalesce = key
+	}
+}
+
+// WithSharding keeps events of the same shard in order when a partition is
+// processed with a Concurrency above 1. key returns the shard of an event;
+// each worker of a partition gets its own queue and events are assigned to a
+// queue by the hash of their shard, so events of different shards are still
+// processed in parallel. Sharding has no effect without WithPartitions.
+//
+// Events are dispatched to the shard queues from a single loop. While the
+// queue of a shard is full, dispatching blocks, which also holds back the
+// events of all other shards and partitions until the shard's worker takes
+// an event off its queue.
+func WithSharding[InputEvent any, OutputEvent any](key func(InputEvent) string) RunnerOption[InputEvent, OutputEvent] {
+	return func(r *Runner[InputEvent, OutputEvent]) {
+		r.shard = key
+	}
+}
+
+// partition is the queues and rate limiter of a single partition. Without
+// sharding all workers of the partition share a single queue.
+type partition[InputEvent any] struct {
+	queues      []chan queuedEvent[InputEvent]
+	rateLimiter *rate.Limiter
+}
+
+// queuedEvent is an event together with the number of times it was retried.
+type queuedEvent[InputEvent any] struct {
+	ev      InputEvent
+	attempt int
+}
+
 // backoffConfig groups the exponential-backoff tuning values stored on a
 // Runner. A nil *backoffConfig means no backoff is configured.
 type backoffConfig struct {
@@ -82,6 +194,10 @@ type Runner[InputEvent any, OutputEvent any] struct {
 	inputChan   <-chan InputEvent
 	outputChan  chan<- OutputEvent
 	errChan     chan<- ErrorEvent
+	source      string
+	metrics     runnerMetrics
+	retries     int
+	retryQueue  chan queuedEvent[InputEvent]
 	logger      logr.Logger
 	stopChan    chan struct{}
 	wg          sync.WaitGroup
@@ -89,6 +205,16 @@ type Runner[InputEvent any, OutputEvent any] struct {
 	stopMu      sync.Mutex
 	rateLimiter *rate.Limiter
 	backoff     *backoffConfig
+	partitions  *partitionConfig[InputEvent]
+	lanes       map[string]*partition[InputEvent]
+	coalesce    func(InputEvent) (string, string)
+	shard       func(InputEvent) string
+	pendingMu   sync.Mutex
+	pending     map[string]string
+	// inFlight is the number of events taken from the input channel that
+	// were neither processed nor dropped yet, including events waiting for a
+	// retry.
+	inFlight atomic.Int64
 }
 
 func NewRunner[InputEvent any, OutputEvent any](
@@ -102,13 +228,20 @@ func NewRunner[InputEvent any, OutputEvent any](
 		inputChan:  inputChan,
 		outputChan: outputChan,
 		errChan:    errChan,
+		source:     fmt.Sprintf("%T", processor),
+		retryQueue: make(chan queuedEvent[InputEvent]),
 		logger:     logr.Discard(),
 		stopChan:   make(chan struct{}),
 	}
+	r.setMeterProvider(otel.GetMeterProvider())
 
 	return r
 }
 
+func (r *Runner[InputEvent, OutputEvent]) setMeterProvider(mp metric.MeterProvider) {
+	r.metrics = newRunnerMetrics(mp)
+}
+
 func (r *Runner[InputEvent, OutputEvent]) Start(ctx context.Context) error {
 	r.logger.Info("starting runner")
 	r.wg.Add(1)
@@ -133,6 +266,11 @@ func (r *Runner[InputEvent, OutputEvent]) Stop() {
 func (r *Runner[InputEvent, OutputEvent]) runLoop(ctx context.Context) {
 	defer r.wg.Done()
 
+	// Partition workers may be waiting on their rate limiter; release them
+	// once the run loop exits.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	for {
 		select {
 		case <-r.stopChan:
@@ -140,26 +278,191 @@ func (r *Runner[InputEvent, OutputEvent]) runLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case ev := <-r.inputChan:
-			r.processEvent(ctx, ev)
+			r.inFlight.Add(1)
+			r.handle(ctx, queuedEvent[InputEvent]{ev: ev})
+		case q := <-r.retryQueue:
+			r.handle(ctx, q)
+		}
+	}
+}
+
+func (r *Runner[InputEvent, OutputEvent]) handle(ctx context.Context, q queuedEvent[InputEvent]) {
+	if r.partitions == nil {
+		r.processEvent(ctx, q.ev, q.attempt)
+		r.inFlight.Add(-1)
+
+		return
+	}
+
+	r.dispatch(ctx, q)
+}
+
+// dispatch queues the event on its partition, starting the partition workers
+// when the first event of a partition arrives.
+func (r *Runner[InputEvent, OutputEvent]) dispatch(ctx context.Context, q queuedEvent[InputEvent]) {
+	if r.coalesced(q.ev) {
+		r.drop(ctx, q.ev, DropReasonCoalesced)
+		r.inFlight.Add(-1)
+
+		return
+	}
+
+	key := r.partitions.key(q.ev)
+
+	lane, ok := r.lanes[key]
+	if !ok {
+		limits := r.partitions.limits(key)
+		concurrency := max(limits.Concurrency, 1)
+
+		shards := 1
+		if r.shard != nil {
+			shards = concurrency
+		}
+		lane = &partition[InputEvent]{queues: make([]chan queuedEvent[InputEvent], shards)}
+		for i := range lane.queues {
+			lane.queues[i] = make(chan queuedEvent[InputEvent], partitionQueueSize)
+		}
+
+		if limits.Interval > 0 {
+			lane.rateLimiter = rate.NewLimiter(rate.Every(limits.Interval), max(limits.Burst, 1))
+		}
+
+		if r.lanes == nil {
+			r.lanes = make(map[string]*partition[InputEvent])
 		}
+		r.lanes[key] = lane
+
+		r.logger.V(1).Info("starting partition", "partition", key, "interval", limits.Interval, "concurrency", concurrency, "shards", shards)
+
+		for i := range concurrency {
+			r.wg.Add(1)
+			go r.partitionLoop(ctx, lane, lane.queues[i%shards])
+		}
+	}
+
+	select {
+	case lane.queues[r.shardOf(q.ev, len(lane.queues))] <- q:
+		r.metrics.queued.Add(ctx, 1, r.queueAttributes())
+	case <-r.stopChan:
+		r.drop(ctx, q.ev, DropReasonShutdown)
+	case <-ctx.Done():
+		r.drop(context.WithoutCancel(ctx), q.ev, DropReasonShutdown)
+	}
+}
+
+// shardOf returns the index of the queue out of n the event is assigned to.
+func (r *Runner[InputEvent, OutputEvent]) shardOf(ev InputEvent, n int) int {
+	if n == 1 {
+		return 0
 	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(r.shard(ev)))
+
+	return int(h.Sum32() % uint32(n)) //nolint:gosec // n is a positive worker count
 }
 
-func (r *Runner[InputEvent, OutputEvent]) processEvent(ctx context.Context, ev InputEvent) {
+func (r *Runner[InputEvent, OutputEvent]) partitionLoop(ctx context.Context, lane *partition[InputEvent], queue <-chan queuedEvent[InputEvent]) {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case <-r.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case q := <-queue:
+			r.metrics.queued.Add(ctx, -1, r.queueAttributes())
+
+			if lane.rateLimiter != nil {
+				if err := lane.rateLimiter.Wait(ctx); err != nil {
+					r.logger.Error(err, "partition rate limiter wait failed")
+					r.drop(ctx, q.ev, DropReasonCanceled)
+					r.inFlight.Add(-1)
+
+					continue
+				}
+			}
+
+			r.release(q.ev)
+			r.processEvent(ctx, q.ev, q.attempt)
+			r.inFlight.Add(-1)
+		}
+	}
+}
+
+// coalesced reports whether the event repeats the latest queued event of its
+// target and can be dropped. Otherwise it records the event as the latest
+// queued one of its target.
+func (r *Runner[InputEvent, OutputEvent]) coalesced(ev InputEvent) bool {
+	if r.coalesce == nil {
+		return false
+	}
+
+	target, mergeKey := r.coalesce(ev)
+
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+
+	if mergeKey == "" {
+		delete(r.pending, target)
+
+		return false
+	}
+	if pending, ok := r.pending[target]; ok && pending == mergeKey {
+		r.logger.V(1).Info("coalescing event with queued event", "event", ev)
+
+		return true
+	}
+	if r.pending == nil {
+		r.pending = make(map[string]string)
+	}
+	r.pending[target] = mergeKey
+
+	return false
+}
+
+// release ends coalescing for the event's target once the event is about to
+// be processed, so events arriving from now on are processed again.
+func (r *Runner[InputEvent, OutputEvent]) release(ev InputEvent) {
+	if r.coalesce == nil {
+		return
+	}
+
+	target, mergeKey := r.coalesce(ev)
+
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+
+	if mergeKey != "" && r.pending[target] == mergeKey {
+		delete(r.pending, target)
+	}
+}
+
+func (r *Runner[InputEvent, OutputEvent]) processEvent(ctx context.Context, ev InputEvent, attempt int) {
 	r.logger.Info("processing event", "event", ev)
 
 	if r.rateLimiter != nil {
 		if err := r.rateLimiter.Wait(ctx); err != nil {
 			r.logger.Error(err, "rate limiter wait failed")
+			r.drop(ctx, ev, DropReasonCanceled)
+
 			return
 		}
 	}
 
+	attrs := eventAttributes(r.source, ev)
+	start := time.Now()
 	outputEvents, err := r.Processor.Process(ctx, ev)
+	r.metrics.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+
 	if err != nil {
-		r.logger.Error(err, "failed to process event", "event", ev)
+		r.metrics.processed.Add(ctx, 1, metric.WithAttributes(append(attrs, attribute.String("result", ResultError))...))
+		r.handleError(ctx, ev, attempt, err)
+
 		return
 	}
+	r.metrics.processed.Add(ctx, 1, metric.WithAttributes(append(attrs, attribute.String("result", ResultSuccess))...))
 
 	if outputEvents == nil {
 		r.logger.Info("processor returned nil output, skipping publish", "event", ev)
@@ -176,6 +479,139 @@ func (r *Runner[InputEvent, OutputEvent]) processEvent(ctx context.Context, ev I
 	}
 }
 
+// handleError classifies the error an event failed with. Transient errors are
+// retried while retries are left; all others are published to the error
+// channel.
+func (r *Runner[InputEvent, OutputEvent]) handleError(ctx context.Context, ev InputEvent, attempt int, err error) {
+	severity, retryAfter := ClassifyError(err)
+	errEv := ErrorEvent{
+		Source:     r.source,
+		Severity:   severity,
+		RetryAfter: retryAfter,
+		Error:      err,
+		Timestamp:  time.Now().UTC(),
+	}
+	if l, ok := any(ev).(locator); ok {
+		errEv.Registry, errEv.Repository = l.location()
+	}
+
+	r.metrics.errors.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("source", errEv.Source),
+		attribute.String("registry", errEv.Registry),
+		attribute.String("severity", string(severity)),
+	))
+
+	if severity == SeverityTransient && attempt < r.retries {
+		delay := r.retryDelay(attempt, retryAfter)
+		r.logger.Info("retrying event after transient error", "event", ev, "attempt", attempt+1, "delay", delay, "error", err.Error())
+		r.retry(ctx, queuedEvent[InputEvent]{ev: ev, attempt: attempt + 1}, delay)
+
+		return
+	}
+
+	r.logger.Error(err, "failed to process event", "event", ev, "severity", severity)
+	r.drop(ctx, ev, DropReasonFailed)
+
+	if r.errChan != nil {
+		Publish(&r.logger, r.errChan, errEv)
+	}
+}
+
+// retryDelay returns how long to wait before the given retry of an event.
+func (r *Runner[InputEvent, OutputEvent]) retryDelay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	interval, maxInterval := defaultRetryInterval, defaultMaxRetryInterval
+	if r.backoff != nil {
+		interval, maxInterval = r.backoff.initialInterval, r.backoff.maxInterval
+	}
+	for range attempt {
+		if interval >= maxInterval/2 {
+			return maxInterval
+		}
+		interval *= 2
+	}
+
+	return min(interval, maxInterval)
+}
+
+// drop counts an event that is not processed any further.
+func (r *Runner[InputEvent, OutputEvent]) drop(ctx context.Context, ev InputEvent, reason string) {
+	attrs := append(eventAttributes(r.source, ev), attribute.String("reason", reason))
+	r.metrics.dropped.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+// queueAttributes returns the attributes of the queue depth of this Runner.
+// They do not depend on the event, so additions and removals always match.
+func (r *Runner[InputEvent, OutputEvent]) queueAttributes() metric.AddOption {
+	return metric.WithAttributes(attribute.String("source", r.source))
+}
+
+// retry queues q again once delay has passed.
+func (r *Runner[InputEvent, OutputEvent]) retry(ctx context.Context, q queuedEvent[InputEvent], delay time.Duration) {
+	r.metrics.queued.Add(ctx, 1, r.queueAttributes())
+	r.inFlight.Add(1)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer r.metrics.queued.Add(context.WithoutCancel(ctx), -1, r.queueAttributes())
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-r.stopChan:
+			r.drop(context.WithoutCancel(ctx), q.ev, DropReasonShutdown)
+			return
+		case <-ctx.Done():
+			r.drop(context.WithoutCancel(ctx), q.ev, DropReasonShutdown)
+			return
+		}
+
+		select {
+		case r.retryQueue <- q:
+		case <-r.stopChan:
+			r.drop(context.WithoutCancel(ctx), q.ev, DropReasonShutdown)
+		case <-ctx.Done():
+			r.drop(context.WithoutCancel(ctx), q.ev, DropReasonShutdown)
+		}
+	}()
+}
+
+// Pending returns the number of events queued for or being processed by the
+// Runner, including events waiting for a retry. A Runner with no pending
+// events is idle.
+func (r *Runner[InputEvent, OutputEvent]) Pending() int {
+	return len(r.inputChan) + int(r.inFlight.Load())
+}
+
+// Discard drops the events left in the input channel and the partition
+// queues of a stopped Runner and returns the number of events the Runner
+// did not process, including events that were waiting for a retry.
+func (r *Runner[InputEvent, OutputEvent]) Discard(ctx context.Context) int {
+	discarded := 0
+	for len(r.inputChan) > 0 {
+		r.drop(ctx, <-r.inputChan, DropReasonShutdown)
+		discarded++
+	}
+
+	for _, lane := range r.lanes {
+		for _, queue := range lane.queues {
+			for len(queue) > 0 {
+				q := <-queue
+				r.metrics.queued.Add(ctx, -1, r.queueAttributes())
+				r.drop(ctx, q.ev, DropReasonShutdown)
+			}
+		}
+	}
+
+	return discarded + int(r.inFlight.Swap(0))
+}
+
 func (r *Runner[InputEvent, OutputEvent]) Logger() logr.Logger {
 	return r.logger
 }
@@ -6,13 +6,17 @@ package discovery
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/time/rate"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -133,20 +137,20 @@ var _ = Describe("Runner.processEvent", func() {
 
 	It("skips publishing when the processor returns an error", func() {
 		proc.err = errors.New("boom")
-		r.processEvent(context.Background(), testEvent{})
+		r.processEvent(context.Background(), testEvent{}, 0)
 		Expect(output).To(BeEmpty())
 	})
 
 	It("skips publishing when the processor returns a nil result", func() {
 		proc.result = nil
-		r.processEvent(context.Background(), testEvent{})
+		r.processEvent(context.Background(), testEvent{}, 0)
 		Expect(output).To(BeEmpty())
 	})
 
 	It("does not panic when the output channel is nil", func() {
 		r := NewRunner[testEvent, testOutput](proc, input, nil, errCh)
 		proc.result = []testOutput{{N: 1}}
-		Expect(func() { r.processEvent(context.Background(), testEvent{}) }).NotTo(Panic())
+		Expect(func() { r.processEvent(context.Background(), testEvent{}, 0) }).NotTo(Panic())
 	})
 
 	It("skips processing when the rate limiter has no capacity and the context is done", func() {
@@ -156,7 +160,7 @@ var _ = Describe("Runner.processEvent", func() {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
 
-		r.processEvent(ctx, testEvent{})
+		r.processEvent(ctx, testEvent{}, 0)
 		Expect(proc.calls).To(Equal(0))
 		Expect(output).To(BeEmpty())
 	})
@@ -165,7 +169,7 @@ var _ = Describe("Runner.processEvent", func() {
 		WithRateLimiter[testEvent, testOutput](time.Millisecond, 1)(r)
 		proc.result = []testOutput{{N: 7}}
 
-		r.processEvent(context.Background(), testEvent{})
+		r.processEvent(context.Background(), testEvent{}, 0)
 		Expect(output).To(Receive(Equal(testOutput{N: 7})))
 	})
 })
@@ -195,3 +199,360 @@ var _ = Describe("WithRateLimiter", func() {
 		Expect(r.rateLimiter.Limit()).To(Equal(rate.Every(time.Hour)))
 	})
 })
+
+// blockingProcessor blocks events whose N is negative until release is closed.
+type blockingProcessor struct {
+	release chan struct{}
+	active  atomic.Int32
+	peak    atomic.Int32
+}
+
+func (p *blockingProcessor) Process(_ context.Context, ev testEvent) ([]testOutput, error) {
+	n := p.active.Add(1)
+	defer p.active.Add(-1)
+
+	for {
+		peak := p.peak.Load()
+		if n <= peak || p.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	if ev.N < 0 {
+		<-p.release
+	}
+
+	return []testOutput{{N: ev.N}}, nil
+}
+
+var _ = Describe("WithPartitions", func() {
+	var (
+		input  chan testEvent
+		output chan testOutput
+		proc   *blockingProcessor
+	)
+
+	BeforeEach(func() {
+		input = make(chan testEvent, 10)
+		output = make(chan testOutput, 10)
+		proc = &blockingProcessor{release: make(chan struct{})}
+	})
+
+	partitionOf := func(ev testEvent) string {
+		if ev.N < 0 {
+			return "slow"
+		}
+
+		return "fast"
+	}
+
+	It("does not let a blocked partition delay other partitions", func() {
+		r := NewRunner[testEvent, testOutput](proc, input, output, nil)
+		WithPartitions[testEvent, testOutput](partitionOf, func(string) PartitionLimits { return PartitionLimits{} })(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		defer r.Stop()
+		defer close(proc.release)
+
+		input <- testEvent{N: -1}
+		input <- testEvent{N: 1}
+
+		Eventually(output).Should(Receive(Equal(testOutput{N: 1})))
+		Consistently(output, 50*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("processes events of a partition with the configured concurrency", func() {
+		r := NewRunner[testEvent, testOutput](proc, input, output, nil)
+		WithPartitions[testEvent, testOutput](partitionOf, func(key string) PartitionLimits {
+			return PartitionLimits{Concurrency: 2}
+		})(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		defer r.Stop()
+
+		input <- testEvent{N: -1}
+		input <- testEvent{N: -2}
+		input <- testEvent{N: -3}
+
+		Eventually(proc.active.Load).Should(Equal(int32(2)))
+		Consistently(proc.active.Load, 50*time.Millisecond).Should(Equal(int32(2)))
+
+		close(proc.release)
+		Eventually(output).Should(HaveLen(3))
+		Expect(proc.peak.Load()).To(Equal(int32(2)))
+	})
+
+	It("rate limits each partition independently", func() {
+		close(proc.release)
+		r := NewRunner[testEvent, testOutput](proc, input, output, nil)
+		WithPartitions[testEvent, testOutput](partitionOf, func(key string) PartitionLimits {
+			if key == "slow" {
+				return PartitionLimits{Interval: time.Hour, Burst: 1}
+			}
+
+			return PartitionLimits{}
+		})(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		defer r.Stop()
+
+		input <- testEvent{N: -1}
+		input <- testEvent{N: -2}
+		input <- testEvent{N: 1}
+		input <- testEvent{N: 2}
+
+		Eventually(output).Should(HaveLen(3))
+		Consistently(output, 50*time.Millisecond).Should(HaveLen(3))
+	})
+})
+
+var _ = Describe("WithSharding", func() {
+	It("keeps events of a shard in order while processing other shards in parallel", func() {
+		input := make(chan testEvent, 10)
+		output := make(chan testOutput, 10)
+		proc := &blockingProcessor{release: make(chan struct{})}
+
+		r := NewRunner[testEvent, testOutput](proc, input, output, nil)
+		WithPartitions[testEvent, testOutput](func(testEvent) string { return "p" }, func(string) PartitionLimits {
+			return PartitionLimits{Concurrency: 4}
+		})(r)
+		// Events -1 and 2 share a shard, event 3 has its own.
+		WithSharding[testEvent, testOutput](func(ev testEvent) string {
+			if ev.N == 3 {
+				return "b"
+			}
+
+			return "a"
+		})(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		defer r.Stop()
+
+		input <- testEvent{N: -1}
+		input <- testEvent{N: 2}
+		input <- testEvent{N: 3}
+
+		Eventually(output).Should(Receive(Equal(testOutput{N: 3})))
+		Consistently(output, 50*time.Millisecond).ShouldNot(Receive())
+
+		close(proc.release)
+		Eventually(output).Should(Receive(Equal(testOutput{N: -1})))
+		Eventually(output).Should(Receive(Equal(testOutput{N: 2})))
+	})
+})
+
+var _ = Describe("WithCoalescing", func() {
+	var (
+		input  chan testEvent
+		output chan testOutput
+		proc   *blockingProcessor
+		r      *Runner[testEvent, testOutput]
+	)
+
+	BeforeEach(func() {
+		input = make(chan testEvent, 10)
+		output = make(chan testOutput, 10)
+		proc = &blockingProcessor{release: make(chan struct{})}
+
+		r = NewRunner[testEvent, testOutput](proc, input, output, nil)
+		WithPartitions[testEvent, testOutput](func(testEvent) string { return "p" }, func(string) PartitionLimits { return PartitionLimits{} })(r)
+		// Events with N == -2 can be merged, all others are processed as-is.
+		WithCoalescing[testEvent, testOutput](func(ev testEvent) (string, string) {
+			if ev.N == -2 {
+				return "t", "merge"
+			}
+
+			return "t", ""
+		})(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		DeferCleanup(r.Stop)
+
+		// Block the partition so the following events stay queued.
+		input <- testEvent{N: -1}
+		Eventually(proc.active.Load).Should(Equal(int32(1)))
+	})
+
+	queued := func() int {
+		return len(r.lanes["p"].queues[0])
+	}
+
+	It("drops events repeating a queued event", func() {
+		input <- testEvent{N: -2}
+		input <- testEvent{N: -2}
+		input <- testEvent{N: -2}
+		input <- testEvent{N: 1}
+		Eventually(queued).Should(Equal(2))
+
+		close(proc.release)
+		Eventually(output).Should(HaveLen(3))
+		Consistently(output, 50*time.Millisecond).Should(HaveLen(3))
+	})
+
+	It("keeps events queued after an event that cannot be merged", func() {
+		input <- testEvent{N: -2}
+		input <- testEvent{N: -3}
+		input <- testEvent{N: -2}
+		Eventually(queued).Should(Equal(3))
+
+		close(proc.release)
+		Eventually(output).Should(HaveLen(4))
+	})
+
+	It("processes an event again once the queued one was picked up", func() {
+		input <- testEvent{N: -2}
+		Eventually(queued).Should(Equal(1))
+
+		close(proc.release)
+		Eventually(output).Should(HaveLen(2))
+
+		input <- testEvent{N: -2}
+		Eventually(output).Should(HaveLen(3))
+	})
+})
+
+// flakyProcessor fails with err until it was called failures times.
+type flakyProcessor struct {
+	err      error
+	failures int32
+	calls    atomic.Int32
+}
+
+func (p *flakyProcessor) Process(_ context.Context, ev RepositoryEvent) ([]testOutput, error) {
+	if p.calls.Add(1) <= p.failures {
+		return nil, p.err
+	}
+
+	return []testOutput{{N: 1}}, nil
+}
+
+var _ = Describe("WithRetries", func() {
+	var (
+		input  chan RepositoryEvent
+		output chan testOutput
+		errCh  chan ErrorEvent
+		ev     = RepositoryEvent{Registry: "reg", Repository: "org/component-descriptors/comp"}
+	)
+
+	BeforeEach(func() {
+		input = make(chan RepositoryEvent, 1)
+		output = make(chan testOutput, 1)
+		errCh = make(chan ErrorEvent, 1)
+	})
+
+	start := func(proc *flakyProcessor, retries int) {
+		r := NewRunner[RepositoryEvent, testOutput](proc, input, output, errCh)
+		WithBackoff[RepositoryEvent, testOutput](time.Millisecond, 10*time.Millisecond, time.Second)(r)
+		WithRetries[RepositoryEvent, testOutput](retries)(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+		DeferCleanup(r.Stop)
+	}
+
+	It("retries events failing with a transient error", func() {
+		proc := &flakyProcessor{err: backoff.RetryAfter(0), failures: 2}
+		start(proc, 3)
+
+		input <- ev
+
+		Eventually(output).Should(Receive(Equal(testOutput{N: 1})))
+		Expect(proc.calls.Load()).To(Equal(int32(3)))
+		Expect(errCh).To(BeEmpty())
+	})
+
+	It("publishes a transient error once the retries are exhausted", func() {
+		proc := &flakyProcessor{err: context.DeadlineExceeded, failures: 10}
+		start(proc, 2)
+
+		input <- ev
+
+		var errEv ErrorEvent
+		Eventually(errCh).Should(Receive(&errEv))
+		Expect(proc.calls.Load()).To(Equal(int32(3)))
+		Expect(errEv.Severity).To(Equal(SeverityTransient))
+		Expect(errEv.Registry).To(Equal("reg"))
+		Expect(errEv.Repository).To(Equal("org/component-descriptors/comp"))
+		Expect(errEv.Source).To(Equal("*discovery.flakyProcessor"))
+	})
+
+	It("publishes permanent errors without retrying", func() {
+		proc := &flakyProcessor{err: errors.New("invalid component descriptor"), failures: 10}
+		start(proc, 3)
+
+		input <- ev
+
+		var errEv ErrorEvent
+		Eventually(errCh).Should(Receive(&errEv))
+		Expect(errEv.Severity).To(Equal(SeverityPermanent))
+		Consistently(proc.calls.Load, 50*time.Millisecond).Should(Equal(int32(1)))
+	})
+
+	It("grows the retry delay up to the maximum interval unless the error asks for one", func() {
+		r := NewRunner[RepositoryEvent, testOutput](&flakyProcessor{}, input, output, errCh)
+		WithBackoff[RepositoryEvent, testOutput](time.Second, 5*time.Second, time.Minute)(r)
+
+		Expect(r.retryDelay(0, 0)).To(Equal(time.Second))
+		Expect(r.retryDelay(2, 0)).To(Equal(4 * time.Second))
+		Expect(r.retryDelay(3, 0)).To(Equal(5 * time.Second))
+		Expect(r.retryDelay(100, 0)).To(Equal(5 * time.Second))
+		Expect(r.retryDelay(2, 30*time.Second)).To(Equal(30 * time.Second))
+	})
+})
+
+var _ = Describe("Runner.Pending and Runner.Discard", func() {
+	var (
+		meters *observabilitytest.MeterProvider
+		source = attribute.String("source", "*discovery.flakyProcessor")
+		shut   = attribute.String("reason", DropReasonShutdown)
+	)
+
+	BeforeEach(func() {
+		meters = observabilitytest.NewMeterProvider()
+	})
+
+	It("counts queued and processing events until they are processed", func() {
+		proc := &blockingProcessor{release: make(chan struct{})}
+		input := make(chan testEvent, 10)
+		output := make(chan testOutput, 10)
+		r := NewRunner[testEvent, testOutput](proc, input, output, nil)
+		Expect(r.Start(context.Background())).To(Succeed())
+		defer r.Stop()
+
+		input <- testEvent{N: -1}
+		input <- testEvent{N: 2}
+
+		Eventually(proc.active.Load).Should(Equal(int32(1)))
+		Expect(r.Pending()).To(Equal(2))
+
+		close(proc.release)
+		Eventually(r.Pending).Should(BeZero())
+		Expect(output).To(HaveLen(2))
+	})
+
+	It("counts events waiting for a retry as pending", func() {
+		proc := &flakyProcessor{err: backoff.RetryAfter(3600), failures: 1}
+		input := make(chan RepositoryEvent, 1)
+		r := NewRunner[RepositoryEvent, testOutput](proc, input, nil, nil)
+		WithMeterProvider[RepositoryEvent, testOutput](meters)(r)
+		WithRetries[RepositoryEvent, testOutput](1)(r)
+		Expect(r.Start(context.Background())).To(Succeed())
+
+		input <- RepositoryEvent{Registry: "reg"}
+
+		Eventually(proc.calls.Load).Should(Equal(int32(1)))
+		Consistently(r.Pending, 50*time.Millisecond).Should(Equal(1))
+
+		r.Stop()
+		Expect(r.Discard(context.Background())).To(Equal(1))
+		Expect(r.Pending()).To(BeZero())
+		Expect(meters.Sum("solar.discovery.events.dropped", source, shut)).To(Equal(float64(1)))
+	})
+
+	It("drops the events left in the input channel of a stopped Runner", func() {
+		input := make(chan RepositoryEvent, 3)
+		r := NewRunner[RepositoryEvent, testOutput](&flakyProcessor{}, input, nil, nil)
+		WithMeterProvider[RepositoryEvent, testOutput](meters)(r)
+		r.Stop()
+
+		input <- RepositoryEvent{Registry: "reg"}
+		input <- RepositoryEvent{Registry: "reg"}
+
+		Expect(r.Discard(context.Background())).To(Equal(2))
+		Expect(input).To(BeEmpty())
+		Expect(meters.Sum("solar.discovery.events.dropped", source, attribute.String("registry", "reg"), shut)).To(Equal(float64(2)))
+	})
+})
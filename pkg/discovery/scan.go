@@ -0,0 +1,105 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+)
+
+// ScanSummary summarizes a single scan of a registry by a RegistryScanner.
+// The component versions of the repositories found are looked up later by
+// the qualifier, so they are not part of the summary.
+type ScanSummary struct {
+	// Registry is the name of the scanned Registry.
+	Registry string `json:"registry"`
+	// StartTime is the time the scan started.
+	StartTime time.Time `json:"startTime"`
+	// Duration is how long the scan took.
+	Duration metav1.Duration `json:"duration"`
+	// Repositories is the number of repositories listed by the registry.
+	Repositories int `json:"repositories"`
+	// Components is the number of component repositories passed on to the
+	// qualifier.
+	Components int `json:"components"`
+	// Filtered is the number of repositories excluded by the repository
+	// filter of the Registry.
+	Filtered int `json:"filtered"`
+	// Skipped is the number of repositories that are not OCM component
+	// repositories.
+	Skipped int `json:"skipped"`
+	// Error is the error the scan failed with, empty if it completed.
+	Error string `json:"error,omitempty"`
+}
+
+// sameOutcome reports whether s and other found the same repositories and
+// failed with the same error, regardless of when they ran.
+func (s ScanSummary) sameOutcome(other ScanSummary) bool {
+	return s.Repositories == other.Repositories &&
+		s.Components == other.Components &&
+		s.Filtered == other.Filtered &&
+		s.Skipped == other.Skipped &&
+		s.Error == other.Error
+}
+
+// ScanRecorder reports ScanSummaries as events on the scanned Registry.
+// Registries are scanned every few seconds to minutes, so an event is only
+// emitted for the first scan and whenever the outcome of a scan differs from
+// the previous one.
+type ScanRecorder struct {
+	registries *RegistryProvider
+	recorder   events.EventRecorder
+	log        logr.Logger
+
+	mu   sync.Mutex
+	last map[string]ScanSummary
+}
+
+// NewScanRecorder returns a ScanRecorder looking up the scanned Registries in
+// registries.
+func NewScanRecorder(registries *RegistryProvider, recorder events.EventRecorder, log logr.Logger) *ScanRecorder {
+	return &ScanRecorder{
+		registries: registries,
+		recorder:   recorder,
+		log:        log,
+		last:       map[string]ScanSummary{},
+	}
+}
+
+// Record logs s and emits an event on its Registry if the outcome of the
+// scan changed. Summaries of unknown Registries are only logged.
+func (r *ScanRecorder) Record(s ScanSummary) {
+	r.log.V(1).Info("registry scan finished", "registry", s.Registry, "duration", s.Duration.Duration,
+		"repositories", s.Repositories, "components", s.Components, "filtered", s.Filtered, "skipped", s.Skipped,
+		"error", s.Error)
+
+	r.mu.Lock()
+	prev, ok := r.last[s.Registry]
+	r.last[s.Registry] = s
+	r.mu.Unlock()
+
+	if ok && prev.sameOutcome(s) {
+		return
+	}
+
+	reg := r.registries.Get(s.Registry)
+	if reg == nil {
+		return
+	}
+
+	if s.Error != "" {
+		r.recorder.Eventf(reg, nil, corev1.EventTypeWarning, "ScanFailed", "Scan",
+			"Failed to scan registry after %s: %s", s.Duration.Duration.Round(time.Millisecond), s.Error)
+
+		return
+	}
+	r.recorder.Eventf(reg, nil, corev1.EventTypeNormal, "ScanCompleted", "Scan",
+		"Scanned %d repositories in %s: %d components, %d filtered, %d skipped",
+		s.Repositories, s.Duration.Duration.Round(time.Millisecond), s.Components, s.Filtered, s.Skipped)
+}
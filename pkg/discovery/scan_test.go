@@ -0,0 +1,75 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScanRecorder", func() {
+	var (
+		recorder *events.FakeRecorder
+		scanRec  *ScanRecorder
+	)
+
+	summary := func(components int, err string) ScanSummary {
+		return ScanSummary{
+			Registry:     "reg",
+			StartTime:    time.Now(),
+			Duration:     metav1.Duration{Duration: 1500 * time.Millisecond},
+			Repositories: components + 2,
+			Components:   components,
+			Filtered:     1,
+			Skipped:      1,
+			Error:        err,
+		}
+	}
+
+	BeforeEach(func() {
+		registries := NewRegistryProvider()
+		Expect(registries.Register(&solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "reg", Namespace: "default"}}, nil)).To(Succeed())
+		recorder = events.NewFakeRecorder(10)
+		scanRec = NewScanRecorder(registries, recorder, logr.Discard())
+	})
+
+	It("emits a Normal event for a completed scan", func() {
+		scanRec.Record(summary(3, ""))
+
+		Expect(recorder.Events).To(Receive(Equal(
+			"Normal ScanCompleted Scanned 5 repositories in 1.5s: 3 components, 1 filtered, 1 skipped")))
+	})
+
+	It("emits a Warning event for a failed scan", func() {
+		scanRec.Record(summary(0, "connection refused"))
+
+		Expect(recorder.Events).To(Receive(Equal("Warning ScanFailed Failed to scan registry after 1.5s: connection refused")))
+	})
+
+	It("only emits an event when the outcome changes", func() {
+		scanRec.Record(summary(3, ""))
+		scanRec.Record(summary(3, ""))
+		scanRec.Record(summary(4, ""))
+		scanRec.Record(summary(4, "connection refused"))
+		scanRec.Record(summary(4, "connection refused"))
+
+		Expect(recorder.Events).To(HaveLen(3))
+	})
+
+	It("only logs scans of unknown registries", func() {
+		s := summary(3, "")
+		s.Registry = "unknown"
+		scanRec.Record(s)
+
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,215 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package scanner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	solarclient "go.opendefense.cloud/solar/client-go/clientset/versioned/typed/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+)
+
+// DefaultTagDiscoveryInterval is the interval used for Components whose
+// TagDiscovery does not set one.
+const DefaultTagDiscoveryInterval = 5 * time.Minute
+
+// ComponentPoller periodically lists the Components of a namespace and, for
+// every Component with TagDiscovery enabled, sends a discovery event asking
+// for the tags of the component's repository. The qualifier then lists the
+// tags matching the Component's semver constraint, and the rest of the
+// pipeline creates a ComponentVersion for every tag not known yet.
+type ComponentPoller struct {
+	client     solarclient.SolarV1alpha1Interface
+	namespace  string
+	provider   *discovery.RegistryProvider
+	eventsChan chan<- discovery.RepositoryEvent
+	logger     logr.Logger
+	resync     time.Duration
+	now        func() time.Time
+	lastPolled map[types.UID]time.Time
+	pollMutex  sync.Mutex
+	stopChan   chan struct{}
+	wg         sync.WaitGroup
+	stopped    bool
+	stopMu     sync.Mutex
+}
+
+// ComponentPollerOption describes the available options
+// for creating the ComponentPoller.
+type ComponentPollerOption func(p *ComponentPoller)
+
+// NewComponentPoller creates a new ComponentPoller for the Components in the
+// given namespace. Component registries are resolved by hostname through the
+// provider.
+func NewComponentPoller(
+	client solarclient.SolarV1alpha1Interface,
+	namespace string,
+	provider *discovery.RegistryProvider,
+	eventsChan chan<- discovery.RepositoryEvent,
+	opts ...ComponentPollerOption,
+) *ComponentPoller {
+	p := &ComponentPoller{
+		client:     client,
+		namespace:  namespace,
+		provider:   provider,
+		eventsChan: eventsChan,
+		logger:     logr.Discard(),
+		resync:     30 * time.Second, // Default resync interval
+		now:        time.Now,
+		lastPolled: make(map[types.UID]time.Time),
+		stopChan:   make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(p)
+	}
+
+	return p
+}
+
+// WithResyncInterval sets how often the Components are listed. It bounds how
+// quickly changes to a Component's TagDiscovery are picked up.
+func WithResyncInterval(d time.Duration) ComponentPollerOption {
+	return func(p *ComponentPoller) {
+		p.resync = d
+	}
+}
+
+func WithComponentPollerLogger(l logr.Logger) ComponentPollerOption {
+	return func(p *ComponentPoller) {
+		p.logger = l
+	}
+}
+
+// Start begins polling the Components in a separate goroutine.
+// The poller will continue until Stop() is called.
+func (p *ComponentPoller) Start(ctx context.Context) error {
+	p.logger.Info("starting component poller", "namespace", p.namespace, "interval", p.resync)
+
+	p.wg.Add(1)
+	go p.pollLoop(ctx)
+
+	return nil
+}
+
+// Stop gracefully stops the component poller.
+func (p *ComponentPoller) Stop() {
+	p.stopMu.Lock()
+	defer p.stopMu.Unlock()
+
+	if p.stopped {
+		return
+	}
+
+	p.logger.Info("stopping component poller")
+	p.stopped = true
+	close(p.stopChan)
+	p.wg.Wait()
+	p.logger.Info("component poller stopped")
+}
+
+func (p *ComponentPoller) pollLoop(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.resync)
+	defer ticker.Stop()
+
+	p.Poll(ctx)
+
+	for {
+		select {
+		case <-p.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Poll(ctx)
+		}
+	}
+}
+
+// Poll lists the Components once and sends a discovery event for every
+// Component whose TagDiscovery interval has elapsed since it was last polled.
+func (p *ComponentPoller) Poll(ctx context.Context) {
+	p.pollMutex.Lock()
+	defer p.pollMutex.Unlock()
+
+	list, err := p.client.Components(p.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		p.logger.Error(err, "failed to list components", "namespace", p.namespace)
+		return
+	}
+
+	now := p.now()
+	seen := make(map[types.UID]struct{}, len(list.Items))
+
+	for i := range list.Items {
+		comp := &list.Items[i]
+		if comp.Spec.TagDiscovery == nil {
+			continue
+		}
+		seen[comp.UID] = struct{}{}
+
+		if last, ok := p.lastPolled[comp.UID]; ok && now.Sub(last) < tagDiscoveryInterval(comp) {
+			continue
+		}
+
+		if p.pollComponent(comp) {
+			p.lastPolled[comp.UID] = now
+		}
+	}
+
+	// Forget Components that were deleted or had TagDiscovery disabled, so
+	// they are polled right away if it is enabled again.
+	for uid := range p.lastPolled {
+		if _, ok := seen[uid]; !ok {
+			delete(p.lastPolled, uid)
+		}
+	}
+}
+
+// pollComponent sends the discovery event for the given Component and reports
+// whether it was sent.
+func (p *ComponentPoller) pollComponent(comp *solarv1alpha1.Component) bool {
+	registry := p.provider.GetByHostname(comp.Spec.Registry)
+	if registry == nil {
+		p.logger.Info("skipping tag discovery, no registry configured for host", "component", comp.Name, "host", comp.Spec.Registry)
+		return false
+	}
+
+	// The repository of the component descriptors cannot be derived from the
+	// Component's spec reliably, as it does not tell where the base ends and
+	// the component name starts, so discovery records it.
+	repo, ok := discovery.DescriptorRepositoryOf(comp)
+	if !ok || repo == "" {
+		p.logger.Info("skipping tag discovery, no descriptor repository recorded", "component", comp.Name)
+		return false
+	}
+
+	p.logger.V(1).Info("discovering tags", "component", comp.Name, "registry", registry.Name, "repository", repo)
+
+	discovery.Publish(&p.logger, p.eventsChan, discovery.RepositoryEvent{
+		Timestamp:         time.Now().UTC(),
+		Registry:          registry.Name,
+		Repository:        repo,
+		VersionConstraint: comp.Spec.TagDiscovery.SemverConstraint,
+		Type:              discovery.EventCreated,
+	})
+
+	return true
+}
+
+func tagDiscoveryInterval(comp *solarv1alpha1.Component) time.Duration {
+	if interval := comp.Spec.TagDiscovery.Interval; interval != nil && interval.Duration > 0 {
+		return interval.Duration
+	}
+
+	return DefaultTagDiscoveryInterval
+}
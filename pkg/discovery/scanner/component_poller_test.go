@@ -0,0 +1,150 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package scanner
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/client-go/clientset/versioned/fake"
+	"go.opendefense.cloud/solar/pkg/discovery"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ComponentPoller", func() {
+	var (
+		ctx        context.Context
+		provider   *discovery.RegistryProvider
+		eventsChan chan discovery.RepositoryEvent
+		now        time.Time
+	)
+
+	newComponent := func(name, host string, td *solarv1alpha1.TagDiscovery) *solarv1alpha1.Component {
+		return &solarv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				UID:         types.UID(name),
+				Annotations: map[string]string{discovery.AnnotationDescriptorRepository: "test/component-descriptors/example.com/" + name},
+			},
+			Spec: solarv1alpha1.ComponentSpec{
+				Scheme:       "https",
+				Registry:     host,
+				Repository:   "test/example.com/" + name,
+				TagDiscovery: td,
+			},
+		}
+	}
+
+	newPoller := func(comps ...*solarv1alpha1.Component) *ComponentPoller {
+		objs := make([]runtime.Object, 0, len(comps))
+		for _, c := range comps {
+			objs = append(objs, c)
+		}
+
+		p := NewComponentPoller(fake.NewClientset(objs...).SolarV1alpha1(), "default", provider, eventsChan)
+		p.now = func() time.Time { return now }
+
+		return p
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now()
+		eventsChan = make(chan discovery.RepositoryEvent, 10)
+		provider = discovery.NewRegistryProvider()
+		Expect(provider.Register(&solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-registry"},
+			Spec:       solarv1alpha1.RegistrySpec{Hostname: "registry.example.com"},
+		}, nil)).To(Succeed())
+	})
+
+	It("should request the tags of components with tag discovery", func() {
+		p := newPoller(
+			newComponent("with-discovery", "registry.example.com", &solarv1alpha1.TagDiscovery{SemverConstraint: ">= 1.0"}),
+			newComponent("without-discovery", "registry.example.com", nil),
+		)
+
+		p.Poll(ctx)
+
+		Expect(eventsChan).To(HaveLen(1))
+		ev := <-eventsChan
+		Expect(ev.Type).To(Equal(discovery.EventCreated))
+		Expect(ev.Registry).To(Equal("my-registry"))
+		Expect(ev.Repository).To(Equal("test/component-descriptors/example.com/with-discovery"))
+		Expect(ev.Version).To(BeEmpty())
+		Expect(ev.VersionConstraint).To(Equal(">= 1.0"))
+	})
+
+	It("should only poll a component again once its interval has elapsed", func() {
+		p := newPoller(newComponent("comp", "registry.example.com", &solarv1alpha1.TagDiscovery{
+			Interval: &metav1.Duration{Duration: time.Minute},
+		}))
+
+		p.Poll(ctx)
+		Expect(eventsChan).To(HaveLen(1))
+		<-eventsChan
+
+		now = now.Add(30 * time.Second)
+		p.Poll(ctx)
+		Expect(eventsChan).To(BeEmpty())
+
+		now = now.Add(30 * time.Second)
+		p.Poll(ctx)
+		Expect(eventsChan).To(HaveLen(1))
+	})
+
+	It("should default the interval", func() {
+		p := newPoller(newComponent("comp", "registry.example.com", &solarv1alpha1.TagDiscovery{}))
+
+		p.Poll(ctx)
+		<-eventsChan
+
+		now = now.Add(DefaultTagDiscoveryInterval - time.Second)
+		p.Poll(ctx)
+		Expect(eventsChan).To(BeEmpty())
+
+		now = now.Add(time.Second)
+		p.Poll(ctx)
+		Expect(eventsChan).To(HaveLen(1))
+	})
+
+	It("should skip components whose registry is not configured", func() {
+		p := newPoller(newComponent("comp", "unknown.example.com", &solarv1alpha1.TagDiscovery{}))
+
+		p.Poll(ctx)
+
+		Expect(eventsChan).To(BeEmpty())
+		Expect(p.lastPolled).To(BeEmpty())
+	})
+
+	It("should skip components without a recorded descriptor repository", func() {
+		comp := newComponent("comp", "registry.example.com", &solarv1alpha1.TagDiscovery{})
+		comp.Annotations = nil
+		p := newPoller(comp)
+
+		p.Poll(ctx)
+
+		Expect(eventsChan).To(BeEmpty())
+		Expect(p.lastPolled).To(BeEmpty())
+	})
+
+	It("should send events periodically once started", func() {
+		p := newPoller(newComponent("comp", "registry.example.com", &solarv1alpha1.TagDiscovery{}))
+		p.resync = 10 * time.Millisecond
+		p.now = time.Now
+		DeferCleanup(p.Stop)
+
+		Expect(p.Start(ctx)).To(Succeed())
+
+		Eventually(eventsChan).Should(Receive(HaveField("Registry", "my-registry")))
+	})
+})
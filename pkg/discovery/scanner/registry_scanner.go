@@ -7,18 +7,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
+	"math/rand/v2"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/cron"
 	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/ociregistry"
 )
 
+// DefaultStallTimeout is the minimum time without a finished scan after which
+// a RegistryScanner reports itself as stuck.
+const DefaultStallTimeout = 10 * time.Minute
+
+const meterName = "go.opendefense.cloud/solar/pkg/discovery/scanner"
+
 type Scanner interface {
 	Scan(ctx context.Context, eventsChan chan<- discovery.RepositoryEvent)
 }
@@ -29,15 +42,34 @@ type RegistryScanner struct {
 	Scanner      Scanner
 	registry     *solarv1alpha1.Registry
 	creds        *discovery.RegistryCredentials
+	credsFunc    func() *discovery.RegistryCredentials
+	tls          *discovery.RegistryTLS
+	responses    *ociregistry.ResponseCache
+	retrier      *ociregistry.Retrier
 	eventsChan   chan<- discovery.RepositoryEvent
 	errChan      chan<- discovery.ErrorEvent
 	logger       logr.Logger
+	scanDuration metric.Float64Histogram
 	stopChan     chan struct{}
 	wg           sync.WaitGroup
 	scanMutex    sync.Mutex
 	scanInterval time.Duration
+	schedule     *cron.Schedule
+	jitter       time.Duration
+	offset       time.Duration
 	stopped      bool
 	stopMu       sync.Mutex
+	scanObserver func(discovery.ScanSummary)
+
+	// running is set while the scan loop runs, scanning while a scan started
+	// by the loop is in progress, and lastScan holds the time the loop was
+	// started or its last scan finished, in Unix nanoseconds.
+	running  atomic.Bool
+	scanning atomic.Bool
+	lastScan atomic.Int64
+
+	// lastSummary holds the summary of the last finished scan.
+	lastSummary atomic.Pointer[discovery.ScanSummary]
 }
 
 // Option describes the available options
@@ -64,6 +96,7 @@ func NewRegistryScanner(
 		scanInterval: 30 * time.Second, // Default scan interval
 	}
 	r.Scanner = r
+	r.setMeterProvider(otel.GetMeterProvider())
 	for _, o := range opts {
 		o(r)
 	}
@@ -71,23 +104,117 @@ func NewRegistryScanner(
 	return r
 }
 
+// WithMeterProvider sets the MeterProvider used to record scan durations.
+// Defaults to the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(r *RegistryScanner) {
+		r.setMeterProvider(mp)
+	}
+}
+
+func (rs *RegistryScanner) setMeterProvider(mp metric.MeterProvider) {
+	duration, err := mp.Meter(meterName).Float64Histogram("solar.discovery.scan.duration",
+		metric.WithDescription("Duration of a full scan of a registry, by registry."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	rs.scanDuration = duration
+}
+
 func WithScanInterval(d time.Duration) Option {
 	return func(r *RegistryScanner) {
 		r.scanInterval = d
 	}
 }
 
+// WithSchedule makes the scanner scan at the times of the cron schedule
+// instead of every scan interval.
+func WithSchedule(s *cron.Schedule) Option {
+	return func(r *RegistryScanner) {
+		r.schedule = s
+	}
+}
+
+// WithJitter delays every scan by a random duration of up to d.
+func WithJitter(d time.Duration) Option {
+	return func(r *RegistryScanner) {
+		r.jitter = d
+	}
+}
+
+// WithOffset shifts the scans by d: the initial scan of an interval-based
+// scanner is delayed by d, and every scan of a scheduled scanner runs d after
+// its scheduled time. Used to stagger scanners sharing an interval or
+// schedule, see discovery.WithScanStagger.
+func WithOffset(d time.Duration) Option {
+	return func(r *RegistryScanner) {
+		r.offset = d
+	}
+}
+
+// WithCredentialsFunc makes the scanner look up the credentials with f on
+// every scan instead of using the ones passed to NewRegistryScanner, so
+// rotated credentials are used without restarting the scanner.
+func WithCredentialsFunc(f func() *discovery.RegistryCredentials) Option {
+	return func(r *RegistryScanner) {
+		r.credsFunc = f
+	}
+}
+
+// WithTLS sets the TLS settings used to connect to the registry.
+func WithTLS(t *discovery.RegistryTLS) Option {
+	return func(r *RegistryScanner) {
+		r.tls = t
+	}
+}
+
 func WithLogger(l logr.Logger) Option {
 	return func(r *RegistryScanner) {
 		r.logger = l
 	}
 }
 
+// Registry returns the registry scanned by the RegistryScanner.
+func (rs *RegistryScanner) Registry() *solarv1alpha1.Registry {
+	return rs.registry
+}
+
 // SetScanInterval sets the interval between registry scans.
 func (rs *RegistryScanner) SetScanInterval(interval time.Duration) {
 	rs.scanInterval = interval
 }
 
+// SetScanObserver makes the scanner pass the summary of every finished scan
+// to f. Scans canceled on shutdown are not passed.
+func (rs *RegistryScanner) SetScanObserver(f func(discovery.ScanSummary)) {
+	rs.scanObserver = f
+}
+
+// LastScan returns the summary of the last finished scan, or false if no
+// scan has finished yet.
+func (rs *RegistryScanner) LastScan() (discovery.ScanSummary, bool) {
+	s := rs.lastSummary.Load()
+	if s == nil {
+		return discovery.ScanSummary{}, false
+	}
+
+	return *s, true
+}
+
+// SetResponseCache makes the registry client answer repeated manifest and
+// blob requests from the given cache.
+func (rs *RegistryScanner) SetResponseCache(c *ociregistry.ResponseCache) {
+	rs.responses = c
+}
+
+// SetRetrier makes the registry client retry requests failing with transient
+// errors, such as 429 responses of a registry under load.
+func (rs *RegistryScanner) SetRetrier(r *ociregistry.Retrier) {
+	rs.retrier = r
+}
+
 // Start begins continuous scanning of the registry in a separate goroutine.
 // The scanner will continue until Stop() is called.
 func (rs *RegistryScanner) Start(ctx context.Context) error {
@@ -95,8 +222,13 @@ func (rs *RegistryScanner) Start(ctx context.Context) error {
 		"registry", rs.registry.Name,
 		"url", rs.registry.GetURL(),
 		"interval", rs.scanInterval,
+		"schedule", rs.registry.Spec.ScanSchedule,
+		"jitter", rs.jitter,
+		"offset", rs.offset,
 	)
 
+	rs.lastScan.Store(time.Now().UnixNano())
+	rs.running.Store(true)
 	rs.wg.Add(1)
 	go rs.scanLoop(ctx)
 
@@ -119,28 +251,131 @@ func (rs *RegistryScanner) Stop() {
 	rs.logger.Info("registry scanner stopped")
 }
 
+// Healthy returns an error if the scan loop is no longer running or no scan
+// has finished for three scan intervals or scheduled scans, or
+// DefaultStallTimeout if that is longer. A scan that hangs on an unresponsive
+// registry blocks all following scans, so such a scanner never recovers on
+// its own.
+func (rs *RegistryScanner) Healthy() error {
+	if !rs.running.Load() {
+		return errors.New("scan loop is not running")
+	}
+
+	last := time.Unix(0, rs.lastScan.Load()).UTC()
+	if now := time.Now(); now.After(rs.stallDeadline(last)) {
+		return fmt.Errorf("no scan finished for %s", now.Sub(last).Round(time.Second))
+	}
+
+	return nil
+}
+
+// stallDeadline returns the time after which the scanner is considered stuck
+// if its last scan finished at last.
+func (rs *RegistryScanner) stallDeadline(last time.Time) time.Time {
+	deadline := last.Add(rs.offset + 3*rs.scanInterval)
+	if rs.schedule != nil {
+		deadline = last
+		for range 3 {
+			deadline = rs.scheduled(deadline)
+		}
+	}
+	if stall := last.Add(DefaultStallTimeout); deadline.Before(stall) {
+		deadline = stall
+	}
+
+	return deadline.Add(rs.jitter)
+}
+
+// scheduled returns the first scheduled time after t, shifted by the offset.
+func (rs *RegistryScanner) scheduled(t time.Time) time.Time {
+	next := rs.schedule.Next(t.Add(-rs.offset))
+	if next.IsZero() {
+		return next
+	}
+
+	return next.Add(rs.offset)
+}
+
+// nextScan returns the time of the scan following the one planned for prev,
+// without jitter. A zero prev plans the initial scan, which runs right away
+// unless there is an offset or a schedule. Scans missed while the previous
+// one was due are skipped. It returns the zero time if the schedule never
+// activates again.
+func (rs *RegistryScanner) nextScan(prev, now time.Time) time.Time {
+	if rs.schedule != nil {
+		if prev.Before(now) {
+			prev = now
+		}
+
+		return rs.scheduled(prev)
+	}
+
+	if prev.IsZero() {
+		return now.Add(rs.offset)
+	}
+
+	next := prev.Add(rs.scanInterval)
+	if next.Before(now) {
+		return now
+	}
+
+	return next
+}
+
+// withJitter delays t by a random duration of up to the configured jitter.
+func (rs *RegistryScanner) withJitter(t time.Time) time.Time {
+	if rs.jitter <= 0 {
+		return t
+	}
+
+	return t.Add(rand.N(rs.jitter))
+}
+
 // scanLoop continuously scans the registry and sends events to the channel.
 func (rs *RegistryScanner) scanLoop(ctx context.Context) {
 	defer rs.wg.Done()
+	defer rs.running.Store(false)
 
-	ticker := time.NewTicker(rs.scanInterval)
-	defer ticker.Stop()
-
-	// Perform initial scan immediately
-	rs.Scanner.Scan(ctx, rs.eventsChan)
+	next := rs.nextScan(time.Time{}, time.Now().UTC())
+	timer := time.NewTimer(time.Until(rs.withJitter(next)))
+	defer timer.Stop()
 
 	for {
+		if next.IsZero() {
+			rs.logger.Info("schedule does not activate anymore, stopping scan loop",
+				"registry", rs.registry.Name, "schedule", rs.registry.Spec.ScanSchedule)
+
+			return
+		}
+
 		select {
 		case <-rs.stopChan:
 			return
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
-			go rs.Scanner.Scan(ctx, rs.eventsChan)
+		case <-timer.C:
+			go rs.scan(ctx)
+
+			// Plan from the previous planned time rather than from now, so
+			// neither the jitter nor timer delays add up over time.
+			next = rs.nextScan(next, time.Now().UTC())
+			timer.Reset(time.Until(rs.withJitter(next)))
 		}
 	}
 }
 
+// scan runs a single scan unless the previous one is still in progress and
+// records when it finished.
+func (rs *RegistryScanner) scan(ctx context.Context) {
+	if !rs.scanning.CompareAndSwap(false, true) {
+		return
+	}
+	defer rs.scanning.Store(false)
+
+	rs.Scanner.Scan(ctx, rs.eventsChan)
+	rs.lastScan.Store(time.Now().UnixNano())
+}
+
 // scanRegistry performs a single scan of the registry and sends discovered events.
 func (rs *RegistryScanner) Scan(ctx context.Context, eventsChan chan<- discovery.RepositoryEvent) {
 	if !rs.scanMutex.TryLock() {
@@ -149,12 +384,21 @@ func (rs *RegistryScanner) Scan(ctx context.Context, eventsChan chan<- discovery
 	}
 	defer rs.scanMutex.Unlock()
 
+	start := time.Now()
+	defer func() {
+		rs.scanDuration.Record(ctx, time.Since(start).Seconds(),
+			metric.WithAttributes(attribute.String("registry", rs.registry.Name)))
+	}()
+	summary := discovery.ScanSummary{Registry: rs.registry.Name, StartTime: start.UTC()}
+
 	rs.logger.V(1).Info("scanning registry", "registry", rs.registry.GetURL())
 
 	// Create a registry client with credentials
 	client, err := rs.createRegistryClient()
 	if err != nil {
 		rs.logger.Error(err, "failed to create registry client", "registry", rs.registry.GetURL())
+		rs.finishScan(summary, start, err)
+
 		return
 	}
 
@@ -163,9 +407,21 @@ func (rs *RegistryScanner) Scan(ctx context.Context, eventsChan chan<- discovery
 	// and must not terminate the scan loop.
 	err = client.Repositories(ctx, "", func(repos []string) error {
 		for _, repo := range repos {
+			summary.Repositories++
+			if !discovery.RepositoryIncluded(rs.registry.Spec.RepositoryFilter, repo) {
+				rs.logger.V(2).Info("skipping filtered repository", "repo", repo)
+				summary.Filtered++
+
+				continue
+			}
+
 			if err := rs.processRepository(ctx, eventsChan, repo); err != nil {
 				rs.handleRepoError(repo, err)
+				summary.Skipped++
+
+				continue
 			}
+			summary.Components++
 		}
 
 		return nil
@@ -179,6 +435,21 @@ func (rs *RegistryScanner) Scan(ctx context.Context, eventsChan chan<- discovery
 		}
 		rs.logger.Error(err, "failed to list repositories", "registry", rs.registry.GetURL())
 	}
+	rs.finishScan(summary, start, err)
+}
+
+// finishScan completes summary with the duration of the scan started at
+// start and the error it failed with, if any, and records it as the last scan.
+func (rs *RegistryScanner) finishScan(summary discovery.ScanSummary, start time.Time, err error) {
+	summary.Duration = metav1.Duration{Duration: time.Since(start)}
+	if err != nil {
+		summary.Error = err.Error()
+	}
+
+	rs.lastSummary.Store(&summary)
+	if rs.scanObserver != nil {
+		rs.scanObserver(summary)
+	}
 }
 
 func (rs *RegistryScanner) processRepository(_ context.Context, eventsChan chan<- discovery.RepositoryEvent, repoName string) error {
@@ -207,7 +478,8 @@ func (rs *RegistryScanner) handleRepoError(repo string, err error) {
 	}
 }
 
-// createRegistryClient creates a registry client authenticated with the configured credentials.
+// createRegistryClient creates a registry client authenticated with the configured
+// credentials and TLS settings.
 func (rs *RegistryScanner) createRegistryClient() (*remote.Registry, error) {
 	reg, err := remote.NewRegistry(rs.registry.Spec.Hostname)
 	if err != nil {
@@ -215,14 +487,29 @@ func (rs *RegistryScanner) createRegistryClient() (*remote.Registry, error) {
 	}
 	reg.PlainHTTP = rs.registry.Spec.PlainHTTP
 
-	// Set up authentication if credentials are provided
-	if rs.creds != nil {
-		authClient := &auth.Client{
-			Client: http.DefaultClient,
-			Credential: auth.StaticCredential(rs.registry.Spec.Hostname, auth.Credential{
-				Username: rs.creds.Username,
-				Password: rs.creds.Password,
-			}),
+	creds := rs.creds
+	if rs.credsFunc != nil {
+		creds = rs.credsFunc()
+	}
+
+	// Set up authentication, TLS, retries and caching if configured
+	if creds != nil || rs.tls != nil || rs.responses != nil || rs.retrier != nil {
+		httpClient, err := rs.tls.HTTPClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create http client: %w", err)
+		}
+		if rs.retrier != nil {
+			httpClient = rs.retrier.Client(httpClient)
+		}
+		if rs.responses != nil {
+			httpClient = rs.responses.Client(httpClient)
+		}
+		authClient := &auth.Client{Client: httpClient}
+		if creds != nil {
+			authClient.Credential = auth.StaticCredential(rs.registry.Spec.Hostname, auth.Credential{
+				Username: creds.Username,
+				Password: creds.Password,
+			})
 		}
 		reg.Client = authClient
 	}
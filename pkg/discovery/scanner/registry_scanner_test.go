@@ -14,11 +14,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/cron"
 	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
 	"go.opendefense.cloud/solar/test"
 	"go.opendefense.cloud/solar/test/registry"
 
@@ -198,6 +204,99 @@ var _ = Describe("RegistryScanner", Ordered, func() {
 	})
 })
 
+var _ = Describe("scan metrics", func() {
+	It("should record the duration of a scan by registry", func() {
+		testServer := httptest.NewServer(registry.New().HandleFunc())
+		DeferCleanup(testServer.Close)
+
+		testServerUrl, err := url.Parse(testServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		meters := observabilitytest.NewMeterProvider()
+		scanner := NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-registry"},
+				Spec: solarv1alpha1.RegistrySpec{
+					Hostname:  testServerUrl.Host,
+					PlainHTTP: true,
+				},
+			},
+			nil, make(chan discovery.RepositoryEvent, 1), nil,
+			WithMeterProvider(meters),
+		)
+
+		scanner.Scan(context.Background(), make(chan discovery.RepositoryEvent, 1))
+
+		Expect(meters.Count("solar.discovery.scan.duration", attribute.String("registry", "my-registry"))).To(Equal(1))
+	})
+})
+
+var _ = Describe("scan summaries", func() {
+	It("should count the repositories of a scan", func() {
+		testServer := httptest.NewServer(registry.New().HandleFunc())
+		DeferCleanup(testServer.Close)
+
+		testServerUrl, err := url.Parse(testServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, repo := range []string{"team/component-descriptors/app", "legacy/component-descriptors/old", "images/nginx"} {
+			ref, err := name.ParseReference(testServerUrl.Host+"/"+repo+":1.0.0", name.Insecure)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(ref, empty.Image)).To(Succeed())
+		}
+
+		var observed []discovery.ScanSummary
+		scanner := NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-registry"},
+				Spec: solarv1alpha1.RegistrySpec{
+					Hostname:         testServerUrl.Host,
+					PlainHTTP:        true,
+					RepositoryFilter: &solarv1alpha1.RepositoryFilter{ExcludePrefixes: []string{"legacy/"}},
+				},
+			},
+			nil, nil, nil,
+		)
+		scanner.SetScanObserver(func(s discovery.ScanSummary) { observed = append(observed, s) })
+
+		_, ok := scanner.LastScan()
+		Expect(ok).To(BeFalse())
+
+		scanner.Scan(context.Background(), make(chan discovery.RepositoryEvent, 3))
+
+		last, ok := scanner.LastScan()
+		Expect(ok).To(BeTrue())
+		Expect(observed).To(Equal([]discovery.ScanSummary{last}))
+		Expect(last.Registry).To(Equal("my-registry"))
+		Expect(last.Repositories).To(Equal(3))
+		Expect(last.Components).To(Equal(1))
+		Expect(last.Filtered).To(Equal(1))
+		Expect(last.Skipped).To(Equal(1))
+		Expect(last.Error).To(BeEmpty())
+		Expect(last.Duration.Duration).To(BeNumerically(">", 0))
+	})
+
+	It("should record the error of a failed scan", func() {
+		testServer := httptest.NewServer(registry.New().HandleFunc())
+		testServerUrl, err := url.Parse(testServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+		testServer.Close()
+
+		scanner := NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-registry"},
+				Spec:       solarv1alpha1.RegistrySpec{Hostname: testServerUrl.Host, PlainHTTP: true},
+			},
+			nil, nil, nil,
+		)
+		scanner.Scan(context.Background(), make(chan discovery.RepositoryEvent, 1))
+
+		last, ok := scanner.LastScan()
+		Expect(ok).To(BeTrue())
+		Expect(last.Error).To(ContainSubstring("connection refused"))
+	})
+})
+
 var _ = Describe("handleRepoError", func() {
 	var (
 		eventsChan chan discovery.RepositoryEvent
@@ -284,3 +383,143 @@ var _ = Describe("scan interval configuration", func() {
 		Expect(scanner.scanInterval).To(Equal(90 * time.Second))
 	})
 })
+
+var _ = Describe("scan planning", func() {
+	newScanner := func(opts ...Option) *RegistryScanner {
+		return NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				Spec: solarv1alpha1.RegistrySpec{Hostname: "registry.example.com", PlainHTTP: true},
+			},
+			nil,
+			make(chan discovery.RepositoryEvent, 1),
+			make(chan discovery.ErrorEvent, 1),
+			opts...,
+		)
+	}
+
+	now := time.Date(2026, time.March, 4, 10, 17, 0, 0, time.UTC)
+
+	mustParse := func(spec string) *cron.Schedule {
+		s, err := cron.Parse(spec)
+		Expect(err).NotTo(HaveOccurred())
+
+		return s
+	}
+
+	It("should scan right away and then every interval", func() {
+		s := newScanner(WithScanInterval(time.Hour))
+
+		first := s.nextScan(time.Time{}, now)
+		Expect(first).To(Equal(now))
+		Expect(s.nextScan(first, now.Add(time.Second))).To(Equal(now.Add(time.Hour)))
+	})
+
+	It("should delay the initial scan by the offset", func() {
+		s := newScanner(WithScanInterval(time.Hour), WithOffset(10*time.Minute))
+
+		first := s.nextScan(time.Time{}, now)
+		Expect(first).To(Equal(now.Add(10 * time.Minute)))
+		Expect(s.nextScan(first, first)).To(Equal(now.Add(70 * time.Minute)))
+	})
+
+	It("should skip interval scans that were missed", func() {
+		s := newScanner(WithScanInterval(time.Minute))
+
+		Expect(s.nextScan(now, now.Add(time.Hour))).To(Equal(now.Add(time.Hour)))
+	})
+
+	It("should scan at the scheduled times shifted by the offset", func() {
+		s := newScanner(WithSchedule(mustParse("0 2 * * *")), WithOffset(5*time.Minute))
+
+		first := s.nextScan(time.Time{}, now)
+		Expect(first).To(Equal(time.Date(2026, time.March, 5, 2, 5, 0, 0, time.UTC)))
+		Expect(s.nextScan(first, first.Add(time.Second))).To(Equal(first.AddDate(0, 0, 1)))
+	})
+
+	It("should stop planning once a schedule no longer activates", func() {
+		s := newScanner(WithSchedule(mustParse("0 0 30 2 *")))
+
+		Expect(s.nextScan(time.Time{}, now)).To(BeZero())
+	})
+
+	It("should delay scans by up to the jitter", func() {
+		s := newScanner(WithJitter(time.Minute))
+
+		for range 100 {
+			Expect(s.withJitter(now)).To(BeTemporally(">=", now))
+			Expect(s.withJitter(now)).To(BeTemporally("<", now.Add(time.Minute)))
+		}
+		Expect(newScanner().withJitter(now)).To(Equal(now))
+	})
+
+	It("should allow three scheduled scans before reporting a stall", func() {
+		s := newScanner(WithSchedule(mustParse("0 9 * * 1-5")), WithJitter(time.Minute))
+
+		// Friday morning: the third following scan is on Wednesday.
+		friday := time.Date(2026, time.March, 6, 9, 0, 0, 0, time.UTC)
+		Expect(s.stallDeadline(friday)).To(Equal(time.Date(2026, time.March, 11, 9, 1, 0, 0, time.UTC)))
+	})
+
+	It("should allow at least the stall timeout between interval scans", func() {
+		s := newScanner(WithScanInterval(time.Minute), WithOffset(time.Minute))
+
+		Expect(s.stallDeadline(now)).To(Equal(now.Add(DefaultStallTimeout)))
+		Expect(newScanner(WithScanInterval(time.Hour), WithOffset(time.Minute)).stallDeadline(now)).To(Equal(now.Add(181 * time.Minute)))
+	})
+})
+
+type hangingScanner struct {
+	started chan struct{}
+}
+
+func (s *hangingScanner) Scan(ctx context.Context, _ chan<- discovery.RepositoryEvent) {
+	close(s.started)
+	<-ctx.Done()
+}
+
+var _ = Describe("Healthy", func() {
+	var scanner *RegistryScanner
+
+	BeforeEach(func() {
+		scanner = NewRegistryScanner(
+			&solarv1alpha1.Registry{
+				Spec: solarv1alpha1.RegistrySpec{Hostname: "registry.example.com", PlainHTTP: true},
+			},
+			nil,
+			make(chan discovery.RepositoryEvent, 1),
+			make(chan discovery.ErrorEvent, 1),
+			WithScanInterval(time.Minute),
+		)
+	})
+
+	It("should report a scanner that was not started", func() {
+		Expect(scanner.Healthy()).To(MatchError("scan loop is not running"))
+	})
+
+	It("should report a scan that hangs for longer than the stall timeout", func() {
+		hanging := &hangingScanner{started: make(chan struct{})}
+		scanner.Scanner = hanging
+
+		ctx, cancel := context.WithCancel(context.Background())
+		Expect(scanner.Start(ctx)).To(Succeed())
+		DeferCleanup(func() {
+			cancel()
+			scanner.Stop()
+		})
+		Eventually(hanging.started).Should(BeClosed())
+		Expect(scanner.Healthy()).To(Succeed())
+
+		scanner.lastScan.Store(time.Now().Add(-DefaultStallTimeout - time.Minute).UnixNano())
+		Expect(scanner.Healthy()).To(MatchError(ContainSubstring("no scan finished for 11m")))
+	})
+
+	It("should report a scanner whose loop has stopped", func() {
+		scanner.Scanner = &hangingScanner{started: make(chan struct{})}
+		ctx, cancel := context.WithCancel(context.Background())
+		Expect(scanner.Start(ctx)).To(Succeed())
+		cancel()
+		scanner.Stop()
+
+		Expect(scanner.Healthy()).To(MatchError("scan loop is not running"))
+	})
+})
@@ -0,0 +1,184 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // sha1 is only offered for registries that cannot sign with anything else
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+const (
+	// DefaultSignatureHeader is the header checked for HMAC signatures if the
+	// registry does not configure one.
+	DefaultSignatureHeader = "X-Hub-Signature-256"
+	// DefaultSignatureAlgorithm is the HMAC hash algorithm used if the registry
+	// does not configure one.
+	DefaultSignatureAlgorithm = "sha256"
+
+	// maxWebhookBodyBytes limits how much of a request body is buffered for
+	// signature verification.
+	maxWebhookBodyBytes = 10 << 20
+
+	rejectReasonMissingCredentials = "missing_credentials"
+	rejectReasonInvalidCredentials = "invalid_credentials"
+	rejectReasonInvalidBody        = "invalid_body"
+)
+
+var signatureAlgorithms = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// authHandler authenticates requests according to a registry's WebhookAuth
+// before passing them on to the flavor handler.
+type authHandler struct {
+	registry string
+	authType solarv1alpha1.WebhookAuthType
+	secret   []byte
+	header   string
+	algo     string
+	newHash  func() hash.Hash
+	rejected metric.Int64Counter
+	next     http.Handler
+}
+
+func newAuthHandler(reg *solarv1alpha1.Registry, secret []byte, rejected metric.Int64Counter, next http.Handler) (http.Handler, error) {
+	auth := reg.Spec.WebhookAuth
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("no webhook secret available for registry %q", reg.Name)
+	}
+
+	h := &authHandler{
+		registry: reg.Name,
+		authType: auth.Type,
+		secret:   secret,
+		rejected: rejected,
+		next:     next,
+	}
+
+	switch auth.Type {
+	case solarv1alpha1.WebhookAuthTypeHMAC:
+		h.header = auth.SignatureHeader
+		if h.header == "" {
+			h.header = DefaultSignatureHeader
+		}
+
+		h.algo = auth.Algorithm
+		if h.algo == "" {
+			h.algo = DefaultSignatureAlgorithm
+		}
+
+		newHash, ok := signatureAlgorithms[h.algo]
+		if !ok {
+			return nil, fmt.Errorf("unsupported signature algorithm %q for registry %q", h.algo, reg.Name)
+		}
+
+		h.newHash = newHash
+	case solarv1alpha1.WebhookAuthTypeBearer:
+	default:
+		return nil, fmt.Errorf("unsupported webhook auth type %q for registry %q", auth.Type, reg.Name)
+	}
+
+	return h, nil
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var reason string
+
+	switch h.authType {
+	case solarv1alpha1.WebhookAuthTypeHMAC:
+		reason = h.verifySignature(w, r)
+	case solarv1alpha1.WebhookAuthTypeBearer:
+		reason = h.verifyBearer(r)
+	}
+
+	if reason != "" {
+		h.reject(w, r, reason)
+
+		return
+	}
+
+	h.next.ServeHTTP(w, r)
+}
+
+// verifySignature checks the HMAC signature of the request body and restores
+// the body for the next handler. It returns the reject reason, if any.
+func (h *authHandler) verifySignature(w http.ResponseWriter, r *http.Request) string {
+	signature := strings.TrimSpace(r.Header.Get(h.header))
+	if signature == "" {
+		return rejectReasonMissingCredentials
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		return rejectReasonInvalidBody
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// GitHub-style headers prefix the digest with the algorithm, e.g. "sha256=<hex>".
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, h.algo+"="))
+	if err != nil {
+		return rejectReasonInvalidCredentials
+	}
+
+	mac := hmac.New(h.newHash, h.secret)
+	mac.Write(body)
+
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return rejectReasonInvalidCredentials
+	}
+
+	return ""
+}
+
+// verifyBearer checks the bearer token in the Authorization header. It returns
+// the reject reason, if any.
+func (h *authHandler) verifyBearer(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return rejectReasonMissingCredentials
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), h.secret) != 1 {
+		return rejectReasonInvalidCredentials
+	}
+
+	return ""
+}
+
+func (h *authHandler) reject(w http.ResponseWriter, r *http.Request, reason string) {
+	logger := logr.FromContextOrDiscard(r.Context())
+	logger.Info("rejected unauthenticated webhook request", "registry", h.registry, "reason", reason, "remoteAddr", r.RemoteAddr)
+
+	h.rejected.Add(r.Context(), 1, metric.WithAttributes(
+		attribute.String("registry", h.registry),
+		attribute.String("reason", reason),
+	))
+
+	if reason == rejectReasonInvalidBody {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+
+		return
+	}
+
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
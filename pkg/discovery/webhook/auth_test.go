@@ -0,0 +1,220 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhook authentication", func() {
+	const (
+		secret = "s3cr3t"
+		body   = `{"name":"myapp","reference":"v1.0.0"}`
+	)
+
+	var (
+		router   *WebhookRouter
+		meters   *observabilitytest.MeterProvider
+		received []byte
+	)
+
+	newRegistry := func(auth *solarv1alpha1.WebhookAuth) *solarv1alpha1.Registry {
+		return &solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-registry"},
+			Spec: solarv1alpha1.RegistrySpec{
+				Flavor:      "auth-flavor",
+				WebhookPath: "my-registry",
+				WebhookAuth: auth,
+			},
+		}
+	}
+
+	sign := func(payload string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(payload))
+
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	newRequest := func() *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/webhook/my-registry", bytes.NewReader([]byte(body)))
+	}
+
+	BeforeEach(func() {
+		UnregisterAllHandlers()
+		received = nil
+		RegisterHandler("auth-flavor", func(_ *solarv1alpha1.Registry, _ chan<- discovery.RepositoryEvent) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusAccepted)
+			})
+		})
+
+		meters = observabilitytest.NewMeterProvider()
+		router = NewWebhookRouter(make(chan discovery.RepositoryEvent, 1))
+		router.WithMeterProvider(meters)
+		router.WithWebhookSecrets(func(name string) []byte {
+			if name == "my-registry" {
+				return []byte(secret)
+			}
+
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		UnregisterAllHandlers()
+	})
+
+	Describe("HMAC", func() {
+		BeforeEach(func() {
+			Expect(router.RegisterPath(newRegistry(&solarv1alpha1.WebhookAuth{
+				Type:      solarv1alpha1.WebhookAuthTypeHMAC,
+				SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+			}))).To(Succeed())
+		})
+
+		It("should pass requests with a valid signature and an intact body", func() {
+			req := newRequest()
+			req.Header.Set(DefaultSignatureHeader, sign(body))
+
+			Expect(serve(req).Code).To(Equal(http.StatusAccepted))
+			Expect(string(received)).To(Equal(body))
+			Expect(meters.Sum("solar.discovery.webhook.rejected")).To(BeZero())
+			Expect(meters.Sum("solar.discovery.webhook.received",
+				attribute.String("registry", "my-registry"),
+				attribute.String("flavor", "auth-flavor"),
+			)).To(Equal(float64(1)))
+		})
+
+		It("should accept signatures without the algorithm prefix", func() {
+			req := newRequest()
+			req.Header.Set(DefaultSignatureHeader, sign(body)[len("sha256="):])
+
+			Expect(serve(req).Code).To(Equal(http.StatusAccepted))
+		})
+
+		It("should reject requests without a signature", func() {
+			Expect(serve(newRequest()).Code).To(Equal(http.StatusUnauthorized))
+			Expect(received).To(BeNil())
+			Expect(meters.Sum("solar.discovery.webhook.rejected",
+				attribute.String("registry", "my-registry"),
+				attribute.String("reason", rejectReasonMissingCredentials),
+			)).To(Equal(float64(1)))
+			Expect(meters.Sum("solar.discovery.webhook.received", attribute.String("registry", "my-registry"))).To(Equal(float64(1)))
+		})
+
+		It("should reject requests with a signature over a different body", func() {
+			req := newRequest()
+			req.Header.Set(DefaultSignatureHeader, sign(`{"name":"other"}`))
+
+			Expect(serve(req).Code).To(Equal(http.StatusUnauthorized))
+			Expect(received).To(BeNil())
+			Expect(meters.Sum("solar.discovery.webhook.rejected",
+				attribute.String("reason", rejectReasonInvalidCredentials),
+			)).To(Equal(float64(1)))
+		})
+
+		It("should reject malformed signatures", func() {
+			req := newRequest()
+			req.Header.Set(DefaultSignatureHeader, "sha256=not-hex")
+
+			Expect(serve(req).Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	It("should honour a custom signature header and algorithm", func() {
+		Expect(router.RegisterPath(newRegistry(&solarv1alpha1.WebhookAuth{
+			Type:            solarv1alpha1.WebhookAuthTypeHMAC,
+			SecretRef:       corev1.LocalObjectReference{Name: "webhook-secret"},
+			SignatureHeader: "X-Signature",
+			Algorithm:       "sha512",
+		}))).To(Succeed())
+
+		mac := hmac.New(sha512.New, []byte(secret))
+		mac.Write([]byte(body))
+
+		req := newRequest()
+		req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+		Expect(serve(req).Code).To(Equal(http.StatusAccepted))
+
+		req = newRequest()
+		req.Header.Set(DefaultSignatureHeader, sign(body))
+		Expect(serve(req).Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	Describe("Bearer", func() {
+		BeforeEach(func() {
+			Expect(router.RegisterPath(newRegistry(&solarv1alpha1.WebhookAuth{
+				Type:      solarv1alpha1.WebhookAuthTypeBearer,
+				SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+			}))).To(Succeed())
+		})
+
+		It("should pass requests with the shared token", func() {
+			req := newRequest()
+			req.Header.Set("Authorization", "Bearer "+secret)
+
+			Expect(serve(req).Code).To(Equal(http.StatusAccepted))
+			Expect(string(received)).To(Equal(body))
+		})
+
+		It("should reject requests with a wrong or missing token", func() {
+			req := newRequest()
+			req.Header.Set("Authorization", "Bearer wrong")
+			Expect(serve(req).Code).To(Equal(http.StatusUnauthorized))
+
+			req = newRequest()
+			req.Header.Set("Authorization", "Basic "+secret)
+			Expect(serve(req).Code).To(Equal(http.StatusUnauthorized))
+
+			Expect(received).To(BeNil())
+			Expect(meters.Sum("solar.discovery.webhook.rejected", attribute.String("registry", "my-registry"))).To(Equal(float64(2)))
+		})
+	})
+
+	It("should refuse to register a registry whose webhook secret is unknown", func() {
+		reg := newRegistry(&solarv1alpha1.WebhookAuth{
+			Type:      solarv1alpha1.WebhookAuthTypeBearer,
+			SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+		})
+		reg.Name = "other-registry"
+
+		Expect(router.RegisterPath(reg)).To(MatchError(ContainSubstring("no webhook secret")))
+		Expect(router.paths).NotTo(HaveKey("my-registry"))
+	})
+
+	It("should refuse to register a registry with an unsupported algorithm", func() {
+		Expect(router.RegisterPath(newRegistry(&solarv1alpha1.WebhookAuth{
+			Type:      solarv1alpha1.WebhookAuthTypeHMAC,
+			SecretRef: corev1.LocalObjectReference{Name: "webhook-secret"},
+			Algorithm: "md5",
+		}))).To(MatchError(ContainSubstring("unsupported signature algorithm")))
+	})
+})
@@ -0,0 +1,159 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cloudevents implements a registry-agnostic webhook flavor accepting
+// repository events as CloudEvents, so any registry or CI system able to emit
+// CloudEvents can feed discovery without a bespoke handler.
+package cloudevents
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-logr/logr"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/discovery/webhook"
+)
+
+type WebhookHandler struct {
+	registry *solarv1alpha1.Registry
+	channel  chan<- discovery.RepositoryEvent
+}
+
+const (
+	name = "cloudevents"
+
+	// EventTypePrefix is the prefix of the CloudEvent types accepted by the
+	// handler. A type consists of the prefix, the action and the version of
+	// the data schema, e.g. "cloud.opendefense.solar.discovery.repository.updated.v1".
+	EventTypePrefix = "cloud.opendefense.solar.discovery.repository."
+
+	// SchemaVersionV1 is the version of the RepositoryEventData schema.
+	SchemaVersionV1 = "v1"
+
+	EventTypeCreatedV1 = EventTypePrefix + "created." + SchemaVersionV1
+	EventTypeUpdatedV1 = EventTypePrefix + "updated." + SchemaVersionV1
+	EventTypeDeletedV1 = EventTypePrefix + "deleted." + SchemaVersionV1
+)
+
+func init() {
+	webhook.RegisterHandler(name, NewHandler)
+}
+
+func NewHandler(registry *solarv1alpha1.Registry, out chan<- discovery.RepositoryEvent) http.Handler {
+	wh := &WebhookHandler{
+		registry: registry,
+		channel:  out,
+	}
+
+	return wh
+}
+
+// RepositoryEventData is the data of a CloudEvent in version v1 of the
+// schema. New fields may be added to a schema version as long as they are
+// optional; anything else requires a new version.
+type RepositoryEventData struct {
+	// Repository is the name of the repository in the registry.
+	Repository string `json:"repository"`
+	// Version is the tag of the image, if the event concerns a single image.
+	Version string `json:"version,omitempty"`
+	// Digest is the digest of the image, if known.
+	Digest string `json:"digest,omitempty"`
+}
+
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+	logger.Info("handling request", "path", r.URL.Path)
+
+	// Handles both the structured and the binary content mode.
+	cloudEvent, err := cloudevents.NewEventFromHTTPRequest(r)
+	if err != nil {
+		logger.Error(err, "failed to parse CloudEvent from request")
+		http.Error(w, "invalid cloud event", http.StatusBadRequest)
+
+		return
+	}
+
+	eventType, err := mapEventType(cloudEvent.Type())
+	if err != nil {
+		logger.Info("rejecting CloudEvent", "type", cloudEvent.Type(), "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	var data RepositoryEventData
+	if err := cloudEvent.DataAs(&data); err != nil {
+		logger.Error(err, "failed to parse data from CloudEvent request")
+		http.Error(w, "invalid data payload", http.StatusBadRequest)
+
+		return
+	}
+
+	if data.Repository == "" {
+		logger.Error(errors.New("missing fields"), "fields in CloudEvent data missing", "data", data)
+		http.Error(w, "invalid data payload", http.StatusBadRequest)
+
+		return
+	}
+
+	if !discovery.RepositoryIncluded(wh.registry.Spec.RepositoryFilter, data.Repository) {
+		logger.V(1).Info("skipping event of filtered repository", "repository", data.Repository)
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	repoEvent := discovery.RepositoryEvent{
+		Type:       eventType,
+		Registry:   wh.registry.Name,
+		Repository: data.Repository,
+		Version:    data.Version,
+		Digest:     data.Digest,
+		Timestamp:  cloudEvent.Time(),
+	}
+
+	select {
+	case wh.channel <- repoEvent:
+		w.WriteHeader(http.StatusAccepted)
+	case <-r.Context().Done():
+		logger.Error(r.Context().Err(), "request context cancelled")
+		http.Error(w, "timeout", http.StatusServiceUnavailable)
+	default:
+		logger.Error(nil, "event channel full, dropping event")
+		http.Error(w, "server busy", http.StatusServiceUnavailable)
+	}
+}
+
+// mapEventType maps a CloudEvent type to a discovery.EventType, rejecting
+// foreign types and schema versions the handler does not understand.
+func mapEventType(ceType string) (discovery.EventType, error) {
+	rest, ok := strings.CutPrefix(ceType, EventTypePrefix)
+	if !ok {
+		return "", fmt.Errorf("unknown event type %q, expected prefix %q", ceType, EventTypePrefix)
+	}
+
+	action, version, ok := strings.Cut(rest, ".")
+	if !ok {
+		return "", fmt.Errorf("event type %q lacks a schema version", ceType)
+	}
+	if version != SchemaVersionV1 {
+		return "", fmt.Errorf("unsupported schema version %q of event type %q, supported: %s", version, ceType, SchemaVersionV1)
+	}
+
+	switch action {
+	case "created":
+		return discovery.EventCreated, nil
+	case "updated":
+		return discovery.EventUpdated, nil
+	case "deleted":
+		return discovery.EventDeleted, nil
+	default:
+		return "", fmt.Errorf("unknown event type %q", ceType)
+	}
+}
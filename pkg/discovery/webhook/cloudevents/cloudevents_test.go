@@ -0,0 +1,175 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/discovery/webhook"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCloudEventsWebhook(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CloudEvents Webhook Handler Suite")
+}
+
+var _ = Describe("CloudEvents Webhook Handler", func() {
+	var (
+		eventsChan    chan discovery.RepositoryEvent
+		webhookRouter *webhook.WebhookRouter
+	)
+
+	BeforeEach(func() {
+		eventsChan = make(chan discovery.RepositoryEvent, 10)
+		webhookRouter = webhook.NewWebhookRouter(eventsChan)
+
+		Expect(webhookRouter.RegisterPath(&solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-ci"},
+			Spec: solarv1alpha1.RegistrySpec{
+				Hostname:    "registry.example.com",
+				Flavor:      "cloudevents",
+				WebhookPath: "cloudevents",
+				RepositoryFilter: &solarv1alpha1.RepositoryFilter{
+					ExcludePrefixes: []string{"internal/"},
+				},
+			},
+		})).To(Succeed())
+	})
+
+	newEvent := func(eventType string, data any) cloudevents.Event {
+		event := cloudevents.NewEvent()
+		event.SetSource("https://ci.example.com/")
+		event.SetType(eventType)
+		event.SetID("test-event")
+		event.SetTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+		Expect(event.SetData(cloudevents.ApplicationJSON, data)).To(Succeed())
+
+		return event
+	}
+
+	structured := func(event cloudevents.Event) *httptest.ResponseRecorder {
+		body, err := json.Marshal(event)
+		Expect(err).NotTo(HaveOccurred())
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook/cloudevents", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/cloudevents+json")
+		w := httptest.NewRecorder()
+		webhookRouter.ServeHTTP(w, req)
+
+		return w
+	}
+
+	binary := func(event cloudevents.Event) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/webhook/cloudevents", bytes.NewReader(event.Data()))
+		req.Header.Set("Ce-Specversion", event.SpecVersion())
+		req.Header.Set("Ce-Type", event.Type())
+		req.Header.Set("Ce-Source", event.Source())
+		req.Header.Set("Ce-Id", event.ID())
+		req.Header.Set("Ce-Time", event.Time().Format(time.RFC3339))
+		req.Header.Set("Content-Type", cloudevents.ApplicationJSON)
+		w := httptest.NewRecorder()
+		webhookRouter.ServeHTTP(w, req)
+
+		return w
+	}
+
+	It("should accept events in structured mode", func() {
+		w := structured(newEvent(EventTypeUpdatedV1, RepositoryEventData{
+			Repository: "component-descriptors/example.com/app",
+			Version:    "v1.0.0",
+			Digest:     "sha256:abc123",
+		}))
+		Expect(w.Code).To(Equal(http.StatusAccepted))
+
+		var event discovery.RepositoryEvent
+		Expect(eventsChan).To(Receive(&event))
+		Expect(event).To(Equal(discovery.RepositoryEvent{
+			Type:       discovery.EventUpdated,
+			Registry:   "test-ci",
+			Repository: "component-descriptors/example.com/app",
+			Version:    "v1.0.0",
+			Digest:     "sha256:abc123",
+			Timestamp:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		}))
+	})
+
+	It("should accept events in binary mode", func() {
+		w := binary(newEvent(EventTypeDeletedV1, RepositoryEventData{
+			Repository: "component-descriptors/example.com/app",
+			Version:    "v1.0.0",
+		}))
+		Expect(w.Code).To(Equal(http.StatusAccepted))
+
+		var event discovery.RepositoryEvent
+		Expect(eventsChan).To(Receive(&event))
+		Expect(event.Type).To(Equal(discovery.EventDeleted))
+		Expect(event.Repository).To(Equal("component-descriptors/example.com/app"))
+		Expect(event.Version).To(Equal("v1.0.0"))
+	})
+
+	It("should map the created action", func() {
+		Expect(binary(newEvent(EventTypeCreatedV1, RepositoryEventData{Repository: "app"})).Code).
+			To(Equal(http.StatusAccepted))
+
+		var event discovery.RepositoryEvent
+		Expect(eventsChan).To(Receive(&event))
+		Expect(event.Type).To(Equal(discovery.EventCreated))
+	})
+
+	It("should reject unsupported schema versions", func() {
+		w := structured(newEvent(EventTypePrefix+"updated.v2", RepositoryEventData{Repository: "app"}))
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+		Expect(w.Body.String()).To(ContainSubstring(`unsupported schema version "v2"`))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should reject event types without a schema version", func() {
+		w := structured(newEvent(EventTypePrefix+"updated", RepositoryEventData{Repository: "app"}))
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should reject foreign and unknown event types", func() {
+		Expect(structured(newEvent("zotregistry.image.updated", RepositoryEventData{Repository: "app"})).Code).
+			To(Equal(http.StatusBadRequest))
+		Expect(structured(newEvent(EventTypePrefix+"renamed.v1", RepositoryEventData{Repository: "app"})).Code).
+			To(Equal(http.StatusBadRequest))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should reject events without a repository", func() {
+		Expect(structured(newEvent(EventTypeUpdatedV1, RepositoryEventData{Version: "v1.0.0"})).Code).
+			To(Equal(http.StatusBadRequest))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should reject requests that are no CloudEvents", func() {
+		req := httptest.NewRequest(http.MethodPost, "/webhook/cloudevents", bytes.NewReader([]byte(`{"repository":"app"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		webhookRouter.ServeHTTP(w, req)
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should skip events of filtered repositories", func() {
+		Expect(structured(newEvent(EventTypeUpdatedV1, RepositoryEventData{Repository: "internal/app"})).Code).
+			To(Equal(http.StatusNoContent))
+		Expect(eventsChan).To(BeEmpty())
+	})
+})
@@ -80,6 +80,13 @@ func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !discovery.RepositoryIncluded(wh.registry.Spec.RepositoryFilter, data.Repository) {
+		logger.V(1).Info("skipping event of filtered repository", "repository", data.Repository)
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
 	var version string
 	if data.Version != nil {
 		version = *data.Version
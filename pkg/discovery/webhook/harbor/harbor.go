@@ -0,0 +1,170 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package harbor
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/discovery/webhook"
+)
+
+type WebhookHandler struct {
+	registry *solarv1alpha1.Registry
+	channel  chan<- discovery.RepositoryEvent
+}
+
+const (
+	name = "harbor"
+
+	HarborEventTypePushArtifact   = "PUSH_ARTIFACT"
+	HarborEventTypeDeleteArtifact = "DELETE_ARTIFACT"
+)
+
+func init() {
+	webhook.RegisterHandler(name, NewHandler)
+}
+
+func NewHandler(registry *solarv1alpha1.Registry, out chan<- discovery.RepositoryEvent) http.Handler {
+	wh := &WebhookHandler{
+		registry: registry,
+		channel:  out,
+	}
+
+	return wh
+}
+
+// HarborEvent is the default (non-CloudEvents) payload Harbor sends to webhook endpoints.
+type HarborEvent struct {
+	Type      string          `json:"type"`
+	OccurAt   int64           `json:"occur_at"`
+	Operator  string          `json:"operator"`
+	EventData HarborEventData `json:"event_data"`
+}
+
+type HarborEventData struct {
+	Resources  []HarborResource `json:"resources"`
+	Repository HarborRepository `json:"repository"`
+}
+
+type HarborResource struct {
+	Digest      string `json:"digest"`
+	Tag         string `json:"tag"`
+	ResourceURL string `json:"resource_url"`
+}
+
+type HarborRepository struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	RepoFullName string `json:"repo_full_name"`
+	RepoType     string `json:"repo_type"`
+}
+
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logr.FromContextOrDiscard(r.Context())
+	logger.Info("handling request", "path", r.URL.Path)
+
+	var event HarborEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		logger.Error(err, "failed to parse Harbor event from request")
+		http.Error(w, "invalid harbor event", http.StatusBadRequest)
+
+		return
+	}
+
+	repository := event.EventData.Repository.RepoFullName
+	if repository == "" && event.EventData.Repository.Namespace != "" && event.EventData.Repository.Name != "" {
+		repository = event.EventData.Repository.Namespace + "/" + event.EventData.Repository.Name
+	}
+
+	if repository == "" || len(event.EventData.Resources) == 0 {
+		logger.Error(errors.New("missing fields"), "fields in Harbor event data missing", "event", event)
+		http.Error(w, "invalid data payload", http.StatusBadRequest)
+
+		return
+	}
+
+	if !discovery.RepositoryIncluded(wh.registry.Spec.RepositoryFilter, repository) {
+		logger.V(1).Info("skipping event of filtered repository", "repository", repository)
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	var eventType discovery.EventType
+
+	switch event.Type {
+	case HarborEventTypePushArtifact:
+		eventType = discovery.EventUpdated
+	case HarborEventTypeDeleteArtifact:
+		eventType = discovery.EventDeleted
+	default:
+		logger.Info("unknown event type, ignoring", "type", event.Type)
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	timestamp := time.Now().UTC()
+	if event.OccurAt > 0 {
+		timestamp = time.Unix(event.OccurAt, 0).UTC()
+	}
+
+	var repoEvents []discovery.RepositoryEvent
+
+	for _, res := range event.EventData.Resources {
+		version := res.Tag
+		if version == "" {
+			// Pushes by digest carry no tag and are followed by a tagged push we process
+			// instead. Deletes are passed through with the digest since the downstream
+			// pipeline resolves the ComponentVersion via a digest label.
+			if eventType != discovery.EventDeleted {
+				logger.V(1).Info("skipping untagged artifact", "digest", res.Digest, "repository", repository)
+
+				continue
+			}
+
+			version = res.Digest
+		}
+
+		repoEvents = append(repoEvents, discovery.RepositoryEvent{
+			Type:       eventType,
+			Registry:   wh.registry.Name,
+			Repository: repository,
+			Version:    version,
+			Digest:     res.Digest,
+			Timestamp:  timestamp,
+		})
+	}
+
+	if len(repoEvents) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	for _, repoEvent := range repoEvents {
+		select {
+		case wh.channel <- repoEvent:
+		case <-r.Context().Done():
+			logger.Error(r.Context().Err(), "request context cancelled")
+			http.Error(w, "timeout", http.StatusServiceUnavailable)
+
+			return
+		default:
+			logger.Error(nil, "event channel full, dropping event")
+			http.Error(w, "server busy", http.StatusServiceUnavailable)
+
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
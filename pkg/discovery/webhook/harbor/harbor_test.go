@@ -0,0 +1,193 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package harbor
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/discovery/webhook"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHarborWebhook(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Harbor Webhook Handler Suite")
+}
+
+var _ = Describe("Harbor Webhook Handler", func() {
+	var (
+		eventsChan    chan discovery.RepositoryEvent
+		webhookRouter *webhook.WebhookRouter
+	)
+
+	BeforeEach(func() {
+		eventsChan = make(chan discovery.RepositoryEvent, 10)
+		webhookRouter = webhook.NewWebhookRouter(eventsChan)
+
+		harborRegistry := &solarv1alpha1.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-harbor"},
+			Spec: solarv1alpha1.RegistrySpec{
+				Hostname:    "harbor.example.com",
+				Flavor:      "harbor",
+				WebhookPath: "harbor",
+			},
+		}
+		Expect(webhookRouter.RegisterPath(harborRegistry)).To(Succeed())
+	})
+
+	send := func(event HarborEvent) *httptest.ResponseRecorder {
+		body, err := json.Marshal(event)
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		webhookRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook/harbor", bytes.NewReader(body)))
+
+		return rec
+	}
+
+	repository := HarborRepository{
+		Name:         "myapp",
+		Namespace:    "library",
+		RepoFullName: "library/myapp",
+		RepoType:     "private",
+	}
+
+	It("should translate PUSH_ARTIFACT events into update events", func() {
+		rec := send(HarborEvent{
+			Type:     HarborEventTypePushArtifact,
+			OccurAt:  1700000000,
+			Operator: "admin",
+			EventData: HarborEventData{
+				Resources: []HarborResource{{
+					Digest:      "sha256:abc123",
+					Tag:         "v1.0.0",
+					ResourceURL: "harbor.example.com/library/myapp:v1.0.0",
+				}},
+				Repository: repository,
+			},
+		})
+		Expect(rec.Code).To(Equal(http.StatusAccepted))
+
+		var ev discovery.RepositoryEvent
+		Expect(eventsChan).To(Receive(&ev))
+		Expect(ev.Type).To(Equal(discovery.EventUpdated))
+		Expect(ev.Registry).To(Equal("test-harbor"))
+		Expect(ev.Repository).To(Equal("library/myapp"))
+		Expect(ev.Version).To(Equal("v1.0.0"))
+		Expect(ev.Digest).To(Equal("sha256:abc123"))
+		Expect(ev.Timestamp).To(Equal(time.Unix(1700000000, 0).UTC()))
+	})
+
+	It("should translate DELETE_ARTIFACT events into delete events and keep the digest", func() {
+		rec := send(HarborEvent{
+			Type: HarborEventTypeDeleteArtifact,
+			EventData: HarborEventData{
+				Resources:  []HarborResource{{Digest: "sha256:abc123"}},
+				Repository: repository,
+			},
+		})
+		Expect(rec.Code).To(Equal(http.StatusAccepted))
+
+		var ev discovery.RepositoryEvent
+		Expect(eventsChan).To(Receive(&ev))
+		Expect(ev.Type).To(Equal(discovery.EventDeleted))
+		Expect(ev.Version).To(Equal("sha256:abc123"))
+		Expect(ev.Digest).To(Equal("sha256:abc123"))
+	})
+
+	It("should emit one event per tagged resource", func() {
+		rec := send(HarborEvent{
+			Type: HarborEventTypePushArtifact,
+			EventData: HarborEventData{
+				Resources: []HarborResource{
+					{Digest: "sha256:abc123", Tag: "v1.0.0"},
+					{Digest: "sha256:abc123", Tag: "latest"},
+				},
+				Repository: repository,
+			},
+		})
+		Expect(rec.Code).To(Equal(http.StatusAccepted))
+		Expect(eventsChan).To(HaveLen(2))
+	})
+
+	It("should fall back to namespace and name when repo_full_name is missing", func() {
+		rec := send(HarborEvent{
+			Type: HarborEventTypePushArtifact,
+			EventData: HarborEventData{
+				Resources:  []HarborResource{{Digest: "sha256:abc123", Tag: "v1.0.0"}},
+				Repository: HarborRepository{Name: "myapp", Namespace: "library"},
+			},
+		})
+		Expect(rec.Code).To(Equal(http.StatusAccepted))
+
+		var ev discovery.RepositoryEvent
+		Expect(eventsChan).To(Receive(&ev))
+		Expect(ev.Repository).To(Equal("library/myapp"))
+	})
+
+	It("should skip pushes without a tag", func() {
+		rec := send(HarborEvent{
+			Type: HarborEventTypePushArtifact,
+			EventData: HarborEventData{
+				Resources:  []HarborResource{{Digest: "sha256:abc123"}},
+				Repository: repository,
+			},
+		})
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should ignore unknown event types", func() {
+		rec := send(HarborEvent{
+			Type: "PULL_ARTIFACT",
+			EventData: HarborEventData{
+				Resources:  []HarborResource{{Digest: "sha256:abc123", Tag: "v1.0.0"}},
+				Repository: repository,
+			},
+		})
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should reject payloads without repository or resources", func() {
+		rec := send(HarborEvent{Type: HarborEventTypePushArtifact})
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(eventsChan).To(BeEmpty())
+	})
+
+	It("should reject malformed JSON", func() {
+		rec := httptest.NewRecorder()
+		webhookRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook/harbor", bytes.NewReader([]byte("{"))))
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should return 503 when the event channel is full", func() {
+		full := make(chan discovery.RepositoryEvent)
+		h := NewHandler(&solarv1alpha1.Registry{ObjectMeta: metav1.ObjectMeta{Name: "test-harbor"}}, full)
+
+		body, err := json.Marshal(HarborEvent{
+			Type: HarborEventTypePushArtifact,
+			EventData: HarborEventData{
+				Resources:  []HarborResource{{Digest: "sha256:abc123", Tag: "v1.0.0"}},
+				Repository: repository,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook/harbor", bytes.NewReader(body)))
+		Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+	})
+})
@@ -0,0 +1,216 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// RateLimitKey selects the source a token bucket of RequestLimits is kept for.
+type RateLimitKey string
+
+const (
+	// RateLimitByRemoteAddr keeps a token bucket per webhook path and remote
+	// address, so a single misbehaving client cannot starve the others.
+	RateLimitByRemoteAddr RateLimitKey = "remoteAddr"
+	// RateLimitByRegistry keeps a single token bucket per webhook path, i.e.
+	// per registry, regardless of where its requests come from.
+	RateLimitByRegistry RateLimitKey = "registry"
+)
+
+const (
+	rejectReasonBodyTooLarge = "body_too_large"
+	rejectReasonRateLimited  = "rate_limited"
+
+	// bucketSweepInterval is how often token buckets that refilled completely
+	// are dropped, which keeps the number of buckets keyed by remote address
+	// bounded.
+	bucketSweepInterval = time.Minute
+)
+
+// DefaultRequestLimits are the RequestLimits of a WebhookRouter unless
+// configured otherwise.
+var DefaultRequestLimits = RequestLimits{
+	MaxBodyBytes: 1 << 20,
+	Rate:         50,
+	Burst:        100,
+	Key:          RateLimitByRemoteAddr,
+}
+
+// RequestLimits bound the requests accepted on each webhook path, so a
+// misbehaving registry cannot run the worker out of memory.
+type RequestLimits struct {
+	// MaxBodyBytes is the maximum size of a request body. Larger requests are
+	// answered with 413. Values below 1 disable the limit.
+	MaxBodyBytes int64
+	// Rate is the number of requests per second accepted from a source, see
+	// Key. Further requests are answered with 429. Values below or equal to 0
+	// disable rate limiting.
+	Rate rate.Limit
+	// Burst is the number of requests accepted from a source at once.
+	Burst int
+	// Key selects the source requests are rate limited by. Defaults to
+	// RateLimitByRemoteAddr.
+	Key RateLimitKey
+}
+
+// Validate returns an error if l cannot be enforced.
+func (l RequestLimits) Validate() error {
+	switch l.Key {
+	case "", RateLimitByRemoteAddr, RateLimitByRegistry:
+	default:
+		return fmt.Errorf("unknown rate limit key %q, must be %q or %q", l.Key, RateLimitByRemoteAddr, RateLimitByRegistry)
+	}
+	if l.Rate > 0 && l.Burst < 1 {
+		return fmt.Errorf("rate limit burst must be at least 1, got %d", l.Burst)
+	}
+
+	return nil
+}
+
+// requestLimiter enforces RequestLimits with token buckets keyed by webhook
+// path and, depending on the limits, remote address.
+type requestLimiter struct {
+	mu        sync.Mutex
+	limits    RequestLimits
+	buckets   map[string]*rate.Limiter
+	lastSweep time.Time
+}
+
+func newRequestLimiter(limits RequestLimits) *requestLimiter {
+	return &requestLimiter{
+		limits:  limits,
+		buckets: make(map[string]*rate.Limiter),
+	}
+}
+
+// set replaces the limits and drops all token buckets.
+func (l *requestLimiter) set(limits RequestLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limits = limits
+	l.buckets = make(map[string]*rate.Limiter)
+}
+
+func (l *requestLimiter) get() RequestLimits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.limits
+}
+
+// allow takes a token from the bucket of the request to path. If the bucket
+// is empty, it returns false and the time until a token is available.
+func (l *requestLimiter) allow(path string, req *http.Request, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limits.Rate <= 0 || l.limits.Rate == rate.Inf {
+		return 0, true
+	}
+
+	if now.Sub(l.lastSweep) >= bucketSweepInterval {
+		for key, bucket := range l.buckets {
+			if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	key := path
+	if l.limits.Key != RateLimitByRegistry {
+		host, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			host = req.RemoteAddr
+		}
+		key += "\x00" + host
+	}
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(l.limits.Rate, l.limits.Burst)
+		l.buckets[key] = bucket
+	}
+
+	res := bucket.ReserveN(now, 1)
+	if !res.OK() {
+		return 0, false
+	}
+	if delay := res.DelayFrom(now); delay > 0 {
+		res.CancelAt(now)
+
+		return delay, false
+	}
+
+	return 0, true
+}
+
+// limitRequests wraps next to reject requests to the webhook path of reg that
+// exceed the rate limit or whose body exceeds the size limit. Accepted bodies
+// are buffered, so next never reads more than the size limit.
+func (r *WebhookRouter) limitRequests(reg *solarv1alpha1.Registry, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if wait, ok := r.limiter.allow(reg.Spec.WebhookPath, req, time.Now()); !ok {
+			if wait > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			}
+			r.rejectLimited(w, req, reg, rejectReasonRateLimited, http.StatusTooManyRequests)
+
+			return
+		}
+
+		if maxBytes := r.limiter.get().MaxBodyBytes; maxBytes > 0 {
+			if req.ContentLength > maxBytes {
+				r.rejectLimited(w, req, reg, rejectReasonBodyTooLarge, http.StatusRequestEntityTooLarge)
+
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBytes))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					r.rejectLimited(w, req, reg, rejectReasonBodyTooLarge, http.StatusRequestEntityTooLarge)
+				} else {
+					r.rejectLimited(w, req, reg, rejectReasonInvalidBody, http.StatusBadRequest)
+				}
+
+				return
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (r *WebhookRouter) rejectLimited(w http.ResponseWriter, req *http.Request, reg *solarv1alpha1.Registry, reason string, code int) {
+	logger := logr.FromContextOrDiscard(req.Context())
+	logger.Info("rejected webhook request exceeding limits", "registry", reg.Name, "reason", reason, "remoteAddr", req.RemoteAddr)
+
+	r.rejected.Add(req.Context(), 1, metric.WithAttributes(
+		attribute.String("registry", reg.Name),
+		attribute.String("reason", reason),
+	))
+
+	http.Error(w, http.StatusText(code), code)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	"go.opendefense.cloud/solar/pkg/discovery"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequestLimits", func() {
+	var (
+		router   *WebhookRouter
+		received []string
+	)
+
+	BeforeEach(func() {
+		UnregisterAllHandlers()
+		router = NewWebhookRouter(make(chan discovery.RepositoryEvent, 10))
+		received = nil
+
+		RegisterHandler("echo", func(_ *solarv1alpha1.Registry, _ chan<- discovery.RepositoryEvent) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				received = append(received, string(body))
+				w.WriteHeader(http.StatusAccepted)
+			})
+		})
+		Expect(router.RegisterPath(&solarv1alpha1.Registry{
+			Spec: solarv1alpha1.RegistrySpec{Flavor: "echo", WebhookPath: "limited"},
+		})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		UnregisterAllHandlers()
+	})
+
+	post := func(remoteAddr, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/webhook/limited", strings.NewReader(body))
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	Describe("body size", func() {
+		BeforeEach(func() {
+			router.WithRequestLimits(RequestLimits{MaxBodyBytes: 8})
+		})
+
+		It("passes bodies within the limit on to the handler", func() {
+			Expect(post("192.0.2.1:1234", "12345678").Code).To(Equal(http.StatusAccepted))
+			Expect(received).To(ConsistOf("12345678"))
+		})
+
+		It("rejects requests declaring a larger body with 413", func() {
+			Expect(post("192.0.2.1:1234", "123456789").Code).To(Equal(http.StatusRequestEntityTooLarge))
+			Expect(received).To(BeEmpty())
+		})
+
+		It("rejects larger bodies of unknown length with 413", func() {
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/webhook/limited", io.NopCloser(strings.NewReader("123456789")))
+			req.ContentLength = -1
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			Expect(rec.Code).To(Equal(http.StatusRequestEntityTooLarge))
+			Expect(received).To(BeEmpty())
+		})
+	})
+
+	Describe("rate", func() {
+		It("rejects requests of a remote address beyond the burst with 429", func() {
+			router.WithRequestLimits(RequestLimits{Rate: 0.1, Burst: 2, Key: RateLimitByRemoteAddr})
+
+			Expect(post("192.0.2.1:1234", "").Code).To(Equal(http.StatusAccepted))
+			Expect(post("192.0.2.1:5678", "").Code).To(Equal(http.StatusAccepted))
+
+			rec := post("192.0.2.1:1234", "")
+			Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+			Expect(rec.Header().Get("Retry-After")).To(Equal("10"))
+
+			Expect(post("192.0.2.2:1234", "").Code).To(Equal(http.StatusAccepted))
+			Expect(received).To(HaveLen(3))
+		})
+
+		It("shares the bucket of a registry among remote addresses", func() {
+			router.WithRequestLimits(RequestLimits{Rate: 0.1, Burst: 1, Key: RateLimitByRegistry})
+
+			Expect(post("192.0.2.1:1234", "").Code).To(Equal(http.StatusAccepted))
+			Expect(post("192.0.2.2:1234", "").Code).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("does not limit requests if the rate is 0", func() {
+			router.WithRequestLimits(RequestLimits{Burst: 1})
+
+			for range 5 {
+				Expect(post("192.0.2.1:1234", "").Code).To(Equal(http.StatusAccepted))
+			}
+		})
+	})
+
+	Describe("requestLimiter", func() {
+		It("drops buckets that refilled completely", func() {
+			l := newRequestLimiter(RequestLimits{Rate: 1, Burst: 1})
+			now := time.Now()
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/webhook/limited", nil)
+
+			_, ok := l.allow("limited", req, now)
+			Expect(ok).To(BeTrue())
+			Expect(l.buckets).To(HaveLen(1))
+
+			req.RemoteAddr = "192.0.2.2:1234"
+			_, ok = l.allow("limited", req, now.Add(bucketSweepInterval))
+			Expect(ok).To(BeTrue())
+			Expect(l.buckets).To(HaveLen(1))
+		})
+	})
+
+	DescribeTable("Validate",
+		func(limits RequestLimits, valid bool) {
+			if valid {
+				Expect(limits.Validate()).To(Succeed())
+			} else {
+				Expect(limits.Validate()).NotTo(Succeed())
+			}
+		},
+		Entry("defaults", DefaultRequestLimits, true),
+		Entry("no limits", RequestLimits{}, true),
+		Entry("unknown key", RequestLimits{Key: "header"}, false),
+		Entry("rate without burst", RequestLimits{Rate: 1}, false),
+	)
+})
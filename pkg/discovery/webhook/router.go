@@ -10,36 +10,92 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 	"go.opendefense.cloud/solar/pkg/discovery"
 )
 
+const meterName = "go.opendefense.cloud/solar/pkg/discovery/webhook"
+
+// WebhookSecretFunc returns the shared webhook secret of the named registry,
+// or nil if none is known.
+type WebhookSecretFunc func(registryName string) []byte
+
 type WebhookRouter struct {
 	eventOuts chan<- discovery.RepositoryEvent
 
 	pathMu sync.RWMutex
 	paths  map[string]http.Handler
 
+	webhookSecrets WebhookSecretFunc
+	limiter        *requestLimiter
+	received       metric.Int64Counter
+	rejected       metric.Int64Counter
+
 	logger logr.Logger
 }
 
 func NewWebhookRouter(eventOuts chan<- discovery.RepositoryEvent) *WebhookRouter {
-	return &WebhookRouter{
+	r := &WebhookRouter{
 		eventOuts: eventOuts,
 		paths:     make(map[string]http.Handler),
+		limiter:   newRequestLimiter(DefaultRequestLimits),
 		logger:    logr.Discard(),
 	}
+	r.WithMeterProvider(otel.GetMeterProvider())
+
+	return r
 }
 
 func (r *WebhookRouter) WithLogger(logger logr.Logger) {
 	r.logger = logger
 }
 
+// WithWebhookSecrets sets the lookup used to resolve the shared secrets of
+// registries with WebhookAuth. It must be set before such registries are
+// registered.
+func (r *WebhookRouter) WithWebhookSecrets(fn WebhookSecretFunc) {
+	r.webhookSecrets = fn
+}
+
+// WithRequestLimits sets the limits of requests to each webhook path and
+// resets all rate limits. Defaults to DefaultRequestLimits.
+func (r *WebhookRouter) WithRequestLimits(limits RequestLimits) {
+	r.limiter.set(limits)
+}
+
+// WithMeterProvider sets the MeterProvider used to record received and
+// rejected webhook requests. It must be set before registries are registered.
+// Defaults to the global MeterProvider.
+func (r *WebhookRouter) WithMeterProvider(mp metric.MeterProvider) {
+	received, err := mp.Meter(meterName).Int64Counter("solar.discovery.webhook.received",
+		metric.WithDescription("Number of webhook requests received for a registered registry, by registry and flavor."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	rejected, err := mp.Meter(meterName).Int64Counter("solar.discovery.webhook.rejected",
+		metric.WithDescription("Number of webhook requests rejected by authentication or request limits, by registry and reason."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	r.received = received
+	r.rejected = rejected
+}
+
 // RegisterPath registers the given solarv1alpha1.Registry with the WebhookRouter, using
 // the registry's flavor (aka handler type) and WebhookPath. If the WebhookPath is
 // already used by a registry or the given flavor is not known (see RegisterHandler),
-// an error is returned.
+// an error is returned. Registries with WebhookAuth get their handler wrapped
+// with request authentication; an error is returned if their secret is unknown.
+// Requests exceeding the RequestLimits of the router are rejected before they
+// are authenticated.
 func (r *WebhookRouter) RegisterPath(reg *solarv1alpha1.Registry) error {
 	registeredHandlersMu.RLock()
 	defer registeredHandlersMu.RUnlock()
@@ -56,13 +112,49 @@ func (r *WebhookRouter) RegisterPath(reg *solarv1alpha1.Registry) error {
 		return fmt.Errorf("webhook handler for path %s already exists", reg.Spec.WebhookPath)
 	}
 
-	r.paths[reg.Spec.WebhookPath] = initFn(reg, r.eventOuts)
+	handler := initFn(reg, r.eventOuts)
+
+	if reg.Spec.WebhookAuth != nil {
+		var secret []byte
+		if r.webhookSecrets != nil {
+			secret = r.webhookSecrets(reg.Name)
+		}
+
+		var err error
+		if handler, err = newAuthHandler(reg, secret, r.rejected, handler); err != nil {
+			return err
+		}
+	}
+
+	r.paths[reg.Spec.WebhookPath] = r.countReceived(reg, r.limitRequests(reg, handler))
 
 	r.logger.Info(fmt.Sprintf("registered webhook handler %s (path %s)", reg.Spec.Flavor, reg.Spec.WebhookPath))
 
 	return nil
 }
 
+// countReceived wraps next to count the requests received for reg, including
+// those rejected by authentication.
+func (r *WebhookRouter) countReceived(reg *solarv1alpha1.Registry, next http.Handler) http.Handler {
+	attrs := metric.WithAttributes(
+		attribute.String("registry", reg.Name),
+		attribute.String("flavor", reg.Spec.Flavor),
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.received.Add(req.Context(), 1, attrs)
+		next.ServeHTTP(w, req)
+	})
+}
+
+// Paths returns the number of registered webhook paths.
+func (r *WebhookRouter) Paths() int {
+	r.pathMu.RLock()
+	defer r.pathMu.RUnlock()
+
+	return len(r.paths)
+}
+
 func (r *WebhookRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	r.logger.Info(fmt.Sprintf("webhook handler %s %s", req.Method, req.URL.Path))
 
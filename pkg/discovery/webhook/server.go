@@ -5,14 +5,20 @@ package webhook
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 
 	"go.opendefense.cloud/solar/pkg/discovery"
+	"go.opendefense.cloud/solar/pkg/observability"
 )
 
 type WebhookServer struct {
@@ -20,13 +26,18 @@ type WebhookServer struct {
 	Addr    string
 	errChan chan<- discovery.ErrorEvent
 	log     logr.Logger
+	serving atomic.Bool
+
+	certFile    string
+	keyFile     string
+	stopWatcher context.CancelFunc
 }
 
 func NewWebhookServer(webhookLstnAddr string, router http.Handler, errChan chan<- discovery.ErrorEvent, log logr.Logger) *WebhookServer {
 
 	server := &http.Server{
 		Addr:              webhookLstnAddr,
-		Handler:           router,
+		Handler:           observability.HTTPMiddleware(router, observability.WithRouteFormatter(webhookRoute)),
 		ReadHeaderTimeout: time.Second * 3,
 	}
 
@@ -38,7 +49,37 @@ func NewWebhookServer(webhookLstnAddr string, router http.Handler, errChan chan<
 	}
 }
 
+// SetTLS serves the webhook over HTTPS with the certificate and key in the
+// given files. The files are watched and the certificate is reloaded when they
+// change, e.g. when cert-manager renews the certificate in a mounted Secret.
+func (s *WebhookServer) SetTLS(certFile, keyFile string) {
+	s.certFile = certFile
+	s.keyFile = keyFile
+}
+
 func (s *WebhookServer) Start(ctx context.Context) error {
+	var watcher *certwatcher.CertWatcher
+	if s.certFile != "" {
+		var err error
+		watcher, err = certwatcher.New(s.certFile, s.keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load webhook server certificate: %w", err)
+		}
+		watcher.RegisterCallback(func(cert tls.Certificate) {
+			if cert.Leaf != nil {
+				s.log.Info("Loaded webhook server certificate", "notAfter", cert.Leaf.NotAfter)
+			}
+		})
+
+		// HTTP/2 stays disabled for the same reasons as for the metrics
+		// server of the controller manager (Rapid Reset CVEs).
+		s.server.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: watcher.GetCertificate,
+			NextProtos:     []string{"http/1.1"},
+		}
+	}
+
 	lc := net.ListenConfig{}
 	l, err := lc.Listen(ctx, "tcp", s.server.Addr)
 	if err != nil {
@@ -47,10 +88,33 @@ func (s *WebhookServer) Start(ctx context.Context) error {
 	// if the port was zero, the address needs to be updated to reflect the actual port that has been used
 	s.Addr = l.Addr().String()
 
-	s.log.Info("Starting webhook server", "addr", s.Addr)
+	if watcher != nil {
+		var watchCtx context.Context
+		watchCtx, s.stopWatcher = context.WithCancel(ctx)
+		go func() {
+			if err := watcher.Start(watchCtx); err != nil {
+				discovery.Publish(&s.log, s.errChan, discovery.ErrorEvent{
+					Source:    "webhook server",
+					Severity:  discovery.SeverityFatal,
+					Error:     fmt.Errorf("failed to watch webhook server certificate: %w", err),
+					Timestamp: time.Now().UTC(),
+				})
+			}
+		}()
+	}
+
+	s.log.Info("Starting webhook server", "addr", s.Addr, "tls", watcher != nil)
+	s.serving.Store(true)
 	go func() {
-		if err := s.server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		defer s.serving.Store(false)
+		serve := s.server.Serve
+		if watcher != nil {
+			serve = func(l net.Listener) error { return s.server.ServeTLS(l, "", "") }
+		}
+		if err := serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			discovery.Publish(&s.log, s.errChan, discovery.ErrorEvent{
+				Source:    "webhook server",
+				Severity:  discovery.SeverityFatal,
 				Error:     err,
 				Timestamp: time.Now().UTC(),
 			})
@@ -60,9 +124,29 @@ func (s *WebhookServer) Start(ctx context.Context) error {
 	return nil
 }
 
+// Serving reports whether the server accepts connections.
+func (s *WebhookServer) Serving() bool {
+	return s.serving.Load()
+}
+
 func (s *WebhookServer) Stop(ctx context.Context) error {
+	if s.stopWatcher != nil {
+		s.stopWatcher()
+	}
+
 	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	return s.server.Shutdown(shutdownCtx)
 }
+
+// webhookRoute reports all registry webhook paths as a single route so that the
+// cardinality of request metrics does not grow with the number of registries or
+// with arbitrary paths sent by clients.
+func webhookRoute(r *http.Request) string {
+	if strings.HasPrefix(r.URL.Path, "/webhook/") {
+		return "/webhook/{path}"
+	}
+
+	return "unmatched"
+}
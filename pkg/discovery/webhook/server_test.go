@@ -6,7 +6,18 @@ package webhook
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -18,6 +29,27 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+// writeTestCertificate writes a self-signed certificate with the given common
+// name and its key to certFile and keyFile.
+func writeTestCertificate(certFile, keyFile, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)).To(Succeed())
+	Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600)).To(Succeed())
+}
+
 var _ = Describe("Webhook Server", Ordered, func() {
 	var (
 		log logr.Logger
@@ -42,6 +74,7 @@ var _ = Describe("Webhook Server", Ordered, func() {
 			err := server.Start(ctx)
 			Expect(err).NotTo(HaveOccurred())
 			defer func() { _ = server.Stop(ctx) }()
+			Expect(server.Serving()).To(BeTrue())
 
 			resp, err := http.Post("http://"+server.Addr, "application/json", bytes.NewBuffer([]byte{}))
 			Expect(err).NotTo(HaveOccurred())
@@ -53,6 +86,7 @@ var _ = Describe("Webhook Server", Ordered, func() {
 				_, err := http.Post("http://"+server.Addr, "application/json", bytes.NewBuffer([]byte{}))
 				return err
 			}, 2*time.Second, 50*time.Millisecond).Should(HaveOccurred())
+			Eventually(server.Serving).Should(BeFalse())
 
 			Expect(errChan).To(BeEmpty())
 		})
@@ -76,5 +110,59 @@ var _ = Describe("Webhook Server", Ordered, func() {
 
 			Expect(errChan).To(BeEmpty())
 		})
+
+		It("should serve TLS and reload a rotated certificate", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			dir := GinkgoT().TempDir()
+			certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+			writeTestCertificate(certFile, keyFile, "first")
+
+			errChan := make(chan discovery.ErrorEvent, 1)
+
+			server := NewWebhookServer("127.0.0.1:0", fakeHandler, errChan, log)
+			server.SetTLS(certFile, keyFile)
+			Expect(server.Start(ctx)).To(Succeed())
+			defer func() { _ = server.Stop(ctx) }()
+
+			servedCommonName := func() string {
+				conn, err := tls.Dial("tcp", server.Addr, &tls.Config{
+					InsecureSkipVerify: true, //nolint:gosec // only the served certificate is inspected
+				})
+				if err != nil {
+					return ""
+				}
+				defer conn.Close()
+
+				return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+			}
+			Expect(servedCommonName()).To(Equal("first"))
+
+			writeTestCertificate(certFile, keyFile, "second")
+			Eventually(servedCommonName, 5*time.Second, 100*time.Millisecond).Should(Equal("second"))
+
+			Expect(errChan).To(BeEmpty())
+		})
+
+		It("should fail to start with a missing certificate", func() {
+			errChan := make(chan discovery.ErrorEvent, 1)
+
+			server := NewWebhookServer("127.0.0.1:0", fakeHandler, errChan, log)
+			server.SetTLS(filepath.Join(GinkgoT().TempDir(), "tls.crt"), filepath.Join(GinkgoT().TempDir(), "tls.key"))
+			Expect(server.Start(context.Background())).To(MatchError(ContainSubstring("failed to load webhook server certificate")))
+			Expect(server.Serving()).To(BeFalse())
+		})
+	})
+
+	Describe("webhookRoute", func() {
+		It("should collapse registry webhook paths into a single route", func() {
+			Expect(webhookRoute(httptest.NewRequest(http.MethodPost, "/webhook/zot-a", nil))).To(Equal("/webhook/{path}"))
+			Expect(webhookRoute(httptest.NewRequest(http.MethodPost, "/webhook/events/zot-b", nil))).To(Equal("/webhook/{path}"))
+		})
+
+		It("should report any other path as unmatched", func() {
+			Expect(webhookRoute(httptest.NewRequest(http.MethodGet, "/random/path", nil))).To(Equal("unmatched"))
+		})
 	})
 })
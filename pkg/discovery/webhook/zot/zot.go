@@ -122,6 +122,13 @@ func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !discovery.RepositoryIncluded(wh.registry.Spec.RepositoryFilter, data.Name) {
+		logger.V(1).Info("skipping event of filtered repository", "repository", data.Name)
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
 	select {
 	case wh.channel <- repoEvent:
 		w.WriteHeader(http.StatusAccepted)
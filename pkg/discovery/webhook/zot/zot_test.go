@@ -474,6 +474,58 @@ var _ = Describe("Zot Webhook Handler", Ordered, func() {
 		})
 	})
 
+	Describe("Repository filtering", func() {
+		post := func(repository string) (int, chan discovery.RepositoryEvent) {
+			GinkgoHelper()
+
+			out := make(chan discovery.RepositoryEvent, 1)
+			handler := NewHandler(&solarv1alpha1.Registry{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-zot-filtered"},
+				Spec: solarv1alpha1.RegistrySpec{
+					RepositoryFilter: &solarv1alpha1.RepositoryFilter{
+						IncludePrefixes: []string{"test/component-descriptors/"},
+						ExcludePrefixes: []string{"test/component-descriptors/opendefense.cloud/internal"},
+					},
+				},
+			}, out)
+
+			event := cloudevents.NewEvent()
+			event.SetSource("https://zot-registry/")
+			event.SetType(ZotEventTypeImageUpdated)
+			event.SetID("test-event-filtered")
+			event.SetTime(time.Now())
+			Expect(event.SetData(cloudevents.ApplicationJSON, ZotEventData{Name: repository, Reference: "v1.0.0"})).To(Succeed())
+
+			body, err := json.Marshal(event)
+			Expect(err).NotTo(HaveOccurred())
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/webhook/zot", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/cloudevents+json")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			return rec.Code, out
+		}
+
+		It("should publish events of included repositories", func() {
+			code, out := post("test/component-descriptors/opendefense.cloud/ocm-demo")
+			Expect(code).To(Equal(http.StatusAccepted))
+			Expect(out).To(HaveLen(1))
+		})
+
+		It("should skip events of repositories not included", func() {
+			code, out := post("test/images/nginx")
+			Expect(code).To(Equal(http.StatusNoContent))
+			Expect(out).To(BeEmpty())
+		})
+
+		It("should skip events of excluded repositories", func() {
+			code, out := post("test/component-descriptors/opendefense.cloud/internal-tools")
+			Expect(code).To(Equal(http.StatusNoContent))
+			Expect(out).To(BeEmpty())
+		})
+	})
+
 	Describe("isDigestReference", func() {
 		DescribeTable("should correctly identify digest references",
 			func(ref string, expected bool) {
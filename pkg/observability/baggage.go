@@ -0,0 +1,151 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// BaggageKeyTenant is the baggage member carrying the tenant a request is
+	// made on behalf of.
+	BaggageKeyTenant = "solar.tenant"
+	// BaggageKeyNamespace is the baggage member carrying the namespace a
+	// request operates in.
+	BaggageKeyNamespace = "solar.namespace"
+
+	attrTenant = attribute.Key("solar.tenant")
+)
+
+// Tenant identifies who a request is made on behalf of. Empty fields are
+// unknown and neither attached to nor overwritten in the baggage.
+type Tenant struct {
+	// ID is the tenant identifier, e.g. the name of a customer.
+	ID string
+	// Namespace is the Kubernetes namespace the request operates in.
+	Namespace string
+}
+
+// HTTPTenantFunc derives the Tenant of an incoming HTTP request.
+type HTTPTenantFunc func(r *http.Request) Tenant
+
+// GRPCTenantFunc derives the Tenant of an incoming gRPC call from its context,
+// which carries the incoming metadata, and the full method name.
+type GRPCTenantFunc func(ctx context.Context, fullMethod string) Tenant
+
+// TenantFromHeaders returns an HTTPTenantFunc reading the tenant ID and
+// namespace from the given request headers. An empty header name is skipped.
+func TenantFromHeaders(tenantHeader, namespaceHeader string) HTTPTenantFunc {
+	return func(r *http.Request) Tenant {
+		var t Tenant
+		if tenantHeader != "" {
+			t.ID = r.Header.Get(tenantHeader)
+		}
+		if namespaceHeader != "" {
+			t.Namespace = r.Header.Get(namespaceHeader)
+		}
+
+		return t
+	}
+}
+
+// TenantFromMetadata returns a GRPCTenantFunc reading the tenant ID and
+// namespace from the given keys of the incoming metadata. An empty key is
+// skipped.
+func TenantFromMetadata(tenantKey, namespaceKey string) GRPCTenantFunc {
+	return func(ctx context.Context, _ string) Tenant {
+		md, _ := metadata.FromIncomingContext(ctx)
+		get := func(key string) string {
+			if key == "" {
+				return ""
+			}
+			if values := md.Get(key); len(values) > 0 {
+				return values[0]
+			}
+
+			return ""
+		}
+
+		return Tenant{ID: get(tenantKey), Namespace: get(namespaceKey)}
+	}
+}
+
+// ContextWithTenant returns a copy of ctx whose baggage carries the non-empty
+// fields of t, so they are propagated to downstream services and picked up by
+// NewLogger. Values that cannot be stored in baggage are reported to the
+// global OpenTelemetry error handler and skipped.
+func ContextWithTenant(ctx context.Context, t Tenant) context.Context {
+	bag := baggage.FromContext(ctx)
+	for key, value := range map[string]string{BaggageKeyTenant: t.ID, BaggageKeyNamespace: t.Namespace} {
+		if value == "" {
+			continue
+		}
+
+		member, err := baggage.NewMemberRaw(key, value)
+		if err == nil {
+			bag, err = bag.SetMember(member)
+		}
+		if err != nil {
+			otel.Handle(err)
+		}
+	}
+
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// TenantFromContext returns the Tenant carried in the baggage of ctx.
+func TenantFromContext(ctx context.Context) Tenant {
+	bag := baggage.FromContext(ctx)
+
+	return Tenant{
+		ID:        bag.Member(BaggageKeyTenant).Value(),
+		Namespace: bag.Member(BaggageKeyNamespace).Value(),
+	}
+}
+
+// NewLogger returns log with the Tenant carried in the baggage of ctx added as
+// "tenant" and "namespace" values, so logs can be filtered per tenant like the
+// traces of the same request.
+func NewLogger(ctx context.Context, log logr.Logger) logr.Logger {
+	t := TenantFromContext(ctx)
+	if t.ID != "" {
+		log = log.WithValues("tenant", t.ID)
+	}
+	if t.Namespace != "" {
+		log = log.WithValues("namespace", t.Namespace)
+	}
+
+	return log
+}
+
+// withTenant attaches t to the baggage of ctx and, if ctx carries a logger,
+// replaces it with one emitting the resulting Tenant.
+func withTenant(ctx context.Context, t Tenant) context.Context {
+	ctx = ContextWithTenant(ctx, t)
+	if log, err := logr.FromContext(ctx); err == nil {
+		ctx = logr.NewContext(ctx, NewLogger(ctx, log))
+	}
+
+	return ctx
+}
+
+// tenantAttributes returns the span attributes of the non-empty fields of t.
+func tenantAttributes(t Tenant) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if t.ID != "" {
+		attrs = append(attrs, attrTenant.String(t.ID))
+	}
+	if t.Namespace != "" {
+		attrs = append(attrs, attrResourceNamespace.String(t.Namespace))
+	}
+
+	return attrs
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tenant baggage", func() {
+	// captureLogger returns a logger appending the values of every log line
+	// to lines.
+	captureLogger := func(lines *[]string) logr.Logger {
+		return funcr.New(func(_, args string) {
+			*lines = append(*lines, args)
+		}, funcr.Options{})
+	}
+
+	It("should round-trip the tenant through the baggage", func() {
+		ctx := ContextWithTenant(context.Background(), Tenant{ID: "acme"})
+		ctx = ContextWithTenant(ctx, Tenant{Namespace: "team-a"})
+
+		Expect(TenantFromContext(ctx)).To(Equal(Tenant{ID: "acme", Namespace: "team-a"}))
+		Expect(TenantFromContext(context.Background())).To(Equal(Tenant{}))
+	})
+
+	It("should emit the tenant as log values", func() {
+		var lines []string
+		ctx := ContextWithTenant(context.Background(), Tenant{ID: "acme", Namespace: "team-a"})
+
+		NewLogger(ctx, captureLogger(&lines)).Info("hello")
+		NewLogger(context.Background(), captureLogger(&lines)).Info("hello")
+
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring(`"tenant"="acme" "namespace"="team-a"`))
+		Expect(lines[1]).NotTo(ContainSubstring("tenant"))
+	})
+
+	Describe("HTTPMiddleware", func() {
+		var (
+			spans *tracetest.SpanRecorder
+			tp    *sdktrace.TracerProvider
+		)
+
+		BeforeEach(func() {
+			spans = tracetest.NewSpanRecorder()
+			tp = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+		})
+
+		It("should attach the tenant of the request to baggage, span and logger", func() {
+			var (
+				lines []string
+				got   Tenant
+			)
+			h := HTTPMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = TenantFromContext(r.Context())
+				logr.FromContextOrDiscard(r.Context()).Info("handled")
+			}), WithTracerProvider(tp), WithHTTPTenant(TenantFromHeaders("X-Tenant", "X-Namespace")))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("X-Tenant", "acme")
+			req.Header.Set("X-Namespace", "team-a")
+			req = req.WithContext(logr.NewContext(req.Context(), captureLogger(&lines)))
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			Expect(got).To(Equal(Tenant{ID: "acme", Namespace: "team-a"}))
+			Expect(lines).To(ConsistOf(ContainSubstring(`"tenant"="acme"`)))
+			Expect(spans.Ended()[0].Attributes()).To(ContainElements(
+				attribute.String("solar.tenant", "acme"),
+				attribute.String("k8s.namespace.name", "team-a"),
+			))
+		})
+
+		It("should pick up a tenant propagated as baggage", func() {
+			var got Tenant
+			h := HTTPMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = TenantFromContext(r.Context())
+			}), WithTracerProvider(tp), WithPropagator(propagation.Baggage{}),
+				WithHTTPTenant(TenantFromHeaders("", "X-Namespace")))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Baggage", "solar.tenant=acme,solar.namespace=ignored")
+			req.Header.Set("X-Namespace", "team-a")
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			Expect(got).To(Equal(Tenant{ID: "acme", Namespace: "team-a"}))
+		})
+	})
+
+	Describe("gRPC interceptors", func() {
+		incoming := func(kv ...string) context.Context {
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+		}
+
+		It("should attach the tenant of unary calls", func() {
+			var got Tenant
+			interceptor := GRPCUnaryServerInterceptor(WithPropagator(propagation.Baggage{}),
+				WithGRPCTenant(TenantFromMetadata("x-tenant", "x-namespace")))
+
+			_, err := interceptor(incoming("baggage", "solar.namespace=team-b", "x-tenant", "acme"), nil,
+				&grpc.UnaryServerInfo{FullMethod: "/solar.Test/Get"},
+				func(ctx context.Context, _ any) (any, error) {
+					got = TenantFromContext(ctx)
+					return nil, nil
+				})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(Equal(Tenant{ID: "acme", Namespace: "team-b"}))
+		})
+
+		It("should attach the tenant of streaming calls", func() {
+			var got Tenant
+			interceptor := GRPCStreamServerInterceptor(WithGRPCTenant(TenantFromMetadata("x-tenant", "x-namespace")))
+
+			err := interceptor(nil, &testServerStream{ctx: incoming("x-tenant", "acme", "x-namespace", "team-a")},
+				&grpc.StreamServerInfo{FullMethod: "/solar.Test/Watch"},
+				func(_ any, ss grpc.ServerStream) error {
+					got = TenantFromContext(ss.Context())
+					return nil
+				})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(Equal(Tenant{ID: "acme", Namespace: "team-a"}))
+		})
+	})
+})
+
+// testServerStream is a grpc.ServerStream only providing a context.
+type testServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package observability contains OpenTelemetry helpers shared by the SolAr
+// binaries. Instrumentation uses the global providers unless configured
+// otherwise, so it is a no-op until a binary installs an SDK.
+package observability
+
+const instrumentationName = "go.opendefense.cloud/solar/pkg/observability"
@@ -0,0 +1,151 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const attrErrorClass = attribute.Key("solar.error.class")
+
+// ErrorClass tells whether an error goes away on retry, needs a fix in SolAr
+// or its deployment, or needs the user to fix a resource.
+type ErrorClass string
+
+const (
+	// ErrorClassTransient is the class of errors expected to go away on
+	// retry, such as conflicts, timeouts and unavailable servers.
+	ErrorClassTransient ErrorClass = "transient"
+	// ErrorClassPermanent is the class of errors retries do not fix. It is
+	// the class of all errors not classified otherwise.
+	ErrorClassPermanent ErrorClass = "permanent"
+	// ErrorClassUser is the class of errors caused by invalid or missing
+	// resources of the user.
+	ErrorClassUser ErrorClass = "user"
+)
+
+// classifiedError is an error with a class set by WithErrorClass.
+type classifiedError struct {
+	error
+	class ErrorClass
+}
+
+func (e *classifiedError) Unwrap() error {
+	return e.error
+}
+
+// WithErrorClass returns err with class, which ClassifyError returns for it
+// and all errors wrapping it. A nil err yields nil.
+func WithErrorClass(err error, class ErrorClass) error {
+	if err == nil {
+		return nil
+	}
+
+	return &classifiedError{error: err, class: class}
+}
+
+// ClassifyError returns the class of err: the one set by WithErrorClass if
+// any, otherwise one derived from the Kubernetes API status or network error
+// in its chain, falling back to ErrorClassPermanent.
+func ClassifyError(err error) ErrorClass {
+	var ce *classifiedError
+	if errors.As(err, &ce) {
+		return ce.class
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled),
+		apierrors.IsConflict(err), apierrors.IsServerTimeout(err), apierrors.IsTimeout(err),
+		apierrors.IsTooManyRequests(err), apierrors.IsServiceUnavailable(err),
+		apierrors.IsInternalError(err), apierrors.IsUnexpectedServerError(err):
+		return ErrorClassTransient
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err), apierrors.IsNotFound(err):
+		return ErrorClassUser
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorClassTransient
+	}
+
+	return ErrorClassPermanent
+}
+
+// ErrorRecorder reports errors on the active span, in a counter by error
+// class and in the log.
+type ErrorRecorder struct {
+	errors metric.Int64Counter
+}
+
+// NewErrorRecorder returns an ErrorRecorder using the MeterProvider of opts.
+func NewErrorRecorder(opts ...Option) *ErrorRecorder {
+	cfg := newConfig(opts)
+
+	// Instrument creation only fails on invalid names or options, in which case
+	// the meter still returns a usable no-op instrument.
+	counter, err := cfg.meterProvider.Meter(instrumentationName).Int64Counter("solar.errors",
+		metric.WithDescription("Errors recorded by error class."),
+		metric.WithUnit("{error}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return &ErrorRecorder{errors: counter}
+}
+
+// Record marks the span of ctx as failed with err, counts err by its class
+// and logs it with the logger of ctx, correlated with the span by its trace
+// and span ID. The attributes are added to the span event and the log entry,
+// but not to the counter, so they may have unbounded cardinality. Record
+// returns err, so callers can return its result.
+func (r *ErrorRecorder) Record(ctx context.Context, err error, attrs ...attribute.KeyValue) error {
+	if err == nil {
+		return nil
+	}
+
+	class := ClassifyError(err)
+	classAttr := attrErrorClass.String(string(class))
+
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err, trace.WithAttributes(append(slices.Clip(attrs), classAttr)...))
+	span.SetStatus(codes.Error, err.Error())
+
+	r.errors.Add(ctx, 1, metric.WithAttributes(classAttr))
+
+	log := NewLogger(ctx, ctrl.LoggerFrom(ctx))
+	if sc := span.SpanContext(); sc.IsValid() {
+		log = log.WithValues("traceID", sc.TraceID().String(), "spanID", sc.SpanID().String())
+	}
+	kvs := make([]any, 0, 2*len(attrs)+2)
+	for _, a := range attrs {
+		kvs = append(kvs, string(a.Key), a.Value.Emit())
+	}
+	log.Error(err, "Operation failed", append(kvs, "errorClass", class)...)
+
+	return err
+}
+
+var defaultErrorRecorder = sync.OnceValue(func() *ErrorRecorder {
+	return NewErrorRecorder()
+})
+
+// RecordError records err with an ErrorRecorder using the global
+// MeterProvider, see ErrorRecorder.Record. Unlike a plain log.Error, the
+// error can be found from the trace of the reconcile and the other way round,
+// and is counted by class.
+func RecordError(ctx context.Context, err error, attrs ...attribute.KeyValue) error {
+	return defaultErrorRecorder().Record(ctx, err, attrs...)
+}
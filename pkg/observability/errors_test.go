@@ -0,0 +1,116 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Errors", func() {
+	gr := schema.GroupResource{Group: "solar.opendefense.cloud", Resource: "releases"}
+
+	DescribeTable("ClassifyError",
+		func(err error, want ErrorClass) {
+			Expect(ClassifyError(err)).To(Equal(want))
+		},
+		Entry("conflict", apierrors.NewConflict(gr, "rel", errors.New("modified")), ErrorClassTransient),
+		Entry("too many requests", apierrors.NewTooManyRequests("slow down", 1), ErrorClassTransient),
+		Entry("wrapped deadline", fmt.Errorf("get: %w", context.DeadlineExceeded), ErrorClassTransient),
+		Entry("invalid", apierrors.NewInvalid(schema.GroupKind{Kind: "Release"}, "rel", field.ErrorList{}), ErrorClassUser),
+		Entry("not found", fmt.Errorf("get: %w", apierrors.NewNotFound(gr, "rel")), ErrorClassUser),
+		Entry("forbidden", apierrors.NewForbidden(gr, "rel", errors.New("denied")), ErrorClassPermanent),
+		Entry("unknown", errors.New("boom"), ErrorClassPermanent),
+		Entry("explicit", fmt.Errorf("render: %w", WithErrorClass(errors.New("bad values"), ErrorClassUser)), ErrorClassUser),
+	)
+
+	It("should keep the wrapped error of WithErrorClass", func() {
+		cause := apierrors.NewNotFound(gr, "rel")
+		err := WithErrorClass(cause, ErrorClassTransient)
+
+		Expect(err).To(MatchError(cause))
+		Expect(err.Error()).To(Equal(cause.Error()))
+		Expect(ClassifyError(err)).To(Equal(ErrorClassTransient))
+		Expect(WithErrorClass(nil, ErrorClassUser)).To(Succeed())
+	})
+
+	Describe("ErrorRecorder", func() {
+		var (
+			spans    *tracetest.SpanRecorder
+			tp       *sdktrace.TracerProvider
+			meters   *observabilitytest.MeterProvider
+			recorder *ErrorRecorder
+			lines    []string
+			ctx      context.Context
+		)
+
+		BeforeEach(func() {
+			spans = tracetest.NewSpanRecorder()
+			tp = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+			meters = observabilitytest.NewMeterProvider()
+			recorder = NewErrorRecorder(WithMeterProvider(meters))
+			lines = nil
+			ctx = logr.NewContext(context.Background(), funcr.New(func(_, args string) {
+				lines = append(lines, args)
+			}, funcr.Options{}))
+		})
+
+		It("should mark the span, count the error by class and log it with the trace", func() {
+			ctx, span := tp.Tracer("test").Start(ctx, "reconcile Release")
+			err := apierrors.NewConflict(gr, "rel", errors.New("modified"))
+
+			Expect(recorder.Record(ctx, err, attribute.String("release", "rel"))).To(BeIdenticalTo(err))
+			span.End()
+
+			ended := spans.Ended()
+			Expect(ended).To(HaveLen(1))
+			Expect(ended[0].Status().Code).To(Equal(codes.Error))
+			Expect(ended[0].Events()).To(ContainElement(And(
+				HaveField("Name", "exception"),
+				HaveField("Attributes", ContainElements(
+					attribute.String("release", "rel"),
+					attribute.String("solar.error.class", "transient"),
+				)),
+			)))
+
+			Expect(meters.Sum("solar.errors", attribute.String("solar.error.class", "transient"))).To(Equal(1.0))
+
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0]).To(ContainSubstring(`"release"="rel"`))
+			Expect(lines[0]).To(ContainSubstring(`"errorClass"="transient"`))
+			Expect(lines[0]).To(ContainSubstring(`"traceID"="` + span.SpanContext().TraceID().String() + `"`))
+			Expect(lines[0]).To(ContainSubstring(`"spanID"="` + span.SpanContext().SpanID().String() + `"`))
+		})
+
+		It("should log without a trace outside of a span", func() {
+			Expect(recorder.Record(ctx, errors.New("boom"))).To(MatchError("boom"))
+
+			Expect(meters.Sum("solar.errors", attribute.String("solar.error.class", "permanent"))).To(Equal(1.0))
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0]).NotTo(ContainSubstring("traceID"))
+		})
+
+		It("should ignore nil errors", func() {
+			Expect(recorder.Record(ctx, nil)).To(Succeed())
+			Expect(meters.Count("solar.errors")).To(BeZero())
+			Expect(lines).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// GRPCUnaryServerInterceptor returns an interceptor that extracts the trace
+// context and baggage of incoming unary calls and attaches their Tenant,
+// propagated as baggage or derived with WithGRPCTenant, to the baggage, the
+// current span and the logger in the call context. It does not create spans;
+// combine it with otelgrpc for that.
+func GRPCUnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(cfg.grpcContext(ctx, info.FullMethod), req)
+	}
+}
+
+// GRPCStreamServerInterceptor is the streaming counterpart of
+// GRPCUnaryServerInterceptor.
+func GRPCStreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: cfg.grpcContext(ss.Context(), info.FullMethod)})
+	}
+}
+
+// grpcContext returns ctx with the trace context and baggage of the incoming
+// metadata and the Tenant of the call.
+func (c *config) grpcContext(ctx context.Context, fullMethod string) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = c.propagator.Extract(ctx, metadataCarrier(md))
+
+	var t Tenant
+	if c.grpcTenant != nil {
+		t = c.grpcTenant(ctx, fullMethod)
+	}
+	ctx = withTenant(ctx, t)
+	trace.SpanFromContext(ctx).SetAttributes(tenantAttributes(TenantFromContext(ctx))...)
+
+	return ctx
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	if values := metadata.MD(m).Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
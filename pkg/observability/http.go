@@ -0,0 +1,212 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	attrHTTPMethod     = attribute.Key("http.request.method")
+	attrHTTPRoute      = attribute.Key("http.route")
+	attrHTTPStatusCode = attribute.Key("http.response.status_code")
+)
+
+// RouteFormatter maps a request to the route reported on spans and metrics.
+// It must return a value of bounded cardinality, e.g. a path template such as
+// "/webhook/{path}" instead of the raw request path.
+type RouteFormatter func(r *http.Request) string
+
+// Option configures HTTPMiddleware, the gRPC interceptors and WrapReconciler.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+	routeFormatter RouteFormatter
+	httpTenant     HTTPTenantFunc
+	grpcTenant     GRPCTenantFunc
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+		routeFormatter: DefaultRouteFormatter,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans. Defaults to
+// the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics. Defaults to
+// the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagator sets the propagator used to extract the trace context and
+// baggage of incoming requests. Defaults to the global TextMapPropagator.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// WithHTTPTenant makes HTTPMiddleware attach the Tenant returned by f to the
+// baggage of every request, see ContextWithTenant.
+func WithHTTPTenant(f HTTPTenantFunc) Option {
+	return func(c *config) {
+		c.httpTenant = f
+	}
+}
+
+// WithGRPCTenant makes the gRPC server interceptors attach the Tenant
+// returned by f to the baggage of every call, see ContextWithTenant.
+func WithGRPCTenant(f GRPCTenantFunc) Option {
+	return func(c *config) {
+		c.grpcTenant = f
+	}
+}
+
+// WithRouteFormatter sets the function HTTPMiddleware uses to derive the route
+// attribute and span name from a request. Defaults to DefaultRouteFormatter.
+func WithRouteFormatter(f RouteFormatter) Option {
+	return func(c *config) {
+		c.routeFormatter = f
+	}
+}
+
+// DefaultRouteFormatter returns the ServeMux pattern that matched the request
+// if available and falls back to the request path otherwise.
+func DefaultRouteFormatter(r *http.Request) string {
+	if r.Pattern != "" {
+		return r.Pattern
+	}
+
+	return r.URL.Path
+}
+
+// HTTPMiddleware wraps next with a server span per request and records RED
+// metrics: a request counter, a request duration histogram and an in-flight
+// gauge, attributed with method, route and (where known) response status.
+// The Tenant of the request, propagated as baggage or derived with
+// WithHTTPTenant, is added to the span and to the logger in the request
+// context, but not to the metrics to keep their cardinality bounded.
+func HTTPMiddleware(next http.Handler, opts ...Option) http.Handler {
+	cfg := newConfig(opts)
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	requests, err := meter.Int64Counter("http.server.request.count",
+		metric.WithDescription("Number of HTTP requests handled by the server."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	duration, err := meter.Float64Histogram("http.server.request.duration",
+		metric.WithDescription("Duration of HTTP requests handled by the server."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	inFlight, err := meter.Int64UpDownCounter("http.server.active_requests",
+		metric.WithDescription("Number of HTTP requests currently being handled by the server."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		route := cfg.routeFormatter(r)
+		baseAttrs := []attribute.KeyValue{
+			attrHTTPMethod.String(r.Method),
+			attrHTTPRoute.String(route),
+		}
+
+		ctx := cfg.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		var t Tenant
+		if cfg.httpTenant != nil {
+			t = cfg.httpTenant(r)
+		}
+		ctx = withTenant(ctx, t)
+
+		ctx, span := tracer.Start(ctx, r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(baseAttrs...),
+			trace.WithAttributes(tenantAttributes(TenantFromContext(ctx))...),
+		)
+		defer span.End()
+
+		inFlightAttrs := metric.WithAttributes(baseAttrs...)
+		inFlight.Add(ctx, 1, inFlightAttrs)
+		defer inFlight.Add(ctx, -1, inFlightAttrs)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		span.SetAttributes(attrHTTPStatusCode.Int(rec.status))
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, strconv.Itoa(rec.status)+" "+http.StatusText(rec.status))
+		}
+
+		attrs := metric.WithAttributes(append(baseAttrs, attrHTTPStatusCode.Int(rec.status))...)
+		requests.Add(ctx, 1, attrs)
+		duration.Record(ctx, time.Since(start).Seconds(), attrs)
+	})
+}
+
+// statusRecorder captures the status code written by the wrapped handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if !s.wroteHeader {
+		s.status = code
+		s.wroteHeader = true
+	}
+
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	s.wroteHeader = true
+
+	return s.ResponseWriter.Write(b)
+}
+
+// Unwrap allows http.ResponseController to reach the underlying ResponseWriter.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTPMiddleware", func() {
+	var (
+		spans  *tracetest.SpanRecorder
+		tp     *sdktrace.TracerProvider
+		meters *observabilitytest.MeterProvider
+	)
+
+	BeforeEach(func() {
+		spans = tracetest.NewSpanRecorder()
+		tp = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+		meters = observabilitytest.NewMeterProvider()
+	})
+
+	serve := func(h http.Handler, method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+
+		return rec
+	}
+
+	It("should create a server span with method, route and status attributes", func() {
+		h := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(trace.SpanFromContext(r.Context()).SpanContext().IsValid()).To(BeTrue())
+			w.WriteHeader(http.StatusAccepted)
+		}), WithTracerProvider(tp), WithMeterProvider(meters))
+
+		rec := serve(h, http.MethodPost, "/webhook/zot")
+		Expect(rec.Code).To(Equal(http.StatusAccepted))
+
+		ended := spans.Ended()
+		Expect(ended).To(HaveLen(1))
+		Expect(ended[0].Name()).To(Equal("POST /webhook/zot"))
+		Expect(ended[0].SpanKind()).To(Equal(trace.SpanKindServer))
+		Expect(ended[0].Attributes()).To(ContainElements(
+			attribute.String("http.request.method", http.MethodPost),
+			attribute.String("http.route", "/webhook/zot"),
+			attribute.Int("http.response.status_code", http.StatusAccepted),
+		))
+		Expect(ended[0].Status().Code).To(Equal(codes.Unset))
+	})
+
+	It("should mark the span as failed for server errors", func() {
+		h := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}), WithTracerProvider(tp), WithMeterProvider(meters))
+
+		serve(h, http.MethodGet, "/")
+
+		Expect(spans.Ended()).To(HaveLen(1))
+		Expect(spans.Ended()[0].Status().Code).To(Equal(codes.Error))
+	})
+
+	It("should record request count, duration and in-flight metrics", func() {
+		var inFlightDuringRequest float64
+		h := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			inFlightDuringRequest = meters.Sum("http.server.active_requests")
+			w.WriteHeader(http.StatusNotFound)
+		}), WithTracerProvider(tp), WithMeterProvider(meters))
+
+		serve(h, http.MethodGet, "/a")
+		serve(h, http.MethodGet, "/a")
+
+		Expect(inFlightDuringRequest).To(Equal(float64(1)))
+		Expect(meters.Sum("http.server.active_requests")).To(BeZero())
+		Expect(meters.Sum("http.server.request.count",
+			attribute.String("http.request.method", http.MethodGet),
+			attribute.String("http.route", "/a"),
+			attribute.Int("http.response.status_code", http.StatusNotFound),
+		)).To(Equal(float64(2)))
+		Expect(meters.Count("http.server.request.duration")).To(Equal(2))
+	})
+
+	It("should default the status code to 200 when the handler only writes a body", func() {
+		h := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("ok"))
+		}), WithTracerProvider(tp), WithMeterProvider(meters))
+
+		serve(h, http.MethodGet, "/")
+
+		Expect(meters.Sum("http.server.request.count", attribute.Int("http.response.status_code", http.StatusOK))).To(Equal(float64(1)))
+	})
+
+	It("should use the route formatter to bound route cardinality", func() {
+		h := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}), WithTracerProvider(tp), WithMeterProvider(meters), WithRouteFormatter(func(r *http.Request) string {
+			if strings.HasPrefix(r.URL.Path, "/webhook/events/") {
+				return "/webhook/events/{registry}"
+			}
+
+			return r.URL.Path
+		}))
+
+		serve(h, http.MethodPost, "/webhook/events/one")
+		serve(h, http.MethodPost, "/webhook/events/two")
+
+		Expect(meters.Sum("http.server.request.count", attribute.String("http.route", "/webhook/events/{registry}"))).To(Equal(float64(2)))
+		Expect(spans.Ended()[0].Name()).To(Equal("POST /webhook/events/{registry}"))
+		Expect(spans.Ended()[1].Name()).To(Equal("POST /webhook/events/{registry}"))
+	})
+
+	Describe("DefaultRouteFormatter", func() {
+		It("should prefer the matched ServeMux pattern over the path", func() {
+			req := httptest.NewRequest(http.MethodGet, "/items/42", nil)
+			Expect(DefaultRouteFormatter(req)).To(Equal("/items/42"))
+
+			req.Pattern = "GET /items/{id}"
+			Expect(DefaultRouteFormatter(req)).To(Equal("GET /items/{id}"))
+		})
+	})
+})
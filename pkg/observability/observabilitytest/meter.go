@@ -0,0 +1,255 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package observabilitytest provides in-memory OpenTelemetry providers for
+// asserting on recorded telemetry in tests without depending on an SDK.
+package observabilitytest
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// measurement is a single value recorded on an instrument of the recordingMeter.
+type measurement struct {
+	instrument string
+	value      float64
+	attrs      attribute.Set
+}
+
+// MeterProvider hands out a meter that keeps every measurement in memory so
+// tests can assert on them.
+type MeterProvider struct {
+	embedded.MeterProvider
+
+	meter *recordingMeter
+}
+
+var _ metric.MeterProvider = &MeterProvider{}
+
+// NewMeterProvider returns an empty MeterProvider.
+func NewMeterProvider() *MeterProvider {
+	return &MeterProvider{meter: &recordingMeter{}}
+}
+
+func (p *MeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+// Collect runs every callback registered for observable instruments and
+// records the observed values, like an SDK reader would on collection.
+func (p *MeterProvider) Collect(ctx context.Context) error {
+	p.meter.mu.Lock()
+	callbacks := append([]metric.Callback(nil), p.meter.callbacks...)
+	p.meter.mu.Unlock()
+
+	for _, cb := range callbacks {
+		if err := cb(ctx, &recordingObserver{meter: p.meter}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sum adds up all values recorded on the named instrument whose attributes
+// contain every attribute in match.
+func (p *MeterProvider) Sum(instrument string, match ...attribute.KeyValue) float64 {
+	var total float64
+
+	for _, m := range p.meter.snapshot() {
+		if m.instrument == instrument && hasAttributes(m.attrs, match) {
+			total += m.value
+		}
+	}
+
+	return total
+}
+
+// Count returns the number of values recorded on the named instrument whose
+// attributes contain every attribute in match.
+func (p *MeterProvider) Count(instrument string, match ...attribute.KeyValue) int {
+	n := 0
+
+	for _, m := range p.meter.snapshot() {
+		if m.instrument == instrument && hasAttributes(m.attrs, match) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Last returns the most recent value recorded on the named instrument whose
+// attributes contain every attribute in match, and whether one was found.
+func (p *MeterProvider) Last(instrument string, match ...attribute.KeyValue) (float64, bool) {
+	ms := p.meter.snapshot()
+	for i := len(ms) - 1; i >= 0; i-- {
+		if ms[i].instrument == instrument && hasAttributes(ms[i].attrs, match) {
+			return ms[i].value, true
+		}
+	}
+
+	return 0, false
+}
+
+func hasAttributes(set attribute.Set, match []attribute.KeyValue) bool {
+	for _, kv := range match {
+		v, ok := set.Value(kv.Key)
+		if !ok || v != kv.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+type recordingMeter struct {
+	noop.Meter
+
+	mu           sync.Mutex
+	measurements []measurement
+	callbacks    []metric.Callback
+}
+
+func (m *recordingMeter) record(instrument string, value float64, attrs attribute.Set) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.measurements = append(m.measurements, measurement{instrument: instrument, value: value, attrs: attrs})
+}
+
+func (m *recordingMeter) snapshot() []measurement {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]measurement(nil), m.measurements...)
+}
+
+func (m *recordingMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return &recordingInt64Counter{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Int64UpDownCounter(name string, _ ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	return &recordingInt64UpDownCounter{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return &recordingFloat64Histogram{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Int64Gauge(name string, _ ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	return &recordingInt64Gauge{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Int64ObservableCounter(name string, _ ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	return &recordingInt64ObservableCounter{name: name}, nil
+}
+
+func (m *recordingMeter) Int64ObservableUpDownCounter(name string, _ ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	return &recordingInt64ObservableUpDownCounter{name: name}, nil
+}
+
+func (m *recordingMeter) Int64ObservableGauge(name string, _ ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	return &recordingInt64ObservableGauge{name: name}, nil
+}
+
+func (m *recordingMeter) RegisterCallback(cb metric.Callback, _ ...metric.Observable) (metric.Registration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks = append(m.callbacks, cb)
+
+	return noop.Registration{}, nil
+}
+
+type recordingInt64Counter struct {
+	noop.Int64Counter
+
+	meter *recordingMeter
+	name  string
+}
+
+func (c *recordingInt64Counter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	c.meter.record(c.name, float64(incr), metric.NewAddConfig(opts).Attributes())
+}
+
+type recordingInt64UpDownCounter struct {
+	noop.Int64UpDownCounter
+
+	meter *recordingMeter
+	name  string
+}
+
+func (c *recordingInt64UpDownCounter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	c.meter.record(c.name, float64(incr), metric.NewAddConfig(opts).Attributes())
+}
+
+type recordingFloat64Histogram struct {
+	noop.Float64Histogram
+
+	meter *recordingMeter
+	name  string
+}
+
+func (h *recordingFloat64Histogram) Record(_ context.Context, value float64, opts ...metric.RecordOption) {
+	h.meter.record(h.name, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+type recordingInt64Gauge struct {
+	noop.Int64Gauge
+
+	meter *recordingMeter
+	name  string
+}
+
+func (g *recordingInt64Gauge) Record(_ context.Context, value int64, opts ...metric.RecordOption) {
+	g.meter.record(g.name, float64(value), metric.NewRecordConfig(opts).Attributes())
+}
+
+// observable is implemented by the observable instruments of the
+// recordingMeter to report the name values are recorded under.
+type observable interface {
+	instrumentName() string
+}
+
+type recordingInt64ObservableCounter struct {
+	noop.Int64ObservableCounter
+
+	name string
+}
+
+func (c *recordingInt64ObservableCounter) instrumentName() string { return c.name }
+
+type recordingInt64ObservableUpDownCounter struct {
+	noop.Int64ObservableUpDownCounter
+
+	name string
+}
+
+func (c *recordingInt64ObservableUpDownCounter) instrumentName() string { return c.name }
+
+type recordingInt64ObservableGauge struct {
+	noop.Int64ObservableGauge
+
+	name string
+}
+
+func (g *recordingInt64ObservableGauge) instrumentName() string { return g.name }
+
+type recordingObserver struct {
+	noop.Observer
+
+	meter *recordingMeter
+}
+
+func (o *recordingObserver) ObserveInt64(inst metric.Int64Observable, value int64, opts ...metric.ObserveOption) {
+	if named, ok := inst.(observable); ok {
+		o.meter.record(named.instrumentName(), float64(value), metric.NewObserveConfig(opts).Attributes())
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"net/http"
+	"net/http/pprof"
+)
+
+// EnableProfiling registers the net/http/pprof handlers below /debug/pprof/
+// on mux, so profiles can be served from a dedicated listener next to the
+// health probes. That listener should not be reachable from outside the
+// cluster.
+func EnableProfiling(mux *http.ServeMux) {
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	// EnvTraceParent is the environment variable carrying the W3C traceparent
+	// of the span a process continues.
+	EnvTraceParent = "TRACEPARENT"
+	// EnvTraceState is the environment variable carrying the W3C tracestate
+	// of the span a process continues.
+	EnvTraceState = "TRACESTATE"
+)
+
+// traceContext propagates the W3C trace context regardless of the global
+// propagator, which is a no-op unless a binary installs one.
+var traceContext = propagation.TraceContext{}
+
+// EnvCarrier is a propagation.TextMapCarrier backed by environment variables.
+// Keys are mapped to upper case, so "traceparent" is stored as TRACEPARENT.
+type EnvCarrier map[string]string
+
+var _ propagation.TextMapCarrier = EnvCarrier{}
+
+// Get returns the value of the environment variable of key.
+func (c EnvCarrier) Get(key string) string {
+	return c[strings.ToUpper(key)]
+}
+
+// Set sets the environment variable of key to value.
+func (c EnvCarrier) Set(key, value string) {
+	c[strings.ToUpper(key)] = value
+}
+
+// Keys returns the names of the environment variables in c.
+func (c EnvCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// TraceContextEnv returns the W3C trace context of the span in ctx as
+// environment variables, so a child process such as a renderer Job can
+// continue the trace with ContextFromEnv. It is empty if ctx carries no
+// valid span context.
+func TraceContextEnv(ctx context.Context) EnvCarrier {
+	env := EnvCarrier{}
+	traceContext.Inject(ctx, env)
+
+	return env
+}
+
+// ContextFromEnv returns a copy of ctx carrying the remote span context read
+// from the TRACEPARENT and TRACESTATE environment variables of the process.
+// If they are unset or invalid, ctx is returned as is and spans started from
+// it begin a new trace.
+func ContextFromEnv(ctx context.Context) context.Context {
+	env := EnvCarrier{}
+	for _, key := range []string{EnvTraceParent, EnvTraceState} {
+		if value, ok := os.LookupEnv(key); ok {
+			env[key] = value
+		}
+	}
+
+	return traceContext.Extract(ctx, env)
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trace context propagation", func() {
+	It("should continue the trace of the span passed in the environment", func() {
+		tp := sdktrace.NewTracerProvider()
+		ctx, span := tp.Tracer("test").Start(context.Background(), "reconcile RenderTask")
+		defer span.End()
+
+		env := TraceContextEnv(ctx)
+		Expect(env).To(HaveKeyWithValue(EnvTraceParent, MatchRegexp(`^00-%s-%s-01$`, span.SpanContext().TraceID(), span.SpanContext().SpanID())))
+
+		for key, value := range env {
+			GinkgoT().Setenv(key, value)
+		}
+
+		remote := trace.SpanContextFromContext(ContextFromEnv(context.Background()))
+		Expect(remote.IsRemote()).To(BeTrue())
+		Expect(remote.TraceID()).To(Equal(span.SpanContext().TraceID()))
+		Expect(remote.SpanID()).To(Equal(span.SpanContext().SpanID()))
+	})
+
+	It("should return no environment without a span", func() {
+		Expect(TraceContextEnv(context.Background())).To(BeEmpty())
+	})
+
+	It("should start a new trace without or with an invalid traceparent", func() {
+		GinkgoT().Setenv(EnvTraceParent, "")
+		Expect(trace.SpanContextFromContext(ContextFromEnv(context.Background())).IsValid()).To(BeFalse())
+
+		GinkgoT().Setenv(EnvTraceParent, "00-invalid")
+		Expect(trace.SpanContextFromContext(ContextFromEnv(context.Background())).IsValid()).To(BeFalse())
+	})
+
+	It("should map carrier keys to environment variable names", func() {
+		c := EnvCarrier{}
+		c.Set("traceparent", "value")
+		Expect(c).To(HaveKeyWithValue("TRACEPARENT", "value"))
+		Expect(c.Get("traceparent")).To(Equal("value"))
+		Expect(c.Keys()).To(ConsistOf("TRACEPARENT"))
+	})
+})
@@ -0,0 +1,80 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	attrResourceKind      = attribute.Key("k8s.resource.kind")
+	attrResourceName      = attribute.Key("k8s.resource.name")
+	attrResourceNamespace = attribute.Key("k8s.namespace.name")
+	attrReconcileResult   = attribute.Key("solar.reconcile.result")
+
+	reconcileResultSuccess = "success"
+	reconcileResultRequeue = "requeue"
+	reconcileResultError   = "error"
+)
+
+// WrapReconciler wraps r with an internal span per reconcile, named after the
+// kind of the reconciled resource and attributed with its kind, name and
+// namespace. Errors are recorded on the span along with their ErrorClass, and
+// the reconcile duration is recorded per kind and result (success, requeue or
+// error).
+func WrapReconciler(kind string, r reconcile.Reconciler, opts ...Option) reconcile.Reconciler {
+	cfg := newConfig(opts)
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+
+	duration, err := cfg.meterProvider.Meter(instrumentationName).Float64Histogram("solar.controller.reconcile.duration",
+		metric.WithDescription("Duration of reconciles by resource kind and result."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	kindAttr := attrResourceKind.String(kind)
+
+	return reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+		start := time.Now()
+
+		ctx, span := tracer.Start(ctx, "reconcile "+kind,
+			trace.WithSpanKind(trace.SpanKindInternal),
+			trace.WithAttributes(
+				kindAttr,
+				attrResourceName.String(req.Name),
+				attrResourceNamespace.String(req.Namespace),
+			),
+		)
+		defer span.End()
+
+		res, err := r.Reconcile(ctx, req)
+
+		result := reconcileResultSuccess
+		switch {
+		case err != nil:
+			result = reconcileResultError
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			span.SetAttributes(attrErrorClass.String(string(ClassifyError(err))))
+		case res.RequeueAfter > 0:
+			result = reconcileResultRequeue
+		}
+		span.SetAttributes(attrReconcileResult.String(result))
+
+		duration.Record(ctx, time.Since(start).Seconds(),
+			metric.WithAttributes(kindAttr, attrReconcileResult.String(result)))
+
+		return res, err
+	})
+}
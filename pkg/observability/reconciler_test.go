@@ -0,0 +1,105 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WrapReconciler", func() {
+	var (
+		spans  *tracetest.SpanRecorder
+		tp     *sdktrace.TracerProvider
+		meters *observabilitytest.MeterProvider
+		req    ctrl.Request
+	)
+
+	BeforeEach(func() {
+		spans = tracetest.NewSpanRecorder()
+		tp = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+		meters = observabilitytest.NewMeterProvider()
+		req = ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "edge-1"}}
+	})
+
+	wrap := func(fn func(context.Context, ctrl.Request) (ctrl.Result, error)) reconcile.Reconciler {
+		return WrapReconciler("Target", reconcile.Func(fn), WithTracerProvider(tp), WithMeterProvider(meters))
+	}
+
+	It("should create a span with the kind, name and namespace of the resource", func() {
+		r := wrap(func(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+			Expect(trace.SpanFromContext(ctx).SpanContext().IsValid()).To(BeTrue())
+
+			return ctrl.Result{}, nil
+		})
+
+		_, err := r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		ended := spans.Ended()
+		Expect(ended).To(HaveLen(1))
+		Expect(ended[0].Name()).To(Equal("reconcile Target"))
+		Expect(ended[0].SpanKind()).To(Equal(trace.SpanKindInternal))
+		Expect(ended[0].Attributes()).To(ContainElements(
+			attribute.String("k8s.resource.kind", "Target"),
+			attribute.String("k8s.resource.name", "edge-1"),
+			attribute.String("k8s.namespace.name", "team-a"),
+			attribute.String("solar.reconcile.result", "success"),
+		))
+		Expect(ended[0].Status().Code).To(Equal(codes.Unset))
+	})
+
+	It("should record errors on the span and return them unchanged", func() {
+		boom := errors.New("boom")
+		r := wrap(func(context.Context, ctrl.Request) (ctrl.Result, error) {
+			return ctrl.Result{}, boom
+		})
+
+		_, err := r.Reconcile(context.Background(), req)
+		Expect(err).To(BeIdenticalTo(boom))
+
+		ended := spans.Ended()
+		Expect(ended).To(HaveLen(1))
+		Expect(ended[0].Status().Code).To(Equal(codes.Error))
+		Expect(ended[0].Status().Description).To(Equal("boom"))
+		Expect(ended[0].Events()).To(ContainElement(HaveField("Name", "exception")))
+		Expect(ended[0].Attributes()).To(ContainElement(attribute.String("solar.error.class", "permanent")))
+	})
+
+	It("should record the reconcile duration by kind and result", func() {
+		result := ctrl.Result{}
+		r := wrap(func(context.Context, ctrl.Request) (ctrl.Result, error) {
+			return result, nil
+		})
+
+		_, _ = r.Reconcile(context.Background(), req)
+		result = ctrl.Result{RequeueAfter: time.Minute}
+		res, _ := r.Reconcile(context.Background(), req)
+		Expect(res.RequeueAfter).To(Equal(time.Minute))
+
+		Expect(meters.Count("solar.controller.reconcile.duration",
+			attribute.String("k8s.resource.kind", "Target"),
+			attribute.String("solar.reconcile.result", "success"),
+		)).To(Equal(1))
+		Expect(meters.Count("solar.controller.reconcile.duration",
+			attribute.String("k8s.resource.kind", "Target"),
+			attribute.String("solar.reconcile.result", "requeue"),
+		)).To(Equal(1))
+	})
+})
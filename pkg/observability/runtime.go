@@ -0,0 +1,138 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"math"
+	"runtime/metrics"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Samples read from runtime/metrics on every collection.
+const (
+	sampleGoroutines       = "/sched/goroutines:goroutines"
+	sampleGOMAXPROCS       = "/sched/gomaxprocs:threads"
+	sampleMemoryTotal      = "/memory/classes/total:bytes"
+	sampleMemoryReleased   = "/memory/classes/heap/released:bytes"
+	sampleMemoryLimit      = "/gc/gomemlimit:bytes"
+	sampleHeapAllocBytes   = "/gc/heap/allocs:bytes"
+	sampleHeapAllocObjects = "/gc/heap/allocs:objects"
+	sampleHeapGoal         = "/gc/heap/goal:bytes"
+	sampleGCCycles         = "/gc/cycles/total:gc-cycles"
+)
+
+var runtimeSamples = []string{
+	sampleGoroutines,
+	sampleGOMAXPROCS,
+	sampleMemoryTotal,
+	sampleMemoryReleased,
+	sampleMemoryLimit,
+	sampleHeapAllocBytes,
+	sampleHeapAllocObjects,
+	sampleHeapGoal,
+	sampleGCCycles,
+}
+
+// RegisterRuntimeMetrics reports goroutine, memory and garbage collector
+// statistics of the Go runtime on the configured MeterProvider. The values
+// are read from runtime/metrics whenever the MeterProvider collects, so
+// registering has no cost while no SDK is installed. Metric names follow the
+// OpenTelemetry semantic conventions for the Go runtime.
+//
+// It is meant to be called once per process; calling it again registers a
+// second set of instruments.
+func RegisterRuntimeMetrics(opts ...Option) error {
+	cfg := newConfig(opts)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	goroutines, err := meter.Int64ObservableUpDownCounter("go.goroutine.count",
+		metric.WithDescription("Count of live goroutines."),
+		metric.WithUnit("{goroutine}"))
+	if err != nil {
+		return err
+	}
+	processorLimit, err := meter.Int64ObservableUpDownCounter("go.processor.limit",
+		metric.WithDescription("The number of OS threads that can execute user-level Go code simultaneously."),
+		metric.WithUnit("{thread}"))
+	if err != nil {
+		return err
+	}
+	memoryUsed, err := meter.Int64ObservableUpDownCounter("go.memory.used",
+		metric.WithDescription("Memory used by the Go runtime."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+	memoryLimit, err := meter.Int64ObservableUpDownCounter("go.memory.limit",
+		metric.WithDescription("Go runtime memory limit configured by the user, if a limit exists."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+	allocated, err := meter.Int64ObservableCounter("go.memory.allocated",
+		metric.WithDescription("Memory allocated to the heap by the application."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+	allocations, err := meter.Int64ObservableCounter("go.memory.allocations",
+		metric.WithDescription("Count of allocations to the heap by the application."),
+		metric.WithUnit("{allocation}"))
+	if err != nil {
+		return err
+	}
+	gcGoal, err := meter.Int64ObservableUpDownCounter("go.memory.gc.goal",
+		metric.WithDescription("Heap size target for the end of the GC cycle."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+	gcCycles, err := meter.Int64ObservableCounter("go.gc.cycles",
+		metric.WithDescription("Count of completed GC cycles."),
+		metric.WithUnit("{gc_cycle}"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		samples := readRuntimeSamples()
+
+		o.ObserveInt64(goroutines, samples[sampleGoroutines])
+		o.ObserveInt64(processorLimit, samples[sampleGOMAXPROCS])
+		o.ObserveInt64(memoryUsed, samples[sampleMemoryTotal]-samples[sampleMemoryReleased])
+		// The runtime reports math.MaxInt64 while GOMEMLIMIT is unset.
+		if limit := samples[sampleMemoryLimit]; limit != math.MaxInt64 {
+			o.ObserveInt64(memoryLimit, limit)
+		}
+		o.ObserveInt64(allocated, samples[sampleHeapAllocBytes])
+		o.ObserveInt64(allocations, samples[sampleHeapAllocObjects])
+		o.ObserveInt64(gcGoal, samples[sampleHeapGoal])
+		o.ObserveInt64(gcCycles, samples[sampleGCCycles])
+
+		return nil
+	}, goroutines, processorLimit, memoryUsed, memoryLimit, allocated, allocations, gcGoal, gcCycles)
+
+	return err
+}
+
+// readRuntimeSamples reads runtimeSamples and returns their values by name.
+// Samples the running Go version does not support are reported as zero.
+func readRuntimeSamples() map[string]int64 {
+	samples := make([]metrics.Sample, len(runtimeSamples))
+	for i, name := range runtimeSamples {
+		samples[i].Name = name
+	}
+	metrics.Read(samples)
+
+	values := make(map[string]int64, len(samples))
+	for _, s := range samples {
+		if s.Value.Kind() == metrics.KindUint64 {
+			values[s.Name] = int64(min(s.Value.Uint64(), math.MaxInt64))
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RegisterRuntimeMetrics", func() {
+	var meters *observabilitytest.MeterProvider
+
+	BeforeEach(func() {
+		meters = observabilitytest.NewMeterProvider()
+		Expect(RegisterRuntimeMetrics(WithMeterProvider(meters))).To(Succeed())
+	})
+
+	It("should not read the runtime until the MeterProvider collects", func() {
+		Expect(meters.Count("go.goroutine.count")).To(BeZero())
+	})
+
+	It("should report goroutine, memory and GC statistics on collection", func() {
+		runtime.GC()
+		Expect(meters.Collect(context.Background())).To(Succeed())
+
+		goroutines, ok := meters.Last("go.goroutine.count")
+		Expect(ok).To(BeTrue())
+		Expect(goroutines).To(BeNumerically(">", 0))
+
+		procs, ok := meters.Last("go.processor.limit")
+		Expect(ok).To(BeTrue())
+		Expect(procs).To(BeEquivalentTo(runtime.GOMAXPROCS(0)))
+
+		for _, name := range []string{"go.memory.used", "go.memory.allocated", "go.memory.allocations", "go.memory.gc.goal", "go.gc.cycles"} {
+			v, ok := meters.Last(name)
+			Expect(ok).To(BeTrue(), name)
+			Expect(v).To(BeNumerically(">", 0), name)
+		}
+	})
+
+	It("should report the goroutines started since the last collection", func() {
+		Expect(meters.Collect(context.Background())).To(Succeed())
+		before, _ := meters.Last("go.goroutine.count")
+
+		stop := make(chan struct{})
+		defer close(stop)
+		for range 10 {
+			go func() { <-stop }()
+		}
+
+		Expect(meters.Collect(context.Background())).To(Succeed())
+		after, _ := meters.Last("go.goroutine.count")
+		Expect(after).To(BeNumerically(">=", before+10))
+	})
+})
+
+var _ = Describe("EnableProfiling", func() {
+	It("should serve the pprof index and named profiles", func() {
+		mux := http.NewServeMux()
+		EnableProfiling(mux)
+
+		for _, path := range []string{"/debug/pprof/", "/debug/pprof/goroutine?debug=1", "/debug/pprof/cmdline"} {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			Expect(rec.Code).To(Equal(http.StatusOK), path)
+		}
+	})
+})
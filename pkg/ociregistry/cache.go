@@ -0,0 +1,355 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// DefaultCacheMaxSize is the default number of body bytes a ResponseCache keeps.
+	DefaultCacheMaxSize = 64 << 20
+	// DefaultCacheTTL is the default time a cached response of a tag is served
+	// before it is revalidated with the registry.
+	DefaultCacheTTL = 5 * time.Minute
+
+	meterName = "go.opendefense.cloud/solar/pkg/ociregistry"
+
+	headerContentDigest = "Docker-Content-Digest"
+
+	cacheResultHit         = "hit"
+	cacheResultRevalidated = "revalidated"
+	cacheResultMiss        = "miss"
+)
+
+var attrCacheResult = attribute.Key("result")
+
+// CacheOption configures a ResponseCache.
+type CacheOption func(*ResponseCache)
+
+// WithCacheMaxSize sets the number of body bytes kept in the cache. Responses
+// larger than this are passed through without being cached.
+func WithCacheMaxSize(n int64) CacheOption {
+	return func(c *ResponseCache) {
+		c.maxSize = n
+	}
+}
+
+// WithCacheTTL sets the time a cached response of a tag is served before it is
+// revalidated with the registry.
+func WithCacheTTL(d time.Duration) CacheOption {
+	return func(c *ResponseCache) {
+		c.ttl = d
+	}
+}
+
+// WithCacheMeterProvider sets the MeterProvider used to record cache lookups.
+// Defaults to the global MeterProvider.
+func WithCacheMeterProvider(mp metric.MeterProvider) CacheOption {
+	return func(c *ResponseCache) {
+		c.meterProvider = mp
+	}
+}
+
+// ResponseCache keeps successful manifest and blob responses of OCI registries
+// in memory. Responses addressed by digest never change and are served until
+// evicted. Responses addressed by tag are served for the configured TTL and
+// then revalidated with the ETag or Docker-Content-Digest and Last-Modified
+// headers of the cached response, so an unchanged tag only costs a 304.
+//
+// A single ResponseCache may be shared by the transports of several registry
+// clients; entries are keyed by URL.
+type ResponseCache struct {
+	maxSize       int64
+	ttl           time.Duration
+	meterProvider metric.MeterProvider
+	now           func() time.Time
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+
+	requests metric.Int64Counter
+	bytes    metric.Int64UpDownCounter
+}
+
+// cacheEntry is a cached response. The body of HEAD responses is empty.
+type cacheEntry struct {
+	key       string
+	status    int
+	header    http.Header
+	body      []byte
+	immutable bool
+	storedAt  time.Time
+}
+
+// NewResponseCache returns an empty ResponseCache.
+func NewResponseCache(opts ...CacheOption) *ResponseCache {
+	c := &ResponseCache{
+		maxSize:       DefaultCacheMaxSize,
+		ttl:           DefaultCacheTTL,
+		meterProvider: otel.GetMeterProvider(),
+		now:           time.Now,
+		lru:           list.New(),
+		entries:       make(map[string]*list.Element),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	meter := c.meterProvider.Meter(meterName)
+	var err error
+	c.requests, err = meter.Int64Counter("solar.ociregistry.cache.requests",
+		metric.WithDescription("Number of cacheable OCI registry requests by result (hit, revalidated or miss)."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	c.bytes, err = meter.Int64UpDownCounter("solar.ociregistry.cache.size",
+		metric.WithDescription("Number of response body bytes held by the OCI registry response cache."),
+		metric.WithUnit("By"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return c
+}
+
+// Transport returns a RoundTripper that answers cacheable requests from the
+// cache and sends all other requests to base. A nil base uses
+// http.DefaultTransport.
+func (c *ResponseCache) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &cachingTransport{cache: c, base: base}
+}
+
+// Client returns a copy of client whose transport uses the cache.
+func (c *ResponseCache) Client(client *http.Client) *http.Client {
+	out := *client
+	out.Transport = c.Transport(client.Transport)
+
+	return &out
+}
+
+type cachingTransport struct {
+	cache *ResponseCache
+	base  http.RoundTripper
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	immutable, ok := cacheableRequest(req)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	c := t.cache
+	key := cacheKey(req)
+	entry := c.get(key)
+	if entry != nil && (entry.immutable || c.now().Sub(entry.storedAt) < c.ttl) {
+		c.record(req, cacheResultHit)
+
+		return entry.response(req), nil
+	}
+
+	outReq := req
+	if entry != nil {
+		outReq = req.Clone(req.Context())
+		setValidators(outReq.Header, entry.header)
+	}
+
+	resp, err := t.base.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		c.touch(entry)
+		c.record(req, cacheResultRevalidated)
+
+		return entry.response(req), nil
+	}
+
+	c.record(req, cacheResultMiss)
+	if resp.StatusCode != http.StatusOK || !c.storable(resp) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.put(&cacheEntry{
+		key:       key,
+		status:    resp.StatusCode,
+		header:    resp.Header.Clone(),
+		body:      body,
+		immutable: immutable,
+		storedAt:  c.now(),
+	})
+
+	return resp, nil
+}
+
+// cacheableRequest reports whether req fetches a manifest or blob and whether
+// it is addressed by digest, i.e. its response never changes.
+func cacheableRequest(req *http.Request) (immutable, ok bool) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false, false
+	}
+	if req.Header.Get("Range") != "" || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return false, false
+	}
+
+	// /v2/<name>/manifests/<reference> or /v2/<name>/blobs/<digest>
+	path := req.URL.Path
+	if !strings.HasPrefix(path, "/v2/") {
+		return false, false
+	}
+	for _, kind := range []string{"/manifests/", "/blobs/"} {
+		i := strings.LastIndex(path, kind)
+		if i < len("/v2/") {
+			continue
+		}
+		ref := path[i+len(kind):]
+		if ref == "" || strings.Contains(ref, "/") {
+			return false, false
+		}
+
+		// Tags cannot contain a colon, digests always do.
+		return strings.Contains(ref, ":"), true
+	}
+
+	return false, false
+}
+
+// cacheKey identifies a response by method, URL and the accepted media types,
+// as registries negotiate the manifest format on the Accept header.
+func cacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String() + " " + strings.Join(req.Header.Values("Accept"), ",")
+}
+
+// setValidators turns the validators of a cached response into conditional
+// request headers.
+func setValidators(h http.Header, cached http.Header) {
+	if etag := cached.Get("ETag"); etag != "" {
+		h.Set("If-None-Match", etag)
+	} else if digest := cached.Get(headerContentDigest); digest != "" {
+		h.Set("If-None-Match", `"`+digest+`"`)
+	}
+	if lastModified := cached.Get("Last-Modified"); lastModified != "" {
+		h.Set("If-Modified-Since", lastModified)
+	}
+}
+
+// storable reports whether resp may be kept in the cache.
+func (c *ResponseCache) storable(resp *http.Response) bool {
+	if strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	if resp.Request != nil && resp.Request.Method == http.MethodHead {
+		return true
+	}
+
+	// Only buffer bodies of known size that fit into the cache.
+	return resp.ContentLength >= 0 && resp.ContentLength <= c.maxSize
+}
+
+func (c *ResponseCache) get(key string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(el)
+
+	return el.Value.(*cacheEntry)
+}
+
+func (c *ResponseCache) touch(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.storedAt = c.now()
+}
+
+func (c *ResponseCache) put(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[entry.key]; ok {
+		c.remove(el)
+	}
+
+	el := c.lru.PushFront(entry)
+	c.entries[entry.key] = el
+	c.size += int64(len(entry.body))
+	c.bytes.Add(context.Background(), int64(len(entry.body)))
+
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove drops el from the cache. The caller must hold c.mu.
+func (c *ResponseCache) remove(el *list.Element) {
+	entry := c.lru.Remove(el).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= int64(len(entry.body))
+	c.bytes.Add(context.Background(), -int64(len(entry.body)))
+}
+
+func (c *ResponseCache) record(req *http.Request, result string) {
+	c.requests.Add(req.Context(), 1, metric.WithAttributes(attrCacheResult.String(result)))
+}
+
+// response builds a response to req from the cached entry.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(e.status) + " " + http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: contentLength(e),
+		Request:       req,
+	}
+}
+
+// contentLength returns the length of the cached body, or for HEAD responses
+// the length announced by the registry.
+func contentLength(e *cacheEntry) int64 {
+	if len(e.body) > 0 {
+		return int64(len(e.body))
+	}
+	n, err := strconv.ParseInt(e.header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+	"go.opendefense.cloud/solar/pkg/ociregistry"
+)
+
+const testDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+// newTestRegistryServer serves a fixed manifest for every manifest and blob
+// path, answering conditional requests with the given ETag with 304. It
+// returns the number of requests reaching the server.
+func newTestRegistryServer(t *testing.T, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Docker-Content-Digest", testDigest)
+		w.Header().Set("ETag", `"`+testDigest+`"`)
+		if r.Header.Get("If-None-Match") == `"`+testDigest+`"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &hits
+}
+
+func get(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s: unexpected status %d", url, resp.StatusCode)
+	}
+	if resp.Header.Get("Docker-Content-Digest") != testDigest {
+		t.Fatalf("GET %s: missing Docker-Content-Digest header", url)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body of %s: %v", url, err)
+	}
+
+	return string(body)
+}
+
+// TestResponseCache_ServesDigestsFromCache verifies that manifests addressed
+// by digest are only fetched once.
+func TestResponseCache_ServesDigestsFromCache(t *testing.T) {
+	srv, hits := newTestRegistryServer(t, "manifest")
+	meters := observabilitytest.NewMeterProvider()
+	cache := ociregistry.NewResponseCache(ociregistry.WithCacheTTL(0), ociregistry.WithCacheMeterProvider(meters))
+	client := cache.Client(srv.Client())
+
+	for range 3 {
+		if body := get(t, client, srv.URL+"/v2/repo/manifests/"+testDigest); body != "manifest" {
+			t.Fatalf("unexpected body %q", body)
+		}
+	}
+
+	if got := hits.Load(); got != 1 {
+		t.Errorf("expected 1 request to reach the registry, got %d", got)
+	}
+	if got := meters.Sum("solar.ociregistry.cache.requests", attribute.String("result", "hit")); got != 2 {
+		t.Errorf("expected 2 cache hits, got %v", got)
+	}
+	if got := meters.Sum("solar.ociregistry.cache.requests", attribute.String("result", "miss")); got != 1 {
+		t.Errorf("expected 1 cache miss, got %v", got)
+	}
+	if got := meters.Sum("solar.ociregistry.cache.size"); got != float64(len("manifest")) {
+		t.Errorf("expected cache size %d, got %v", len("manifest"), got)
+	}
+}
+
+// TestResponseCache_ServesTagsWithinTTL verifies that manifests addressed by
+// tag are served from the cache until the TTL expires.
+func TestResponseCache_ServesTagsWithinTTL(t *testing.T) {
+	srv, hits := newTestRegistryServer(t, "manifest")
+	client := ociregistry.NewResponseCache(ociregistry.WithCacheTTL(time.Hour)).Client(srv.Client())
+
+	get(t, client, srv.URL+"/v2/repo/manifests/v1.0.0")
+	get(t, client, srv.URL+"/v2/repo/manifests/v1.0.0")
+
+	if got := hits.Load(); got != 1 {
+		t.Errorf("expected 1 request to reach the registry, got %d", got)
+	}
+}
+
+// TestResponseCache_RevalidatesExpiredTags verifies that expired tag entries
+// are revalidated with the cached ETag and served from the cache on 304.
+func TestResponseCache_RevalidatesExpiredTags(t *testing.T) {
+	srv, hits := newTestRegistryServer(t, "manifest")
+	meters := observabilitytest.NewMeterProvider()
+	client := ociregistry.NewResponseCache(ociregistry.WithCacheTTL(0), ociregistry.WithCacheMeterProvider(meters)).Client(srv.Client())
+
+	get(t, client, srv.URL+"/v2/repo/manifests/v1.0.0")
+	if body := get(t, client, srv.URL+"/v2/repo/manifests/v1.0.0"); body != "manifest" {
+		t.Fatalf("expected the cached body after revalidation, got %q", body)
+	}
+
+	if got := hits.Load(); got != 2 {
+		t.Errorf("expected 2 requests to reach the registry, got %d", got)
+	}
+	if got := meters.Sum("solar.ociregistry.cache.requests", attribute.String("result", "revalidated")); got != 1 {
+		t.Errorf("expected 1 revalidated request, got %v", got)
+	}
+}
+
+// TestResponseCache_EvictsLeastRecentlyUsed verifies that the cache stays
+// within its size and skips responses larger than the cache.
+func TestResponseCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	srv, hits := newTestRegistryServer(t, strings.Repeat("x", 6))
+	meters := observabilitytest.NewMeterProvider()
+	client := ociregistry.NewResponseCache(ociregistry.WithCacheMaxSize(10), ociregistry.WithCacheMeterProvider(meters)).Client(srv.Client())
+
+	get(t, client, srv.URL+"/v2/repo/blobs/sha256:a")
+	get(t, client, srv.URL+"/v2/repo/blobs/sha256:b") // evicts sha256:a
+	get(t, client, srv.URL+"/v2/repo/blobs/sha256:b")
+	get(t, client, srv.URL+"/v2/repo/blobs/sha256:a")
+
+	if got := hits.Load(); got != 3 {
+		t.Errorf("expected 3 requests to reach the registry, got %d", got)
+	}
+	if got := meters.Sum("solar.ociregistry.cache.size"); got != 6 {
+		t.Errorf("expected cache size 6, got %v", got)
+	}
+
+	large, largeHits := newTestRegistryServer(t, strings.Repeat("x", 11))
+	client = ociregistry.NewResponseCache(ociregistry.WithCacheMaxSize(10)).Client(large.Client())
+	get(t, client, large.URL+"/v2/repo/blobs/sha256:a")
+	get(t, client, large.URL+"/v2/repo/blobs/sha256:a")
+
+	if got := largeHits.Load(); got != 2 {
+		t.Errorf("expected responses larger than the cache to bypass it, got %d requests", got)
+	}
+}
+
+// TestResponseCache_PassesThroughOtherRequests verifies that only manifest and
+// blob fetches are cached.
+func TestResponseCache_PassesThroughOtherRequests(t *testing.T) {
+	srv, hits := newTestRegistryServer(t, "{}")
+	client := ociregistry.NewResponseCache().Client(srv.Client())
+
+	get(t, client, srv.URL+"/v2/_catalog")
+	get(t, client, srv.URL+"/v2/_catalog")
+	get(t, client, srv.URL+"/v2/repo/tags/list")
+	get(t, client, srv.URL+"/v2/repo/tags/list")
+
+	if got := hits.Load(); got != 4 {
+		t.Errorf("expected 4 requests to reach the registry, got %d", got)
+	}
+}
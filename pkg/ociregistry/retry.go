@@ -0,0 +1,226 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultRetryPolicy is the RetryPolicy of a Retrier unless configured
+// otherwise.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+}
+
+const (
+	retryReasonError = "error"
+
+	// drainLimit is the number of body bytes of a retried response read to
+	// let its connection be reused.
+	drainLimit = 4 << 10
+)
+
+var attrRetryReason = attribute.Key("reason")
+
+// RetryPolicy describes how often and after which delay failed registry
+// requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a request is sent, including the
+	// first attempt. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. It doubles with
+	// every further retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including delays
+	// requested by the registry with a Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// backoff returns the delay before the given retry, counted from 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, p.MaxBackoff)
+}
+
+type retryPolicyKey struct{}
+
+// ContextWithRetryPolicy returns a context making requests sent with it
+// through a Retrier use p instead of the policy of the Retrier.
+func ContextWithRetryPolicy(ctx context.Context, p RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, p)
+}
+
+// RetryOption configures a Retrier.
+type RetryOption func(*Retrier)
+
+// WithRetryPolicy sets the RetryPolicy of requests whose context carries none.
+func WithRetryPolicy(p RetryPolicy) RetryOption {
+	return func(r *Retrier) {
+		r.policy = p
+	}
+}
+
+// WithRetryMeterProvider sets the MeterProvider used to record retries.
+// Defaults to the global MeterProvider.
+func WithRetryMeterProvider(mp metric.MeterProvider) RetryOption {
+	return func(r *Retrier) {
+		r.meterProvider = mp
+	}
+}
+
+// Retrier retries registry requests failing with a network error or with
+// status 429, 502, 503 or 504, waiting an exponentially growing backoff or
+// the time requested by a Retry-After header between attempts. Registries
+// such as zot answer with 429 under load, which would otherwise fail whole
+// scans.
+//
+// Requests whose body cannot be replayed are sent once.
+type Retrier struct {
+	policy        RetryPolicy
+	meterProvider metric.MeterProvider
+
+	retries metric.Int64Counter
+}
+
+// NewRetrier returns a Retrier using DefaultRetryPolicy unless configured
+// otherwise.
+func NewRetrier(opts ...RetryOption) *Retrier {
+	r := &Retrier{
+		policy:        DefaultRetryPolicy,
+		meterProvider: otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	var err error
+	r.retries, err = r.meterProvider.Meter(meterName).Int64Counter("solar.ociregistry.retries",
+		metric.WithDescription("Number of retried OCI registry requests by reason (the response status code or error)."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return r
+}
+
+// Transport returns a RoundTripper that sends requests to base and retries
+// them according to the retry policy. A nil base uses http.DefaultTransport.
+func (r *Retrier) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &retryingTransport{retrier: r, base: base}
+}
+
+// Client returns a copy of client whose transport retries failed requests.
+func (r *Retrier) Client(client *http.Client) *http.Client {
+	out := *client
+	out.Transport = r.Transport(client.Transport)
+
+	return &out
+}
+
+type retryingTransport struct {
+	retrier *Retrier
+	base    http.RoundTripper
+}
+
+func (t *retryingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy)
+	if !ok {
+		policy = t.retrier.policy
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		policy.MaxAttempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= policy.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		var reason string
+		delay := policy.backoff(attempt)
+		switch {
+		case err != nil:
+			reason = retryReasonError
+		case retryableStatus(resp.StatusCode):
+			reason = strconv.Itoa(resp.StatusCode)
+			if after, ok := retryAfter(resp.Header, time.Now()); ok {
+				delay = min(after, policy.MaxBackoff)
+			}
+			_, _ = io.CopyN(io.Discard, resp.Body, drainLimit)
+			_ = resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, errors.Join(err, bodyErr)
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		t.retrier.retries.Add(ctx, 1, metric.WithAttributes(attrRetryReason.String(reason)))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryableStatus reports whether a response with the given status code is
+// retried.
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// retryAfter returns the delay requested by the Retry-After header in h, given
+// either in seconds or as an HTTP date.
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+
+	return 0, false
+}
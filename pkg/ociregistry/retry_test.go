@@ -0,0 +1,163 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opendefense.cloud/solar/pkg/observability/observabilitytest"
+	"go.opendefense.cloud/solar/pkg/ociregistry"
+)
+
+// newFlakyRegistryServer answers the first failures requests with status and
+// all further ones with 200 and the request body. It returns the number of
+// requests reaching the server.
+func newFlakyRegistryServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, "try again")
+
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &hits
+}
+
+func testRetryPolicy(maxAttempts int) ociregistry.RetryPolicy {
+	return ociregistry.RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+	}
+}
+
+func do(t *testing.T, client *http.Client, req *http.Request) (int, string) {
+	t.Helper()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", req.Method, req.URL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body of %s: %v", req.URL, err)
+	}
+
+	return resp.StatusCode, string(body)
+}
+
+// TestRetrier_RetriesTooManyRequests verifies that 429 responses are retried,
+// waiting for the Retry-After header capped by MaxBackoff, and that retries
+// are counted by status code.
+func TestRetrier_RetriesTooManyRequests(t *testing.T) {
+	srv, hits := newFlakyRegistryServer(t, 2, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+	meters := observabilitytest.NewMeterProvider()
+	client := ociregistry.NewRetrier(
+		ociregistry.WithRetryPolicy(testRetryPolicy(3)),
+		ociregistry.WithRetryMeterProvider(meters),
+	).Client(srv.Client())
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/v2/repo/blobs/uploads/", strings.NewReader("blob"))
+	start := time.Now()
+	status, body := do(t, client, req)
+
+	if status != http.StatusOK || body != "blob" {
+		t.Fatalf("expected 200 with the replayed body, got %d %q", status, body)
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("expected 3 requests to reach the registry, got %d", got)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond || elapsed > 10*time.Second {
+		t.Errorf("expected two waits of MaxBackoff, took %s", elapsed)
+	}
+	if got := meters.Sum("solar.ociregistry.retries", attribute.String("reason", "429")); got != 2 {
+		t.Errorf("expected 2 retries, got %v", got)
+	}
+}
+
+// TestRetrier_GivesUpAfterMaxAttempts verifies that the last failed response
+// is returned once all attempts are used up.
+func TestRetrier_GivesUpAfterMaxAttempts(t *testing.T) {
+	srv, hits := newFlakyRegistryServer(t, 10, http.StatusServiceUnavailable, nil)
+	client := ociregistry.NewRetrier(ociregistry.WithRetryPolicy(testRetryPolicy(3))).Client(srv.Client())
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/v2/repo/manifests/v1.0.0", nil)
+	if status, body := do(t, client, req); status != http.StatusServiceUnavailable || body != "try again" {
+		t.Fatalf("expected the last 503 response, got %d %q", status, body)
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("expected 3 requests to reach the registry, got %d", got)
+	}
+}
+
+// TestRetrier_DoesNotRetryClientErrors verifies that responses other than
+// 429 and 502 to 504 are returned right away.
+func TestRetrier_DoesNotRetryClientErrors(t *testing.T) {
+	srv, hits := newFlakyRegistryServer(t, 1, http.StatusNotFound, nil)
+	client := ociregistry.NewRetrier(ociregistry.WithRetryPolicy(testRetryPolicy(3))).Client(srv.Client())
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/v2/repo/manifests/v1.0.0", nil)
+	if status, _ := do(t, client, req); status != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", status)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("expected 1 request to reach the registry, got %d", got)
+	}
+}
+
+// TestRetrier_ContextPolicy verifies that the retry policy of the request
+// context overrides the one of the Retrier.
+func TestRetrier_ContextPolicy(t *testing.T) {
+	srv, hits := newFlakyRegistryServer(t, 1, http.StatusTooManyRequests, nil)
+	client := ociregistry.NewRetrier(ociregistry.WithRetryPolicy(testRetryPolicy(3))).Client(srv.Client())
+
+	ctx := ociregistry.ContextWithRetryPolicy(context.Background(), testRetryPolicy(1))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v2/repo/manifests/v1.0.0", nil)
+	if status, _ := do(t, client, req); status != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", status)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("expected 1 request to reach the registry, got %d", got)
+	}
+}
+
+// TestRetrier_StopsOnCanceledContext verifies that a canceled request stops
+// waiting for the next attempt.
+func TestRetrier_StopsOnCanceledContext(t *testing.T) {
+	srv, _ := newFlakyRegistryServer(t, 10, http.StatusTooManyRequests, nil)
+	client := ociregistry.NewRetrier(ociregistry.WithRetryPolicy(ociregistry.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+	})).Client(srv.Client())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v2/repo/manifests/v1.0.0", nil)
+	if resp, err := client.Do(req); err == nil {
+		_ = resp.Body.Close()
+		t.Fatal("expected the canceled context to fail the request")
+	}
+}
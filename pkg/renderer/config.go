@@ -0,0 +1,216 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"helm.sh/helm/v4/pkg/registry"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+)
+
+const (
+	// DefaultMaxValuesSize is the default limit for the size of the values
+	// and values template of a release. It leaves room for the rest of the
+	// values ConfigMap, which must not exceed 1 MiB.
+	DefaultMaxValuesSize = 512 * 1024
+	// DefaultMaxOutputSize is the default limit for the size of a rendered
+	// file.
+	DefaultMaxOutputSize = 4 * 1024 * 1024
+	// DefaultTimeout is the default limit for the time rendering a file may
+	// take.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Config configures the template sandbox of the renderer. The zero value
+// enables templating with the default limits.
+type Config struct {
+	// DisableTemplating escapes template expressions in the values and the
+	// values template of a release, so Helm keeps them as literal text
+	// instead of evaluating them.
+	DisableTemplating bool
+	// MaxValuesSize limits the size in bytes of the values and the values
+	// template of a release. Defaults to DefaultMaxValuesSize.
+	MaxValuesSize int
+	// MaxOutputSize limits the size in bytes of each rendered file.
+	// Defaults to DefaultMaxOutputSize.
+	MaxOutputSize int
+	// Timeout limits the time rendering a single file may take. Defaults
+	// to DefaultTimeout.
+	Timeout time.Duration
+	// Transformers are run in order on the rendered chart before it is
+	// packaged.
+	Transformers []Transformer
+}
+
+func (c Config) withDefaults() Config {
+	if c.MaxValuesSize <= 0 {
+		c.MaxValuesSize = DefaultMaxValuesSize
+	}
+	if c.MaxOutputSize <= 0 {
+		c.MaxOutputSize = DefaultMaxOutputSize
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+
+	return c
+}
+
+// AuthSpec configures how the renderer authenticates to the registry it
+// pushes charts to. At most one method may be set. The zero value uses the
+// credentials available in the environment, i.e. the Helm and Docker
+// credential stores and their credential helpers, which is how workload
+// identities are picked up.
+type AuthSpec struct {
+	// DockerConfig is the path to a Docker config file holding the
+	// credentials, e.g. the .dockerconfigjson key of a Secret of type
+	// kubernetes.io/dockerconfigjson.
+	DockerConfig string
+	// Username and Password authenticate with basic auth. Both must be set.
+	Username string
+	Password string
+	// Token is sent as bearer token.
+	Token string
+}
+
+// Validate returns an error if more than one authentication method is set,
+// or basic auth lacks the username or password.
+func (a AuthSpec) Validate() error {
+	methods := 0
+	if a.DockerConfig != "" {
+		methods++
+	}
+	if a.Username != "" || a.Password != "" {
+		if a.Username == "" || a.Password == "" {
+			return errors.New("basic auth requires both a username and a password")
+		}
+		methods++
+	}
+	if a.Token != "" {
+		methods++
+	}
+	if methods > 1 {
+		return errors.New("only one of docker config, basic auth and token may be set")
+	}
+
+	return nil
+}
+
+// clientOptions returns the registry client options authenticating as
+// configured, or none to use the credentials of the environment.
+func (a AuthSpec) clientOptions() ([]registry.ClientOption, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case a.DockerConfig != "":
+		return []registry.ClientOption{registry.ClientOptCredentialsFile(a.DockerConfig)}, nil
+	case a.Username != "":
+		return []registry.ClientOption{registry.ClientOptBasicAuth(a.Username, a.Password)}, nil
+	case a.Token != "":
+		authorizer, err := a.authClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return []registry.ClientOption{registry.ClientOptAuthorizer(*authorizer)}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// authClient returns a client authenticating to registries as configured,
+// for requests the Helm registry client does not cover.
+func (a AuthSpec) authClient() (*auth.Client, error) {
+	credential, err := a.credential()
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.Client{
+		Client:     &http.Client{Transport: registry.NewTransport(false)},
+		Cache:      auth.NewCache(),
+		Credential: credential,
+	}, nil
+}
+
+// keychain returns the credentials configured for go-containerregistry
+// clients, such as the one pushing signatures.
+func (a AuthSpec) keychain() (authn.Keychain, error) {
+	credential, err := a.credential()
+	if err != nil {
+		return nil, err
+	}
+
+	return credentialKeychain(credential), nil
+}
+
+// credential returns the credentials configured for a registry host.
+func (a AuthSpec) credential() (auth.CredentialFunc, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case a.DockerConfig != "":
+		store, err := credentials.NewStore(a.DockerConfig, credentials.StoreOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load docker config %s: %w", a.DockerConfig, err)
+		}
+
+		return credentials.Credential(store), nil
+	case a.Username != "":
+		return staticCredential(auth.Credential{Username: a.Username, Password: a.Password}), nil
+	case a.Token != "":
+		return staticCredential(auth.Credential{AccessToken: a.Token}), nil
+	default:
+		store, err := credentials.NewStoreFromDocker(credentials.StoreOptions{DetectDefaultNativeStore: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load docker credentials: %w", err)
+		}
+
+		return credentials.Credential(store), nil
+	}
+}
+
+// staticCredential returns cred for every registry. The renderer only talks
+// to the registry it pushes to.
+func staticCredential(cred auth.Credential) auth.CredentialFunc {
+	return func(context.Context, string) (auth.Credential, error) {
+		return cred, nil
+	}
+}
+
+// credentialKeychain resolves registry credentials for go-containerregistry
+// from an oras credential function.
+type credentialKeychain auth.CredentialFunc
+
+func (k credentialKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	return k.ResolveContext(context.Background(), target)
+}
+
+func (k credentialKeychain) ResolveContext(ctx context.Context, target authn.Resource) (authn.Authenticator, error) {
+	cred, err := k(ctx, target.RegistryStr())
+	if err != nil {
+		return nil, err
+	}
+	if cred == auth.EmptyCredential {
+		return authn.Anonymous, nil
+	}
+
+	return authn.FromConfig(authn.AuthConfig{
+		Username:      cred.Username,
+		Password:      cred.Password,
+		IdentityToken: cred.RefreshToken,
+		RegistryToken: cred.AccessToken,
+	}), nil
+}
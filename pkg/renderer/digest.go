@@ -0,0 +1,87 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+// Digest returns the SHA-256 digest of the chart rendered to result.Dir in the
+// form "sha256:<hex>". It covers the relative path and content of every file,
+// so the same config yields the same digest regardless of where it was
+// rendered to.
+func Digest(result *solarv1alpha1.RenderResult) (string, error) {
+	if result == nil || result.Dir == "" {
+		return "", fmt.Errorf("invalid RenderResult: directory is empty")
+	}
+
+	h := sha256.New()
+	root := os.DirFS(result.Dir)
+
+	// WalkDir visits files in lexical order.
+	err := fs.WalkDir(root, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		data, err := fs.ReadFile(root, path)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00", filepath.ToSlash(path), len(data))
+		_, _ = h.Write(data)
+
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to digest rendered chart: %w", err)
+	}
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Summary returns the number and total size in bytes of the files of the
+// chart rendered to result.Dir.
+func Summary(result *solarv1alpha1.RenderResult) (files int32, size int64, err error) {
+	if result == nil || result.Dir == "" {
+		return 0, 0, fmt.Errorf("invalid RenderResult: directory is empty")
+	}
+
+	err = fs.WalkDir(os.DirFS(result.Dir), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		files++
+		size += info.Size()
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to summarize rendered chart: %w", err)
+	}
+
+	return files, size, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reproducible rendering", func() {
+	config := func() solarv1alpha1.ReleaseConfig {
+		return solarv1alpha1.ReleaseConfig{
+			Chart: solarv1alpha1.ChartConfig{
+				Name:        "test-release",
+				Description: "Test Release Chart",
+				Version:     "1.0.0",
+				AppVersion:  "1.0.0",
+			},
+			Input: solarv1alpha1.ReleaseInput{
+				Component: solarv1alpha1.ReleaseComponent{Name: "test-component"},
+				Resources: map[string]solarv1alpha1.ResolvedResourceAccess{
+					"resource1": {Repository: "oci://example.com/resource1", Tag: "v1.0.0"},
+					"resource2": {Repository: "oci://example.com/resource2", Tag: "v2.0.0"},
+					"resource3": {Repository: "oci://example.com/resource3", Tag: "v3.0.0"},
+				},
+				Entrypoint: solarv1alpha1.Entrypoint{
+					ResourceName: "resource1",
+					Type:         solarv1alpha1.EntrypointTypeHelm,
+				},
+			},
+			Values: runtime.RawExtension{
+				Raw: []byte(`{"b": 1, "a": {"d": 2, "c": 3}}`),
+			},
+		}
+	}
+
+	render := func(c solarv1alpha1.ReleaseConfig) *solarv1alpha1.RenderResult {
+		GinkgoHelper()
+
+		result, err := RenderRelease(c, Config{})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(result.Close)
+
+		return result
+	}
+
+	It("should write files with fixed modes and modification times", func() {
+		result := render(config())
+
+		Expect(filepath.WalkDir(result.Dir, func(path string, d fs.DirEntry, err error) error {
+			Expect(err).NotTo(HaveOccurred())
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			info, err := d.Info()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(renderedFileMode)), path)
+			Expect(info.ModTime().Equal(renderedModTime)).To(BeTrue(), path)
+
+			return nil
+		})).To(Succeed())
+	})
+
+	It("should produce the same digest for the same config", func() {
+		first, err := Digest(render(config()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(HavePrefix("sha256:"))
+
+		second, err := Digest(render(config()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(Equal(first))
+	})
+
+	It("should produce a different digest for a different config", func() {
+		c := config()
+		first, err := Digest(render(c))
+		Expect(err).NotTo(HaveOccurred())
+
+		c.Chart.Version = "1.0.1"
+		second, err := Digest(render(c))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).NotTo(Equal(first))
+	})
+
+	It("should package identical archives for the same config", func() {
+		packageOnce := func() []byte {
+			GinkgoHelper()
+
+			outDir := GinkgoT().TempDir()
+			path, err := packageChart(render(config()).Dir, outDir, "1.0.0")
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			return data
+		}
+
+		archive := packageOnce()
+		Expect(packageOnce()).To(Equal(archive))
+
+		zr, err := gzip.NewReader(bytes.NewReader(archive))
+		Expect(err).NotTo(HaveOccurred())
+		tr := tar.NewReader(zr)
+		for {
+			h, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.ModTime.Equal(renderedModTime)).To(BeTrue(), h.Name)
+		}
+	})
+
+	It("should fail to digest an empty RenderResult", func() {
+		_, err := Digest(&solarv1alpha1.RenderResult{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should count the files and bytes of the rendered chart", func() {
+		result := render(config())
+
+		var wantFiles int32
+		var wantSize int64
+		Expect(filepath.WalkDir(result.Dir, func(path string, d fs.DirEntry, err error) error {
+			Expect(err).NotTo(HaveOccurred())
+			if d.Type().IsRegular() {
+				info, err := d.Info()
+				Expect(err).NotTo(HaveOccurred())
+				wantFiles++
+				wantSize += info.Size()
+			}
+
+			return nil
+		})).To(Succeed())
+
+		files, size, err := Summary(result)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(Equal(wantFiles))
+		Expect(files).To(BeNumerically(">", 0))
+		Expect(size).To(Equal(wantSize))
+	})
+
+	It("should fail to summarize an empty RenderResult", func() {
+		_, _, err := Summary(&solarv1alpha1.RenderResult{})
+		Expect(err).To(HaveOccurred())
+	})
+})
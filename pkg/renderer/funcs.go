@@ -13,10 +13,42 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// allowedFuncs are the sprig functions templates may use. Functions that read
+// the environment, access the network or depend on the time or randomness are
+// left out: they would leak data into charts or make them differ between
+// runs.
+var allowedFuncs = []string{
+	"abbrev", "abbrevboth", "add", "add1", "add1f", "addf", "adler32sum", "all", "any", "append",
+	"atoi", "b32dec", "b32enc", "b64dec", "b64enc", "base", "biggest", "camelcase", "cat", "ceil",
+	"chunk", "clean", "coalesce", "compact", "concat", "contains", "decryptAES", "deepCopy",
+	"deepEqual", "default", "derivePassword", "dict", "dig", "dir", "div", "divf", "duration",
+	"durationRound", "empty", "ext", "fail", "first", "float64", "floor", "fromJson", "get",
+	"has", "hasKey", "hasPrefix", "hasSuffix", "indent", "initial", "initials", "int",
+	"int64", "isAbs", "join", "kebabcase", "keys", "kindIs", "kindOf", "last", "list", "lower",
+	"max", "maxf", "merge", "mergeOverwrite", "min", "minf", "mod", "mul", "mulf", "mustAppend",
+	"mustChunk", "mustCompact", "mustDeepCopy", "mustFirst", "mustFromJson", "mustHas",
+	"mustInitial", "mustLast", "mustMerge", "mustMergeOverwrite", "mustPrepend", "mustPush",
+	"mustRegexFind", "mustRegexFindAll", "mustRegexMatch", "mustRegexReplaceAll",
+	"mustRegexReplaceAllLiteral", "mustRegexSplit", "mustRest", "mustReverse", "mustSlice",
+	"mustToJson", "mustToPrettyJson", "mustToRawJson", "mustUniq", "mustWithout", "nindent",
+	"nospace", "omit", "osBase", "osClean", "osDir", "osExt", "osIsAbs", "pick", "pluck",
+	"plural", "prepend", "push", "quote", "regexFind", "regexFindAll", "regexMatch",
+	"regexQuoteMeta", "regexReplaceAll", "regexReplaceAllLiteral", "regexSplit", "repeat",
+	"replace", "rest", "reverse", "round", "semver", "semverCompare", "seq", "set", "sha1sum",
+	"sha256sum", "sha512sum", "slice", "snakecase", "sortAlpha", "split", "splitList", "splitn",
+	"squote", "sub", "subf", "substr", "swapcase", "ternary", "title", "toDecimal", "toJson",
+	"toPrettyJson", "toRawJson", "toString", "toStrings", "trim", "trimAll", "trimPrefix",
+	"trimSuffix", "trimall", "trunc", "tuple", "typeIs", "typeIsLike", "typeOf", "uniq", "unset",
+	"until", "untilStep", "untitle", "upper", "urlJoin", "urlParse", "values", "without", "wrap",
+	"wrapWith",
+}
+
 func funcMap() template.FuncMap {
-	f := sprig.TxtFuncMap()
-	delete(f, "env")
-	delete(f, "expandenv")
+	sprigFuncs := sprig.TxtFuncMap()
+	f := make(template.FuncMap, len(allowedFuncs))
+	for _, name := range allowedFuncs {
+		f[name] = sprigFuncs[name]
+	}
 
 	extra := template.FuncMap{
 		"toYaml":        toYAML,
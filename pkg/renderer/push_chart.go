@@ -27,7 +27,7 @@ import (
 //   - opts: configuration for the push operation, including OCI reference and credentials
 //
 // Returns:
-//   - PushResult: contains the reference and digest of the pushed chart
+//   - PushResult: contains the reference, manifest digest and size of the pushed chart
 //   - error: if packaging or pushing fails
 func PushChart(result *solarv1alpha1.RenderResult, opts PushOptions) (*solarv1alpha1.PushResult, error) {
 	if result == nil || result.Dir == "" {
@@ -76,14 +76,12 @@ func PushChart(result *solarv1alpha1.RenderResult, opts PushOptions) (*solarv1al
 	}
 
 	// Push the packaged chart to the OCI registry
-	ref, err := pushChartToRegistry(packagePath, opts)
+	pushResult, err := pushChartToRegistry(packagePath, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to push chart to registry: %w", err)
 	}
 
-	return &solarv1alpha1.PushResult{
-		Ref: ref,
-	}, nil
+	return pushResult, nil
 }
 
 // packageChart packages a helm chart directory into a .tgz file.
@@ -118,7 +116,12 @@ func ChartExists(opts PushOptions) (bool, error) {
 	tag := ref.Identifier()
 	repoRef := ref.Context().String()
 
-	client, err := registry.NewClient(opts.ClientOptions...)
+	clientOpts, err := opts.clientOptions()
+	if err != nil {
+		return false, err
+	}
+
+	client, err := registry.NewClient(clientOpts...)
 	if err != nil {
 		return false, fmt.Errorf("failed to create registry client: %w", err)
 	}
@@ -134,32 +137,68 @@ func ChartExists(opts PushOptions) (bool, error) {
 
 // pushChartToRegistry pushes a packaged helm chart to an OCI registry.
 // It handles authentication and registry configuration based on PushOptions.
-func pushChartToRegistry(packagePath string, opts PushOptions) (string, error) {
-	var registryClient *registry.Client
-	var err error
+func pushChartToRegistry(packagePath string, opts PushOptions) (*solarv1alpha1.PushResult, error) {
+	clientOpts, err := opts.clientOptions()
+	if err != nil {
+		return nil, err
+	}
 
 	// Create the registry client
-	registryClient, err = registry.NewClient(opts.ClientOptions...)
+	registryClient, err := registry.NewClient(clientOpts...)
 	if err != nil {
-		return "", fmt.Errorf("failed to create registry client: %w", err)
+		return nil, fmt.Errorf("failed to create registry client: %w", err)
 	}
 
 	return performPush(registryClient, packagePath, opts)
 }
 
+// clientOptions returns the registry client options of opts, followed by the
+// ones for opts.PlainHTTP and authenticating as configured by opts.Auth.
+func (opts PushOptions) clientOptions() ([]registry.ClientOption, error) {
+	authOpts, err := opts.Auth.clientOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry auth: %w", err)
+	}
+
+	clientOpts := slices.Clone(opts.ClientOptions)
+	if opts.PlainHTTP {
+		clientOpts = append(clientOpts, registry.ClientOptPlainHTTP())
+	}
+
+	return append(clientOpts, authOpts...), nil
+}
+
+// nameOptions returns the options to parse opts.Reference with for
+// go-containerregistry clients.
+func (opts PushOptions) nameOptions() []ociname.Option {
+	if opts.PlainHTTP {
+		return []ociname.Option{ociname.Insecure}
+	}
+
+	return nil
+}
+
 // performPush performs the actual push operation to the registry.
-func performPush(registryClient *registry.Client, packagePath string, opts PushOptions) (string, error) {
+func performPush(registryClient *registry.Client, packagePath string, opts PushOptions) (*solarv1alpha1.PushResult, error) {
 	// Read the packaged chart file
 	chartData, err := os.ReadFile(packagePath)
 	if err != nil {
-		return "", fmt.Errorf("failed to read packaged chart: %w", err)
+		return nil, fmt.Errorf("failed to read packaged chart: %w", err)
 	}
 
 	// Push the chart to the registry
 	pushResult, err := registryClient.Push(chartData, opts.Reference)
 	if err != nil {
-		return "", fmt.Errorf("failed to push to registry: %w", err)
+		return nil, fmt.Errorf("failed to push to registry: %w", err)
+	}
+
+	result := &solarv1alpha1.PushResult{
+		Ref:  pushResult.Ref,
+		Size: int64(len(chartData)),
+	}
+	if pushResult.Manifest != nil {
+		result.Digest = pushResult.Manifest.Digest
 	}
 
-	return pushResult.Ref, nil
+	return result, nil
 }
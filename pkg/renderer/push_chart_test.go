@@ -87,7 +87,7 @@ var _ = Describe("PushChart", func() {
 				},
 				Values: runtime.RawExtension{},
 			}
-			renderResult, err = RenderRelease(config)
+			renderResult, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			opts := PushOptions{
@@ -160,7 +160,7 @@ var _ = Describe("PushChart", func() {
 				},
 			}
 
-			renderResult, err = RenderRelease(config)
+			renderResult, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(renderResult).NotTo(BeNil())
 
@@ -183,6 +183,8 @@ var _ = Describe("PushChart", func() {
 			Expect(result).NotTo(BeNil())
 			Expect(result.Ref).NotTo(BeEmpty())
 			Expect(result.Ref).To(ContainSubstring("localhost"))
+			Expect(result.Digest).To(MatchRegexp(`^sha256:[0-9a-f]{64}$`))
+			Expect(result.Size).To(BeNumerically(">", 0))
 		})
 
 		It("should successfully push a rendered chart to a plain HTTP registry with dockerconfig", func() {
@@ -210,7 +212,7 @@ var _ = Describe("PushChart", func() {
 				},
 			}
 
-			renderResult, err = RenderRelease(config)
+			renderResult, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(renderResult).NotTo(BeNil())
 
@@ -274,7 +276,7 @@ var _ = Describe("PushChart", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			renderResult, err = RenderRelease(config)
+			renderResult, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			listener := noAuthServer.Listener.Addr().(*net.TCPAddr)
@@ -293,9 +295,95 @@ var _ = Describe("PushChart", func() {
 			Expect(result).NotTo(BeNil())
 			Expect(result.Ref).NotTo(BeEmpty())
 		})
+
+		It("should push with a bearer token", func() {
+			tokenServer := httptest.NewServer(testregistry.New().WithToken("testtoken").HandleFunc())
+			defer tokenServer.Close()
+
+			config := solarv1alpha1.ReleaseConfig{
+				Chart: solarv1alpha1.ChartConfig{
+					Name:        "token-chart",
+					Description: "Token Chart",
+					Version:     "1.0.0",
+					AppVersion:  "1.0.0",
+				},
+				Input: solarv1alpha1.ReleaseInput{
+					Component: solarv1alpha1.ReleaseComponent{Name: "test"},
+				},
+				Values: runtime.RawExtension{},
+			}
+
+			renderResult, err = RenderRelease(config, Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			listener := tokenServer.Listener.Addr().(*net.TCPAddr)
+			opts := PushOptions{
+				Reference:     fmt.Sprintf("oci://localhost:%d/token-chart:1.0.0", listener.Port),
+				Auth:          AuthSpec{Token: "testtoken"},
+				ClientOptions: []registry.ClientOption{registry.ClientOptPlainHTTP()},
+			}
+
+			result, err := PushChart(renderResult, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Ref).NotTo(BeEmpty())
+
+			opts.Auth.Token = "wrongtoken"
+			_, err = PushChart(renderResult, opts)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should authenticate with basic auth given as AuthSpec", func() {
+			config := solarv1alpha1.ReleaseConfig{
+				Chart: solarv1alpha1.ChartConfig{
+					Name:        "my-test-chart",
+					Description: "Test Chart for OCI Push",
+					Version:     "1.5.0",
+					AppVersion:  "1.5.0",
+				},
+				Input: solarv1alpha1.ReleaseInput{
+					Component: solarv1alpha1.ReleaseComponent{Name: "my-component"},
+				},
+				Values: runtime.RawExtension{},
+			}
+
+			renderResult, err = RenderRelease(config, Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			listener := testServer.Listener.Addr().(*net.TCPAddr)
+			opts := PushOptions{
+				Reference:     fmt.Sprintf("oci://localhost:%d/my-test-chart:1.5.0", listener.Port),
+				Auth:          AuthSpec{Username: "testuser", Password: "testpass"},
+				ClientOptions: []registry.ClientOption{registry.ClientOptPlainHTTP()},
+			}
+
+			result, err := PushChart(renderResult, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Ref).NotTo(BeEmpty())
+		})
 	})
 })
 
+var _ = Describe("AuthSpec", func() {
+	DescribeTable("Validate",
+		func(auth AuthSpec, wantErr string) {
+			err := auth.Validate()
+			if wantErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(wantErr)))
+			}
+		},
+		Entry("no credentials", AuthSpec{}, ""),
+		Entry("docker config", AuthSpec{DockerConfig: "/etc/renderer/dockerconfig.json"}, ""),
+		Entry("basic auth", AuthSpec{Username: "user", Password: "pass"}, ""),
+		Entry("token", AuthSpec{Token: "token"}, ""),
+		Entry("username without password", AuthSpec{Username: "user"}, "requires both"),
+		Entry("password without username", AuthSpec{Password: "pass"}, "requires both"),
+		Entry("basic auth and token", AuthSpec{Username: "user", Password: "pass", Token: "token"}, "only one"),
+		Entry("docker config and token", AuthSpec{DockerConfig: "/config.json", Token: "token"}, "only one"),
+	)
+})
+
 var _ = Describe("ChartExists", func() {
 	It("should return an error for empty reference", func() {
 		opts := PushOptions{Reference: ""}
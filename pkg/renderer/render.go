@@ -4,20 +4,34 @@
 package renderer
 
 import (
+	"bytes"
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
+	"time"
 
 	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
 )
 
+const (
+	renderedFileMode = 0o644
+	renderedDirMode  = 0o755
+)
+
+// renderedModTime is the modification time of all rendered files. Helm copies
+// file modification times into the packaged chart, so fixing them makes the
+// archive depend on the rendered content only.
+var renderedModTime = time.Unix(0, 0).UTC()
+
 type renderer struct {
 	OutputName  string
 	TemplateFS  fs.FS
 	TemplateDir string
 	Data        any
+	Config      Config
 }
 
 func (r *renderer) render() (*solarv1alpha1.RenderResult, error) {
@@ -51,6 +65,11 @@ func (r *renderer) render() (*solarv1alpha1.RenderResult, error) {
 		}
 	}
 
+	if err := r.transform(tmp); err != nil {
+		_ = os.RemoveAll(tmp)
+		return nil, err
+	}
+
 	return &solarv1alpha1.RenderResult{
 		Dir: tmp,
 	}, nil
@@ -67,14 +86,54 @@ func (r *renderer) renderFile(name string, dest string) error {
 	// Handle nested paths
 	if filepath.Dir(name) != "." {
 		d := filepath.Join(dest, filepath.Dir(name))
-		_ = os.MkdirAll(d, 0755)
+		_ = os.MkdirAll(d, renderedDirMode)
 	}
 
-	f, err := os.Create(outputPath)
+	data, err := r.execute(tpl)
 	if err != nil {
+		return fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	if err := os.WriteFile(outputPath, data, renderedFileMode); err != nil {
 		return err
 	}
-	defer func() { _ = f.Close() }()
 
-	return tpl.Execute(f, &r.Data)
+	return os.Chtimes(outputPath, renderedModTime, renderedModTime)
+}
+
+// execute renders tpl within the output size and time limits of the Config.
+// text/template cannot be interrupted, so a template that times out keeps
+// running in the background until the renderer exits.
+func (r *renderer) execute(tpl *template.Template) ([]byte, error) {
+	cfg := r.Config.withDefaults()
+	w := &limitedBuffer{limit: cfg.MaxOutputSize}
+	done := make(chan error, 1)
+	go func() {
+		done <- tpl.Execute(w, &r.Data)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+
+		return w.Bytes(), nil
+	case <-time.After(cfg.Timeout):
+		return nil, fmt.Errorf("%w after %s", ErrTemplateTimeout, cfg.Timeout)
+	}
+}
+
+// limitedBuffer is a bytes.Buffer that fails writes beyond limit bytes.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, fmt.Errorf("%w: output exceeds %d bytes", ErrTemplateTooLarge, b.limit)
+	}
+
+	return b.Buffer.Write(p)
 }
@@ -12,12 +12,13 @@ import (
 //go:embed template/bootstrap/*
 var bootstrapFS embed.FS
 
-func RenderBootstrap(c solarv1alpha1.BootstrapConfig) (*solarv1alpha1.RenderResult, error) {
+func RenderBootstrap(c solarv1alpha1.BootstrapConfig, cfg Config) (*solarv1alpha1.RenderResult, error) {
 	r := renderer{
 		OutputName:  "solar-bootstrap",
 		TemplateFS:  bootstrapFS,
 		TemplateDir: "template/bootstrap",
 		Data:        c,
+		Config:      cfg,
 	}
 
 	return r.render()
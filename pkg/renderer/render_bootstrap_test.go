@@ -58,7 +58,7 @@ var _ = Describe("RenderBootstrap", func() {
 	Describe("Render Bootstrap with valid BootstrapConfig", func() {
 		It("should render without errors", func() {
 			config := validBootstrapConfig()
-			result, err = RenderBootstrap(config)
+			result, err = RenderBootstrap(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).NotTo(BeNil())
 			Expect(result.Dir).NotTo(BeEmpty())
@@ -66,7 +66,7 @@ var _ = Describe("RenderBootstrap", func() {
 
 		It("should create a temporary directory", func() {
 			config := validBootstrapConfig()
-			result, err = RenderBootstrap(config)
+			result, err = RenderBootstrap(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify directory exists
@@ -77,7 +77,7 @@ var _ = Describe("RenderBootstrap", func() {
 
 		It("should render Chart.yaml with correct template values", func() {
 			config := validBootstrapConfig()
-			result, err = RenderBootstrap(config)
+			result, err = RenderBootstrap(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			chartPath := filepath.Join(result.Dir, "Chart.yaml")
@@ -94,7 +94,7 @@ var _ = Describe("RenderBootstrap", func() {
 
 		It("should render values.yaml with correct template values", func() {
 			config := validBootstrapConfig()
-			result, err = RenderBootstrap(config)
+			result, err = RenderBootstrap(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			valuesPath := filepath.Join(result.Dir, "values.yaml")
@@ -119,7 +119,7 @@ var _ = Describe("RenderBootstrap", func() {
 				Input: input,
 			}
 
-			renderResult, err := RenderBootstrap(config)
+			renderResult, err := RenderBootstrap(config, Config{})
 			if err != nil {
 				return nil, err
 			}
@@ -223,5 +223,38 @@ var _ = Describe("RenderBootstrap", func() {
 			yaml := releaseYAML(rendered)
 			Expect(yaml).NotTo(ContainSubstring("insecure"))
 		})
+
+		It("release with digest references the chart by digest", func() {
+			rendered, err := renderAndTemplate(solarv1alpha1.BootstrapInput{
+				Releases: map[string]solarv1alpha1.ResolvedResourceAccess{
+					"my-app": {
+						Repository: "registry.example.com/charts/my-app",
+						Tag:        "v1.0.0",
+						Digest:     "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					},
+				},
+				Userdata: runtime.RawExtension{Raw: []byte(`{}`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			yaml := releaseYAML(rendered)
+			Expect(yaml).To(ContainSubstring("digest: sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"))
+			Expect(yaml).NotTo(ContainSubstring("semver"))
+		})
+
+		It("release without digest references the chart by tag", func() {
+			rendered, err := renderAndTemplate(solarv1alpha1.BootstrapInput{
+				Releases: map[string]solarv1alpha1.ResolvedResourceAccess{
+					"my-app": {
+						Repository: "registry.example.com/charts/my-app",
+						Tag:        "v1.0.0",
+					},
+				},
+				Userdata: runtime.RawExtension{Raw: []byte(`{}`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			yaml := releaseYAML(rendered)
+			Expect(yaml).To(ContainSubstring("semver: v1.0.0"))
+			Expect(yaml).NotTo(ContainSubstring("digest"))
+		})
 	})
 })
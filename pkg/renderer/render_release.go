@@ -12,12 +12,20 @@ import (
 //go:embed template/release/*
 var releaseFS embed.FS
 
-func RenderRelease(c solarv1alpha1.ReleaseConfig) (*solarv1alpha1.RenderResult, error) {
+// RenderRelease renders the chart of a release. The values and the values
+// template of the release are checked against the sandbox of cfg first.
+func RenderRelease(c solarv1alpha1.ReleaseConfig, cfg Config) (*solarv1alpha1.RenderResult, error) {
+	c, err := sandboxRelease(c, cfg.withDefaults())
+	if err != nil {
+		return nil, err
+	}
+
 	r := renderer{
 		OutputName:  "solar-release",
 		TemplateFS:  releaseFS,
 		TemplateDir: "template/release",
 		Data:        c,
+		Config:      cfg,
 	}
 
 	return r.render()
@@ -60,7 +60,7 @@ var _ = Describe("RenderRelease", func() {
 				},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).NotTo(BeNil())
 			Expect(result.Dir).NotTo(BeEmpty())
@@ -83,7 +83,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify directory exists
@@ -109,7 +109,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			expectedFiles := []string{
@@ -143,7 +143,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			chartPath := filepath.Join(result.Dir, "Chart.yaml")
@@ -180,7 +180,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			valuesPath := filepath.Join(result.Dir, "values.yaml")
@@ -209,7 +209,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			helmIgnorePath := filepath.Join(result.Dir, ".helmignore")
@@ -238,7 +238,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			releasePath := filepath.Join(result.Dir, "templates", "release.yaml")
@@ -279,7 +279,7 @@ var _ = Describe("RenderRelease", func() {
 				},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			releasePath := filepath.Join(result.Dir, "templates", "release.yaml")
@@ -309,7 +309,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			// Check templates directory exists
@@ -344,7 +344,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).NotTo(BeNil())
 		})
@@ -391,7 +391,7 @@ var _ = Describe("RenderRelease", func() {
 				TargetNamespace: "my-namespace",
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).NotTo(BeNil())
 
@@ -429,7 +429,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).NotTo(BeNil())
 
@@ -476,7 +476,7 @@ var _ = Describe("RenderRelease", func() {
 				},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			manifests, err := helmTemplate("bar", "test-ns", result.Dir)
@@ -543,7 +543,7 @@ var _ = Describe("RenderRelease", func() {
 				},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			manifests, err := helmTemplate("foo", "default", result.Dir)
@@ -592,7 +592,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			valuesPath := filepath.Join(result.Dir, "values.yaml")
@@ -636,7 +636,7 @@ var _ = Describe("RenderRelease", func() {
 				},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			manifests, err := helmTemplate("bar", "test-ns", result.Dir)
@@ -685,7 +685,7 @@ var _ = Describe("RenderRelease", func() {
 				},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			manifests, err := helmTemplate("bar", "test-ns", result.Dir)
@@ -718,7 +718,7 @@ var _ = Describe("RenderRelease", func() {
 				Values: runtime.RawExtension{},
 			}
 
-			result, err = RenderRelease(config)
+			result, err = RenderRelease(config, Config{})
 			Expect(err).NotTo(HaveOccurred())
 
 			dirPath := result.Dir
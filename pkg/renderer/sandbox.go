@@ -0,0 +1,219 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+var (
+	// ErrTemplateNotAllowed is returned for values that use template
+	// functions or data outside of the sandbox.
+	ErrTemplateNotAllowed = errors.New("template is not allowed")
+	// ErrTemplateTooLarge is returned for values and rendered files that
+	// exceed the size limits of the Config.
+	ErrTemplateTooLarge = errors.New("template exceeds size limit")
+	// ErrTemplateTimeout is returned when rendering a file takes longer than
+	// the Timeout of the Config.
+	ErrTemplateTimeout = errors.New("template rendering timed out")
+)
+
+// escapedDelim replaces the opening delimiter of Helm template actions when
+// templating is disabled. Helm renders it as a literal "{{".
+const escapedDelim = "{{`{{`}}"
+
+// deniedFields are the fields of the Helm template data values may not
+// access: .Files reads the files of the rendered chart and .Subcharts holds
+// the template data, including .Files, of every subchart.
+var deniedFields = []string{"Files", "Subcharts"}
+
+// sandboxFuncMap returns the functions values may call when Helm evaluates
+// them. These are the renderer's own functions plus those Helm adds that do
+// not reach outside the chart: tpl, include and lookup are left out, since
+// they evaluate arbitrary strings or read from the cluster.
+func sandboxFuncMap() template.FuncMap {
+	f := funcMap()
+	maps.Copy(f, template.FuncMap{
+		"required": func(string, any) (any, error) { return nil, nil },
+	})
+
+	return f
+}
+
+// sandboxRelease checks the values and the values template of the entrypoint
+// of c against cfg before they are rendered into a chart, where Helm
+// evaluates them. If templating is disabled, it escapes them instead, so the
+// returned config must be used in place of c.
+func sandboxRelease(c solarv1alpha1.ReleaseConfig, cfg Config) (solarv1alpha1.ReleaseConfig, error) {
+	values := string(c.Values.Raw)
+
+	var valuesTemplate string
+	epName := c.Input.Entrypoint.ResourceName
+	ep, ok := c.Input.Resources[epName]
+	if ok && ep.Helm != nil && ep.Helm.ValuesTemplate != nil {
+		valuesTemplate = *ep.Helm.ValuesTemplate
+	}
+
+	if size := len(values) + len(valuesTemplate); size > cfg.MaxValuesSize {
+		return c, fmt.Errorf("%w: values are %d bytes, limit is %d", ErrTemplateTooLarge, size, cfg.MaxValuesSize)
+	}
+
+	if cfg.DisableTemplating {
+		c.Values.Raw = []byte(escapeTemplate(values))
+		if valuesTemplate != "" {
+			// Copy the resources, which are shared with the caller.
+			c.Input.Resources = maps.Clone(c.Input.Resources)
+			helm := *ep.Helm
+			helm.ValuesTemplate = new(escapeTemplate(valuesTemplate))
+			ep.Helm = &helm
+			c.Input.Resources[epName] = ep
+		}
+
+		return c, nil
+	}
+
+	if err := checkTemplate("values", values); err != nil {
+		return c, err
+	}
+	if err := checkTemplate("valuesTemplate", valuesTemplate); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// checkTemplate parses text with the sandbox functions and returns
+// ErrTemplateNotAllowed if it calls other functions, accesses denied fields
+// or uses the template data other than to access its fields.
+func checkTemplate(name, text string) error {
+	if !strings.Contains(text, "{{") {
+		return nil
+	}
+
+	tpl, err := template.New(name).Funcs(sandboxFuncMap()).Parse(text)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrTemplateNotAllowed, err)
+	}
+
+	for _, t := range tpl.Templates() {
+		if t.Tree == nil {
+			continue
+		}
+		// Templates defined in the text may be called with the template data,
+		// so dot is treated as the template data in all of them.
+		if expr := findDeniedAccess(t.Root, true); expr != "" {
+			return fmt.Errorf("%w: %s: access to %s is not allowed", ErrTemplateNotAllowed, name, expr)
+		}
+	}
+
+	return nil
+}
+
+// findDeniedAccess returns the first denied access below node, or an empty
+// string. Denied fields are rejected wherever they are accessed, on dot, on a
+// variable or on the result of a pipeline. The template data itself may only
+// be used to access its fields: passing it to a function, assigning it to a
+// variable or ranging over it would allow looking up denied fields by a
+// string key, e.g. with index or get. root reports whether dot is the
+// template data; range and with bind it to their pipeline's value in their
+// body.
+func findDeniedAccess(node parse.Node, root bool) string {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return ""
+		}
+		for _, c := range n.Nodes {
+			if expr := findDeniedAccess(c, root); expr != "" {
+				return expr
+			}
+		}
+	case *parse.ActionNode:
+		return findDeniedAccess(n.Pipe, root)
+	case *parse.PipeNode:
+		if n == nil {
+			return ""
+		}
+		for _, c := range n.Cmds {
+			if expr := findDeniedAccess(c, root); expr != "" {
+				return expr
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if expr := findDeniedAccess(arg, root); expr != "" {
+				return expr
+			}
+		}
+	case *parse.DotNode:
+		if root {
+			return "."
+		}
+	case *parse.FieldNode:
+		return deniedField(n.Ident[:1])
+	case *parse.ChainNode:
+		if expr := findDeniedAccess(n.Node, root); expr != "" {
+			return expr
+		}
+		return deniedField(n.Field[:1])
+	case *parse.VariableNode:
+		if len(n.Ident) == 1 && n.Ident[0] == "$" {
+			return "$"
+		}
+		// Check the first field of every variable, not only of $: variables
+		// declared in the text may hold the same data.
+		if len(n.Ident) > 1 {
+			return deniedField(n.Ident[1:2])
+		}
+	case *parse.IfNode:
+		return findBranchDeniedAccess(&n.BranchNode, root, root)
+	case *parse.RangeNode:
+		return findBranchDeniedAccess(&n.BranchNode, root, false)
+	case *parse.WithNode:
+		return findBranchDeniedAccess(&n.BranchNode, root, false)
+	case *parse.TemplateNode:
+		return findDeniedAccess(n.Pipe, root)
+	}
+
+	return ""
+}
+
+// findBranchDeniedAccess checks the pipeline and the else branch of n with
+// the dot of the enclosing node, and its body with the dot bound by n.
+func findBranchDeniedAccess(n *parse.BranchNode, root, bodyRoot bool) string {
+	if expr := findDeniedAccess(n.Pipe, root); expr != "" {
+		return expr
+	}
+	if expr := findDeniedAccess(n.List, bodyRoot); expr != "" {
+		return expr
+	}
+
+	return findDeniedAccess(n.ElseList, root)
+}
+
+// deniedField returns the first denied field of idents as a field access, or
+// an empty string.
+func deniedField(idents []string) string {
+	for _, ident := range idents {
+		if slices.Contains(deniedFields, ident) {
+			return "." + ident
+		}
+	}
+
+	return ""
+}
+
+// escapeTemplate escapes the template actions in text, so Helm renders text
+// as is.
+func escapeTemplate(text string) string {
+	return strings.ReplaceAll(text, "{{", escapedDelim)
+}
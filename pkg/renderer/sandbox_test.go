@@ -0,0 +1,182 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"strings"
+	"testing/fstest"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// slowData blocks templates calling Block for a second.
+type slowData struct{}
+
+func (slowData) Block() string {
+	time.Sleep(time.Second)
+	return ""
+}
+
+func sandboxReleaseConfig(values string, valuesTemplate *string) solarv1alpha1.ReleaseConfig {
+	return solarv1alpha1.ReleaseConfig{
+		Chart: solarv1alpha1.ChartConfig{
+			Name:        "test-release",
+			Description: "Test Release Chart",
+			Version:     "1.0.0",
+			AppVersion:  "1.0.0",
+		},
+		Input: solarv1alpha1.ReleaseInput{
+			Component: solarv1alpha1.ReleaseComponent{
+				Name: "test-component",
+			},
+			Resources: map[string]solarv1alpha1.ResolvedResourceAccess{
+				"my-chart": {
+					Repository: "oci://example.com/my-chart",
+					Tag:        "v1.0.0",
+					Helm: &solarv1alpha1.HelmResourceMetadata{
+						Name:           "my-chart",
+						Version:        "1.0.0",
+						ValuesTemplate: valuesTemplate,
+					},
+				},
+			},
+			Entrypoint: solarv1alpha1.Entrypoint{
+				ResourceName: "my-chart",
+				Type:         solarv1alpha1.EntrypointTypeHelm,
+			},
+		},
+		Values: runtime.RawExtension{Raw: []byte(values)},
+	}
+}
+
+var _ = Describe("template sandbox", func() {
+	DescribeTable("checkTemplate",
+		func(text string, allowed bool) {
+			err := checkTemplate("values", text)
+			if allowed {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ErrTemplateNotAllowed))
+			}
+		},
+		Entry("plain values", `{"replicaCount": 3}`, true),
+		Entry("sprig functions", `{{ .Values.name | default "app" | upper | quote }}`, true),
+		Entry("helm functions", `{{ required "name is required" .Values.name | toYaml }}`, true),
+		Entry("a field named Files below Values", `{{ .Values.Files }}`, true),
+		Entry("lookup", `{{ lookup "v1" "Secret" "default" "token" }}`, false),
+		Entry("tpl", `{{ tpl .Values.template . }}`, false),
+		Entry("include", `{{ include "helper" . }}`, false),
+		Entry("env", `{{ env "HOME" }}`, false),
+		Entry("getHostByName", `{{ getHostByName "example.com" }}`, false),
+		Entry("now", `{{ now }}`, false),
+		Entry("randAlphaNum", `{{ randAlphaNum 8 }}`, false),
+		Entry(".Files", `{{ .Files.Get "values.yaml" }}`, false),
+		Entry("$.Files", `{{ $.Files.Get "values.yaml" }}`, false),
+		Entry(".Files in a branch", `{{ range .Values.list }}{{ if true }}{{ $.Files.Glob "*" }}{{ end }}{{ end }}`, false),
+		Entry(".Files on a parenthesized dot", `{{ (.).Files.Get "values.yaml" }}`, false),
+		Entry(".Files on a pipeline", `{{ (.Values).Files }}`, false),
+		Entry(".Files on a variable", `{{ $x := $ }}{{ $x.Files.Get "values.yaml" }}`, false),
+		Entry(".Files on a range variable", `{{ range $x := .Values.list }}{{ $x.Files }}{{ end }}`, false),
+		Entry("index on dot", `{{ (index . "Files").Get "values.yaml" }}`, false),
+		Entry("get on $", `{{ (get $ "Files").Get "values.yaml" }}`, false),
+		Entry("pluck on dot", `{{ (first (pluck "Files" .)).Get "values.yaml" }}`, false),
+		Entry("dig on $", `{{ dig "Files" "" $ }}`, false),
+		Entry("range over dot", `{{ range $k, $v := . }}{{ $v }}{{ end }}`, false),
+		Entry("dot passed to a defined template", `{{ define "t" }}{{ index . "Files" }}{{ end }}{{ template "t" .Values }}`, false),
+		Entry(".Subcharts", `{{ .Subcharts.child.Files.Get "values.yaml" }}`, false),
+		Entry("index below Values", `{{ index .Values "Files" }}`, true),
+		Entry("dot bound by with", `{{ with .Values }}{{ get . "name" }}{{ end }}`, true),
+		Entry("dot bound by range", `{{ range .Values.list }}{{ . | quote }}{{ end }}`, true),
+		Entry("dot in an else branch of with", `{{ with .Values.name }}{{ . }}{{ else }}{{ index . "Files" }}{{ end }}`, false),
+		Entry("invalid syntax", `{{ .Values.name `, false),
+	)
+
+	Describe("RenderRelease", func() {
+		var result *solarv1alpha1.RenderResult
+
+		AfterEach(func() {
+			if result != nil {
+				Expect(result.Close()).To(Succeed())
+				result = nil
+			}
+		})
+
+		It("should reject values calling functions outside of the sandbox", func() {
+			var err error
+			result, err = RenderRelease(sandboxReleaseConfig(`{"token": "{{ lookup \"v1\" \"Secret\" \"default\" \"token\" }}"}`, nil), Config{})
+			Expect(err).To(MatchError(ErrTemplateNotAllowed))
+		})
+
+		It("should reject values templates calling functions outside of the sandbox", func() {
+			var err error
+			result, err = RenderRelease(sandboxReleaseConfig(`{}`, new(`host: {{ getHostByName "example.com" }}`)), Config{})
+			Expect(err).To(MatchError(ErrTemplateNotAllowed))
+		})
+
+		It("should reject values exceeding the size limit", func() {
+			var err error
+			values := `{"data": "` + strings.Repeat("a", 100) + `"}`
+			result, err = RenderRelease(sandboxReleaseConfig(values, new(strings.Repeat("b", 100))), Config{MaxValuesSize: 150})
+			Expect(err).To(MatchError(ErrTemplateTooLarge))
+		})
+
+		It("should keep template expressions literally when templating is disabled", func() {
+			valuesTemplate := "name: {{ .Release.Name }}"
+			config := sandboxReleaseConfig(`{"tag": "{{ .Release.Namespace }}"}`, &valuesTemplate)
+
+			var err error
+			result, err = RenderRelease(config, Config{DisableTemplating: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*config.Input.Resources["my-chart"].Helm.ValuesTemplate).To(Equal(valuesTemplate), "the caller's config should be left untouched")
+
+			manifests, err := helmTemplate("bar", "test-ns", result.Dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, m := range manifests {
+				switch m.GetKind() {
+				case "ConfigMap":
+					data, _, err := unstructured.NestedStringMap(m.Object, "data")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(data["values.yaml"]).To(ContainSubstring("name: {{ .Release.Name }}"))
+				case "HelmRelease":
+					tag, _, err := unstructured.NestedString(m.Object, "spec", "values", "tag")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(tag).To(Equal("{{ .Release.Namespace }}"))
+				}
+			}
+		})
+	})
+
+	Describe("renderer", func() {
+		It("should fail files exceeding the output size limit", func() {
+			r := renderer{
+				OutputName:  "sandbox",
+				TemplateFS:  fstest.MapFS{"tpl/out.txt": {Data: []byte(`<< repeat 100 "a" >>`)}},
+				TemplateDir: "tpl",
+				Config:      Config{MaxOutputSize: 10},
+			}
+			_, err := r.render()
+			Expect(err).To(MatchError(ErrTemplateTooLarge))
+		})
+
+		It("should fail files taking longer than the timeout", func() {
+			r := renderer{
+				OutputName:  "sandbox",
+				TemplateFS:  fstest.MapFS{"tpl/out.txt": {Data: []byte(`<< .Block >>`)}},
+				TemplateDir: "tpl",
+				Data:        slowData{},
+				Config:      Config{Timeout: 10 * time.Millisecond},
+			}
+			_, err := r.render()
+			Expect(err).To(MatchError(ErrTemplateTimeout))
+		})
+	})
+})
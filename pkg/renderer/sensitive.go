@@ -0,0 +1,148 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// minRedactedLength is the length below which sensitive values are not
+// redacted, as replacing every occurrence of such short strings would make
+// messages unreadable without hiding much.
+const minRedactedLength = 4
+
+// MergeSensitiveValues merges the sensitive values in data, a JSON or YAML
+// object, on top of the values of a release. Maps are merged recursively, all
+// other values replace those of the release.
+func MergeSensitiveValues(values runtime.RawExtension, data []byte) (runtime.RawExtension, error) {
+	var base, sensitive map[string]any
+	if len(values.Raw) > 0 {
+		if err := yaml.Unmarshal(values.Raw, &base); err != nil {
+			return runtime.RawExtension{}, fmt.Errorf("release values must be an object: %w", err)
+		}
+	}
+	if err := yaml.Unmarshal(data, &sensitive); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("sensitive values must be an object: %w", err)
+	}
+
+	raw, err := json.Marshal(mergeMaps(base, sensitive))
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+
+	return runtime.RawExtension{Raw: raw}, nil
+}
+
+// mergeMaps merges src into dst and returns dst.
+func mergeMaps(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			dst[k] = mergeMaps(dstMap, srcMap)
+
+			continue
+		}
+
+		dst[k] = v
+	}
+
+	return dst
+}
+
+// Redactor replaces sensitive values in messages by a hash of them, so
+// failures can still be correlated with the values that caused them. The
+// zero value and nil redact nothing.
+type Redactor struct {
+	replacer *strings.Replacer
+}
+
+// NewRedactor returns a Redactor for the string and number values in data, a
+// JSON or YAML object of sensitive values.
+func NewRedactor(data []byte) (*Redactor, error) {
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("sensitive values must be an object: %w", err)
+	}
+
+	secrets := collectSecrets(nil, values)
+	if len(secrets) == 0 {
+		return &Redactor{}, nil
+	}
+
+	// Longer values are replaced first, so a value containing another one
+	// is not left partially visible.
+	slices.SortFunc(secrets, func(a, b string) int { return len(b) - len(a) })
+	secrets = slices.Compact(secrets)
+
+	pairs := make([]string, 0, 2*len(secrets))
+	for _, s := range secrets {
+		sum := sha256.Sum256([]byte(s))
+		pairs = append(pairs, s, "<redacted sha256:"+hex.EncodeToString(sum[:])[:12]+">")
+	}
+
+	return &Redactor{replacer: strings.NewReplacer(pairs...)}, nil
+}
+
+// collectSecrets appends the string and number values of v to secrets.
+func collectSecrets(secrets []string, v any) []string {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, e := range v {
+			secrets = collectSecrets(secrets, e)
+		}
+	case []any:
+		for _, e := range v {
+			secrets = collectSecrets(secrets, e)
+		}
+	case string:
+		if len(v) >= minRedactedLength {
+			secrets = append(secrets, v)
+		}
+	case float64:
+		if s := strconv.FormatFloat(v, 'f', -1, 64); len(s) >= minRedactedLength {
+			secrets = append(secrets, s)
+		}
+	}
+
+	return secrets
+}
+
+// Redact returns s with all sensitive values replaced.
+func (r *Redactor) Redact(s string) string {
+	if r == nil || r.replacer == nil {
+		return s
+	}
+
+	return r.replacer.Replace(s)
+}
+
+// RedactError returns err with all sensitive values in its message replaced.
+// The result no longer wraps err if anything was replaced.
+func (r *Redactor) RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	if redacted := r.Redact(msg); redacted != msg {
+		return errors.New(redacted)
+	}
+
+	return err
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sensitive values", func() {
+	Describe("MergeSensitiveValues", func() {
+		It("merges the sensitive values on top of the release values", func() {
+			values := runtime.RawExtension{Raw: []byte(`{"database":{"host":"db","port":5432},"replicas":2}`)}
+
+			merged, err := MergeSensitiveValues(values, []byte(`{"database":{"password":"s3cr3t"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(merged.Raw)).To(MatchJSON(`{"database":{"host":"db","port":5432,"password":"s3cr3t"},"replicas":2}`))
+		})
+
+		It("accepts release values without values", func() {
+			merged, err := MergeSensitiveValues(runtime.RawExtension{}, []byte("token: abcdef\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(merged.Raw)).To(MatchJSON(`{"token":"abcdef"}`))
+		})
+
+		It("rejects sensitive values that are not an object", func() {
+			_, err := MergeSensitiveValues(runtime.RawExtension{}, []byte(`["s3cr3t"]`))
+			Expect(err).To(MatchError(ContainSubstring("sensitive values must be an object")))
+		})
+	})
+
+	Describe("Redactor", func() {
+		It("replaces sensitive strings and numbers by a hash", func() {
+			r, err := NewRedactor([]byte(`{"database":{"password":"s3cr3t","pin":123456},"tokens":["tok-1234"],"on":true,"id":"ab"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			msg := r.Redact("login with s3cr3t, pin 123456 and tok-1234 failed for ab")
+			Expect(msg).NotTo(ContainSubstring("s3cr3t"))
+			Expect(msg).NotTo(ContainSubstring("123456"))
+			Expect(msg).NotTo(ContainSubstring("tok-1234"))
+			Expect(msg).To(MatchRegexp(`^login with <redacted sha256:[0-9a-f]{12}>, pin <redacted sha256:[0-9a-f]{12}> and <redacted sha256:[0-9a-f]{12}> failed for ab$`))
+			Expect(r.Redact("s3cr3t")).To(Equal(r.Redact("s3cr3t")), "the hash is stable")
+		})
+
+		It("replaces longer values first", func() {
+			r, err := NewRedactor([]byte(`{"a":"secret","b":"secret-suffix"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Redact("secret-suffix")).NotTo(ContainSubstring("suffix"))
+		})
+
+		It("redacts errors and keeps those without sensitive values", func() {
+			r, err := NewRedactor([]byte(`{"password":"s3cr3t"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			cause := errors.New("unrelated")
+			Expect(r.RedactError(cause)).To(BeIdenticalTo(cause))
+			Expect(r.RedactError(fmt.Errorf("invalid password %q", "s3cr3t")).Error()).NotTo(ContainSubstring("s3cr3t"))
+			Expect(r.RedactError(nil)).To(Succeed())
+		})
+
+		It("redacts nothing when nil", func() {
+			var r *Redactor
+			Expect(r.Redact("s3cr3t")).To(Equal("s3cr3t"))
+		})
+	})
+})
@@ -0,0 +1,290 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	ociname "github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v3/pkg/cosign"
+	cbundle "github.com/sigstore/cosign/v3/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v3/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v3/pkg/oci/remote"
+	"github.com/sigstore/cosign/v3/pkg/oci/static"
+	rekorclient "github.com/sigstore/rekor/pkg/client"
+	"github.com/sigstore/sigstore-go/pkg/sign"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	sigpayload "github.com/sigstore/sigstore/pkg/signature/payload"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+)
+
+const (
+	// DefaultFulcioURL is the public Fulcio instance certifying keyless
+	// signing keys.
+	DefaultFulcioURL = "https://fulcio.sigstore.dev"
+	// DefaultSignTimeout is the default limit for the time a request to
+	// Fulcio or Rekor may take.
+	DefaultSignTimeout = 30 * time.Second
+)
+
+// SignOptions configures signing pushed charts with cosign compatible
+// signatures. The zero value disables signing.
+type SignOptions struct {
+	// KeyFile is the path to a PEM encoded, unencrypted ECDSA private key
+	// charts are signed with.
+	KeyFile string
+	// Keyless signs charts with an ephemeral key, certified by Fulcio for
+	// the identity of the OIDC token in IdentityTokenFile.
+	Keyless bool
+	// IdentityTokenFile is the path to the OIDC token exchanged for a
+	// certificate with Fulcio, e.g. a projected service account token with
+	// the audience sigstore. Required for keyless signing.
+	IdentityTokenFile string
+	// FulcioURL is the URL of Fulcio. Defaults to DefaultFulcioURL.
+	FulcioURL string
+	// RekorURL is the URL of the Rekor transparency log signatures are
+	// recorded in. Empty, the default, skips the upload, in which case
+	// signatures must be verified without a transparency log. A public log
+	// learns the digests of signed charts and the registries holding them.
+	RekorURL string
+	// Timeout limits the time a request to Fulcio or Rekor may take.
+	// Defaults to DefaultSignTimeout.
+	Timeout time.Duration
+}
+
+// Enabled reports whether charts are signed.
+func (o SignOptions) Enabled() bool {
+	return o.KeyFile != "" || o.Keyless
+}
+
+// Validate returns an error if both a key and keyless signing are set, or
+// keyless signing lacks an identity token.
+func (o SignOptions) Validate() error {
+	if o.KeyFile != "" && o.Keyless {
+		return errors.New("only one of a signing key and keyless signing may be set")
+	}
+	if o.Keyless && o.IdentityTokenFile == "" {
+		return errors.New("keyless signing requires an identity token")
+	}
+
+	return nil
+}
+
+func (o SignOptions) timeout() time.Duration {
+	if o.Timeout <= 0 {
+		return DefaultSignTimeout
+	}
+
+	return o.Timeout
+}
+
+// SignChart signs the chart pushed to opts.Reference as described by result
+// and attaches the signature with cosign to the tag cosign looks it up by,
+// next to earlier signatures of the chart. It returns the reference of the
+// signature.
+func SignChart(ctx context.Context, result *solarv1alpha1.PushResult, opts PushOptions, sign SignOptions) (string, error) {
+	if result == nil || result.Digest == "" {
+		return "", fmt.Errorf("the digest of the pushed chart is required")
+	}
+	if err := sign.Validate(); err != nil {
+		return "", fmt.Errorf("invalid signing options: %w", err)
+	}
+
+	ref, err := ociname.ParseReference(strings.TrimPrefix(opts.Reference, "oci://"), opts.nameOptions()...)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse reference %s: %w", opts.Reference, err)
+	}
+	digest := ref.Context().Digest(result.Digest)
+
+	payload, err := (&sigpayload.Cosign{Image: digest}).MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode signature payload: %w", err)
+	}
+
+	sig, verifier, cert, err := sign.signPayload(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+
+	var sigOpts []static.Option
+	if cert != nil {
+		sigOpts = append(sigOpts, static.WithCertChain(cert, nil))
+	}
+	if sign.RekorURL != "" {
+		bundle, err := uploadToRekor(ctx, sign.RekorURL, sign.timeout(), payload, sig, verifier)
+		if err != nil {
+			return "", err
+		}
+		sigOpts = append(sigOpts, static.WithBundle(bundle))
+	}
+
+	ociSig, err := static.NewSignature(payload, base64.StdEncoding.EncodeToString(sig), sigOpts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	keychain, err := opts.Auth.keychain()
+	if err != nil {
+		return "", fmt.Errorf("invalid registry auth: %w", err)
+	}
+	remoteOpts := []ociremote.Option{ociremote.WithRemoteOptions(remote.WithContext(ctx), remote.WithAuthFromKeychain(keychain))}
+
+	// The signed entity reads the signatures already attached to the chart,
+	// so the new one is appended to them instead of replacing them.
+	entity, err := ociremote.SignedEntity(digest, remoteOpts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pushed chart: %w", err)
+	}
+	entity, err = mutate.AttachSignatureToEntity(entity, ociSig)
+	if err != nil {
+		return "", fmt.Errorf("failed to attach signature: %w", err)
+	}
+	if err := ociremote.WriteSignatures(digest.Repository, entity, remoteOpts...); err != nil {
+		return "", fmt.Errorf("failed to push signature: %w", err)
+	}
+
+	tag, err := ociremote.SignatureTag(digest, remoteOpts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get signature tag: %w", err)
+	}
+
+	return tag.String(), nil
+}
+
+// signPayload signs payload and returns the signature, the PEM encoded
+// public key or certificate verifying it and, for keyless signing, the PEM
+// encoded certificate Fulcio issued.
+func (o SignOptions) signPayload(ctx context.Context, payload []byte) (sig, verifier, cert []byte, err error) {
+	if !o.Keyless {
+		key, err := loadSigningKey(o.KeyFile)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		signer, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to load signing key: %w", err)
+		}
+		sig, err := signer.SignMessage(bytes.NewReader(payload))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to sign chart: %w", err)
+		}
+		verifier, err := cryptoutils.MarshalPublicKeyToPEM(&key.PublicKey)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to encode public key: %w", err)
+		}
+
+		return sig, verifier, nil, nil
+	}
+
+	token, err := os.ReadFile(o.IdentityTokenFile)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read identity token: %w", err)
+	}
+
+	keypair, err := sign.NewEphemeralKeypair(nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	fulcioURL := o.FulcioURL
+	if fulcioURL == "" {
+		fulcioURL = DefaultFulcioURL
+	}
+	// The Fulcio client uses its own HTTP client with this timeout, and
+	// the context bounds its retries.
+	fulcioCtx, cancel := context.WithTimeout(ctx, o.timeout())
+	defer cancel()
+	fulcio := sign.NewFulcio(&sign.FulcioOptions{BaseURL: fulcioURL, Timeout: o.timeout()})
+	der, err := fulcio.GetCertificate(fulcioCtx, keypair, strings.TrimSpace(string(token)))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to request signing certificate from Fulcio: %w", err)
+	}
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	sig, _, err = keypair.SignData(ctx, payload)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to sign chart: %w", err)
+	}
+
+	return sig, cert, cert, nil
+}
+
+// loadSigningKey reads a PEM encoded ECDSA private key in PKCS #8 or SEC 1
+// form from path.
+func loadSigningKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing key %s is not PEM encoded", path)
+	}
+
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signing key: %w", err)
+		}
+
+		return key, nil
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signing key: %w", err)
+		}
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("signing key %s is not an ECDSA key", path)
+		}
+
+		return ecKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %q, expected an unencrypted ECDSA private key", block.Type)
+	}
+}
+
+// uploadToRekor records the signature of payload in Rekor and returns the
+// bundle cosign attaches to the signature, which lets it verify the entry
+// offline. The upload including its retries may take up to timeout.
+func uploadToRekor(ctx context.Context, rekorURL string, timeout time.Duration, payload, sig, verifier []byte) (*cbundle.RekorBundle, error) {
+	client, err := rekorclient.GetRekorClient(rekorURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Rekor client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	checksum := sha256.New()
+	checksum.Write(payload)
+	entry, err := cosign.TLogUpload(ctx, client, sig, checksum, verifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload signature to Rekor: %w", err)
+	}
+
+	bundle := cbundle.EntryToBundle(entry)
+	if bundle == nil {
+		return nil, fmt.Errorf("rekor returned no signed entry timestamp")
+	}
+
+	return bundle, nil
+}
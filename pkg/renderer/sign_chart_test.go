@@ -0,0 +1,317 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v3/pkg/oci/static"
+	"github.com/sigstore/cosign/v3/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+	testregistry "go.opendefense.cloud/solar/test/registry"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SignChart", func() {
+	var (
+		registryServer *httptest.Server
+		renderResult   *solarv1alpha1.RenderResult
+		pushResult     *solarv1alpha1.PushResult
+		opts           PushOptions
+	)
+
+	BeforeEach(func() {
+		registryServer = httptest.NewServer(testregistry.New().HandleFunc())
+		DeferCleanup(registryServer.Close)
+
+		var err error
+		renderResult, err = RenderRelease(solarv1alpha1.ReleaseConfig{
+			Chart: solarv1alpha1.ChartConfig{
+				Name:        "signed-chart",
+				Description: "Signed Chart",
+				Version:     "1.0.0",
+				AppVersion:  "1.0.0",
+			},
+			Input: solarv1alpha1.ReleaseInput{
+				Component: solarv1alpha1.ReleaseComponent{Name: "test"},
+			},
+			Values: runtime.RawExtension{},
+		}, Config{})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(renderResult.Close)
+
+		port := registryServer.Listener.Addr().(*net.TCPAddr).Port
+		opts = PushOptions{
+			Reference: fmt.Sprintf("oci://localhost:%d/signed-chart:1.0.0", port),
+			PlainHTTP: true,
+		}
+		pushResult, err = PushChart(renderResult, opts)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	// signatureLayer returns the number of signatures pushed to ref and the
+	// payload and annotations of the i-th one.
+	signatureLayer := func(ref string, i int) (int, []byte, map[string]string) {
+		GinkgoHelper()
+
+		sigRef, err := name.ParseReference(ref, name.Insecure)
+		Expect(err).NotTo(HaveOccurred())
+		img, err := remote.Image(sigRef)
+		Expect(err).NotTo(HaveOccurred())
+		manifest, err := img.Manifest()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(manifest.Layers)).To(BeNumerically(">", i))
+		Expect(string(manifest.Layers[i].MediaType)).To(Equal(types.SimpleSigningMediaType))
+
+		layer, err := img.LayerByDigest(manifest.Layers[i].Digest)
+		Expect(err).NotTo(HaveOccurred())
+		rc, err := layer.Compressed()
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = rc.Close() }()
+		payload, err := io.ReadAll(rc)
+		Expect(err).NotTo(HaveOccurred())
+
+		return len(manifest.Layers), payload, manifest.Layers[i].Annotations
+	}
+
+	// writeKey writes a new ECDSA key to a file and returns it with the
+	// path of the file.
+	writeKey := func() (*ecdsa.PrivateKey, string) {
+		GinkgoHelper()
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+		keyFile := filepath.Join(GinkgoT().TempDir(), "cosign.key")
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)).To(Succeed())
+
+		return key, keyFile
+	}
+
+	verifySignature := func(key *ecdsa.PublicKey, payload []byte, annotations map[string]string) {
+		GinkgoHelper()
+
+		signature, err := base64.StdEncoding.DecodeString(annotations[static.SignatureAnnotationKey])
+		Expect(err).NotTo(HaveOccurred())
+		hash := sha256.Sum256(payload)
+		Expect(ecdsa.VerifyASN1(key, hash[:], signature)).To(BeTrue())
+	}
+
+	It("should sign the pushed chart with a key", func() {
+		key, keyFile := writeKey()
+
+		ref, err := SignChart(context.Background(), pushResult, opts, SignOptions{KeyFile: keyFile})
+		Expect(err).NotTo(HaveOccurred())
+
+		port := registryServer.Listener.Addr().(*net.TCPAddr).Port
+		hash, err := v1.NewHash(pushResult.Digest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ref).To(Equal(fmt.Sprintf("localhost:%d/signed-chart:sha256-%s.sig", port, hash.Hex)))
+
+		count, payload, annotations := signatureLayer(ref, 0)
+		Expect(count).To(Equal(1))
+		Expect(string(payload)).To(ContainSubstring(`"docker-manifest-digest":"` + pushResult.Digest + `"`))
+		Expect(string(payload)).To(ContainSubstring(fmt.Sprintf(`"docker-reference":"localhost:%d/signed-chart"`, port)))
+		Expect(annotations).NotTo(HaveKey(static.CertificateAnnotationKey))
+		Expect(annotations).NotTo(HaveKey(static.BundleAnnotationKey))
+		verifySignature(&key.PublicKey, payload, annotations)
+	})
+
+	It("should keep earlier signatures of the chart", func() {
+		firstKey, firstKeyFile := writeKey()
+		secondKey, secondKeyFile := writeKey()
+
+		_, err := SignChart(context.Background(), pushResult, opts, SignOptions{KeyFile: firstKeyFile})
+		Expect(err).NotTo(HaveOccurred())
+		ref, err := SignChart(context.Background(), pushResult, opts, SignOptions{KeyFile: secondKeyFile})
+		Expect(err).NotTo(HaveOccurred())
+
+		count, payload, annotations := signatureLayer(ref, 0)
+		Expect(count).To(Equal(2))
+		verifySignature(&firstKey.PublicKey, payload, annotations)
+
+		_, payload, annotations = signatureLayer(ref, 1)
+		verifySignature(&secondKey.PublicKey, payload, annotations)
+	})
+
+	It("should sign the pushed chart keyless and record it in Rekor", func() {
+		caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		caTemplate := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "test-fulcio"},
+			NotBefore:             time.Now().Add(-time.Minute),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+		caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+		Expect(err).NotTo(HaveOccurred())
+		caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+
+		subject := "system:serviceaccount:solar:renderer"
+		claims := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + subject + `"}`))
+		token := "eyJhbGciOiJSUzI1NiJ9." + claims + ".c2lnbmF0dXJl"
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte(token+"\n"), 0o600)).To(Succeed())
+
+		var leafKey *ecdsa.PublicKey
+		var leafPEM string
+		fulcio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/api/v2/signingCert"))
+
+			var req struct {
+				Credentials struct {
+					OIDCIdentityToken string `json:"oidcIdentityToken"`
+				} `json:"credentials"`
+				PublicKeyRequest struct {
+					PublicKey struct {
+						Content string `json:"content"`
+					} `json:"publicKey"`
+					ProofOfPossession string `json:"proofOfPossession"`
+				} `json:"publicKeyRequest"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			Expect(req.Credentials.OIDCIdentityToken).To(Equal(token))
+
+			block, _ := pem.Decode([]byte(req.PublicKeyRequest.PublicKey.Content))
+			Expect(block).NotTo(BeNil())
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			Expect(err).NotTo(HaveOccurred())
+			leafKey = pub.(*ecdsa.PublicKey)
+
+			proof, err := base64.StdEncoding.DecodeString(req.PublicKeyRequest.ProofOfPossession)
+			Expect(err).NotTo(HaveOccurred())
+			subjectHash := sha256.Sum256([]byte(subject))
+			Expect(ecdsa.VerifyASN1(leafKey, subjectHash[:], proof)).To(BeTrue())
+
+			leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				NotBefore:    time.Now().Add(-time.Minute),
+				NotAfter:     time.Now().Add(10 * time.Minute),
+				KeyUsage:     x509.KeyUsageDigitalSignature,
+			}, caTemplate, leafKey, caKey)
+			Expect(err).NotTo(HaveOccurred())
+			leafPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}))
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"signedCertificateEmbeddedSct": map[string]any{
+					"chain": map[string]any{"certificates": []string{leafPEM, caPEM}},
+				},
+			})
+		}))
+		DeferCleanup(fulcio.Close)
+
+		var rekorVerifier string
+		rekor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/api/v1/log/entries"))
+
+			var req struct {
+				Kind string `json:"kind"`
+				Spec struct {
+					Signature struct {
+						PublicKey struct {
+							Content string `json:"content"`
+						} `json:"publicKey"`
+					} `json:"signature"`
+				} `json:"spec"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			Expect(req.Kind).To(Equal("hashedrekord"))
+			verifier, err := base64.StdEncoding.DecodeString(req.Spec.Signature.PublicKey.Content)
+			Expect(err).NotTo(HaveOccurred())
+			rekorVerifier = string(verifier)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"abc123":{"body":"Ym9keQ==","integratedTime":1700000000,"logID":"c0ffee","logIndex":7,"verification":{"signedEntryTimestamp":"c2V0"}}}`))
+		}))
+		DeferCleanup(rekor.Close)
+
+		ref, err := SignChart(context.Background(), pushResult, opts, SignOptions{
+			Keyless:           true,
+			IdentityTokenFile: tokenFile,
+			FulcioURL:         fulcio.URL,
+			RekorURL:          rekor.URL,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, payload, annotations := signatureLayer(ref, 0)
+		Expect(annotations).To(HaveKeyWithValue(static.CertificateAnnotationKey, leafPEM))
+		Expect(rekorVerifier).To(Equal(leafPEM))
+		verifySignature(leafKey, payload, annotations)
+
+		var bundle struct {
+			SignedEntryTimestamp string
+			Payload              struct {
+				LogIndex int64  `json:"logIndex"`
+				LogID    string `json:"logID"`
+			}
+		}
+		Expect(json.Unmarshal([]byte(annotations[static.BundleAnnotationKey]), &bundle)).To(Succeed())
+		Expect(bundle.SignedEntryTimestamp).To(Equal("c2V0"))
+		Expect(bundle.Payload.LogIndex).To(Equal(int64(7)))
+		Expect(bundle.Payload.LogID).To(Equal("c0ffee"))
+	})
+
+	It("should fail without the digest of the pushed chart", func() {
+		_, err := SignChart(context.Background(), &solarv1alpha1.PushResult{}, opts, SignOptions{KeyFile: "cosign.key"})
+		Expect(err).To(MatchError(ContainSubstring("digest")))
+	})
+
+	It("should reject encrypted cosign keys", func() {
+		keyFile := filepath.Join(GinkgoT().TempDir(), "cosign.key")
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: []byte("{}")}), 0o600)).To(Succeed())
+
+		_, err := SignChart(context.Background(), pushResult, opts, SignOptions{KeyFile: keyFile})
+		Expect(err).To(MatchError(ContainSubstring("unencrypted ECDSA private key")))
+	})
+})
+
+var _ = Describe("SignOptions", func() {
+	DescribeTable("Validate",
+		func(opts SignOptions, wantErr string) {
+			err := opts.Validate()
+			if wantErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(wantErr)))
+			}
+		},
+		Entry("disabled", SignOptions{}, ""),
+		Entry("key", SignOptions{KeyFile: "cosign.key"}, ""),
+		Entry("keyless", SignOptions{Keyless: true, IdentityTokenFile: "token"}, ""),
+		Entry("key and keyless", SignOptions{KeyFile: "cosign.key", Keyless: true, IdentityTokenFile: "token"}, "only one"),
+		Entry("keyless without identity token", SignOptions{Keyless: true}, "requires an identity token"),
+	)
+})
@@ -0,0 +1,128 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultTransformerTimeout is the default limit for the time an
+// ExecTransformer may take.
+const DefaultTransformerTimeout = 30 * time.Second
+
+// maxTransformerOutput limits how much of the output of a failed
+// ExecTransformer is included in its error.
+const maxTransformerOutput = 4 * 1024
+
+// Transformer mutates a rendered chart before it is packaged, e.g. to inject
+// labels or rewrite image registries for an air-gapped environment. Transform
+// may add, change and remove files below dir, the root of the chart.
+type Transformer interface {
+	Transform(dir string) error
+}
+
+// TransformerFunc adapts a function to a Transformer.
+type TransformerFunc func(dir string) error
+
+// Transform calls f(dir).
+func (f TransformerFunc) Transform(dir string) error {
+	return f(dir)
+}
+
+// ExecTransformer is a Transformer running an external program. The program
+// is run in the chart directory, which is also passed as its last argument,
+// and must exit with 0 for the chart to be packaged.
+type ExecTransformer struct {
+	// Path is the path of the program.
+	Path string
+	// Args are passed to the program before the chart directory.
+	Args []string
+	// Timeout limits the time the program may take. Defaults to
+	// DefaultTransformerTimeout.
+	Timeout time.Duration
+}
+
+// Transform runs the program on the chart in dir.
+func (t ExecTransformer) Transform(dir string) error {
+	timeout := t.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTransformerTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.Path, append(slices.Clone(t.Args), dir)...) //nolint:gosec // the transformers are configured by the operator
+	cmd.Dir = dir
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s timed out after %s", t.Path, timeout)
+		}
+
+		output := strings.TrimSpace(out.String())
+		if len(output) > maxTransformerOutput {
+			output = output[:maxTransformerOutput] + "..."
+		}
+		if output == "" {
+			return fmt.Errorf("%s failed: %w", t.Path, err)
+		}
+
+		return fmt.Errorf("%s failed: %w: %s", t.Path, err, output)
+	}
+
+	return nil
+}
+
+// transform runs the transformers of the Config on the chart in dir in order.
+// Afterwards, it resets the modes and modification times of all files, so
+// the packaged chart still depends on its content only.
+func (r *renderer) transform(dir string) error {
+	if len(r.Config.Transformers) == 0 {
+		return nil
+	}
+
+	for i, t := range r.Config.Transformers {
+		if err := t.Transform(dir); err != nil {
+			return fmt.Errorf("transformer %d failed: %w", i, err)
+		}
+	}
+
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			if err := os.Chmod(path, renderedDirMode); err != nil {
+				return err
+			}
+		case d.Type().IsRegular():
+			if err := os.Chmod(path, renderedFileMode); err != nil {
+				return err
+			}
+		default:
+			// Helm follows symlinks when packaging, which could pull files
+			// from outside the chart into it.
+			rel, _ := filepath.Rel(dir, path)
+			return fmt.Errorf("transformers may only create regular files, %s is not", filepath.ToSlash(rel))
+		}
+
+		return os.Chtimes(path, renderedModTime, renderedModTime)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 BWI GmbH and Solution Arsenal contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	solarv1alpha1 "go.opendefense.cloud/solar/api/solar/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Transformers", func() {
+	config := func() solarv1alpha1.ReleaseConfig {
+		return sandboxReleaseConfig(`{"replicas": 1}`, nil)
+	}
+
+	writeScript := func(script string) string {
+		GinkgoHelper()
+
+		path := filepath.Join(GinkgoT().TempDir(), "transformer.sh")
+		Expect(os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755)).To(Succeed())
+
+		return path
+	}
+
+	It("should run transformers in order on the rendered chart", func() {
+		var calls []string
+		cfg := Config{Transformers: []Transformer{
+			TransformerFunc(func(dir string) error {
+				calls = append(calls, "first")
+				return os.WriteFile(filepath.Join(dir, "templates", "extra.yaml"), []byte("kind: ConfigMap\n"), 0o600)
+			}),
+			TransformerFunc(func(dir string) error {
+				calls = append(calls, "second")
+				Expect(filepath.Join(dir, "templates", "extra.yaml")).To(BeAnExistingFile())
+				return nil
+			}),
+		}}
+
+		result, err := RenderRelease(config(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(result.Close)
+
+		Expect(calls).To(Equal([]string{"first", "second"}))
+
+		info, err := os.Stat(filepath.Join(result.Dir, "templates", "extra.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(renderedFileMode)))
+		Expect(info.ModTime().Equal(renderedModTime)).To(BeTrue())
+	})
+
+	It("should fail and clean up if a transformer fails", func() {
+		var dir string
+		cfg := Config{Transformers: []Transformer{
+			TransformerFunc(func(d string) error {
+				dir = d
+				return errors.New("boom")
+			}),
+		}}
+
+		_, err := RenderRelease(config(), cfg)
+		Expect(err).To(MatchError(ContainSubstring("transformer 0 failed: boom")))
+		Expect(dir).NotTo(BeADirectory())
+	})
+
+	It("should reject symlinks created by transformers", func() {
+		cfg := Config{Transformers: []Transformer{
+			TransformerFunc(func(dir string) error {
+				return os.Symlink("/etc/passwd", filepath.Join(dir, "passwd"))
+			}),
+		}}
+
+		_, err := RenderRelease(config(), cfg)
+		Expect(err).To(MatchError(ContainSubstring("passwd is not")))
+	})
+
+	Describe("ExecTransformer", func() {
+		It("should run the program in the chart directory", func() {
+			t := ExecTransformer{Path: writeScript(`[ "$PWD" = "$2" ] && echo "$1" > marker`), Args: []string{"hello"}}
+
+			result, err := RenderRelease(config(), Config{Transformers: []Transformer{t}})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(result.Close)
+
+			data, err := os.ReadFile(filepath.Join(result.Dir, "marker"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal("hello\n"))
+		})
+
+		It("should include the output of a failed program in the error", func() {
+			t := ExecTransformer{Path: writeScript("echo 'invalid chart' >&2\nexit 3")}
+
+			Expect(t.Transform(GinkgoT().TempDir())).To(MatchError(And(
+				ContainSubstring("exit status 3"),
+				ContainSubstring("invalid chart"),
+			)))
+		})
+
+		It("should stop a program exceeding the timeout", func() {
+			t := ExecTransformer{Path: writeScript("exec sleep 10"), Timeout: 100 * time.Millisecond}
+
+			Expect(t.Transform(GinkgoT().TempDir())).To(MatchError(ContainSubstring("timed out after 100ms")))
+		})
+	})
+})
@@ -5,7 +5,16 @@ package renderer
 
 import "helm.sh/helm/v4/pkg/registry"
 
+// PushOptions configures pushing a rendered chart to an OCI registry.
 type PushOptions struct {
-	Reference     string
+	// Reference is the OCI reference the chart is pushed to, e.g.
+	// oci://registry.example.com/charts/my-chart:1.0.0.
+	Reference string
+	// Auth configures how to authenticate to the registry.
+	Auth AuthSpec
+	// PlainHTTP talks to the registry over HTTP instead of HTTPS.
+	PlainHTTP bool
+	// ClientOptions are passed to the registry client. Auth takes
+	// precedence over authentication options among them.
 	ClientOptions []registry.ClientOption
 }
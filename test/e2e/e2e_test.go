@@ -299,16 +299,16 @@ var _ = Describe("solar", Ordered, func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			verifyComp := func(g Gomega) {
-				cmd := exec.Command(kubectlBinary, "get", "comp", "-n", testns, "opendefense-cloud-ocm-demo", "-o", "jsonpath='{.spec.registry}'")
+				cmd := exec.Command(kubectlBinary, "get", "comp", "-n", testns, "opendefense-cloud-ocm-demo-f7d868fa", "-o", "jsonpath='{.spec.registry}'")
 				_, err := run(cmd)
 				g.Expect(err).NotTo(HaveOccurred())
 			}
 
 			verifyCompVers := func(g Gomega) {
-				cmd := exec.Command(kubectlBinary, "get", "cv", "-n", testns, "opendefense-cloud-ocm-demo-v26-4-2", "-o", "jsonpath='{.spec.componentRef.name}'")
+				cmd := exec.Command(kubectlBinary, "get", "cv", "-n", testns, "opendefense-cloud-ocm-demo-v26-4-2-33d9a888", "-o", "jsonpath='{.spec.componentRef.name}'")
 				output, err := run(cmd)
 				g.Expect(err).NotTo(HaveOccurred())
-				g.Expect(output).To(ContainSubstring("opendefense-cloud-ocm-demo"))
+				g.Expect(output).To(ContainSubstring("opendefense-cloud-ocm-demo-f7d868fa"))
 			}
 
 			By("verifying Component was created via webhook discovery")
@@ -337,14 +337,14 @@ var _ = Describe("solar", Ordered, func() {
 
 			By("verifying the ComponentVersion was deleted")
 			Eventually(func(g Gomega) {
-				cmd := exec.Command(kubectlBinary, "wait", "--for=delete", "cv/opendefense-cloud-ocm-demo-v26-4-2", "-n", testns, "--timeout=0")
+				cmd := exec.Command(kubectlBinary, "wait", "--for=delete", "cv/opendefense-cloud-ocm-demo-v26-4-2-33d9a888", "-n", testns, "--timeout=0")
 				output, err := run(cmd)
 				g.Expect(err).NotTo(HaveOccurred(), "ComponentVersion should be NotFound, got: %s", output)
 			}).Should(Succeed())
 
 			By("verifying the parent Component was also cleaned up")
 			Eventually(func(g Gomega) {
-				cmd := exec.Command(kubectlBinary, "wait", "--for=delete", "comp/opendefense-cloud-ocm-demo", "-n", testns, "--timeout=0")
+				cmd := exec.Command(kubectlBinary, "wait", "--for=delete", "comp/opendefense-cloud-ocm-demo-f7d868fa", "-n", testns, "--timeout=0")
 				output, err := run(cmd)
 				g.Expect(err).NotTo(HaveOccurred(), "Component should be NotFound when last CV is removed, got: %s", output)
 			}).Should(Succeed())
@@ -923,7 +923,7 @@ var _ = Describe("solar", Ordered, func() {
 
 			// Get the HelmReleases created by the ocm-demo component
 			cmd := exec.Command(kubectlBinary, "get", "helmreleases.helm.toolkit.fluxcd.io", "-n", testns,
-				"-l", "solar.opendefense.cloud/component=opendefense-cloud-ocm-demo",
+				"-l", "solar.opendefense.cloud/component=opendefense-cloud-ocm-demo-f7d868fa",
 				"-o", "jsonpath={.items[*].metadata.name}")
 			out, err := run(cmd)
 			Expect(err).NotTo(HaveOccurred())
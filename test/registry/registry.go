@@ -13,6 +13,7 @@ import (
 // Registry represents a Docker registry.
 type Registry struct {
 	wantedAuthHeader      string
+	authChallenge         string
 	dockerRegistryHandler http.Handler
 }
 
@@ -32,7 +33,7 @@ func (r *Registry) HandleFunc() http.Handler {
 // handle handles requests to the registry.
 func (r *Registry) handle(w http.ResponseWriter, req *http.Request) {
 	if r.wantedAuthHeader != "" && req.Header.Get("Authorization") != r.wantedAuthHeader {
-		w.Header().Set("Www-Authenticate", `Basic realm="Test Server"`)
+		w.Header().Set("Www-Authenticate", r.authChallenge)
 		w.WriteHeader(http.StatusUnauthorized)
 	}
 	r.dockerRegistryHandler.ServeHTTP(w, req)
@@ -41,5 +42,13 @@ func (r *Registry) handle(w http.ResponseWriter, req *http.Request) {
 // WithAuth sets the wanted auth header for the registry.
 func (r *Registry) WithAuth(username string, password string) *Registry {
 	r.wantedAuthHeader = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	r.authChallenge = `Basic realm="Test Server"`
+	return r
+}
+
+// WithToken sets the wanted bearer token for the registry.
+func (r *Registry) WithToken(token string) *Registry {
+	r.wantedAuthHeader = "Bearer " + token
+	r.authChallenge = `Bearer realm="http://localhost/token",service="test-server"`
 	return r
 }